@@ -405,6 +405,7 @@ describe('getCompletionItems()', () => {
               "has.content(\${1:TODO}) ",
               "has.file(path:\${1:CHANGELOG} content:\${2:fix}) ",
               "has.topic(\${1}) ",
+              "depends.on(\${1:npm/react@^18}) ",
               "has.commit.after(\${1:1 month ago}) ",
               "has.description(\${1}) ",
               "has.tag(\${1}) ",
@@ -431,6 +432,7 @@ describe('getCompletionItems()', () => {
               "has.content(\${1:TODO}) ",
               "has.file(path:\${1:CHANGELOG} content:\${2:fix}) ",
               "has.topic(\${1}) ",
+              "depends.on(\${1:npm/react@^18}) ",
               "has.commit.after(\${1:1 month ago}) ",
               "has.description(\${1}) ",
               "has.tag(\${1}) ",
@@ -1010,6 +1010,7 @@ const decoratePredicateBody = (path: string[], body: string, offset: number): De
         case 'has.owner':
         case 'has.key':
         case 'has.topic':
+        case 'depends.on':
             return [
                 {
                     type: 'literal',
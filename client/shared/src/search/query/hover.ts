@@ -171,6 +171,8 @@ const toPredicateHover = (token: MetaPredicate): string => {
             return `**Built-in predicate**. Search only inside repositories that contain **file content** matching the regular expression \`${parameters}\`.`
         case 'has.topic':
             return `**Built-in predicate**. Search only inside repositories that have the github topic \`${parameters}\`.`
+        case 'depends.on':
+            return `**Built-in predicate**. Search only inside repositories that depend on the package \`${parameters}\`. A version range, e.g. \`npm/react@^18\`, follows the semver constraint syntax.`
         case 'contains.commit.after':
         case 'has.commit.after':
             return `**Built-in predicate**. Search only inside repositories that have been committed to since \`${parameters}\`.`
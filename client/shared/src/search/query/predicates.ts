@@ -43,6 +43,10 @@ export const PREDICATES: Access[] = [
                     { name: 'topic' },
                 ],
             },
+            {
+                name: 'depends',
+                fields: [{ name: 'on' }],
+            },
         ],
     },
     {
@@ -199,6 +203,12 @@ export const predicateCompletion = (field: string): Completion[] => {
                 description: 'Search only inside repositories that have a matching GitHub tag',
                 asSnippet: true,
             },
+            {
+                label: 'depends.on(...)',
+                insertText: 'depends.on(${1:npm/react@^18})',
+                description: 'Search only inside repositories that depend on a package, optionally within a semver range',
+                asSnippet: true,
+            },
             {
                 label: 'has.commit.after(...)',
                 insertText: 'has.commit.after(${1:1 month ago})',
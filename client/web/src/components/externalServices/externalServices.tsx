@@ -27,6 +27,7 @@ import gitlabSchemaJSON from '../../../../../schema/gitlab.schema.json'
 import gitoliteSchemaJSON from '../../../../../schema/gitolite.schema.json'
 import goModulesSchemaJSON from '../../../../../schema/go-modules.schema.json'
 import jvmPackagesSchemaJSON from '../../../../../schema/jvm-packages.schema.json'
+import mercurialSchemaJSON from '../../../../../schema/mercurial.schema.json'
 import npmPackagesSchemaJSON from '../../../../../schema/npm-packages.schema.json'
 import otherExternalServiceSchemaJSON from '../../../../../schema/other_external_service.schema.json'
 import pagureSchemaJSON from '../../../../../schema/pagure.schema.json'
@@ -1278,6 +1279,43 @@ const PAGURE: AddExternalServiceOptions = {
     editorActions: [],
 }
 
+const MERCURIAL: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.MERCURIAL,
+    title: 'Mercurial',
+    icon: GitIcon,
+    jsonSchema: mercurialSchemaJSON,
+    defaultDisplayName: 'Mercurial',
+    defaultConfig: `{
+  "url": "https://hg.example.com",
+  "repos": []
+}`,
+    instructions: (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>url</Field> to the base URL of your Mercurial server.
+                </li>
+                <li>
+                    Add the paths of the repositories to mirror to <Field>repos</Field>. Each repository is converted
+                    to Git on Sourcegraph, preserving its branches, bookmarks and tags.
+                </li>
+            </ol>
+        </div>
+    ),
+    editorActions: [
+        {
+            id: 'addRepo',
+            label: 'Add a repository',
+            run: (config: string) => {
+                const value = 'path/to/repository'
+                const edits = modify(config, ['repos', -1], value, defaultModificationOptions)
+                return { edits, selectText: value }
+            },
+        },
+    ],
+    status: 'experimental',
+}
+
 const GERRIT: AddExternalServiceOptions = {
     kind: ExternalServiceKind.GERRIT,
     title: 'Gerrit',
@@ -1514,6 +1552,7 @@ export const codeHostExternalServices: Record<string, AddExternalServiceOptions>
     gitolite: GITOLITE,
     git: GENERIC_GIT,
     gerrit: GERRIT,
+    mercurial: MERCURIAL,
     azuredevops: AZUREDEVOPS,
     ...(window.context?.experimentalFeatures?.pythonPackages === 'enabled' ? { pythonPackages: PYTHON_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rustPackages === 'enabled' ? { rustPackages: RUST_PACKAGES } : {}),
@@ -1547,6 +1586,7 @@ export const defaultExternalServices: Record<ExternalServiceKind, AddExternalSer
     [ExternalServiceKind.PERFORCE]: PERFORCE,
     [ExternalServiceKind.GERRIT]: GERRIT,
     [ExternalServiceKind.PAGURE]: PAGURE,
+    [ExternalServiceKind.MERCURIAL]: MERCURIAL,
     [ExternalServiceKind.GOMODULES]: GO_MODULES,
     [ExternalServiceKind.JVMPACKAGES]: JVM_PACKAGES,
     [ExternalServiceKind.NPMPACKAGES]: NPM_PACKAGES,
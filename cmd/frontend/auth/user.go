@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/usagestats"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -142,6 +143,8 @@ func GetAndSaveUser(ctx context.Context, db database.DB, op GetAndSaveUserOp) (u
 			// OK to continue, since this is a best-effort to improve the UX with some initial permissions available.
 		}
 
+		outbound.EnqueueEvent(ctx, logger, db, outbound.UserCreate, nil, outbound.NewUserPayload(user))
+
 		const eventName = "ExternalAuthSignupSucceeded"
 		args, err := json.Marshal(map[string]any{
 			// NOTE: The conventional name should be "service_type", but keeping as-is for
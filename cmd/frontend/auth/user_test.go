@@ -445,6 +445,7 @@ func TestGetAndSaveUser(t *testing.T) {
 		db.UserExternalAccountsFunc.SetDefaultReturn(externalAccountsStore)
 		db.AuthzFunc.SetDefaultReturn(database.NewMockAuthzStore())
 		db.EventLogsFunc.SetDefaultReturn(eventLogsStore)
+		db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 
 		_, _, err := GetAndSaveUser(
 			ctx,
@@ -632,6 +633,7 @@ func (m *mocks) DB() database.DB {
 	db.UsersFunc.SetDefaultReturn(users)
 	db.AuthzFunc.SetDefaultReturn(authzStore)
 	db.EventLogsFunc.SetDefaultReturn(database.NewMockEventLogStore())
+	db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 	return db
 }
 
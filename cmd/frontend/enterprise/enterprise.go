@@ -29,6 +29,7 @@ type Services struct {
 	BatchesChangesFileGetHandler    http.Handler
 	BatchesChangesFileExistsHandler http.Handler
 	BatchesChangesFileUploadHandler http.Handler
+	BatchesChangesExportHandler     http.Handler
 
 	// Repo related webhook handlers, currently only handle `push` events.
 	ReposGithubWebhook          webhooks.Registerer
@@ -44,6 +45,9 @@ type Services struct {
 	// Handler for completions stream.
 	NewCompletionsStreamHandler NewCompletionsStreamHandler
 
+	// Handler for importing vulnerability bundles into code intel sentinel.
+	VulnerabilityImportHandler http.Handler
+
 	PermissionsGitHubWebhook  webhooks.Registerer
 	NewCodeIntelUploadHandler NewCodeIntelUploadHandler
 	RankingService            RankingService
@@ -96,8 +100,10 @@ func DefaultServices() Services {
 		BatchesChangesFileGetHandler:    makeNotFoundHandler("batches file get handler"),
 		BatchesChangesFileExistsHandler: makeNotFoundHandler("batches file exists handler"),
 		BatchesChangesFileUploadHandler: makeNotFoundHandler("batches file upload handler"),
+		BatchesChangesExportHandler:     makeNotFoundHandler("batches export handler"),
 		SCIMHandler:                     makeNotFoundHandler("SCIM handler"),
 		NewCodeIntelUploadHandler:       func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
+		VulnerabilityImportHandler:      makeNotFoundHandler("code intel vulnerability import"),
 		RankingService:                  stubRankingService{},
 		NewExecutorProxyHandler:         func() http.Handler { return makeNotFoundHandler("executor proxy") },
 		NewGitHubAppSetupHandler:        func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
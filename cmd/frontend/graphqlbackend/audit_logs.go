@@ -0,0 +1,233 @@
+package graphqlbackend
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type auditLogEntriesArgs struct {
+	graphqlutil.ConnectionArgs
+	After  *string
+	Actor  *string
+	Entity *string
+	Action *string
+	Since  *gqlutil.DateTime
+	Until  *gqlutil.DateTime
+}
+
+// toListOpts transforms the GraphQL auditLogEntriesArgs into options that can
+// be provided to the AuditLogStore's Count and List methods.
+func (args *auditLogEntriesArgs) toListOpts() (database.AuditLogListOpts, error) {
+	opts := database.AuditLogListOpts{
+		Limit: 50,
+	}
+
+	if args.First != nil {
+		if *args.First < 1 {
+			return opts, errors.New("first must be a positive number")
+		}
+		opts.Limit = int(*args.First)
+	}
+
+	if args.After != nil {
+		var err error
+		opts.Cursor, err = strconv.ParseInt(*args.After, 10, 64)
+		if err != nil {
+			return opts, errors.Wrap(err, "parsing the after cursor")
+		}
+	}
+
+	if args.Actor != nil {
+		opts.Actor = *args.Actor
+	}
+	if args.Entity != nil {
+		opts.Entity = *args.Entity
+	}
+	if args.Action != nil {
+		opts.Action = *args.Action
+	}
+	if args.Since != nil {
+		opts.Since = args.Since.Time
+	}
+	if args.Until != nil {
+		opts.Until = args.Until.Time
+	}
+
+	return opts, nil
+}
+
+func (r *schemaResolver) AuditLogEntries(ctx context.Context, args *auditLogEntriesArgs) (*auditLogEntryConnectionResolver, error) {
+	// 🚨 SECURITY: Only site admins can read the audit log.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	opts, err := args.toListOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditLogEntryConnectionResolver{
+		store: r.db.AuditLogs(),
+		opts:  opts,
+	}, nil
+}
+
+type auditLogEntryConnectionResolver struct {
+	store database.AuditLogStore
+	opts  database.AuditLogListOpts
+
+	once    sync.Once
+	entries []*audit.Entry
+	next    int64
+	err     error
+}
+
+func (r *auditLogEntryConnectionResolver) Nodes(ctx context.Context) ([]*auditLogEntryResolver, error) {
+	entries, _, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*auditLogEntryResolver, len(entries))
+	for i, e := range entries {
+		nodes[i] = &auditLogEntryResolver{entry: e}
+	}
+	return nodes, nil
+}
+
+func (r *auditLogEntryConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	count, err := r.store.Count(ctx, r.opts)
+	return int32(count), err
+}
+
+func (r *auditLogEntryConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	_, next, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if next == 0 {
+		return graphqlutil.HasNextPage(false), nil
+	}
+	return graphqlutil.NextPageCursor(strconv.FormatInt(next, 10)), nil
+}
+
+func (r *auditLogEntryConnectionResolver) compute(ctx context.Context) ([]*audit.Entry, int64, error) {
+	r.once.Do(func() {
+		// Request one extra entry to know whether there is a next page.
+		opts := r.opts
+		opts.Limit++
+
+		r.entries, r.err = r.store.List(ctx, opts)
+		if r.err != nil {
+			return
+		}
+
+		if len(r.entries) > r.opts.Limit {
+			r.entries = r.entries[:r.opts.Limit]
+			r.next = r.entries[len(r.entries)-1].ID
+		}
+	})
+
+	return r.entries, r.next, r.err
+}
+
+type auditLogEntryResolver struct {
+	entry *audit.Entry
+}
+
+func (r *auditLogEntryResolver) AuditID() string {
+	return r.entry.AuditID
+}
+
+func (r *auditLogEntryResolver) Timestamp() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.entry.Timestamp}
+}
+
+func (r *auditLogEntryResolver) Actor() string {
+	return r.entry.Actor
+}
+
+func (r *auditLogEntryResolver) IP() string {
+	return r.entry.IP
+}
+
+func (r *auditLogEntryResolver) ForwardedFor() string {
+	return r.entry.ForwardedFor
+}
+
+func (r *auditLogEntryResolver) Entity() string {
+	return r.entry.Entity
+}
+
+func (r *auditLogEntryResolver) Action() string {
+	return r.entry.Action
+}
+
+func (r *auditLogEntryResolver) Fields() JSONValue {
+	return JSONValue{Value: r.entry.Fields}
+}
+
+func (r *auditLogEntryResolver) PrevHash() string {
+	return r.entry.PrevHash
+}
+
+func (r *auditLogEntryResolver) Hash() string {
+	return r.entry.Hash
+}
+
+func (r *schemaResolver) AuditLogVerification(ctx context.Context) (*auditLogVerificationResolver, error) {
+	// 🚨 SECURITY: Only site admins can read the audit log.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	head, err := loadAuditLogChainHead()
+	if err != nil {
+		return nil, errors.Wrap(err, "loading audit log chain head")
+	}
+
+	verification, err := r.db.AuditLogs().Verify(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLogVerificationResolver{verification: verification}, nil
+}
+
+// loadAuditLogChainHead is a variable so that tests can replace it.
+var loadAuditLogChainHead = audit.LoadChainHead
+
+type auditLogVerificationResolver struct {
+	verification *database.AuditLogVerification
+}
+
+func (r *auditLogVerificationResolver) Valid() bool {
+	return r.verification.Valid()
+}
+
+func (r *auditLogVerificationResolver) CheckedEntries() int32 {
+	return int32(r.verification.Checked)
+}
+
+func (r *auditLogVerificationResolver) BrokenEntry() *auditLogEntryResolver {
+	if r.verification.BrokenEntry == nil {
+		return nil
+	}
+	return &auditLogEntryResolver{entry: r.verification.BrokenEntry}
+}
+
+func (r *auditLogVerificationResolver) Reason() *string {
+	if r.verification.Valid() {
+		return nil
+	}
+	return &r.verification.Reason
+}
@@ -0,0 +1,218 @@
+package graphqlbackend
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+func TestAuditLogEntriesArgs(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			input auditLogEntriesArgs
+			want  database.AuditLogListOpts
+		}{
+			"no arguments": {
+				input: auditLogEntriesArgs{},
+				want:  database.AuditLogListOpts{Limit: 50},
+			},
+			"all arguments": {
+				input: auditLogEntriesArgs{
+					ConnectionArgs: graphqlutil.ConnectionArgs{First: int32Ptr(25)},
+					After:          stringPtr("40"),
+					Actor:          stringPtr("1"),
+					Entity:         stringPtr("gitserver"),
+					Action:         stringPtr("access"),
+					Since:          &gqlutil.DateTime{Time: now},
+					Until:          &gqlutil.DateTime{Time: later},
+				},
+				want: database.AuditLogListOpts{
+					Limit:  25,
+					Cursor: 40,
+					Actor:  "1",
+					Entity: "gitserver",
+					Action: "access",
+					Since:  now,
+					Until:  later,
+				},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				have, err := tc.input.toListOpts()
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, have)
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, input := range map[string]auditLogEntriesArgs{
+			"invalid cursor": {After: stringPtr("foo")},
+			"zero first":     {ConnectionArgs: graphqlutil.ConnectionArgs{First: int32Ptr(0)}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := input.toListOpts()
+				assert.NotNil(t, err)
+			})
+		}
+	})
+}
+
+func TestAuditLogEntries(t *testing.T) {
+	timestamp := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*audit.Entry{
+		{ID: 3, AuditID: "c", Timestamp: timestamp, Actor: "1", IP: "127.0.0.1", ForwardedFor: "unknown", Entity: "gitserver", Action: "access", Fields: json.RawMessage(`{"repo":"github.com/foo/bar"}`), PrevHash: "b", Hash: "c"},
+		{ID: 2, AuditID: "b", Timestamp: timestamp, Actor: "1", Entity: "gitserver", Action: "access", Fields: json.RawMessage(`{}`), PrevHash: "a", Hash: "b"},
+		{ID: 1, AuditID: "a", Timestamp: timestamp, Actor: "1", Entity: "gitserver", Action: "access", Fields: json.RawMessage(`{}`), Hash: "a"},
+	}
+
+	store := database.NewMockAuditLogStore()
+	store.ListFunc.SetDefaultHook(func(_ context.Context, opts database.AuditLogListOpts) ([]*audit.Entry, error) {
+		assert.Equal(t, database.AuditLogListOpts{Entity: "gitserver", Limit: 2}, opts)
+		return entries[:2], nil
+	})
+	store.CountFunc.SetDefaultReturn(3, nil)
+
+	query := `
+		{
+			auditLogEntries(first: 1, entity: "gitserver") {
+				nodes {
+					auditID
+					timestamp
+					actor
+					ip
+					forwardedFor
+					entity
+					action
+					fields
+					prevHash
+					hash
+				}
+				totalCount
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	t.Run("site admin", func(t *testing.T) {
+		db := database.NewMockDB()
+		db.AuditLogsFunc.SetDefaultReturn(store)
+		ctx, _, _ := fakeUser(t, context.Background(), db, true)
+
+		RunTest(t, &Test{
+			Context: ctx,
+			Schema:  mustParseGraphQLSchema(t, db),
+			Query:   query,
+			ExpectedResult: `
+				{
+					"auditLogEntries": {
+						"nodes": [
+							{
+								"auditID": "c",
+								"timestamp": "2023-03-01T12:00:00Z",
+								"actor": "1",
+								"ip": "127.0.0.1",
+								"forwardedFor": "unknown",
+								"entity": "gitserver",
+								"action": "access",
+								"fields": {"repo": "github.com/foo/bar"},
+								"prevHash": "b",
+								"hash": "c"
+							}
+						],
+						"totalCount": 3,
+						"pageInfo": {
+							"hasNextPage": true,
+							"endCursor": "3"
+						}
+					}
+				}
+			`,
+		})
+	})
+
+	t.Run("not site admin", func(t *testing.T) {
+		db := database.NewMockDB()
+		db.AuditLogsFunc.SetDefaultReturn(store)
+		ctx, _, _ := fakeUser(t, context.Background(), db, false)
+
+		RunTest(t, &Test{
+			Context:        ctx,
+			Schema:         mustParseGraphQLSchema(t, db),
+			Query:          query,
+			ExpectedResult: "null",
+			ExpectedErrors: []*gqlerrors.QueryError{
+				{
+					Message: auth.ErrMustBeSiteAdmin.Error(),
+					Path:    []any{"auditLogEntries"},
+				},
+			},
+		})
+	})
+}
+
+func TestAuditLogVerification(t *testing.T) {
+	head := &audit.ChainHead{ID: 50, Hash: "abcdef"}
+	loadAuditLogChainHead = func() (*audit.ChainHead, error) { return head, nil }
+	t.Cleanup(func() { loadAuditLogChainHead = audit.LoadChainHead })
+
+	store := database.NewMockAuditLogStore()
+	store.VerifyFunc.SetDefaultReturn(&database.AuditLogVerification{
+		Checked:     41,
+		BrokenEntry: &audit.Entry{ID: 42, AuditID: "abc", Fields: json.RawMessage(`{}`)},
+		Reason:      "audit log entry 42 does not match its hash: the entry was modified",
+	}, nil)
+
+	db := database.NewMockDB()
+	db.AuditLogsFunc.SetDefaultReturn(store)
+	ctx, _, _ := fakeUser(t, context.Background(), db, true)
+
+	RunTest(t, &Test{
+		Context: ctx,
+		Schema:  mustParseGraphQLSchema(t, db),
+		Query: `
+			{
+				auditLogVerification {
+					valid
+					checkedEntries
+					brokenEntry {
+						auditID
+					}
+					reason
+				}
+			}
+		`,
+		ExpectedResult: `
+			{
+				"auditLogVerification": {
+					"valid": false,
+					"checkedEntries": 41,
+					"brokenEntry": {
+						"auditID": "abc"
+					},
+					"reason": "audit log entry 42 does not match its hash: the entry was modified"
+				}
+			}
+		`,
+	})
+
+	if history := store.VerifyFunc.History(); len(history) != 1 || history[0].Arg1 != head {
+		t.Errorf("expected the audit log to be verified against the chain head")
+	}
+}
@@ -44,6 +44,14 @@ type CloseBatchChangeArgs struct {
 	CloseChangesets bool
 }
 
+type PauseBatchChangeRolloutArgs struct {
+	BatchChange graphql.ID
+}
+
+type ResumeBatchChangeRolloutArgs struct {
+	BatchChange graphql.ID
+}
+
 type MoveBatchChangeArgs struct {
 	BatchChange  graphql.ID
 	NewName      *string
@@ -269,6 +277,8 @@ type BatchChangesResolver interface {
 
 	ApplyBatchChange(ctx context.Context, args *ApplyBatchChangeArgs) (BatchChangeResolver, error)
 	CloseBatchChange(ctx context.Context, args *CloseBatchChangeArgs) (BatchChangeResolver, error)
+	PauseBatchChangeRollout(ctx context.Context, args *PauseBatchChangeRolloutArgs) (BatchChangeResolver, error)
+	ResumeBatchChangeRollout(ctx context.Context, args *ResumeBatchChangeRolloutArgs) (BatchChangeResolver, error)
 	MoveBatchChange(ctx context.Context, args *MoveBatchChangeArgs) (BatchChangeResolver, error)
 	DeleteBatchChange(ctx context.Context, args *DeleteBatchChangeArgs) (*EmptyResponse, error)
 	CreateBatchChangesCredential(ctx context.Context, args *CreateBatchChangesCredentialArgs) (BatchChangesCredentialResolver, error)
@@ -660,10 +670,18 @@ type BatchChangeResolver interface {
 	ClosedAt() *gqlutil.DateTime
 	DiffStat(ctx context.Context) (*DiffStat, error)
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
+	Rollout(ctx context.Context) (BatchChangeRolloutResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
 	BatchSpecs(ctx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
 }
 
+type BatchChangeRolloutResolver interface {
+	State() string
+	CurrentWave() int32
+	WaveCount() int32
+	PauseReason() *string
+}
+
 type BatchChangesConnectionResolver interface {
 	Nodes(ctx context.Context) ([]BatchChangeResolver, error)
 	TotalCount(ctx context.Context) (int32, error)
@@ -11,6 +11,7 @@ import (
 
 type EmbeddingsResolver interface {
 	EmbeddingsSearch(ctx context.Context, args EmbeddingsSearchInputArgs) (EmbeddingsSearchResultsResolver, error)
+	EmbeddingsMultiSearch(ctx context.Context, args EmbeddingsMultiSearchInputArgs) (EmbeddingsSearchResultsResolver, error)
 	IsContextRequiredForChatQuery(ctx context.Context, args IsContextRequiredForChatQueryInputArgs) (bool, error)
 	RepoEmbeddingJobs(ctx context.Context, args ListRepoEmbeddingJobsArgs) (*graphqlutil.ConnectionResolver[RepoEmbeddingJobResolver], error)
 
@@ -33,16 +34,29 @@ type EmbeddingsSearchInputArgs struct {
 	TextResultsCount int32
 }
 
+type EmbeddingsMultiSearchInputArgs struct {
+	Repos            *[]graphql.ID
+	SearchContext    *string
+	Query            string
+	CodeResultsCount int32
+	TextResultsCount int32
+}
+
 type EmbeddingsSearchResultsResolver interface {
 	CodeResults(ctx context.Context) []EmbeddingsSearchResultResolver
 	TextResults(ctx context.Context) []EmbeddingsSearchResultResolver
 }
 
 type EmbeddingsSearchResultResolver interface {
+	RepoName(ctx context.Context) string
+	Revision(ctx context.Context) string
 	FileName(ctx context.Context) string
 	StartLine(ctx context.Context) int32
 	EndLine(ctx context.Context) int32
 	Content(ctx context.Context) string
+	SymbolName(ctx context.Context) *string
+	SymbolKind(ctx context.Context) *string
+	Score(ctx context.Context) float64
 }
 
 type ListRepoEmbeddingJobsArgs struct {
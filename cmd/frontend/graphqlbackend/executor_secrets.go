@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -288,7 +289,9 @@ func checkNamespaceAccess(ctx context.Context, db database.DB, namespaceUserID,
 		return auth.CheckOrgAccessOrSiteAdmin(ctx, db, namespaceOrgID)
 	}
 
-	return auth.CheckCurrentUserIsSiteAdmin(ctx, db)
+	// Global secrets can be managed by site admins and users with a role that
+	// grants the executor secrets permission.
+	return rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, db, rbac.ExecutorSecretsWritePermission)
 }
 
 // validateExecutorSecret validates that the secret value is non-empty and if the
@@ -16,6 +16,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -33,6 +35,34 @@ func TestSchemaResolver_CreateExecutorSecret(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// Non-admins can manage global secrets if one of their roles grants the
+	// executor secrets permission.
+	nonAdmin, err := db.Users().Create(ctx, database.NewUser{Username: "test-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretsAdmin, err := db.Users().Create(ctx, database.NewUser{Username: "test-3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	role, err := db.Roles().Create(ctx, "EXECUTOR-SECRETS-ADMIN", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	permission, err := db.Permissions().Create(ctx, database.CreatePermissionOpts{
+		Namespace: types.ExecutorSecretsNamespace,
+		Action:    "WRITE",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RolePermissions().Assign(ctx, database.AssignRolePermissionOpts{RoleID: role.ID, PermissionID: permission.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UserRoles().Assign(ctx, database.AssignUserRoleOpts{UserID: secretsAdmin.ID, RoleID: role.ID}); err != nil {
+		t.Fatal(err)
+	}
+
 	gqlIDPtr := func(id graphql.ID) *graphql.ID { return &id }
 
 	tts := []struct {
@@ -90,6 +120,25 @@ func TestSchemaResolver_CreateExecutorSecret(t *testing.T) {
 			},
 			actor: actor.FromUser(user.ID),
 		},
+		{
+			name: "Create global secret without permission",
+			args: CreateExecutorSecretArgs{
+				Key:   "NPM_TOKEN",
+				Value: "1234",
+				Scope: ExecutorSecretScopeBatches,
+			},
+			actor:   actor.FromUser(nonAdmin.ID),
+			wantErr: &rbac.ErrNotAuthorized{Permission: rbac.ExecutorSecretsWritePermission},
+		},
+		{
+			name: "Create global secret with permission",
+			args: CreateExecutorSecretArgs{
+				Key:   "NPM_TOKEN",
+				Value: "1234",
+				Scope: ExecutorSecretScopeBatches,
+			},
+			actor: actor.FromUser(secretsAdmin.ID),
+		},
 	}
 
 	for _, tt := range tts {
@@ -425,7 +425,7 @@ func NewSchema(
 	optional OptionalResolver,
 ) (*graphql.Schema, error) {
 	resolver := newSchemaResolver(db, gitserverClient, enterpriseJobs)
-	schemas := []string{mainSchema, outboundWebhooksSchema}
+	schemas := []string{mainSchema, outboundWebhooksSchema, auditLogsSchema}
 
 	if batchChanges := optional.BatchChangesResolver; batchChanges != nil {
 		EnterpriseResolvers.batchChangesResolver = batchChanges
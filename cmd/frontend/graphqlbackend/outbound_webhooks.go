@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/encryption"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/syncx"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
@@ -30,6 +31,14 @@ type OutboundWebhookConnectionResolver interface {
 type OutboundWebhookEventTypeResolver interface {
 	Key() string
 	Description() string
+	Scope() *string
+	Payload() []OutboundWebhookEventPayloadFieldResolver
+}
+
+type OutboundWebhookEventPayloadFieldResolver interface {
+	Name() string
+	Type() string
+	Description() string
 }
 
 type OutboundWebhookResolver interface {
@@ -87,7 +96,7 @@ type OutboundWebhookUpdateInput struct {
 }
 
 func (r *schemaResolver) OutboundWebhooks(ctx context.Context, args ListOutboundWebhooksArgs) (OutboundWebhookConnectionResolver, error) {
-	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+	if err := rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, r.db, rbac.OutboundWebhooksReadPermission); err != nil {
 		return nil, err
 	}
 
@@ -111,7 +120,7 @@ func (r *schemaResolver) OutboundWebhooks(ctx context.Context, args ListOutbound
 }
 
 func (r *schemaResolver) OutboundWebhookEventTypes(ctx context.Context) ([]OutboundWebhookEventTypeResolver, error) {
-	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+	if err := rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, r.db, rbac.OutboundWebhooksReadPermission); err != nil {
 		return nil, err
 	}
 
@@ -128,12 +137,15 @@ func (r *schemaResolver) OutboundWebhookEventTypes(ctx context.Context) ([]Outbo
 }
 
 func (r *schemaResolver) CreateOutboundWebhook(ctx context.Context, args CreateOutboundWebhookArgs) (OutboundWebhookResolver, error) {
+	if err := rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, r.db, rbac.OutboundWebhooksWritePermission); err != nil {
+		return nil, err
+	}
 	user, err := auth.CurrentUser(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
-	if !user.SiteAdmin {
-		return nil, auth.ErrMustBeSiteAdmin
+	if user == nil {
+		return nil, auth.ErrNotAuthenticated
 	}
 
 	// Validate the URL
@@ -159,7 +171,7 @@ func (r *schemaResolver) CreateOutboundWebhook(ctx context.Context, args CreateO
 }
 
 func (r *schemaResolver) DeleteOutboundWebhook(ctx context.Context, args DeleteOutboundWebhookArgs) (*EmptyResponse, error) {
-	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+	if err := rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, r.db, rbac.OutboundWebhooksWritePermission); err != nil {
 		return nil, err
 	}
 
@@ -177,12 +189,15 @@ func (r *schemaResolver) DeleteOutboundWebhook(ctx context.Context, args DeleteO
 }
 
 func (r *schemaResolver) UpdateOutboundWebhook(ctx context.Context, args UpdateOutboundWebhookArgs) (OutboundWebhookResolver, error) {
+	if err := rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, r.db, rbac.OutboundWebhooksWritePermission); err != nil {
+		return nil, err
+	}
 	user, err := auth.CurrentUser(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
-	if !user.SiteAdmin {
-		return nil, auth.ErrMustBeSiteAdmin
+	if user == nil {
+		return nil, auth.ErrNotAuthenticated
 	}
 
 	id, err := unmarshalOutboundWebhookID(args.ID)
@@ -213,7 +228,7 @@ func (r *schemaResolver) UpdateOutboundWebhook(ctx context.Context, args UpdateO
 }
 
 func OutboundWebhookByID(ctx context.Context, db database.DB, gql graphql.ID) (OutboundWebhookResolver, error) {
-	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+	if err := rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, db, rbac.OutboundWebhooksReadPermission); err != nil {
 		return nil, err
 	}
 
@@ -317,6 +332,37 @@ func (r *outboundWebhookEventTypeResolver) Description() string {
 	return r.eventType.Description
 }
 
+func (r *outboundWebhookEventTypeResolver) Scope() *string {
+	if r.eventType.Scope == "" {
+		return nil
+	}
+	return &r.eventType.Scope
+}
+
+func (r *outboundWebhookEventTypeResolver) Payload() []OutboundWebhookEventPayloadFieldResolver {
+	resolvers := make([]OutboundWebhookEventPayloadFieldResolver, len(r.eventType.Payload))
+	for i, field := range r.eventType.Payload {
+		resolvers[i] = &outboundWebhookEventPayloadFieldResolver{field}
+	}
+	return resolvers
+}
+
+type outboundWebhookEventPayloadFieldResolver struct {
+	field outbound.PayloadField
+}
+
+func (r *outboundWebhookEventPayloadFieldResolver) Name() string {
+	return r.field.Name
+}
+
+func (r *outboundWebhookEventPayloadFieldResolver) Type() string {
+	return r.field.Type
+}
+
+func (r *outboundWebhookEventPayloadFieldResolver) Description() string {
+	return r.field.Description
+}
+
 type outboundWebhookResolver struct {
 	store   database.OutboundWebhookStore
 	id      graphql.ID
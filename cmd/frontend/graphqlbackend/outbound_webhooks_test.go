@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 )
@@ -20,13 +21,13 @@ import (
 func TestSchemaResolver_OutboundWebhooks(t *testing.T) {
 	t.Parallel()
 
-	t.Run("not site admin", func(t *testing.T) {
+	t.Run("not site admin without permission", func(t *testing.T) {
 		t.Parallel()
 
 		db := database.NewMockDB()
 		ctx, _, _ := fakeUser(t, context.Background(), db, false)
 
-		runMustBeSiteAdminTest(t, []any{"outboundWebhooks"}, &Test{
+		runMissingPermissionTest(t, []any{"outboundWebhooks"}, rbac.OutboundWebhooksReadPermission, &Test{
 			Context: ctx,
 			Schema:  mustParseGraphQLSchema(t, db),
 			Query: `
@@ -147,11 +148,11 @@ func TestSchemaResolver_OutboundWebhooks(t *testing.T) {
 }
 
 func TestSchemaResolver_OutboundWebhookEventTypes(t *testing.T) {
-	t.Run("not site admin", func(t *testing.T) {
+	t.Run("not site admin without permission", func(t *testing.T) {
 		db := database.NewMockDB()
 		ctx, _, _ := fakeUser(t, context.Background(), db, false)
 
-		runMustBeSiteAdminTest(t, []any{"outboundWebhookEventTypes"}, &Test{
+		runMissingPermissionTest(t, []any{"outboundWebhookEventTypes"}, rbac.OutboundWebhooksReadPermission, &Test{
 			Context: ctx,
 			Schema:  mustParseGraphQLSchema(t, db),
 			Query: `
@@ -165,6 +166,38 @@ func TestSchemaResolver_OutboundWebhookEventTypes(t *testing.T) {
 		})
 	})
 
+	t.Run("not site admin with permission", func(t *testing.T) {
+		outbound.MockGetRegisteredEventTypes = func() []outbound.EventType {
+			return []outbound.EventType{{Key: "test:a", Description: "a test"}}
+		}
+		t.Cleanup(func() {
+			outbound.MockGetRegisteredEventTypes = nil
+		})
+
+		db := database.NewMockDB()
+		ctx, _, _ := fakeUser(t, context.Background(), db, false)
+
+		permissions := database.NewMockPermissionStore()
+		permissions.GetPermissionForUserFunc.SetDefaultReturn(&types.Permission{
+			Namespace: types.OutboundWebhooksNamespace,
+			Action:    "READ",
+		}, nil)
+		db.PermissionsFunc.SetDefaultReturn(permissions)
+
+		RunTest(t, &Test{
+			Context: ctx,
+			Schema:  mustParseGraphQLSchema(t, db),
+			Query: `
+				{
+					outboundWebhookEventTypes {
+						key
+					}
+				}
+			`,
+			ExpectedResult: `{"outboundWebhookEventTypes":[{"key":"test:a"}]}`,
+		})
+	})
+
 	t.Run("site admin", func(t *testing.T) {
 		for name, tc := range map[string]struct {
 			eventTypes []outbound.EventType
@@ -177,18 +210,35 @@ func TestSchemaResolver_OutboundWebhookEventTypes(t *testing.T) {
 			"not empty": {
 				eventTypes: []outbound.EventType{
 					{Key: "test:a", Description: "a test"},
-					{Key: "test:b", Description: "b test"},
+					{
+						Key:         "test:b",
+						Description: "b test",
+						Scope:       "the b",
+						Payload: []outbound.PayloadField{
+							{Name: "id", Type: "string", Description: "the ID of the b"},
+						},
+					},
 				},
 				want: `
 					{
 						"outboundWebhookEventTypes": [
 							{
 								"key": "test:a",
-								"description": "a test"
+								"description": "a test",
+								"scope": null,
+								"payload": []
 							},
 							{
 								"key": "test:b",
-								"description": "b test"
+								"description": "b test",
+								"scope": "the b",
+								"payload": [
+									{
+										"name": "id",
+										"type": "string",
+										"description": "the ID of the b"
+									}
+								]
 							}
 						]
 					}
@@ -214,6 +264,12 @@ func TestSchemaResolver_OutboundWebhookEventTypes(t *testing.T) {
 							outboundWebhookEventTypes {
 								key
 								description
+								scope
+								payload {
+									name
+									type
+									description
+								}
 							}
 						}
 					`,
@@ -242,13 +298,13 @@ func TestSchemaResolver_CreateOutboundWebhook(t *testing.T) {
 		}
 	)
 
-	t.Run("not site admin", func(t *testing.T) {
+	t.Run("not site admin without permission", func(t *testing.T) {
 		t.Parallel()
 
 		db := database.NewMockDB()
 		ctx, _, _ := fakeUser(t, context.Background(), db, false)
 
-		runMustBeSiteAdminTest(t, []any{"createOutboundWebhook"}, &Test{
+		runMissingPermissionTest(t, []any{"createOutboundWebhook"}, rbac.OutboundWebhooksWritePermission, &Test{
 			Context: ctx,
 			Schema:  mustParseGraphQLSchema(t, db),
 			Query: `
@@ -330,13 +386,13 @@ func TestSchemaResolver_DeleteOutboundWebhook(t *testing.T) {
 	// Outbound webhook ID 1.
 	id := "T3V0Ym91bmRXZWJob29rOjE="
 
-	t.Run("not site admin", func(t *testing.T) {
+	t.Run("not site admin without permission", func(t *testing.T) {
 		t.Parallel()
 
 		db := database.NewMockDB()
 		ctx, _, _ := fakeUser(t, context.Background(), db, false)
 
-		runMustBeSiteAdminTest(t, []any{"deleteOutboundWebhook"}, &Test{
+		runMissingPermissionTest(t, []any{"deleteOutboundWebhook"}, rbac.OutboundWebhooksWritePermission, &Test{
 			Context: ctx,
 			Schema:  mustParseGraphQLSchema(t, db),
 			Query: `
@@ -405,13 +461,13 @@ func TestSchemaResolver_UpdateOutboundWebhook(t *testing.T) {
 		}
 	)
 
-	t.Run("not site admin", func(t *testing.T) {
+	t.Run("not site admin without permission", func(t *testing.T) {
 		t.Parallel()
 
 		db := database.NewMockDB()
 		ctx, _, _ := fakeUser(t, context.Background(), db, false)
 
-		runMustBeSiteAdminTest(t, []any{"updateOutboundWebhook"}, &Test{
+		runMissingPermissionTest(t, []any{"updateOutboundWebhook"}, rbac.OutboundWebhooksWritePermission, &Test{
 			Context: ctx,
 			Schema:  mustParseGraphQLSchema(t, db),
 			Query: `
@@ -505,12 +561,17 @@ func fakeUser(t *testing.T, inputCtx context.Context, db *database.MockDB, siteA
 	store.GetByCurrentAuthUserFunc.SetDefaultReturn(user, nil)
 	db.UsersFunc.SetDefaultReturn(store)
 
+	// Users don't have any RBAC permissions unless a test grants them.
+	permissions := database.NewMockPermissionStore()
+	permissions.GetPermissionForUserFunc.SetDefaultReturn(nil, nil)
+	db.PermissionsFunc.SetDefaultReturn(permissions)
+
 	ctx = actor.WithActor(inputCtx, &actor.Actor{UID: user.ID})
 
 	return
 }
 
-func runMustBeSiteAdminTest(t *testing.T, path []any, test *Test) {
+func runMissingPermissionTest(t *testing.T, path []any, permission string, test *Test) {
 	t.Helper()
 
 	// Check that the test doesn't already have expectations.
@@ -519,7 +580,7 @@ func runMustBeSiteAdminTest(t *testing.T, path []any, test *Test) {
 
 	test.ExpectedErrors = []*gqlerrors.QueryError{
 		{
-			Message: "must be site admin",
+			Message: (&rbac.ErrNotAuthorized{Permission: permission}).Error(),
 			Path:    path,
 		},
 	}
@@ -59,6 +59,8 @@ type OwnerResolver interface {
 
 type OwnershipReasonResolver interface {
 	ToCodeownersFileEntry() (CodeownersFileEntryResolver, bool)
+	ToRecentContributorOwnershipSignal() (InferredOwnershipSignalResolver, bool)
+	ToRecentReviewerOwnershipSignal() (InferredOwnershipSignalResolver, bool)
 }
 
 type CodeownersFileEntryResolver interface {
@@ -68,6 +70,16 @@ type CodeownersFileEntryResolver interface {
 	RuleLineMatch(context.Context) (int32, error)
 }
 
+// InferredOwnershipSignalResolver resolves an ownership reason that was inferred
+// from recent activity on a file.
+type InferredOwnershipSignalResolver interface {
+	Title(context.Context) (string, error)
+	Description(context.Context) (string, error)
+	Score(context.Context) (float64, error)
+	WindowStart(context.Context) (gqlutil.DateTime, error)
+	WindowEnd(context.Context) (gqlutil.DateTime, error)
+}
+
 type CodeownersFileArgs struct {
 	Input CodeownersFileInput
 }
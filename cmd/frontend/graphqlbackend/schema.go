@@ -69,6 +69,11 @@ var insightsAggregationsSchema string
 //go:embed outbound_webhooks.graphql
 var outboundWebhooksSchema string
 
+// auditLogsSchema is the audit log raw GraphQL schema.
+//
+//go:embed audit_logs.graphql
+var auditLogsSchema string
+
 // embeddingsSchema is the Embeddings raw graqhql schema.
 //
 //go:embed embeddings.graphql
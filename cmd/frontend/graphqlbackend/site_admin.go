@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -181,6 +182,10 @@ func (r *schemaResolver) DeleteUsers(ctx context.Context, args *struct {
 		}
 	}
 
+	for _, user := range users {
+		outbound.EnqueueEvent(ctx, logger, r.db, outbound.UserDelete, nil, outbound.NewUserPayload(user))
+	}
+
 	// NOTE: Practically, we don't reuse the ID for any new users, and the situation of left-over pending permissions
 	// is possible but highly unlikely. Therefore, there is no need to roll back user deletion even if this step failed.
 	// This call is purely for the purpose of cleanup.
@@ -282,6 +287,14 @@ func (r *schemaResolver) SetUserIsSiteAdmin(ctx context.Context, args *struct {
 		return nil, err
 	}
 
+	if args.SiteAdmin {
+		if user, err := r.db.Users().GetByID(ctx, affectedUserID); err != nil {
+			r.logger.Warn("failed to get promoted user for outbound webhook", log.Int32("userID", affectedUserID), log.Error(err))
+		} else {
+			outbound.EnqueueEvent(ctx, r.logger, r.db, outbound.UserPromote, nil, outbound.NewUserPayload(user))
+		}
+	}
+
 	eventName = database.SecurityEventNameRoleChangeGranted
 	return &EmptyResponse{}, nil
 }
@@ -128,6 +128,7 @@ func TestDeleteUser(t *testing.T) {
 	db.UserEmailsFunc.SetDefaultReturn(userEmails)
 	db.UserExternalAccountsFunc.SetDefaultReturn(externalAccounts)
 	db.AuthzFunc.SetDefaultReturn(authzStore)
+	db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 
 	// Disable event logging, which is triggered for SOAP users
 	conf.Mock(&conf.Unified{
@@ -386,6 +387,7 @@ func TestSetIsSiteAdmin(t *testing.T) {
 		wantErr               error
 		securityLogEventCalls int
 		setIsSiteAdminCalls   int
+		webhookJobCalls       int
 	}{
 		"authenticated as non-admin": {
 			isSiteAdmin:           false,
@@ -413,6 +415,7 @@ func TestSetIsSiteAdmin(t *testing.T) {
 			wantErr:               nil,
 			securityLogEventCalls: 1,
 			setIsSiteAdminCalls:   1,
+			webhookJobCalls:       1,
 		},
 		"authenticated as site-admin: demoting to site-admin": {
 			isSiteAdmin:           true,
@@ -430,13 +433,22 @@ func TestSetIsSiteAdmin(t *testing.T) {
 			users := database.NewMockUserStore()
 			users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: tc.isSiteAdmin}, nil)
 			users.SetIsSiteAdminFunc.SetDefaultReturn(nil)
+			users.GetByIDFunc.SetDefaultHook(func(_ context.Context, id int32) (*types.User, error) {
+				return &types.User{ID: id, Username: "bob"}, nil
+			})
 
 			securityLogEvents := database.NewMockSecurityEventLogsStore()
 			securityLogEvents.LogEventFunc.SetDefaultReturn()
 
+			webhooks := database.NewMockOutboundWebhookStore()
+			webhooks.CountFunc.SetDefaultReturn(1, nil)
+			webhookJobs := database.NewMockOutboundWebhookJobStore()
+
 			db := database.NewMockDB()
 			db.UsersFunc.SetDefaultReturn(users)
 			db.SecurityEventLogsFunc.SetDefaultReturn(securityLogEvents)
+			db.OutboundWebhooksFunc.SetDefaultReturn(webhooks)
+			db.OutboundWebhookJobsFunc.SetDefaultReturn(webhookJobs)
 
 			s := newSchemaResolver(db, gitserver.NewClient(), jobutil.NewUnimplementedEnterpriseJobs())
 
@@ -458,6 +470,7 @@ func TestSetIsSiteAdmin(t *testing.T) {
 
 			mockrequire.CalledN(t, securityLogEvents.LogEventFunc, tc.securityLogEventCalls)
 			mockrequire.CalledN(t, users.SetIsSiteAdminFunc, tc.setIsSiteAdminCalls)
+			mockrequire.CalledN(t, webhookJobs.CreateFunc, tc.webhookJobCalls)
 		})
 	}
 }
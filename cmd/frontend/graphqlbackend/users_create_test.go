@@ -40,6 +40,7 @@ func makeUsersCreateTestDB(t *testing.T) mockFuncs {
 	db.UsersFunc.SetDefaultReturn(users)
 	db.AuthzFunc.SetDefaultReturn(authz)
 	db.UserEmailsFunc.SetDefaultReturn(userEmails)
+	db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 
 	return mockFuncs{
 		dB:             db,
@@ -438,6 +438,7 @@ func TestHandleSignUp(t *testing.T) {
 		db.UsersFunc.SetDefaultReturn(users)
 		db.AuthzFunc.SetDefaultReturn(authz)
 		db.EventLogsFunc.SetDefaultReturn(eventLogs)
+		db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 
 		logger := logtest.NoOp(t)
 		if testing.Verbose() {
@@ -514,6 +515,7 @@ func TestHandleSiteInit(t *testing.T) {
 		db.UsersFunc.SetDefaultReturn(users)
 		db.AuthzFunc.SetDefaultReturn(authz)
 		db.EventLogsFunc.SetDefaultReturn(eventLogs)
+		db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 
 		logger := logtest.NoOp(t)
 		if testing.Verbose() {
@@ -6,7 +6,10 @@ import (
 
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/snapshots"
 )
 
 func DeleteOldEventLogsInPostgres(ctx context.Context, db database.DB) {
@@ -38,3 +41,25 @@ func DeleteOldSecurityEventLogsInPostgres(ctx context.Context, db database.DB) {
 		time.Sleep(time.Hour)
 	}
 }
+
+func DeleteOldAuditLogsInPostgres(ctx context.Context, db database.DB) {
+	for {
+		// The retention period is configurable since compliance requirements
+		// for audit logs differ between organizations.
+		retention := time.Duration(audit.RetentionDays(conf.SiteConfig())) * 24 * time.Hour
+		if err := db.AuditLogs().DeleteOlderThan(ctx, time.Now().Add(-retention)); err != nil {
+			log15.Error("deleting expired rows from audit_logs table", "error", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
+
+func DeleteOldSearchResultSnapshotsInPostgres(ctx context.Context, db database.DB) {
+	store := snapshots.NewStore(db)
+	for {
+		if err := store.DeleteOlderThan(ctx, time.Now().Add(-snapshots.MaxAge)); err != nil {
+			log15.Error("deleting expired rows from search_result_snapshots table", "error", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
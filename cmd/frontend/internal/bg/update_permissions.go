@@ -7,7 +7,6 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/rbac"
-	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -54,8 +53,11 @@ func UpdatePermissions(ctx context.Context, logger log.Logger, db database.DB) {
 				// current experience and always assume that everyone has access until a site administrator revokes that
 				// access.
 				// Context: https://sourcegraph.slack.com/archives/C044BUJET7C/p1675292124253779?thread_ts=1675280399.192819&cid=C044BUJET7C
+				//
+				// Permissions guarding features that used to require site-admin are excluded from the USER role, so
+				// that they are only available to site administrators until they are delegated to another role.
 				if err := rolePermissionStore.BulkAssignPermissionsToSystemRoles(ctx, database.BulkAssignPermissionsToSystemRolesOpts{
-					Roles:        []types.SystemRole{types.SiteAdministratorSystemRole, types.UserSystemRole},
+					Roles:        rbac.RBACSchema.SystemRolesFor(permission),
 					PermissionID: permission.ID,
 				}); err != nil {
 					return errors.Wrap(err, "assigning permission to system roles")
@@ -0,0 +1,141 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// auditLogExportBatchSize is the number of entries read from the database at
+// once while exporting the audit log.
+const auditLogExportBatchSize = 1000
+
+// serveAuditLogExport streams the persisted audit log entries as newline
+// delimited JSON, oldest first. The entries can be filtered with the actor,
+// entity, action, since and until query parameters, where since and until are
+// RFC 3339 timestamps.
+func serveAuditLogExport(db database.DB) http.HandlerFunc {
+	logger := log.Scoped("serveAuditLogExport", "")
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// 🚨 SECURITY: Only site admins can export the audit log.
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			status := http.StatusForbidden
+			if errors.Is(err, auth.ErrNotAuthenticated) {
+				status = http.StatusUnauthorized
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		opts, err := auditLogExportOpts(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		store := db.AuditLogs()
+
+		// Read the first batch before writing the response, so that errors
+		// still result in an error status code.
+		entries, err := store.List(ctx, opts)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to list audit log entries: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"audit-log-%s.ndjson\"", time.Now().UTC().Format("20060102T150405Z")))
+		w.WriteHeader(http.StatusOK)
+
+		enc := json.NewEncoder(w)
+		for {
+			for _, e := range entries {
+				if err := enc.Encode(newAuditLogExportEntry(e)); err != nil {
+					logger.Error("failed to write audit log entry to client", log.Error(err))
+					return
+				}
+			}
+
+			if len(entries) < auditLogExportBatchSize {
+				return
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+
+			opts.Cursor = entries[len(entries)-1].ID
+			entries, err = store.List(ctx, opts)
+			if err != nil {
+				// The status code was already sent, so the export can only
+				// end early.
+				logger.Error("failed to list audit log entries", log.Error(err))
+				return
+			}
+		}
+	}
+}
+
+func auditLogExportOpts(query url.Values) (database.AuditLogListOpts, error) {
+	opts := database.AuditLogListOpts{
+		Actor:     query.Get("actor"),
+		Entity:    query.Get("entity"),
+		Action:    query.Get("action"),
+		Ascending: true,
+		Limit:     auditLogExportBatchSize,
+	}
+
+	for param, t := range map[string]*time.Time{"since": &opts.Since, "until": &opts.Until} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return opts, errors.Wrapf(err, "invalid %s parameter", param)
+		}
+		*t = parsed
+	}
+
+	return opts, nil
+}
+
+// auditLogExportEntry is the JSON representation of an audit log entry in an
+// export.
+type auditLogExportEntry struct {
+	AuditID      string          `json:"auditId"`
+	Timestamp    time.Time       `json:"timestamp"`
+	Actor        string          `json:"actor"`
+	IP           string          `json:"ip"`
+	ForwardedFor string          `json:"forwardedFor"`
+	Entity       string          `json:"entity"`
+	Action       string          `json:"action"`
+	Fields       json.RawMessage `json:"fields"`
+	PrevHash     string          `json:"prevHash"`
+	Hash         string          `json:"hash"`
+}
+
+func newAuditLogExportEntry(e *audit.Entry) auditLogExportEntry {
+	return auditLogExportEntry{
+		AuditID:      e.AuditID,
+		Timestamp:    e.Timestamp.UTC(),
+		Actor:        e.Actor,
+		IP:           e.IP,
+		ForwardedFor: e.ForwardedFor,
+		Entity:       e.Entity,
+		Action:       e.Action,
+		Fields:       e.Fields,
+		PrevHash:     e.PrevHash,
+		Hash:         e.Hash,
+	}
+}
@@ -0,0 +1,105 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestServeAuditLogExport(t *testing.T) {
+	since := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// Two full batches and a partial one.
+	var entries []*audit.Entry
+	for i := 1; i <= 2*auditLogExportBatchSize+1; i++ {
+		entries = append(entries, &audit.Entry{
+			ID:        int64(i),
+			AuditID:   "id",
+			Timestamp: since,
+			Actor:     "1",
+			Entity:    "gitserver",
+			Action:    "access",
+			Fields:    json.RawMessage(`{"repo":"github.com/foo/bar"}`),
+		})
+	}
+
+	store := database.NewMockAuditLogStore()
+	store.ListFunc.SetDefaultHook(func(_ context.Context, opts database.AuditLogListOpts) ([]*audit.Entry, error) {
+		assert.Equal(t, "gitserver", opts.Entity)
+		assert.Equal(t, since, opts.Since)
+		assert.True(t, opts.Ascending)
+
+		start := int(opts.Cursor)
+		end := start + opts.Limit
+		if end > len(entries) {
+			end = len(entries)
+		}
+		return entries[start:end], nil
+	})
+
+	newDB := func(user *types.User) database.DB {
+		users := database.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(user, nil)
+
+		db := database.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+		db.AuditLogsFunc.SetDefaultReturn(store)
+		return db
+	}
+
+	serve := func(db database.DB, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/audit-log/export?"+query, nil)
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(1)))
+		rec := httptest.NewRecorder()
+		serveAuditLogExport(db)(rec, req)
+		return rec
+	}
+
+	t.Run("site admin", func(t *testing.T) {
+		rec := serve(newDB(&types.User{ID: 1, SiteAdmin: true}), "entity=gitserver&since=2023-03-01T00:00:00Z")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+		require.Len(t, lines, len(entries))
+		assert.JSONEq(t, `{
+			"auditId": "id",
+			"timestamp": "2023-03-01T00:00:00Z",
+			"actor": "1",
+			"ip": "",
+			"forwardedFor": "",
+			"entity": "gitserver",
+			"action": "access",
+			"fields": {"repo": "github.com/foo/bar"},
+			"prevHash": "",
+			"hash": ""
+		}`, lines[0])
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		rec := serve(newDB(&types.User{ID: 1, SiteAdmin: true}), "entity=gitserver&since=yesterday")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("not site admin", func(t *testing.T) {
+		rec := serve(newDB(&types.User{ID: 1}), "entity=gitserver")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("not authenticated", func(t *testing.T) {
+		rec := serve(newDB(nil), "entity=gitserver")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
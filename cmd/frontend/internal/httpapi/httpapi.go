@@ -60,12 +60,14 @@ type Handlers struct {
 	BatchesChangesFileGetHandler    http.Handler
 	BatchesChangesFileExistsHandler http.Handler
 	BatchesChangesFileUploadHandler http.Handler
+	BatchesChangesExportHandler     http.Handler
 
 	// SCIM
 	SCIMHandler http.Handler
 
 	// Code intel
-	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	NewCodeIntelUploadHandler  enterprise.NewCodeIntelUploadHandler
+	VulnerabilityImportHandler http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
@@ -146,13 +148,16 @@ func NewHandler(
 	m.Get(apirouter.BatchesFileGet).Handler(trace.Route(handlers.BatchesChangesFileGetHandler))
 	m.Get(apirouter.BatchesFileExists).Handler(trace.Route(handlers.BatchesChangesFileExistsHandler))
 	m.Get(apirouter.BatchesFileUpload).Handler(trace.Route(handlers.BatchesChangesFileUploadHandler))
+	m.Get(apirouter.BatchesExport).Handler(trace.Route(handlers.BatchesChangesExportHandler))
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
+	m.Get(apirouter.CodeIntelVulnerabilityImport).Handler(trace.Route(handlers.VulnerabilityImportHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Get(apirouter.CompletionsStream).Handler(trace.Route(handlers.NewCompletionsStreamHandler()))
 
 	m.Get(apirouter.CodeInsightsDataExport).Handler(trace.Route(handlers.CodeInsightsDataExportHandler))
+	m.Get(apirouter.AuditLogExport).Handler(trace.Route(serveAuditLogExport(db)))
 
 	if envvar.SourcegraphDotComMode() {
 		m.Path("/updates").Methods("GET", "POST").Name("updatecheck").Handler(trace.Route(http.HandlerFunc(updatecheck.HandlerWithLog(logger))))
@@ -39,9 +39,14 @@ const (
 	BatchesFileGet    = "batches.file.get"
 	BatchesFileExists = "batches.file.exists"
 	BatchesFileUpload = "batches.file.upload"
+	BatchesExport     = "batches.export"
 
 	CodeInsightsDataExport = "insights.data.export"
 
+	AuditLogExport = "audit-log.export"
+
+	CodeIntelVulnerabilityImport = "codeintel.vulnerabilities.import"
+
 	ExternalURL            = "internal.app-url"
 	SendEmail              = "internal.send-email"
 	GitInfoRefs            = "internal.git.info-refs"
@@ -76,6 +81,7 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("GET").Name(BatchesFileGet)
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("HEAD").Name(BatchesFileExists)
 	base.Path("/files/batch-changes/{spec}").Methods("POST").Name(BatchesFileUpload)
+	base.Path("/batches/export/{id}").Methods("GET").Name(BatchesExport)
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
@@ -85,6 +91,8 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/src-cli/versions/{rest:.*}").Methods("GET", "POST").Name(SrcCliVersionCache)
 	base.Path("/src-cli/{rest:.*}").Methods("GET").Name(SrcCli)
 	base.Path("/insights/export/{id}").Methods("GET").Name(CodeInsightsDataExport)
+	base.Path("/audit-log/export").Methods("GET").Name(AuditLogExport)
+	base.Path("/codeintel/vulnerabilities/import").Methods("POST").Name(CodeIntelVulnerabilityImport)
 	base.Path("/completions/stream").Methods("POST").Name(CompletionsStream)
 
 	// repo contains routes that are NOT specific to a revision. In these routes, the URL may not contain a revspec after the repo (that is, no "github.com/foo/bar@myrevspec").
@@ -2,6 +2,8 @@ package search
 
 import (
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/snapshots"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
@@ -72,3 +74,46 @@ func (e *eventWriter) Alert(alert *search.Alert) error {
 		ProposedQueries: pqs,
 	})
 }
+
+func (e *eventWriter) Snapshot(snapshot *snapshots.Snapshot) error {
+	return e.inner.Event("snapshot", streamhttp.EventSnapshot{
+		ID:       snapshot.ID,
+		Count:    len(snapshot.Entries),
+		LimitHit: snapshot.LimitHit,
+	})
+}
+
+func (e *eventWriter) SnapshotDiff(since int32, diff result.SnapshotDiff, limitHit bool) error {
+	moved := make([]streamhttp.EventSnapshotMove, 0, len(diff.Moved))
+	for _, m := range diff.Moved {
+		moved = append(moved, streamhttp.EventSnapshotMove{
+			From: fromSnapshotEntry(m.From),
+			To:   fromSnapshotEntry(m.To),
+		})
+	}
+	return e.inner.Event("diff", streamhttp.EventSnapshotDiff{
+		Since:     since,
+		Added:     fromSnapshotEntries(diff.Added),
+		Removed:   fromSnapshotEntries(diff.Removed),
+		Moved:     moved,
+		Unchanged: diff.Unchanged,
+		LimitHit:  limitHit,
+	})
+}
+
+func fromSnapshotEntries(entries result.SnapshotEntries) []streamhttp.EventSnapshotEntry {
+	out := make([]streamhttp.EventSnapshotEntry, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, fromSnapshotEntry(entry))
+	}
+	return out
+}
+
+func fromSnapshotEntry(entry result.SnapshotEntry) streamhttp.EventSnapshotEntry {
+	return streamhttp.EventSnapshotEntry{
+		Repository: string(entry.Repo),
+		Path:       entry.Path,
+		Line:       entry.Line,
+		Hash:       entry.Hash,
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	searchlogs "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search/logs"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
@@ -32,6 +34,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/snapshots"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	streamclient "github.com/sourcegraph/sourcegraph/internal/search/streaming/client"
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
@@ -47,6 +50,7 @@ func StreamHandler(db database.DB, enterpriseJobs jobutil.EnterpriseJobs) http.H
 		logger:              logger,
 		db:                  db,
 		searchClient:        client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs(), enterpriseJobs),
+		snapshots:           snapshots.NewStore(db),
 		flushTickerInternal: 100 * time.Millisecond,
 		pingTickerInterval:  5 * time.Second,
 	}
@@ -56,6 +60,7 @@ type streamHandler struct {
 	logger              log.Logger
 	db                  database.DB
 	searchClient        client.SearchClient
+	snapshots           snapshots.Store
 	flushTickerInternal time.Duration
 	pingTickerInterval  time.Duration
 }
@@ -100,6 +105,22 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr *trace.Trace, eventWriter
 		attribute.Int("search_mode", args.SearchMode),
 	)
 
+	var prevSnapshot *snapshots.Snapshot
+	if args.Snapshot || args.DiffSnapshot != 0 {
+		if !actor.FromContext(ctx).IsAuthenticated() {
+			return errors.New("snapshots require an authenticated user")
+		}
+		if args.DiffSnapshot != 0 {
+			prevSnapshot, err = h.snapshots.GetByID(ctx, args.DiffSnapshot)
+			if err != nil {
+				return err
+			}
+			if err := auth.CheckSiteAdminOrSameUser(ctx, h.db, prevSnapshot.UserID); err != nil {
+				return err
+			}
+		}
+	}
+
 	settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, h.db)
 	if err != nil {
 		return err
@@ -156,6 +177,7 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr *trace.Trace, eventWriter
 	// process because they are running in a goroutine that does not have a
 	// panic handler. We cannot add a panic handler because the goroutines are
 	// spawned by the go runtime.
+	var snapshot result.SnapshotEntries
 	alert, err := func() (*search.Alert, error) {
 		eventHandler := newEventHandler(
 			ctx,
@@ -174,15 +196,44 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr *trace.Trace, eventWriter
 		batchedStream := streaming.NewBatchingStream(50*time.Millisecond, eventHandler)
 		defer batchedStream.Done()
 
+		if args.Snapshot || prevSnapshot != nil {
+			snapshotStream := streaming.NewSnapshottingStream(batchedStream)
+			alert, err := h.searchClient.Execute(ctx, snapshotStream, inputs)
+			snapshot = snapshotStream.Snapshot()
+			return alert, err
+		}
 		return h.searchClient.Execute(ctx, batchedStream, inputs)
 	}()
 	if alert != nil {
 		eventWriter.Alert(alert)
 	}
+	if err == nil && (args.Snapshot || prevSnapshot != nil) {
+		err = h.sendSnapshotEvents(ctx, args, eventWriter, prevSnapshot, snapshot, progress.Stats.IsLimitHit)
+	}
 	logSearch(ctx, h.logger, alert, err, start, inputs.OriginalQuery, progress)
 	return err
 }
 
+// sendSnapshotEvents stores the snapshot of the result set if requested and
+// sends the difference to prevSnapshot if it is not nil.
+func (h *streamHandler) sendSnapshotEvents(ctx context.Context, args *args, eventWriter *eventWriter, prevSnapshot *snapshots.Snapshot, entries result.SnapshotEntries, limitHit bool) error {
+	if prevSnapshot != nil {
+		diff := result.DiffSnapshots(prevSnapshot.Entries, entries)
+		if err := eventWriter.SnapshotDiff(prevSnapshot.ID, diff, limitHit || prevSnapshot.LimitHit); err != nil {
+			return err
+		}
+	}
+
+	if !args.Snapshot {
+		return nil
+	}
+	snapshot, err := h.snapshots.Create(ctx, actor.FromContext(ctx).UID, args.Query, entries, limitHit)
+	if err != nil {
+		return errors.Wrap(err, "storing search result snapshot")
+	}
+	return eventWriter.Snapshot(snapshot)
+}
+
 func logSearch(ctx context.Context, logger log.Logger, alert *search.Alert, err error, start time.Time, originalQuery string, progress *streamclient.ProgressAggregator) {
 	status := graphqlbackend.DetermineStatusForLogs(alert, progress.Stats, err)
 
@@ -222,6 +273,13 @@ type args struct {
 	EnableChunkMatches bool
 	SearchMode         int
 
+	// Snapshot stores a snapshot of the result set that later searches can
+	// be diffed against.
+	Snapshot bool
+	// DiffSnapshot is the ID of a prior snapshot to diff the result set
+	// against, or 0.
+	DiffSnapshot int32
+
 	// Optional decoration parameters for server-side rendering a result set
 	// or subset. Decorations may specify, e.g., highlighting results with
 	// HTML markup up-front, and/or including context lines around file results.
@@ -266,6 +324,18 @@ func parseURLQuery(q url.Values) (*args, error) {
 		return nil, errors.Errorf("search mode must be integer, got %q: %w", searchMode, err)
 	}
 
+	snapshot := get("snapshot", "f")
+	if a.Snapshot, err = strconv.ParseBool(snapshot); err != nil {
+		return nil, errors.Errorf("snapshot must be parseable as a boolean, got %q: %w", snapshot, err)
+	}
+
+	diffSnapshot := get("diff", "0")
+	diffSnapshotID, err := strconv.ParseInt(diffSnapshot, 10, 32)
+	if err != nil {
+		return nil, errors.Errorf("diff must be a snapshot ID, got %q: %w", diffSnapshot, err)
+	}
+	a.DiffSnapshot = int32(diffSnapshotID)
+
 	decorationLimit := get("dl", "0")
 	if a.DecorationLimit, err = strconv.Atoi(decorationLimit); err != nil {
 		return nil, errors.Errorf("decorationLimit must be an integer, got %q: %w", decorationLimit, err)
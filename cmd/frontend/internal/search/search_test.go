@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,16 +16,19 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	api2 "github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/snapshots"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -278,6 +282,156 @@ func TestDisplayLimit(t *testing.T) {
 	}
 }
 
+func TestServeStream_snapshots(t *testing.T) {
+	graphqlbackend.MockDecodedViewerFinalSettings = &schema.Settings{}
+	t.Cleanup(func() { graphqlbackend.MockDecodedViewerFinalSettings = nil })
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultReturn(&search.Inputs{Query: query.Q{query.Parameter{Field: "count", Value: "1000"}}}, nil)
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, _ *search.Inputs) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{mkRepoMatch(1), mkRepoMatch(3)},
+		})
+		return nil, nil
+	})
+
+	mockRepos := database.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultHook(func(_ context.Context, ids ...api2.RepoID) ([]*types.SearchedRepo, error) {
+		out := make([]*types.SearchedRepo, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, &types.SearchedRepo{ID: id})
+		}
+		return out, nil
+	})
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	repoEntry := func(id int) result.SnapshotEntry {
+		return result.NewSnapshotEntries(mkRepoMatch(id))[0]
+	}
+	store := &fakeSnapshotStore{snapshots: map[int32]*snapshots.Snapshot{
+		1: {ID: 1, UserID: 1, Entries: result.SnapshotEntries{repoEntry(1), repoEntry(2)}},
+		2: {ID: 2, UserID: 2},
+	}}
+
+	h := &streamHandler{
+		logger:              logtest.Scoped(t),
+		db:                  db,
+		snapshots:           store,
+		flushTickerInternal: 1 * time.Millisecond,
+		pingTickerInterval:  1 * time.Millisecond,
+		searchClient:        mock,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if uid, _ := strconv.Atoi(r.Header.Get("X-Test-User")); uid != 0 {
+			r = r.WithContext(actor.WithActor(r.Context(), actor.FromUser(int32(uid))))
+		}
+		h.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	type events struct {
+		snapshot *streamhttp.EventSnapshot
+		diff     *streamhttp.EventSnapshotDiff
+		err      *streamhttp.EventError
+	}
+	get := func(t *testing.T, uid int, params string) events {
+		req, err := http.NewRequest("GET", ts.URL+"?q=test&"+params, nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Test-User", strconv.Itoa(uid))
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		var got events
+		err = streamhttp.FrontendStreamDecoder{
+			OnSnapshot: func(e *streamhttp.EventSnapshot) { got.snapshot = e },
+			OnDiff:     func(e *streamhttp.EventSnapshotDiff) { got.diff = e },
+			OnError:    func(e *streamhttp.EventError) { got.err = e },
+		}.ReadAll(res.Body)
+		require.NoError(t, err)
+		return got
+	}
+
+	t.Run("snapshot", func(t *testing.T) {
+		got := get(t, 1, "snapshot=t")
+		require.Nil(t, got.err)
+		require.Nil(t, got.diff)
+		require.Equal(t, &streamhttp.EventSnapshot{ID: 3, Count: 2}, got.snapshot)
+		require.Equal(t, result.SnapshotEntries{repoEntry(1), repoEntry(3)}, store.snapshots[3].Entries)
+		require.Equal(t, int32(1), store.snapshots[3].UserID)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		got := get(t, 1, "diff=1")
+		require.Nil(t, got.err)
+		require.Nil(t, got.snapshot)
+		require.Equal(t, &streamhttp.EventSnapshotDiff{
+			Since:     1,
+			Added:     []streamhttp.EventSnapshotEntry{{Repository: "repo3", Line: -1, Hash: repoEntry(3).Hash}},
+			Removed:   []streamhttp.EventSnapshotEntry{{Repository: "repo2", Line: -1, Hash: repoEntry(2).Hash}},
+			Moved:     []streamhttp.EventSnapshotMove{},
+			Unchanged: 1,
+		}, got.diff)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		got := get(t, 0, "snapshot=t")
+		require.NotNil(t, got.err)
+		require.Nil(t, got.snapshot)
+	})
+
+	t.Run("other user's snapshot", func(t *testing.T) {
+		users := database.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+		db.UsersFunc.SetDefaultReturn(users)
+
+		got := get(t, 1, "diff=2")
+		require.NotNil(t, got.err)
+		require.Nil(t, got.diff)
+	})
+}
+
+type fakeSnapshotStore struct {
+	mu        sync.Mutex
+	snapshots map[int32]*snapshots.Snapshot
+}
+
+func (s *fakeSnapshotStore) Create(_ context.Context, userID int32, query string, entries result.SnapshotEntries, limitHit bool) (*snapshots.Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := &snapshots.Snapshot{
+		ID:       int32(len(s.snapshots) + 1),
+		UserID:   userID,
+		Query:    query,
+		Entries:  entries,
+		LimitHit: limitHit,
+	}
+	s.snapshots[snapshot.ID] = snapshot
+	return snapshot, nil
+}
+
+func (s *fakeSnapshotStore) GetByID(_ context.Context, id int32) (*snapshots.Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot, ok := s.snapshots[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return snapshot, nil
+}
+
+func (s *fakeSnapshotStore) DeleteOlderThan(_ context.Context, t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, snapshot := range s.snapshots {
+		if snapshot.CreatedAt.Before(t) {
+			delete(s.snapshots, id)
+		}
+	}
+	return nil
+}
+
 func mkRepoMatch(id int) *result.RepoMatch {
 	return &result.RepoMatch{
 		ID:   api2.RepoID(id),
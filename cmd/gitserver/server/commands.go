@@ -1,16 +1,40 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/internal/accesslog"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/adapters"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 )
 
+// getObjectFunc returns a gitdomain.GetObjectFunc backed by the repositories
+// in s.ReposDir.
+func (s *Server) getObjectFunc() gitdomain.GetObjectFunc {
+	gitAdapter := &adapters.Git{
+		ReposDir: s.ReposDir,
+	}
+	getObjectService := gitdomain.GetObjectService{
+		RevParse:      gitAdapter.RevParse,
+		GetObjectType: gitAdapter.GetObjectType,
+	}
+	return func(ctx context.Context, repo api.RepoName, objectName string) (*gitdomain.GitObject, error) {
+		// Tracing is server concern, so add it here. Once generics lands we should be
+		// able to create some simple wrappers
+		span, ctx := ot.StartSpanFromContext(ctx, "Git: GetObject") //nolint:staticcheck // OT is deprecated
+		span.SetTag("objectName", objectName)
+		defer span.Finish()
+		return getObjectService.GetObject(ctx, repo, objectName)
+	}
+}
+
 func handleGetObject(logger log.Logger, getObject gitdomain.GetObjectFunc) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req protocol.GetObjectRequest
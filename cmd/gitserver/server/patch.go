@@ -196,6 +196,74 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 		return http.StatusBadRequest, resp
 	}
 
+	// A stacked commit is created on top of the head commit of the changeset
+	// it's stacked on. The patch contains the changes of that parent as well,
+	// so only the changes between the parent and the patched base commit end
+	// up in the commit.
+	if req.StackParentCommit != "" {
+		onto := req.StackOntoCommit
+		if onto == "" {
+			onto = req.StackParentCommit
+		}
+
+		if onto == req.StackParentCommit {
+			// The parent contains the same changes as the base commit with the
+			// patch of the parent applied, so we can keep the index as is.
+			cmd = exec.CommandContext(ctx, "git", "reset", "-q", "--soft", string(onto))
+			cmd.Dir = tmpRepoDir
+			cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
+
+			if out, err := run(cmd, "basing staging on stack parent"); err != nil {
+				logger.Error("Failed to base the temporary repo on the stack parent", log.String("output", string(out)))
+				return http.StatusInternalServerError, resp
+			}
+		} else {
+			// Otherwise we rebase the changes of the commit onto the given
+			// commit, similar to a cherry-pick.
+			cmd = exec.CommandContext(ctx, "git", "write-tree")
+			cmd.Dir = tmpRepoDir
+			cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
+
+			out, err := cmd.Output()
+			if err != nil {
+				resp.SetError(repo, argsToString(cmd.Args), string(out), errors.Wrap(err, "gitserver: writing patched tree"))
+				return http.StatusInternalServerError, resp
+			}
+			tree := strings.TrimSpace(string(out))
+
+			cmd = exec.CommandContext(ctx, "git", "diff", "--binary", "--full-index", "--no-prefix", string(req.StackParentCommit), tree)
+			cmd.Dir = tmpRepoDir
+			cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
+
+			stackedPatch, err := cmd.Output()
+			if err != nil {
+				resp.SetError(repo, argsToString(cmd.Args), string(stackedPatch), errors.Wrap(err, "gitserver: diffing against stack parent"))
+				return http.StatusInternalServerError, resp
+			}
+
+			cmd = exec.CommandContext(ctx, "git", "reset", "-q", string(onto))
+			cmd.Dir = tmpRepoDir
+			cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
+
+			if out, err := run(cmd, "basing staging on rebase target"); err != nil {
+				logger.Error("Failed to base the temporary repo on the rebase target", log.String("output", string(out)))
+				return http.StatusInternalServerError, resp
+			}
+
+			if len(stackedPatch) > 0 {
+				cmd = exec.CommandContext(ctx, "git", "apply", "--cached", "-p0", "--3way")
+				cmd.Dir = tmpRepoDir
+				cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
+				cmd.Stdin = bytes.NewReader(stackedPatch)
+
+				if out, err := run(cmd, "rebasing stacked patch"); err != nil {
+					logger.Error("Failed to rebase stacked patch", log.String("output", string(out)))
+					return http.StatusBadRequest, resp
+				}
+			}
+		}
+	}
+
 	message := req.CommitInfo.Message
 	if message == "" {
 		message = "<Sourcegraph> Creating commit from patch"
@@ -275,8 +343,21 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 		return http.StatusInternalServerError, resp
 	}
 
-	if req.Push != nil {
-		cmd = exec.CommandContext(ctx, "git", "push", "--force", remoteURL.String(), fmt.Sprintf("%s:%s", cmtHash, ref))
+	if req.Push != nil && remoteURL.Scheme == "perforce" {
+		// Perforce depots cannot be pushed to, so the changes of the commit
+		// are shelved in a pending changelist instead.
+		changelistID, err := s.shelveChangelist(ctx, logger, remoteURL, repoGitDir, cmtHash, message, req.ChangelistID)
+		if err != nil {
+			resp.SetError(repo, "", "", errors.Wrap(err, "gitserver: shelving changelist"))
+			return http.StatusInternalServerError, resp
+		}
+		resp.ChangelistID = changelistID
+	} else if req.Push != nil {
+		pushRef := ref
+		if req.PushRef != "" {
+			pushRef = req.PushRef
+		}
+		cmd = exec.CommandContext(ctx, "git", "push", "--force", remoteURL.String(), fmt.Sprintf("%s:%s", cmtHash, pushRef))
 		cmd.Dir = repoGitDir
 
 		// If the protocol is SSH and a private key was given, we want to
@@ -0,0 +1,271 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// p4ChangeMarkerPattern matches the marker that git-p4 and p4-fusion add to the
+// message of every commit they convert from a Perforce changelist.
+var p4ChangeMarkerPattern = lazyregexp.New(`\[(?:git-p4|p4-fusion): depot-paths = "[^"]*": change = (\d+)`)
+
+// p4ChangeCreatedPattern matches the output of `p4 change -i` for a new
+// changelist.
+var p4ChangeCreatedPattern = lazyregexp.New(`^Change (\d+) created`)
+
+// p4ChangeSpecFieldPattern matches a single-line field of the spec printed by
+// `p4 change -o`.
+var p4ChangeSpecFieldPattern = lazyregexp.New(`(?m)^(Client|Status):\s+(\S+)\s*$`)
+
+// shelveChangelist shelves the files changed by the given commit in a pending
+// changelist on the Perforce server that the given remote URL points to. The
+// commit must be a direct descendant of a commit converted from a Perforce
+// changelist.
+//
+// If changelistID is empty, a new changelist is created along with a client
+// workspace that owns it. Otherwise the changes replace the files shelved in
+// the existing changelist, using the workspace that owns it. The workspace is
+// kept either way, as Perforce requires it for as long as the changelist is
+// pending. It returns the ID of the changelist.
+func (s *Server) shelveChangelist(ctx context.Context, logger log.Logger, remoteURL *vcs.URL, gitDir, commit, description, changelistID string) (string, error) {
+	username, password, host, depot, err := decomposePerforceRemoteURL(remoteURL)
+	if err != nil {
+		return "", errors.Wrap(err, "decompose")
+	}
+
+	if err := p4testWithTrust(ctx, host, username, password); err != nil {
+		return "", errors.Wrap(err, "test with trust")
+	}
+
+	gitEnv := append(os.Environ(), "GIT_DIR="+gitDir)
+
+	parentMessage, err := runP4ShelveCommand(ctx, logger, exec.CommandContext(ctx, "git", "log", "-1", "--format=%B", commit+"^"), "", gitEnv)
+	if err != nil {
+		return "", err
+	}
+	baseChangelist, err := perforceChangelistFromCommitMessage(parentMessage)
+	if err != nil {
+		return "", err
+	}
+
+	nameStatus, err := runP4ShelveCommand(ctx, logger, exec.CommandContext(ctx, "git", "diff-tree", "--no-commit-id", "-r", "--name-status", commit), "", gitEnv)
+	if err != nil {
+		return "", err
+	}
+	added, modified, deleted := parseNameStatus(nameStatus)
+
+	root, err := s.tempDir("p4-shelve-")
+	if err != nil {
+		return "", errors.Wrap(err, "creating workspace root")
+	}
+	defer os.RemoveAll(root)
+
+	client := "sourcegraph-batches-" + commit[:12]
+	p4 := func(stdin string, args ...string) (string, error) {
+		cmd := exec.CommandContext(ctx, "p4", args...)
+		cmd.Dir = root
+		return runP4ShelveCommand(ctx, logger, cmd, stdin, append(os.Environ(),
+			"P4PORT="+host,
+			"P4USER="+username,
+			"P4PASSWD="+password,
+			"P4CLIENT="+client,
+			"PWD="+root,
+		))
+	}
+
+	if changelistID != "" {
+		out, err := p4("", "change", "-o", changelistID)
+		if err != nil {
+			return "", err
+		}
+		var status string
+		client, status = parseP4ChangeSpec(out)
+		if status != "pending" {
+			return "", errors.Newf("changelist %s is not pending", changelistID)
+		}
+	}
+
+	// If the workspace already exists, this only points its root at our
+	// temporary directory.
+	if _, err := p4(buildP4ClientSpec(client, username, root, depot), "client", "-i"); err != nil {
+		return "", err
+	}
+
+	// Record the files of the base changelist as synced without transferring
+	// them: we only need to open the files changed by the commit.
+	if _, err := p4("", "sync", "-k", fmt.Sprintf("%s...@%s", depot, baseChangelist)); err != nil {
+		return "", err
+	}
+
+	out, err := p4(buildP4ChangeSpec(changelistID, client, username, description), "change", "-i")
+	if err != nil {
+		return "", err
+	}
+	if changelistID == "" {
+		match := p4ChangeCreatedPattern.FindStringSubmatch(strings.TrimSpace(out))
+		if match == nil {
+			return "", errors.Newf("unexpected output from p4 change: %q", out)
+		}
+		changelistID = match[1]
+	}
+	defer func() {
+		// Revert the opened files without touching the shelf, so that the
+		// workspace can be used to shelve the changelist again. This doesn't
+		// affect what was shelved, so a failure is only logged.
+		if _, err := p4("", "revert", "-k", "-c", changelistID, "//..."); err != nil {
+			logger.Warn("failed to revert files opened for shelving", log.String("changelist", changelistID), log.Error(err))
+		}
+	}()
+
+	if len(modified) > 0 {
+		if _, err := p4("", append([]string{"edit", "-k", "-c", changelistID}, modified...)...); err != nil {
+			return "", err
+		}
+	}
+	if len(deleted) > 0 {
+		if _, err := p4("", append([]string{"delete", "-k", "-c", changelistID}, deleted...)...); err != nil {
+			return "", err
+		}
+	}
+
+	for _, path := range append(append([]string{}, modified...), added...) {
+		// We don't use runP4ShelveCommand here as we only want stdout.
+		cmd := exec.CommandContext(ctx, "git", "show", commit+":"+path)
+		cmd.Env = gitEnv
+		content, err := cmd.Output()
+		if err != nil {
+			return "", errors.Wrapf(err, "reading %q at %s", path, commit)
+		}
+		dst := filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(dst, content, 0o644); err != nil {
+			return "", err
+		}
+	}
+
+	if len(added) > 0 {
+		if _, err := p4("", append([]string{"add", "-c", changelistID}, added...)...); err != nil {
+			return "", err
+		}
+	}
+
+	// -r replaces all files shelved in the changelist, so that files that an
+	// earlier version of the commit changed are dropped from the shelf.
+	if _, err := p4("", "shelve", "-r", "-c", changelistID); err != nil {
+		return "", err
+	}
+
+	return changelistID, nil
+}
+
+// runP4ShelveCommand runs the given command with the given environment and
+// standard input and returns its combined output.
+func runP4ShelveCommand(ctx context.Context, logger log.Logger, cmd *exec.Cmd, stdin string, env []string) (string, error) {
+	cmd.Env = env
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+
+	out, err := runWith(ctx, wrexec.Wrap(ctx, logger, cmd), false, nil)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		return "", errors.Wrapf(err, "running %q (output follows)\n\n%s", strings.Join(cmd.Args, " "), specifyCommandInErrorMessage(string(out), cmd))
+	}
+	return string(out), nil
+}
+
+// perforceChangelistFromCommitMessage returns the ID of the Perforce changelist
+// that the commit with the given message was converted from.
+func perforceChangelistFromCommitMessage(message string) (string, error) {
+	match := p4ChangeMarkerPattern.FindStringSubmatch(message)
+	if match == nil {
+		return "", errors.New("base commit was not converted from a Perforce changelist")
+	}
+	return match[1], nil
+}
+
+// parseNameStatus splits the output of `git diff-tree --name-status` into the
+// added, modified and deleted paths.
+func parseNameStatus(out string) (added, modified, deleted []string) {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		status, path, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok {
+			continue
+		}
+		switch status {
+		case "A":
+			added = append(added, path)
+		case "D":
+			deleted = append(deleted, path)
+		default:
+			modified = append(modified, path)
+		}
+	}
+	return added, modified, deleted
+}
+
+// buildP4ClientSpec returns a client spec suitable for `p4 client -i` that
+// maps the given depot into a workspace rooted at root.
+func buildP4ClientSpec(client, owner, root, depot string) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Client: %s\n\n", client)
+	fmt.Fprintf(&b, "Owner: %s\n\n", owner)
+	fmt.Fprintf(&b, "Root: %s\n\n", root)
+	fmt.Fprintf(&b, "Options: allwrite noclobber nocompress unlocked nomodtime normdir\n\n")
+	fmt.Fprintf(&b, "LineEnd: local\n\n")
+	fmt.Fprintf(&b, "View:\n\t%s... //%s/...\n", depot, client)
+	return b.String()
+}
+
+// parseP4ChangeSpec returns the client and status fields of the changelist
+// spec printed by `p4 change -o`.
+func parseP4ChangeSpec(out string) (client, status string) {
+	for _, match := range p4ChangeSpecFieldPattern.FindAllStringSubmatch(out, -1) {
+		switch match[1] {
+		case "Client":
+			client = match[2]
+		case "Status":
+			status = match[2]
+		}
+	}
+	return client, status
+}
+
+// buildP4ChangeSpec returns a changelist spec suitable for `p4 change -i` with
+// the given description. If changelistID is empty, the spec creates a new
+// changelist, otherwise it updates the description of the given one.
+func buildP4ChangeSpec(changelistID, client, user, description string) string {
+	change, status := "new", "new"
+	if changelistID != "" {
+		change, status = changelistID, "pending"
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Change: %s\n\n", change)
+	fmt.Fprintf(&b, "Client: %s\n\n", client)
+	fmt.Fprintf(&b, "User: %s\n\n", user)
+	fmt.Fprintf(&b, "Status: %s\n\n", status)
+	fmt.Fprintf(&b, "Description:\n")
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		fmt.Fprintf(&b, "\t%s\n", line)
+	}
+	return b.String()
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPerforceChangelistFromCommitMessage(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		message string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "git-p4",
+			message: "Fix the build\n\n[git-p4: depot-paths = \"//depot/\": change = 1234]\n",
+			want:    "1234",
+		},
+		{
+			name:    "p4-fusion",
+			message: "Fix the build\n[p4-fusion: depot-paths = \"//depot/game/\": change = 42]",
+			want:    "42",
+		},
+		{
+			name:    "no marker",
+			message: "Fix the build",
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := perforceChangelistFromCommitMessage(tc.message)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have != tc.want {
+				t.Errorf("wrong changelist. want=%q, have=%q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestParseNameStatus(t *testing.T) {
+	added, modified, deleted := parseNameStatus("M\tsrc/main.cpp\nA\tassets/new.png\nD\told.txt\nT\tlink\n")
+
+	if diff := cmp.Diff([]string{"assets/new.png"}, added); diff != "" {
+		t.Errorf("unexpected added paths (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"src/main.cpp", "link"}, modified); diff != "" {
+		t.Errorf("unexpected modified paths (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"old.txt"}, deleted); diff != "" {
+		t.Errorf("unexpected deleted paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseP4ChangeSpec(t *testing.T) {
+	client, status := parseP4ChangeSpec("# A Perforce Change Specification.\n" +
+		"#\n" +
+		"#  Change:      The change number. 'new' on a new changelist.\n" +
+		"\n" +
+		"Change:\t1234\n" +
+		"\n" +
+		"Date:\t2023/03/20 10:11:12\n" +
+		"\n" +
+		"Client:\tsourcegraph-batches-abc\n" +
+		"\n" +
+		"User:\talice\n" +
+		"\n" +
+		"Status:\tpending\n" +
+		"\n" +
+		"Description:\n" +
+		"\tUpdate assets\n")
+
+	if want := "sourcegraph-batches-abc"; client != want {
+		t.Errorf("wrong client. want=%q, have=%q", want, client)
+	}
+	if want := "pending"; status != want {
+		t.Errorf("wrong status. want=%q, have=%q", want, status)
+	}
+}
+
+func TestBuildP4ChangeSpec(t *testing.T) {
+	t.Run("new changelist", func(t *testing.T) {
+		have := buildP4ChangeSpec("", "sourcegraph-batches-abc", "alice", "Update assets\n\nShelved by Sourcegraph.\n")
+		want := "Change: new\n\n" +
+			"Client: sourcegraph-batches-abc\n\n" +
+			"User: alice\n\n" +
+			"Status: new\n\n" +
+			"Description:\n" +
+			"\tUpdate assets\n" +
+			"\t\n" +
+			"\tShelved by Sourcegraph.\n"
+
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected change spec (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("existing changelist", func(t *testing.T) {
+		have := buildP4ChangeSpec("1234", "sourcegraph-batches-abc", "alice", "Update assets")
+		want := "Change: 1234\n\n" +
+			"Client: sourcegraph-batches-abc\n\n" +
+			"User: alice\n\n" +
+			"Status: pending\n\n" +
+			"Description:\n" +
+			"\tUpdate assets\n"
+
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected change spec (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -17,19 +16,29 @@ import (
 )
 
 func (s *Server) handleReposStats(w http.ResponseWriter, r *http.Request) {
+	b, err := s.readReposStatsFile()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(b)
+}
+
+// readReposStatsFile returns the JSON encoded protocol.ReposStats last
+// computed by the janitor.
+func (s *Server) readReposStatsFile() ([]byte, error) {
 	b, err := os.ReadFile(filepath.Join(s.ReposDir, reposStatsName))
 	if errors.Is(err, os.ErrNotExist) {
 		// When a gitserver is new this file might not have been computed
 		// yet. Clients are expected to handle this case by noticing UpdatedAt
 		// is not set.
-		b = []byte("{}")
+		return []byte("{}"), nil
 	} else if err != nil {
-		http.Error(w, fmt.Sprintf("failed to read %s: %v", reposStatsName, err.Error()), http.StatusInternalServerError)
-		return
+		return nil, errors.Newf("failed to read %s: %v", reposStatsName, err.Error())
 	}
-
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	_, _ = w.Write(b)
+	return b, nil
 }
 
 func (s *Server) repoCloneProgress(repo api.RepoName) *protocol.RepoCloneProgress {
@@ -45,7 +45,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
-	"github.com/sourcegraph/sourcegraph/internal/gitserver/adapters"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/search"
@@ -60,6 +59,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -594,22 +594,7 @@ func (s *Server) Handler() http.Handler {
 	)))
 
 	// Migration to hexagonal architecture starting here:
-
-	gitAdapter := &adapters.Git{
-		ReposDir: s.ReposDir,
-	}
-	getObjectService := gitdomain.GetObjectService{
-		RevParse:      gitAdapter.RevParse,
-		GetObjectType: gitAdapter.GetObjectType,
-	}
-	getObjectFunc := gitdomain.GetObjectFunc(func(ctx context.Context, repo api.RepoName, objectName string) (*gitdomain.GitObject, error) {
-		// Tracing is server concern, so add it here. Once generics lands we should be
-		// able to create some simple wrappers
-		span, ctx := ot.StartSpanFromContext(ctx, "Git: GetObject") //nolint:staticcheck // OT is deprecated
-		span.SetTag("objectName", objectName)
-		defer span.Finish()
-		return getObjectService.GetObject(ctx, repo, objectName)
-	})
+	getObjectFunc := s.getObjectFunc()
 
 	mux.HandleFunc("/commands/get-object", trace.WithRouteName("commands/get-object",
 		accesslog.HTTPMiddleware(
@@ -1035,41 +1020,50 @@ func (s *Server) handleIsRepoCloneable(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	resp, err := s.isRepoCloneable(r.Context(), req.Repo)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *Server) isRepoCloneable(ctx context.Context, repo api.RepoName) (protocol.IsRepoCloneableResponse, error) {
 	var syncer VCSSyncer
 	// We use an internal actor here as the repo may be private. It is safe since all
 	// we return is a bool indicating whether the repo is cloneable or not. Perhaps
 	// the only things that could leak here is whether a private repo exists although
 	// the endpoint is only available internally so it's low risk.
-	remoteURL, err := s.getRemoteURL(actor.WithInternalActor(r.Context()), req.Repo)
+	remoteURL, err := s.getRemoteURL(actor.WithInternalActor(ctx), repo)
 	if err != nil {
 		// We use this endpoint to verify if a repo exists without consuming
 		// API rate limit, since many users visit private or bogus repos,
 		// so we deduce the unauthenticated clone URL from the repo name.
-		remoteURL, _ = vcs.ParseURL("https://" + string(req.Repo) + ".git")
+		remoteURL, _ = vcs.ParseURL("https://" + string(repo) + ".git")
 
 		// At this point we are assuming it's a git repo
 		syncer = &GitRepoSyncer{}
 	} else {
-		syncer, err = s.GetVCSSyncer(r.Context(), req.Repo)
+		syncer, err = s.GetVCSSyncer(ctx, repo)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return protocol.IsRepoCloneableResponse{}, err
 		}
 	}
 
 	resp := protocol.IsRepoCloneableResponse{
-		Cloned: repoCloned(s.dir(req.Repo)),
+		Cloned: repoCloned(s.dir(repo)),
 	}
-	if err := syncer.IsCloneable(r.Context(), remoteURL); err == nil {
+	if err := syncer.IsCloneable(ctx, remoteURL); err == nil {
 		resp.Cloneable = true
 	} else {
 		resp.Reason = err.Error()
 	}
 
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	return resp, nil
 }
 
 // handleRepoUpdate is a synchronous (waits for update to complete or
@@ -1077,12 +1071,22 @@ func (s *Server) handleIsRepoCloneable(w http.ResponseWriter, r *http.Request) {
 // unconditional; we debounce them based on the provided
 // interval, to avoid spam.
 func (s *Server) handleRepoUpdate(w http.ResponseWriter, r *http.Request) {
-	logger := s.Logger.Scoped("handleRepoUpdate", "synchronous http handler for repo updates")
 	var req protocol.RepoUpdateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+
+	resp := s.repoUpdate(&req)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *Server) repoUpdate(req *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+	logger := s.Logger.Scoped("handleRepoUpdate", "synchronous http handler for repo updates")
 	var resp protocol.RepoUpdateResponse
 	req.Repo = protocol.NormalizeRepo(req.Repo)
 	dir := s.dir(req.Repo)
@@ -1140,30 +1144,20 @@ func (s *Server) handleRepoUpdate(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	return resp
 }
 
 // handleRepoClone is an asynchronous (does not wait for update to complete or
 // time out) call to clone a repository.
 // Asynchronous errors will have to be checked in the gitserver_repos table under last_error.
 func (s *Server) handleRepoClone(w http.ResponseWriter, r *http.Request) {
-	logger := s.Logger.Scoped("handleRepoClone", "asynchronous http handler for repo clones")
 	var req protocol.RepoCloneRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	var resp protocol.RepoCloneResponse
-	req.Repo = protocol.NormalizeRepo(req.Repo)
 
-	_, err := s.cloneRepo(context.Background(), req.Repo, &cloneOptions{Block: false})
-	if err != nil {
-		logger.Warn("error cloning repo", log.String("repo", string(req.Repo)), log.Error(err))
-		resp.Error = err.Error()
-	}
+	resp := s.repoClone(req.Repo)
 
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1171,6 +1165,20 @@ func (s *Server) handleRepoClone(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *Server) repoClone(repo api.RepoName) protocol.RepoCloneResponse {
+	logger := s.Logger.Scoped("handleRepoClone", "asynchronous http handler for repo clones")
+	var resp protocol.RepoCloneResponse
+	repo = protocol.NormalizeRepo(repo)
+
+	_, err := s.cloneRepo(context.Background(), repo, &cloneOptions{Block: false})
+	if err != nil {
+		logger.Warn("error cloning repo", log.String("repo", string(repo)), log.Error(err))
+		resp.Error = err.Error()
+	}
+
+	return resp
+}
+
 func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 	var (
 		logger    = s.Logger.Scoped("handleArchive", "http handler for repo archive")
@@ -1200,8 +1208,15 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	s.execHTTP(w, r, archiveExecRequest(api.RepoName(repo), treeish, format, pathspecs))
+}
+
+// archiveExecRequest returns the exec request that produces an archive of the
+// given treeish in the given format. Callers are expected to have validated
+// treeish with checkSpecArgSafety.
+func archiveExecRequest(repo api.RepoName, treeish, format string, pathspecs []string) *protocol.ExecRequest {
 	req := &protocol.ExecRequest{
-		Repo: api.RepoName(repo),
+		Repo: repo,
 		Args: []string{
 			"archive",
 
@@ -1226,7 +1241,7 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 	req.Args = append(req.Args, treeish, "--")
 	req.Args = append(req.Args, pathspecs...)
 
-	s.execHTTP(w, r, req)
+	return req
 }
 
 func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
@@ -1484,6 +1499,46 @@ func (s *Server) handleBatchLog(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Read request body
+	var req protocol.BatchLogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate request parameters
+	if len(req.RepoCommits) == 0 {
+		// Early exit: implicitly writes 200 OK
+		_ = json.NewEncoder(w).Encode(protocol.BatchLogResponse{Results: []protocol.BatchLogResult{}})
+		return
+	}
+	if err := checkBatchLogFormat(req.Format); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	// Handle unexpected error conditions. We expect the batch log to not have
+	// written the status code or any of the body if this error value is non-nil.
+	resp, err := s.batchGitLog(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Write payload to client: implicitly writes 200 OK
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func checkBatchLogFormat(format string) error {
+	if !strings.HasPrefix(format, "--format=") {
+		return errors.New("format parameter expected to be of the form `--format=<git log format>`")
+	}
+	return nil
+}
+
+// batchGitLog runs `git log` for each repository and commit pair of the given
+// request. The request is expected to have been validated by the caller.
+func (s *Server) batchGitLog(ctx context.Context, req protocol.BatchLogRequest) (_ protocol.BatchLogResponse, err error) {
 	operations := s.ensureOperations()
 
 	// Run git log for a single repository.
@@ -1527,94 +1582,63 @@ func (s *Server) handleBatchLog(w http.ResponseWriter, r *http.Request) {
 		return buf.String(), true, nil
 	}
 
-	// Handles the /batch-log route
-	instrumentedHandler := func(ctx context.Context) (statusCodeOnError int, err error) {
-		ctx, logger, endObservation := operations.batchLog.With(ctx, &err, observation.Args{})
-		defer func() {
-			endObservation(1, observation.Args{LogFields: []otlog.Field{
-				otlog.Int("statusCodeOnError", statusCodeOnError),
-			}})
-		}()
+	ctx, logger, endObservation := operations.batchLog.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
 
-		// Read request body
-		var req protocol.BatchLogRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			return http.StatusBadRequest, err
-		}
-		logger.AddEvent("read request.body", req.SpanAttributes()...)
+	logger.AddEvent("read request.body", req.SpanAttributes()...)
 
-		// Validate request parameters
-		if len(req.RepoCommits) == 0 {
-			// Early exit: implicitly writes 200 OK
-			_ = json.NewEncoder(w).Encode(protocol.BatchLogResponse{Results: []protocol.BatchLogResult{}})
-			return 0, nil
-		}
-		if !strings.HasPrefix(req.Format, "--format=") {
-			return http.StatusUnprocessableEntity, errors.New("format parameter expected to be of the form `--format=<git log format>`")
-		}
+	// Perform requests in each repository in the input batch. We perform these commands
+	// concurrently, but only allow for so many commands to be in-flight at a time so that
+	// we don't overwhelm a shard with either a large request or too many concurrent batch
+	// requests.
+
+	g, ctx := errgroup.WithContext(ctx)
+	results := make([]protocol.BatchLogResult, len(req.RepoCommits))
 
-		// Perform requests in each repository in the input batch. We perform these commands
-		// concurrently, but only allow for so many commands to be in-flight at a time so that
-		// we don't overwhelm a shard with either a large request or too many concurrent batch
-		// requests.
+	if s.GlobalBatchLogSemaphore == nil {
+		return protocol.BatchLogResponse{}, errors.New("s.GlobalBatchLogSemaphore not initialized")
+	}
 
-		g, ctx := errgroup.WithContext(ctx)
-		results := make([]protocol.BatchLogResult, len(req.RepoCommits))
+	for i, repoCommit := range req.RepoCommits {
+		// Avoid capture of loop variables
+		i, repoCommit := i, repoCommit
 
-		if s.GlobalBatchLogSemaphore == nil {
-			return http.StatusInternalServerError, errors.New("s.GlobalBatchLogSemaphore not initialized")
+		start := time.Now()
+		if err := s.GlobalBatchLogSemaphore.Acquire(ctx, 1); err != nil {
+			return protocol.BatchLogResponse{}, err
 		}
+		s.operations.batchLogSemaphoreWait.Observe(time.Since(start).Seconds())
 
-		for i, repoCommit := range req.RepoCommits {
-			// Avoid capture of loop variables
-			i, repoCommit := i, repoCommit
+		g.Go(func() error {
+			defer s.GlobalBatchLogSemaphore.Release(1)
 
-			start := time.Now()
-			if err := s.GlobalBatchLogSemaphore.Acquire(ctx, 1); err != nil {
-				return http.StatusInternalServerError, err
+			output, isRepoCloned, err := performGitLogCommand(ctx, repoCommit, req.Format)
+			if err == nil && !isRepoCloned {
+				err = errors.Newf("repo not found")
+			}
+			var errMessage string
+			if err != nil {
+				errMessage = err.Error()
 			}
-			s.operations.batchLogSemaphoreWait.Observe(time.Since(start).Seconds())
-
-			g.Go(func() error {
-				defer s.GlobalBatchLogSemaphore.Release(1)
-
-				output, isRepoCloned, err := performGitLogCommand(ctx, repoCommit, req.Format)
-				if err == nil && !isRepoCloned {
-					err = errors.Newf("repo not found")
-				}
-				var errMessage string
-				if err != nil {
-					errMessage = err.Error()
-				}
-
-				// Concurrently write results to shared slice. This slice is already properly
-				// sized, and each goroutine writes to a unique index exactly once. There should
-				// be no data race conditions possible here.
-
-				results[i] = protocol.BatchLogResult{
-					RepoCommit:    repoCommit,
-					CommandOutput: output,
-					CommandError:  errMessage,
-				}
-				return nil
-			})
-		}
 
-		if err := g.Wait(); err != nil {
-			return http.StatusInternalServerError, err
-		}
+			// Concurrently write results to shared slice. This slice is already properly
+			// sized, and each goroutine writes to a unique index exactly once. There should
+			// be no data race conditions possible here.
 
-		// Write payload to client: implicitly writes 200 OK
-		_ = json.NewEncoder(w).Encode(protocol.BatchLogResponse{Results: results})
-		return 0, nil
+			results[i] = protocol.BatchLogResult{
+				RepoCommit:    repoCommit,
+				CommandOutput: output,
+				CommandError:  errMessage,
+			}
+			return nil
+		})
 	}
 
-	// Handle unexpected error conditions. We expect the instrumented handler to not
-	// have written the status code or any of the body if this error value is non-nil.
-	if statusCodeOnError, err := instrumentedHandler(r.Context()); err != nil {
-		http.Error(w, err.Error(), statusCodeOnError)
-		return
+	if err := g.Wait(); err != nil {
+		return protocol.BatchLogResponse{}, err
 	}
+
+	return protocol.BatchLogResponse{Results: results}, nil
 }
 
 // ensureOperations returns the non-nil operations value supplied to this server
@@ -1902,22 +1926,8 @@ func (s *Server) handleP4Exec(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(req.Args) < 1 {
-		http.Error(w, "args must be greater than or equal to 1", http.StatusBadRequest)
-		return
-	}
-
-	// Make sure the subcommand is explicitly allowed
-	allowlist := []string{"protects", "groups", "users", "group"}
-	allowed := false
-	for _, arg := range allowlist {
-		if req.Args[0] == arg {
-			allowed = true
-			break
-		}
-	}
-	if !allowed {
-		http.Error(w, fmt.Sprintf("subcommand %q is not allowed", req.Args[0]), http.StatusBadRequest)
+	if err := checkP4ExecArgs(req.Args); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -1938,10 +1948,27 @@ func (s *Server) handleP4Exec(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.p4exec(w, r, &req)
+	s.p4execHTTP(w, r, &req)
+}
+
+// checkP4ExecArgs makes sure the p4 subcommand is explicitly allowed.
+func checkP4ExecArgs(args []string) error {
+	if len(args) < 1 {
+		return errors.New("args must be greater than or equal to 1")
+	}
+
+	allowlist := []string{"protects", "groups", "users", "group", "describe"}
+	for _, arg := range allowlist {
+		if args[0] == arg {
+			return nil
+		}
+	}
+	return errors.Newf("subcommand %q is not allowed", args[0])
 }
 
-func (s *Server) p4exec(w http.ResponseWriter, r *http.Request, req *protocol.P4ExecRequest) {
+// p4execHTTP translates the results of a p4exec into the expected HTTP
+// statuses and trailers.
+func (s *Server) p4execHTTP(w http.ResponseWriter, r *http.Request, req *protocol.P4ExecRequest) {
 	logger := s.Logger.Scoped("p4exec", "")
 
 	// Flush writes more aggressively than standard net/http so that clients
@@ -1951,7 +1978,22 @@ func (s *Server) p4exec(w http.ResponseWriter, r *http.Request, req *protocol.P4
 		defer fw.Close()
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), time.Minute)
+	w.Header().Set("Trailer", "X-Exec-Error")
+	w.Header().Add("Trailer", "X-Exec-Exit-Status")
+	w.Header().Add("Trailer", "X-Exec-Stderr")
+	w.WriteHeader(http.StatusOK)
+
+	execStatus := s.p4Exec(r.Context(), logger, req, r.UserAgent(), w)
+
+	// write trailer
+	w.Header().Set("X-Exec-Error", errorString(execStatus.Err))
+	w.Header().Set("X-Exec-Exit-Status", strconv.Itoa(execStatus.ExitStatus))
+	w.Header().Set("X-Exec-Stderr", execStatus.Stderr)
+}
+
+// p4Exec runs a p4 command, writing its stdout to w.
+func (s *Server) p4Exec(ctx context.Context, logger log.Logger, req *protocol.P4ExecRequest, userAgent string, w io.Writer) execStatus {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 
 	start := time.Now()
@@ -2002,7 +2044,7 @@ func (s *Server) p4exec(w http.ResponseWriter, r *http.Request, req *protocol.P4
 				ev.AddField("cmd", cmd)
 				ev.AddField("args", args)
 				ev.AddField("actor", act.UIDString())
-				ev.AddField("client", r.UserAgent())
+				ev.AddField("client", userAgent)
 				ev.AddField("duration_ms", duration.Milliseconds())
 				ev.AddField("stdout_size", stdoutN)
 				ev.AddField("stderr_size", stderrN)
@@ -2032,11 +2074,6 @@ func (s *Server) p4exec(w http.ResponseWriter, r *http.Request, req *protocol.P4
 		}()
 	}
 
-	w.Header().Set("Trailer", "X-Exec-Error")
-	w.Header().Add("Trailer", "X-Exec-Exit-Status")
-	w.Header().Add("Trailer", "X-Exec-Stderr")
-	w.WriteHeader(http.StatusOK)
-
 	var stderrBuf bytes.Buffer
 	stdoutW := &writeCounter{w: w}
 	stderrW := &writeCounter{w: &limitWriter{W: &stderrBuf, N: 1024}}
@@ -2057,12 +2094,11 @@ func (s *Server) p4exec(w http.ResponseWriter, r *http.Request, req *protocol.P4
 	stdoutN = stdoutW.n
 	stderrN = stderrW.n
 
-	stderr := stderrBuf.String()
-
-	// write trailer
-	w.Header().Set("X-Exec-Error", errorString(execErr))
-	w.Header().Set("X-Exec-Exit-Status", status)
-	w.Header().Set("X-Exec-Stderr", stderr)
+	return execStatus{
+		Err:        execErr,
+		Stderr:     stderrBuf.String(),
+		ExitStatus: exitStatus,
+	}
 }
 
 func (s *Server) setLastFetched(ctx context.Context, name api.RepoName) error {
@@ -2306,6 +2342,10 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir GitDir, syn
 		}
 	}
 
+	defer func() {
+		s.enqueueCloneWebhook(repo, remoteURL, err)
+	}()
+
 	tmpPath, err := s.tempDir("clone-")
 	if err != nil {
 		return err
@@ -2406,6 +2446,32 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir GitDir, syn
 	return nil
 }
 
+// enqueueCloneWebhook enqueues the outbound webhook for the result of cloning
+// the given repo.
+func (s *Server) enqueueCloneWebhook(repo api.RepoName, remoteURL *vcs.URL, cloneErr error) {
+	// Use a different context in case we failed because the original context
+	// failed.
+	ctx := actor.WithInternalActor(s.ctx)
+
+	r, err := s.DB.Repos().GetByName(ctx, repo)
+	if err != nil {
+		s.Logger.Warn("failed to get repo for outbound webhook", log.String("repo", string(repo)), log.Error(err))
+		return
+	}
+
+	eventType := outbound.RepoClone
+	payload := outbound.NewRepoPayload(r.ID, r.Name)
+	if cloneErr != nil {
+		// 🚨 SECURITY: The error could include the clone URL, which may contain
+		// a sensitive token.
+		eventType = outbound.RepoCloneError
+		payload.Error = newURLRedactor(remoteURL).redact(cloneErr.Error())
+	}
+
+	scope := string(r.Name)
+	outbound.EnqueueEvent(ctx, s.Logger, s.DB, eventType, &scope, payload)
+}
+
 // readCloneProgress scans the reader and saves the most recent line of output
 // as the lock status.
 func readCloneProgress(logger log.Logger, redactor *urlRedactor, lock *RepositoryLock, pr io.Reader, repo api.RepoName) {
@@ -1,11 +1,16 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/internal/accesslog"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
@@ -34,12 +39,18 @@ func (gs *GRPCServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_Exe
 		})
 	})
 
+	return gs.doExec(ss.Context(), &internalReq, w)
+}
+
+// doExec runs the given exec request, writing stdout to w, and translates the
+// result into a gRPC status.
+func (gs *GRPCServer) doExec(ctx context.Context, req *protocol.ExecRequest, w io.Writer) error {
 	// TODO(camdencheek): set user agent from all grpc clients
-	execStatus, err := gs.Server.exec(ss.Context(), gs.Server.Logger, &internalReq, "unknown-grpc-client", w)
+	execStatus, err := gs.Server.exec(ctx, gs.Server.Logger, req, "unknown-grpc-client", w)
 	if err != nil {
 		if v := (&NotFoundError{}); errors.As(err, &v) {
 			s, err := status.New(codes.NotFound, "repo not found").WithDetails(&proto.NotFoundPayload{
-				Repo:            req.GetRepo(),
+				Repo:            string(req.Repo),
 				CloneInProgress: v.Payload.CloneInProgress,
 				CloneProgress:   v.Payload.CloneProgress,
 			})
@@ -56,13 +67,19 @@ func (gs *GRPCServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_Exe
 		return err
 	}
 
+	return execStatusToGRPC(gs.Server.Logger, execStatus)
+}
+
+// execStatusToGRPC returns a gRPC status carrying an ExecStatusPayload if the
+// command failed, and nil otherwise.
+func execStatusToGRPC(logger log.Logger, execStatus execStatus) error {
 	if execStatus.ExitStatus != 0 || execStatus.Err != nil {
-		s, err := status.New(codes.Unknown, execStatus.Err.Error()).WithDetails(&proto.ExecStatusPayload{
+		s, err := status.New(codes.Unknown, errorString(execStatus.Err)).WithDetails(&proto.ExecStatusPayload{
 			StatusCode: int32(execStatus.ExitStatus),
 			Stderr:     execStatus.Stderr,
 		})
 		if err != nil {
-			gs.Server.Logger.Error("failed to marshal status", log.Error(err))
+			logger.Error("failed to marshal status", log.Error(err))
 			return err
 		}
 		return s.Err()
@@ -101,3 +118,217 @@ func (gs *GRPCServer) Search(req *proto.SearchRequest, ss proto.GitserverService
 		},
 	})
 }
+
+func (gs *GRPCServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverService_ArchiveServer) error {
+	// Log which which actor is accessing the repo.
+	accesslog.Record(ss.Context(), req.GetRepo(),
+		log.String("treeish", req.GetTreeish()),
+		log.String("format", req.GetFormat()),
+		log.Strings("path", req.GetPathspecs()),
+	)
+
+	if err := checkSpecArgSafety(req.GetTreeish()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetRepo() == "" || req.GetFormat() == "" {
+		return status.Error(codes.InvalidArgument, "empty repo or format")
+	}
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.ArchiveResponse{
+			Data: p,
+		})
+	})
+
+	execReq := archiveExecRequest(api.RepoName(req.GetRepo()), req.GetTreeish(), req.GetFormat(), req.GetPathspecs())
+	return gs.doExec(ss.Context(), execReq, w)
+}
+
+// maxBatchLogMessageOutputBytes is the approximate amount of git log output
+// sent in a single BatchLogResponse message, to stay well below the maximum
+// gRPC message size.
+const maxBatchLogMessageOutputBytes = 1024 * 1024
+
+func (gs *GRPCServer) BatchLog(req *proto.BatchLogRequest, ss proto.GitserverService_BatchLogServer) error {
+	internalReq := protocol.BatchLogRequestFromProto(req)
+
+	// Validate request parameters
+	if len(internalReq.RepoCommits) == 0 {
+		return nil
+	}
+	if err := checkBatchLogFormat(internalReq.Format); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := gs.Server.batchGitLog(ss.Context(), internalReq)
+	if err != nil {
+		return err
+	}
+
+	// Send the results in chunks so that the output of many large commits
+	// does not exceed the maximum message size.
+	var (
+		chunk     []*proto.BatchLogResult
+		chunkSize int
+	)
+	for _, result := range resp.Results {
+		chunk = append(chunk, result.ToProto())
+		chunkSize += len(result.CommandOutput) + len(result.CommandError)
+
+		if chunkSize >= maxBatchLogMessageOutputBytes {
+			if err := ss.Send(&proto.BatchLogResponse{Results: chunk}); err != nil {
+				return err
+			}
+			chunk, chunkSize = nil, 0
+		}
+	}
+	if len(chunk) > 0 {
+		return ss.Send(&proto.BatchLogResponse{Results: chunk})
+	}
+
+	return nil
+}
+
+func (gs *GRPCServer) P4Exec(req *proto.P4ExecRequest, ss proto.GitserverService_P4ExecServer) error {
+	internalReq := protocol.P4ExecRequestFromProto(req)
+
+	if err := checkP4ExecArgs(internalReq.Args); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Log which actor is accessing p4-exec.
+	//
+	// p4-exec is currently only used for fetching user based permissions information
+	// so, we don't have a repo name.
+	accesslog.Record(ss.Context(), "<no-repo>",
+		log.String("p4user", internalReq.P4User),
+		log.String("p4port", internalReq.P4Port),
+		log.Strings("args", internalReq.Args),
+	)
+
+	// Make sure credentials are valid before heavier operation
+	if err := p4testWithTrust(ss.Context(), internalReq.P4Port, internalReq.P4User, internalReq.P4Passwd); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.P4ExecResponse{
+			Data: p,
+		})
+	})
+
+	logger := gs.Server.Logger.Scoped("p4exec", "")
+	execStatus := gs.Server.p4Exec(ss.Context(), logger, &internalReq, "unknown-grpc-client", w)
+	return execStatusToGRPC(logger, execStatus)
+}
+
+func (gs *GRPCServer) IsRepoCloneable(ctx context.Context, req *proto.IsRepoCloneableRequest) (*proto.IsRepoCloneableResponse, error) {
+	repo := api.RepoName(req.GetRepo())
+	if repo == "" {
+		return nil, status.Error(codes.InvalidArgument, "no Repo given")
+	}
+
+	resp, err := gs.Server.isRepoCloneable(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ToProto(), nil
+}
+
+func (gs *GRPCServer) ReposStats(ctx context.Context, req *proto.ReposStatsRequest) (*proto.ReposStatsResponse, error) {
+	b, err := gs.Server.readReposStatsFile()
+	if err != nil {
+		return nil, err
+	}
+
+	var stats protocol.ReposStats
+	if err := json.Unmarshal(b, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats.ToProto(), nil
+}
+
+func (gs *GRPCServer) RepoCloneProgress(ctx context.Context, req *proto.RepoCloneProgressRequest) (*proto.RepoCloneProgressResponse, error) {
+	repositories := req.GetRepos()
+
+	resp := protocol.RepoCloneProgressResponse{
+		Results: make(map[api.RepoName]*protocol.RepoCloneProgress, len(repositories)),
+	}
+	for _, repo := range repositories {
+		repoName := api.RepoName(repo)
+		resp.Results[repoName] = gs.Server.repoCloneProgress(repoName)
+	}
+
+	return resp.ToProto(), nil
+}
+
+func (gs *GRPCServer) RepoDelete(ctx context.Context, req *proto.RepoDeleteRequest) (*proto.RepoDeleteResponse, error) {
+	repo := api.RepoName(req.GetRepo())
+
+	if err := gs.Server.deleteRepo(ctx, repo); err != nil {
+		gs.Server.Logger.Error("failed to delete repository", log.String("repo", string(repo)), log.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete repository %s: %s", repo, err)
+	}
+	gs.Server.Logger.Info("deleted repository", log.String("repo", string(repo)))
+
+	return &proto.RepoDeleteResponse{}, nil
+}
+
+func (gs *GRPCServer) RepoUpdate(ctx context.Context, req *proto.RepoUpdateRequest) (*proto.RepoUpdateResponse, error) {
+	internalReq := protocol.RepoUpdateRequestFromProto(req)
+	resp := gs.Server.repoUpdate(&internalReq)
+	return resp.ToProto(), nil
+}
+
+func (gs *GRPCServer) RepoClone(ctx context.Context, req *proto.RepoCloneRequest) (*proto.RepoCloneResponse, error) {
+	resp := gs.Server.repoClone(api.RepoName(req.GetRepo()))
+	return &proto.RepoCloneResponse{Error: resp.Error}, nil
+}
+
+func (gs *GRPCServer) CreateCommitFromPatchBinary(ctx context.Context, req *proto.CreateCommitFromPatchBinaryRequest) (*proto.CreateCommitFromPatchBinaryResponse, error) {
+	_, resp := gs.Server.createCommitFromPatch(ctx, protocol.CreateCommitFromPatchRequestFromProto(req))
+
+	if resp.Error != nil {
+		s, err := status.New(codes.Internal, resp.Error.Error()).WithDetails(resp.Error.ToProto())
+		if err != nil {
+			gs.Server.Logger.Error("failed to marshal status", log.Error(err))
+			return nil, err
+		}
+		return nil, s.Err()
+	}
+
+	return &proto.CreateCommitFromPatchBinaryResponse{
+		Rev:          resp.Rev,
+		ChangelistId: resp.ChangelistID,
+	}, nil
+}
+
+func (gs *GRPCServer) GetObject(ctx context.Context, req *proto.GetObjectRequest) (*proto.GetObjectResponse, error) {
+	repo := api.RepoName(req.GetRepo())
+
+	// Log which actor is accessing the repo.
+	accesslog.Record(ctx, string(repo), log.String("objectname", req.GetObjectName()))
+
+	obj, err := gs.Server.getObjectFunc()(ctx, repo, req.GetObjectName())
+	if err != nil {
+		if notExistError := new(gitdomain.RepoNotExistError); errors.As(err, &notExistError) {
+			st, _ := status.New(codes.NotFound, err.Error()).WithDetails(&proto.NotFoundPayload{
+				Repo:            string(notExistError.Repo),
+				CloneInProgress: notExistError.CloneInProgress,
+				CloneProgress:   notExistError.CloneProgress,
+			})
+			return nil, st.Err()
+		}
+		gs.Server.Logger.Error("getting object", log.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := protocol.GetObjectResponse{
+		Object: *obj,
+	}
+
+	return resp.ToProto(), nil
+}
@@ -546,6 +546,12 @@ func makeTestServer(ctx context.Context, t *testing.T, repoDir, remote string, d
 		mDB := database.NewMockDB()
 		gr := database.NewMockGitserverRepoStore()
 		mDB.GitserverReposFunc.SetDefaultReturn(gr)
+		repos := database.NewMockRepoStore()
+		repos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+			return &types.Repo{Name: name}, nil
+		})
+		mDB.ReposFunc.SetDefaultReturn(repos)
+		mDB.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
 		db = mDB
 	}
 	s := &Server{
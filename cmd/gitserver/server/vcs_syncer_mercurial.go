@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MercurialRepoSyncer is a syncer for Mercurial repositories. It relies on the
+// git-remote-hg helper to present the Mercurial remote as a git remote, so the
+// repository on disk is a regular bare git repository.
+//
+// git-remote-hg keeps its conversion state (the hg to git marks) inside the
+// git directory, so every Fetch after the initial clone only converts the
+// changesets that were added since. Mercurial bookmarks are mapped to
+// refs/heads/<bookmark>, named branches to refs/heads/branches/<branch> and
+// tags to refs/tags/<tag>.
+type MercurialRepoSyncer struct{}
+
+func (s *MercurialRepoSyncer) Type() string {
+	return "hg"
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *MercurialRepoSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	args := []string{"ls-remote", hgRemote(remoteURL), "HEAD"}
+	ctx, cancel := context.WithTimeout(ctx, shortGitCommandTimeout(args))
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = hgCommandEnv()
+	out, err := runWith(ctx, wrexec.Wrap(ctx, log.NoOp(), cmd), true, nil)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = &GitCommandError{Err: err, Output: newURLRedactor(remoteURL).redact(string(out))}
+		}
+		return err
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for cloning a Mercurial
+// repository into a bare git repository.
+func (s *MercurialRepoSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (cmd *exec.Cmd, err error) {
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "init", "--bare", ".")
+	cmd.Dir = tmpPath
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(&GitCommandError{Err: err}, "clone setup failed")
+	}
+
+	cmd = s.fetchCommand(ctx, remoteURL)
+	cmd.Dir = tmpPath
+	return cmd, nil
+}
+
+// Fetch tries to fetch updates of a Mercurial repository. Only the changesets
+// that have not been converted yet are imported.
+func (s *MercurialRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir, _ string) error {
+	cmd := s.fetchCommand(ctx, remoteURL)
+	dir.Set(cmd)
+	if output, err := runWith(ctx, wrexec.Wrap(ctx, log.NoOp(), cmd), true, nil); err != nil {
+		return errors.Wrapf(&GitCommandError{Err: err, Output: newURLRedactor(remoteURL).redact(string(output))}, "failed to update")
+	}
+	return nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing the remote
+// of a Mercurial repository.
+func (s *MercurialRepoSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	cmd = exec.CommandContext(ctx, "git", "remote", "show", hgRemote(remoteURL))
+	cmd.Env = hgCommandEnv()
+	return cmd, nil
+}
+
+func (s *MercurialRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "git", "fetch",
+		"--progress", "--prune", hgRemote(remoteURL),
+		// Bookmarks and named branches (under branches/)
+		"+refs/heads/*:refs/heads/*",
+		// Tags
+		"+refs/tags/*:refs/tags/*")
+	cmd.Env = hgCommandEnv()
+	return cmd
+}
+
+// hgRemote returns the remote that makes git use the git-remote-hg helper.
+func hgRemote(remoteURL *vcs.URL) string {
+	return "hg::" + remoteURL.String()
+}
+
+// hgCommandEnv returns the environment for git commands talking to a Mercurial
+// remote. HGPLAIN makes hg ignore user configuration that changes its output
+// (aliases, localization, ...), which git-remote-hg parses.
+func hgCommandEnv() []string {
+	return append(os.Environ(), "HGPLAIN=1")
+}
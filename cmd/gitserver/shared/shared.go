@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/audit"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -110,6 +111,9 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		return errors.Wrap(err, "initializing database stores")
 	}
 	db := database.NewDB(observationCtx.Logger, sqlDB)
+	audit.SetStore(db.AuditLogs())
+	// Persist the audit log entries that are still queued before exiting.
+	defer audit.SetStore(nil)
 
 	repoStore := db.Repos()
 	dependenciesSvc := dependencies.NewService(observationCtx, db)
@@ -517,6 +521,8 @@ func getVCSSyncer(
 		}
 		cli := rubygems.NewClient(urn, c.Repository, httpcli.ExternalDoer)
 		return server.NewRubyPackagesSyncer(&c, depsSvc, cli), nil
+	case extsvc.TypeMercurial:
+		return &server.MercurialRepoSyncer{}, nil
 	}
 	return &server.GitRepoSyncer{}, nil
 }
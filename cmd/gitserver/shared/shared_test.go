@@ -191,3 +191,53 @@ func TestGetVCSSyncer(t *testing.T) {
 		t.Fatalf("Want *server.PerforceDepotSyncer, got %T", s)
 	}
 }
+
+func TestGetVCSSyncerMercurial(t *testing.T) {
+	tempReposDir, err := os.MkdirTemp("", "TestGetVCSSyncerMercurial")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.RemoveAll(tempReposDir); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	repo := api.RepoName("foo/bar")
+	extsvcStore := database.NewMockExternalServiceStore()
+	repoStore := database.NewMockRepoStore()
+	depsSvc := new(dependencies.Service)
+
+	repoStore.GetByNameFunc.SetDefaultHook(func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{
+			ExternalRepo: api.ExternalRepoSpec{
+				ServiceType: extsvc.TypeMercurial,
+			},
+			Sources: map[string]*types.SourceInfo{
+				"a": {
+					ID:       "abc",
+					CloneURL: "example.com",
+				},
+			},
+		}, nil
+	})
+
+	extsvcStore.GetByIDFunc.SetDefaultHook(func(ctx context.Context, i int64) (*types.ExternalService, error) {
+		return &types.ExternalService{
+			ID:          1,
+			Kind:        extsvc.KindMercurial,
+			DisplayName: "test",
+			Config:      extsvc.NewEmptyConfig(),
+		}, nil
+	})
+
+	s, err := getVCSSyncer(context.Background(), extsvcStore, repoStore, depsSvc, repo, tempReposDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := s.(*server.MercurialRepoSyncer)
+	if !ok {
+		t.Fatalf("Want *server.MercurialRepoSyncer, got %T", s)
+	}
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/service"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -171,7 +172,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		debugDumpers, enqueueRepoPerms = enterpriseInit(observationCtx, db, store, keyring.Default(), cf, server)
 	}
 
-	go watchSyncer(ctx, logger, syncer, updateScheduler, enqueueRepoPerms, server.ChangesetSyncRegistry)
+	go watchSyncer(ctx, logger, db, syncer, updateScheduler, enqueueRepoPerms, server.ChangesetSyncRegistry)
 	go func() {
 		err := syncer.Run(ctx, store, repos.RunOptions{
 			EnqueueInterval: repos.ConfRepoListUpdateInterval,
@@ -463,6 +464,7 @@ func repoUpdaterStatsHandler(debugDumpers map[string]debugserver.Dumper) http.Ha
 func watchSyncer(
 	ctx context.Context,
 	logger log.Logger,
+	db database.DB,
 	syncer *repos.Syncer,
 	sched *repos.UpdateScheduler,
 	enqueueRepoPermsJob func(ctx context.Context, repo api.RepoID, syncReason database.PermissionsSyncJobReason) error,
@@ -499,6 +501,8 @@ func watchSyncer(
 					}
 				}
 			}
+
+			enqueueRepoWebhooks(ctx, logger, db, diff)
 		}
 	}
 }
@@ -521,6 +525,41 @@ func getPrivateAddedOrModifiedRepos(diff repos.Diff) []api.RepoID {
 	return repoIDs
 }
 
+// enqueueRepoWebhooks enqueues the outbound webhooks for the repos that were
+// added or removed.
+func enqueueRepoWebhooks(ctx context.Context, logger log.Logger, db database.DB, diff repos.Diff) {
+	for _, r := range diff.Added {
+		scope := string(r.Name)
+		outbound.EnqueueEvent(ctx, logger, db, outbound.RepoAdd, &scope, outbound.NewRepoPayload(r.ID, r.Name))
+	}
+
+	if len(diff.Deleted) == 0 {
+		return
+	}
+
+	// Deleted repos in the diff only have their ID set. They also include the
+	// repos that are still synced by another external service, which aren't
+	// deleted.
+	deleted, err := db.Repos().List(ctx, database.ReposListOptions{
+		IDs:            diff.Deleted.IDs(),
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		logger.Warn("error listing deleted repos for outbound webhooks", log.Error(err))
+		return
+	}
+	for _, r := range deleted {
+		if !r.IsDeleted() {
+			continue
+		}
+
+		// Deleted repos are renamed in the database.
+		name := api.UndeletedRepoName(r.Name)
+		scope := string(name)
+		outbound.EnqueueEvent(ctx, logger, db, outbound.RepoRemove, &scope, outbound.NewRepoPayload(r.ID, name))
+	}
+}
+
 // manageUnclonedRepos will periodically list the uncloned repositories on gitserver
 // and update the scheduler with the list. It also ensures that if any of our
 // indexable repos are missing from the cloned list they will be added for
@@ -74,6 +74,12 @@ func makeSearchConditions(args search.SymbolsParameters) []*sqlf.Query {
 	for _, includePattern := range args.IncludePatterns {
 		conditions = append(conditions, makeSearchCondition("path", includePattern, args.IsCaseSensitive))
 	}
+	conditions = append(conditions, makeKindCondition(args.IncludeKinds))
+	conditions = append(conditions, negate(makeKindCondition(args.ExcludeKinds)))
+	for _, includeContainer := range args.IncludeContainers {
+		conditions = append(conditions, makeContainerCondition(includeContainer, args.IsCaseSensitive))
+	}
+	conditions = append(conditions, negate(makeContainerCondition(args.ExcludeContainer, args.IsCaseSensitive)))
 
 	filtered := conditions[:0]
 	for _, condition := range conditions {
@@ -120,6 +126,45 @@ func makeSearchCondition(column string, regex string, isCaseSensitive bool) *sql
 	return sqlf.Sprintf(column+" REGEXP %s", regex)
 }
 
+// makeKindCondition returns a condition matching symbols of any of the given
+// symbol selector kinds.
+func makeKindCondition(selectKinds []string) *sqlf.Query {
+	if len(selectKinds) == 0 {
+		return nil
+	}
+
+	var kinds []*sqlf.Query
+	for _, selectKind := range selectKinds {
+		for _, kind := range result.SymbolKindsForSelectKind(selectKind) {
+			kinds = append(kinds, sqlf.Sprintf("%s", kind))
+		}
+	}
+	return sqlf.Sprintf("lower(kind) IN (%s)", sqlf.Join(kinds, ","))
+}
+
+// makeContainerCondition is like makeSearchCondition for the parent column,
+// which has no lowercase counterpart.
+func makeContainerCondition(regex string, isCaseSensitive bool) *sqlf.Query {
+	if regex == "" {
+		return nil
+	}
+
+	// Exact match
+	if parentName, isExact, err := isLiteralEquality(regex); err == nil && isExact {
+		if isCaseSensitive {
+			return sqlf.Sprintf("parent = %s", parentName)
+		} else {
+			return sqlf.Sprintf("lower(parent) = %s", strings.ToLower(parentName))
+		}
+	}
+
+	// Regex match
+	if !isCaseSensitive {
+		regex = "(?i:" + regex + ")"
+	}
+	return sqlf.Sprintf("parent REGEXP %s", regex)
+}
+
 // isLiteralEquality returns true if the given regex matches literal strings exactly.
 // If so, this function returns true along with the literal search query. If not, this
 // function returns false.
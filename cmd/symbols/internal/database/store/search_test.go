@@ -1,6 +1,13 @@
 package store
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+)
 
 func TestIsLiteralEquality(t *testing.T) {
 	for _, test := range []struct {
@@ -101,3 +108,35 @@ func TestEscapeGlob(t *testing.T) {
 		}
 	}
 }
+
+func TestMakeSearchConditions(t *testing.T) {
+	args := search.SymbolsParameters{
+		Query:             "^Run",
+		IncludeKinds:      []string{"method"},
+		ExcludeKinds:      []string{"Enum"},
+		IncludeContainers: []string{"^Server$"},
+		ExcludeContainer:  "Test",
+	}
+
+	var queries []string
+	var queryArgs []any
+	for _, condition := range makeSearchConditions(args) {
+		queries = append(queries, condition.Query(sqlf.PostgresBindVar))
+		queryArgs = append(queryArgs, condition.Args()...)
+	}
+
+	wantQueries := []string{
+		"namelowercase GLOB $1",
+		"lower(kind) IN ($1 , $2)",
+		"NOT lower(kind) IN ($1)",
+		"lower(parent) = $1",
+		"NOT parent REGEXP $1",
+	}
+	if diff := cmp.Diff(wantQueries, queries); diff != "" {
+		t.Errorf("unexpected conditions (-want +got):\n%s", diff)
+	}
+	wantArgs := []any{"run*", "method", "methodspec", "enum", "server", "(?i:Test)"}
+	if diff := cmp.Diff(wantArgs, queryArgs); diff != "" {
+		t.Errorf("unexpected arguments (-want +got):\n%s", diff)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/smacker/go-tree-sitter/javascript"
 	"github.com/smacker/go-tree-sitter/python"
 	"github.com/smacker/go-tree-sitter/ruby"
+	"github.com/smacker/go-tree-sitter/rust"
 	"github.com/smacker/go-tree-sitter/typescript/tsx"
 )
 
@@ -52,6 +53,10 @@ type LangSpec struct {
 	// localsQuery is a tree-sitter localsQuery that finds scopes and defs.
 	localsQuery          string
 	topLevelSymbolsQuery string
+	// refNamespaces maps identifier node types to the namespace of the definitions they
+	// refer to, matching the "definition.<namespace>" captures of localsQuery. Identifiers
+	// of other types refer to definitions in the default namespace.
+	refNamespaces map[string]string
 }
 
 // Info about comments in a language.
@@ -109,6 +114,7 @@ var langToLangSpec = map[string]LangSpec{
 			codeFenceName: "go",
 		},
 		localsQuery: `
+(source_file)             @scope ; package p ...
 (block)                   @scope ; { ... }
 (function_declaration)    @scope ; func f() { ... }
 (method_declaration)      @scope ; func (r R) f() { ... }
@@ -117,6 +123,7 @@ var langToLangSpec = map[string]LangSpec{
 (for_statement)           @scope ; for x := range xs { ... }
 (expression_case)         @scope ; case "foo": ...
 (communication_case)      @scope ; case x := <-ch: ...
+(type_switch_statement)   @scope ; switch x := y.(type) { ... }
 
 (var_spec              name: (identifier) @definition)                   ; var x int = ...
 (const_spec            name: (identifier) @definition)                   ; const x int = ...
@@ -124,7 +131,16 @@ var langToLangSpec = map[string]LangSpec{
 (short_var_declaration left: (expression_list (identifier) @definition)) ; x, y := ...
 (range_clause          left: (expression_list (identifier) @definition)) ; for i := range ... { ... }
 (receive_statement     left: (expression_list (identifier) @definition)) ; case x := <-ch: ...
+
+(type_switch_statement alias: (expression_list (identifier) @definition))    ; switch x := y.(type) { ... }
+(import_spec           name: (package_identifier) @definition)               ; import f "fmt"
+(import_spec           !name path: (interpreted_string_literal) @definition) ; import "fmt"
+(field_declaration     name: (field_identifier) @definition.field)           ; struct { x int }
 `,
+		refNamespaces: map[string]string{
+			"field_identifier": "field", // x.f, T{f: ...}
+			"type_identifier":  "type",  // var x T
+		},
 	},
 	"csharp": {
 		name:     "csharp",
@@ -205,6 +221,7 @@ var langToLangSpec = map[string]LangSpec{
 			codeFenceName: "typescript",
 		},
 		localsQuery: `
+(program)                        @scope ; import ...
 (class_declaration)              @scope ; class C { ... }
 (method_definition)              @scope ; class ... { f() { ... } }
 (statement_block)                @scope ; { ... }
@@ -217,6 +234,11 @@ var langToLangSpec = map[string]LangSpec{
 (generator_function_declaration) @scope ; function *f(x) { ... }
 (arrow_function)                 @scope ; x => ...
 
+(import_clause (identifier) @definition)                 ; import x from '...'
+(namespace_import (identifier) @definition)              ; import * as x from '...'
+(import_specifier !alias name: (identifier) @definition) ; import { x } from '...'
+(import_specifier alias: (identifier) @definition)       ; import { y as x } from '...'
+
 (variable_declarator name: (identifier) @definition)            ; const x = ...
 (function_declaration name: (identifier) @definition)           ; function f() { ... }
 (generator_function_declaration name: (identifier) @definition) ; function *f() { ... }
@@ -227,7 +249,16 @@ var langToLangSpec = map[string]LangSpec{
 (arrow_function parameter: (identifier) @definition)            ; x => ...
 (for_in_statement left: (identifier) @definition)               ; for (const x of xs) ...
 (catch_clause parameter: (identifier) @definition)              ; catch (e) ...
+
+(object_pattern (shorthand_property_identifier_pattern) @definition)    ; const { x } = ...
+(object_pattern (pair_pattern value: (identifier) @definition))         ; const { y: x } = ...
+(array_pattern (identifier) @definition)                                ; const [x] = ...
+(public_field_definition name: (property_identifier) @definition.field) ; class C { x = 5 }
+(property_signature name: (property_identifier) @definition.field)      ; interface I { x: number }
 `,
+		refNamespaces: map[string]string{
+			"property_identifier": "field", // this.x, { x: 5 }
+		},
 	},
 	"cpp": {
 		name:     "cpp",
@@ -279,6 +310,44 @@ var langToLangSpec = map[string]LangSpec{
 (for                  pattern: (identifier) @definition) ; for i in 1..5 ...
 `,
 	},
+	"rust": {
+		name:     "rust",
+		language: rust.GetLanguage(),
+		commentStyle: CommentStyle{
+			nodeTypes:     []string{"line_comment", "block_comment"},
+			stripRegex:    regexp.MustCompile(`^//[/!]?|^\s*\*/?|^/\*\*|\*/$`),
+			ignoreRegex:   javaStyleIgnoreRegex,
+			codeFenceName: "rust",
+		},
+		localsQuery: `
+(source_file)          @scope ; use ...
+(block)                @scope ; { ... }
+(function_item)        @scope ; fn f() { ... }
+(closure_expression)   @scope ; |x| ...
+(for_expression)       @scope ; for x in xs { ... }
+(if_let_expression)    @scope ; if let Some(x) = ... { ... }
+(while_let_expression) @scope ; while let Some(x) = ... { ... }
+(match_arm)            @scope ; Some(x) => ...
+
+(use_declaration argument: (identifier) @definition)                           ; use x;
+(use_declaration argument: (scoped_identifier name: (identifier) @definition)) ; use a::x;
+(use_list (identifier) @definition)                                            ; use a::{x};
+(use_list (scoped_identifier name: (identifier) @definition))                  ; use a::{b::x};
+(use_as_clause alias: (identifier) @definition)                                ; use a::y as x;
+(let_declaration pattern: (identifier) @definition)                            ; let x = ...;
+(tuple_pattern (identifier) @definition)                                       ; let (x, y) = ...;
+(tuple_struct_pattern type: (_) (identifier) @definition)                      ; Some(x) => ...
+(field_pattern name: (shorthand_field_identifier) @definition)                 ; let S { x, .. } = ...;
+(field_pattern pattern: (identifier) @definition)                              ; let S { y: x, .. } = ...;
+(parameter pattern: (identifier) @definition)                                  ; fn f(x: u32) { ... }
+(closure_parameters (identifier) @definition)                                  ; |x| ...
+(for_expression pattern: (identifier) @definition)                             ; for x in xs { ... }
+(field_declaration name: (field_identifier) @definition.field)                 ; struct S { x: u32 }
+`,
+		refNamespaces: map[string]string{
+			"field_identifier": "field", // s.x, S { x: ... }
+		},
+	},
 	"starlark": {
 		name:     "starlark",
 		language: python.GetLanguage(),
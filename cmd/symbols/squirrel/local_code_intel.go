@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/grafana/regexp"
 	sitter "github.com/smacker/go-tree-sitter"
 
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -16,8 +17,15 @@ import (
 // Nominal type for symbol names.
 type SymbolName string
 
-// Scope is a mapping from symbol name to symbol.
-type Scope = map[SymbolName]*PartialSymbol // pointer for mutability
+// Scope is a mapping from namespaced symbol name to symbol.
+type Scope = map[ScopedSymbolName]*PartialSymbol // pointer for mutability
+
+// ScopedSymbolName is a symbol name within a namespace. Symbols in different namespaces
+// (e.g. a struct field and a local variable) with the same name don't shadow each other.
+type ScopedSymbolName struct {
+	Namespace string
+	Name      SymbolName
+}
 
 // PartialSymbol is the same as types.Symbol, but with the refs stored in a map to deduplicate.
 type PartialSymbol struct {
@@ -40,7 +48,7 @@ func (squirrel *SquirrelService) LocalCodeIntel(ctx context.Context, repoCommitP
 	scopes := map[NodeId]Scope{}
 	err = forEachCapture(root.LangSpec.localsQuery, *root, func(nameToNode map[string]Node) {
 		if node, ok := nameToNode["scope"]; ok {
-			scopes[nodeId(node.Node)] = map[SymbolName]*PartialSymbol{}
+			scopes[nodeId(node.Node)] = Scope{}
 			return
 		}
 	})
@@ -53,12 +61,15 @@ func (squirrel *SquirrelService) LocalCodeIntel(ctx context.Context, repoCommitP
 		for captureName, node := range nameToNode {
 			// Only collect "definition*" captures.
 			if strings.HasPrefix(captureName, "definition") {
+				// "definition.field" defines a symbol in the "field" namespace.
+				namespace := strings.TrimPrefix(strings.TrimPrefix(captureName, "definition"), ".")
+
 				// Find the nearest scope (if it exists).
 				for cur := node.Node; cur != nil; cur = cur.Parent() {
 					// Found the scope.
 					if scope, ok := scopes[nodeId(cur)]; ok {
 						// Get the symbol name.
-						symbolName := SymbolName(node.Content(node.Contents))
+						symbolName := ScopedSymbolName{Namespace: namespace, Name: definitionName(node)}
 
 						// Skip the symbol if it's already defined.
 						if _, ok := scope[symbolName]; ok {
@@ -67,7 +78,7 @@ func (squirrel *SquirrelService) LocalCodeIntel(ctx context.Context, repoCommitP
 
 						// Put the symbol in the scope.
 						scope[symbolName] = &PartialSymbol{
-							Name:  string(symbolName),
+							Name:  string(symbolName.Name),
 							Hover: findHover(node),
 							Def:   nodeToRange(node.Node),
 							Refs:  map[types.Range]struct{}{},
@@ -92,7 +103,10 @@ func (squirrel *SquirrelService) LocalCodeIntel(ctx context.Context, repoCommitP
 		}
 
 		// Get the symbol name.
-		symbolName := SymbolName(node.Content(root.Contents))
+		symbolName := ScopedSymbolName{
+			Namespace: root.LangSpec.refNamespaces[node.Type()],
+			Name:      SymbolName(node.Content(root.Contents)),
+		}
 
 		// Find the nearest scope (if it exists).
 		for cur := node; cur != nil; cur = cur.Parent() {
@@ -134,6 +148,27 @@ func (squirrel *SquirrelService) LocalCodeIntel(ctx context.Context, repoCommitP
 	return &types.LocalCodeIntelPayload{Symbols: symbols}, nil
 }
 
+// definitionName returns the name of the symbol defined by the given node.
+func definitionName(node Node) SymbolName {
+	// Unaliased Go imports define the last path component, skipping major version
+	// suffixes: both "github.com/go-chi/chi/v5" and "gopkg.in/chi.v5" define chi.
+	if node.LangSpec.name == "go" && node.Type() == "interpreted_string_literal" {
+		components := strings.Split(strings.Trim(node.Content(node.Contents), `"`), "/")
+		name := components[len(components)-1]
+		if len(components) > 1 && goMajorVersionComponent.MatchString(name) {
+			name = components[len(components)-2]
+		}
+		return SymbolName(goMajorVersionSuffix.ReplaceAllString(name, ""))
+	}
+
+	return SymbolName(node.Content(node.Contents))
+}
+
+var (
+	goMajorVersionComponent = regexp.MustCompile(`^v[0-9]+$`)
+	goMajorVersionSuffix    = regexp.MustCompile(`\.v[0-9]+$`)
+)
+
 // Pretty prints the local code intel payload for debugging.
 func prettyPrintLocalCodeIntelPayload(w io.Writer, payload types.LocalCodeIntelPayload, contents string) {
 	lines := strings.Split(contents, "\n")
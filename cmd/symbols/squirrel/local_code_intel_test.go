@@ -47,6 +47,8 @@ class Foo {
 `}, {
 		path: "test.go",
 		contents: `
+//  v x def
+//  v x ref
 var x = 5
 
 //      v f1.p def
@@ -89,6 +91,58 @@ func f1(p int) {
 	case x := <-ch:
 	}
 }
+`}, {
+		path: "imports.go",
+		contents: `
+package p
+
+import (
+//  v fmt def
+	"fmt"
+//  v chi def
+	"github.com/go-chi/chi/v5"
+//  v yaml def
+	"gopkg.in/yaml.v3"
+
+	str "strings" // < "str" str def < "str" str ref
+)
+
+type T struct {
+	Name string // < "Name" T.Name def < "Name" T.Name ref
+}
+
+//     v f.t def
+//     v f.t ref
+//          v f.x def
+//          v f.x ref
+func f(t T, x any) {
+	//     vvvv T.Name ref
+	u := T{Name: "x"} // < "u" f.u def < "u" f.u ref
+	//          v f.t ref
+	//            vvvv T.Name ref
+	//                  v f.u ref
+	//                    vvvv T.Name ref
+	//                          vvv str ref
+	//                                           vvv chi ref
+	//                                                            vvvv yaml ref
+	fmt.Println(t.Name, u.Name, str.ToUpper(""), chi.NewRouter(), yaml.Marshal) // < "fmt" fmt ref
+
+	//     v f.v def
+	//     v f.v ref
+	//          v f.x ref
+	switch v := x.(type) {
+	case int:
+		//          v f.v ref
+		fmt.Println(v) // < "fmt" fmt ref
+	}
+
+	//  v f.s def
+	//  v f.s ref
+	//    vvv fmt ref
+	var s fmt.Stringer
+	//  v f.s ref
+	_ = s
+}
 `}, {
 		path: "test.cs",
 		contents: `
@@ -219,6 +273,8 @@ const f = (p1, p2 = 3, ...p3) => {
 `}, {
 		path: "test.ts",
 		contents: `
+//    v f def
+//    v f ref
 //         vv f.p1 def
 //         vv f.p1 ref
 //                      vv f.p2 def
@@ -260,6 +316,55 @@ const f = (p1?: number, p2 = 3, ...p3) => {
 		console.log(e)
 	}
 }
+`}, {
+		path: "imports.ts",
+		contents: `
+//     vvvvv React def
+//     vvvvv React ref
+//              vvvvvvvv useState def
+//              vvvvvvvv useState ref
+//                                     vvvv Base def
+//                                     vvvv Base ref
+import React, { useState, Component as Base } from 'react'
+//          vvvv path def
+//          vvvv path ref
+import * as path from 'path'
+
+interface Props {
+    name: string // < "name" Props.name def < "name" Props.name ref
+}
+
+//                    vvvv Base ref
+class Greeter extends Base {
+    count = 0 // < "count" count def < "count" count ref
+    //    vvvvv greet.props def
+    //    vvvvv greet.props ref
+    greet(props: Props) {
+        //     vvvvv greet.value def
+        //     vvvvv greet.value ref
+        //            vvvvvvvv greet.setValue def
+        //            vvvvvvvv greet.setValue ref
+        //                        vvvvvvvv useState ref
+        //                                 vvvvv greet.props ref
+        //                                       vvvv Props.name ref
+        const [value, setValue] = useState(props.name)
+        //      vvvv greet.name def
+        //      vvvv greet.name ref
+        //            vvvvv count ref
+        //                   v greet.n def
+        //                   v greet.n ref
+        //                         vvvvv greet.props ref
+        const { name, count: n } = props
+        //   vvvvv count ref
+        //           v greet.n ref
+        this.count = n
+        //     vvvv path ref
+        //               vvvvv greet.value ref
+        //                      vvvv greet.name ref
+        //                            vvvvv React ref
+        return path.join(value, name, React.version)
+    }
+}
 `}, {
 		path: "test.cpp",
 		contents: `
@@ -334,6 +439,86 @@ def f(p1, p2 = 3, *p3, **p4)
 		puts e
 	end
 end
+`}, {
+		path: "test.rs",
+		contents: `
+//                    vvvvvvv HashMap def
+//                    vvvvvvv HashMap ref
+use std::collections::HashMap;
+//                          v R def
+//                          v R ref
+//                             vvvvv Write def
+//                             vvvvv Write ref
+use std::io::{self, Read as R, Write};
+
+struct Point {
+    x: u32, // < "x" Point.x def < "x" Point.x ref
+    y: u32, // < "y" Point.y def < "y" Point.y ref
+}
+
+//   v f.p def
+//   v f.p ref
+//                 v f.m def
+//                 v f.m ref
+//                    vvvvvvv HashMap ref
+fn f(p: Point, mut m: HashMap<u32, u32>) -> u32 {
+    //   v f.a def
+    //   v f.a ref
+    //      v f.b def
+    //      v f.b ref
+    //            v f.p ref
+    //              v Point.x ref
+    //                 v f.p ref
+    //                   v Point.y ref
+    let (a, b) = (p.x, p.y);
+    //  v f.q def
+    //  v f.q ref
+    //              v Point.x ref
+    //                 v f.a ref
+    //                    v Point.y ref
+    //                       v f.b ref
+    let q = Point { x: a, y: b };
+    //          v f.x def
+    //          v f.x ref
+    //             v Point.y ref
+    //                v f.z def
+    //                v f.z ref
+    //                      v f.q ref
+    let Point { x, y: z } = q;
+    //  vvv f.add def
+    //  vvv f.add ref
+    //         v add.l def
+    //         v add.l ref
+    //            v add.r def
+    //            v add.r ref
+    //                    v add.l ref
+    //                        v add.r ref
+    let add = |l, r: u32| l + r;
+    //  v f.i def
+    //  v f.i ref
+    for i in 0..3 {
+        //       v f.i ref
+        //          vvv f.add ref
+        //              v f.x ref
+        //                 v f.z ref
+        m.insert(i, add(x, z)); // < "m" f.m ref
+    }
+    //          v f.v def
+    //          v f.v ref
+    //               v f.m ref
+    if let Some(v) = m.get(&1) {
+        //      v f.v ref
+        return *v;
+    }
+    //    v f.m ref
+    match m.get(&2) {
+        //   v f.w def
+        //   v f.w ref
+        //          v f.w ref
+        Some(w) => *w,
+        None => 0,
+    }
+}
 `},
 	}
 
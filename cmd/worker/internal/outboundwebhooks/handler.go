@@ -37,10 +37,7 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job *types.Outb
 
 	webhooks, err := h.store.List(ctx, database.OutboundWebhookListOpts{
 		OutboundWebhookCountOpts: database.OutboundWebhookCountOpts{
-			EventTypes: []database.FilterEventType{{
-				EventType: job.EventType,
-				Scope:     job.Scope,
-			}},
+			EventTypes: outbound.FilterEventTypes(job.EventType, job.Scope),
 		},
 	})
 	if err != nil {
@@ -11,11 +11,15 @@ type Config struct {
 	env.BaseConfig
 
 	EmbeddingsUploadStoreConfig *emb.EmbeddingsUploadStoreConfig
+
+	RepoEmbeddingIndexCacheSize int
 }
 
 func (c *Config) Load() {
 	c.EmbeddingsUploadStoreConfig = &emb.EmbeddingsUploadStoreConfig{}
 	c.EmbeddingsUploadStoreConfig.Load()
+
+	c.RepoEmbeddingIndexCacheSize = c.GetInt("EMBEDDINGS_REPO_INDEX_CACHE_SIZE", "5", "The maximum number of repository embedding indexes kept in memory.")
 }
 
 func (c *Config) Validate() error {
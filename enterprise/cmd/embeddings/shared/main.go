@@ -72,9 +72,9 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		return gitserverClient.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, repoName, revision, fileName)
 	}
 
-	getRepoEmbeddingIndex, err := getCachedRepoEmbeddingIndex(repoStore, repoEmbeddingJobsStore, func(ctx context.Context, repoEmbeddingIndexName embeddings.RepoEmbeddingIndexName) (*embeddings.RepoEmbeddingIndex, error) {
-		return embeddings.DownloadIndex[embeddings.RepoEmbeddingIndex](ctx, uploadStore, string(repoEmbeddingIndexName))
-	})
+	getRepoEmbeddingIndex, err := getCachedRepoEmbeddingIndex(repoStore, repoEmbeddingJobsStore, func(ctx context.Context, repoName api.RepoName) (*embeddings.QuantizedRepoEmbeddingIndex, error) {
+		return downloadQuantizedRepoEmbeddingIndex(ctx, logger, uploadStore, repoName)
+	}, config.RepoEmbeddingIndexCacheSize)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
@@ -12,14 +13,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 )
 
-const REPO_EMBEDDING_INDEX_CACHE_MAX_ENTRIES = 5
-
-type downloadRepoEmbeddingIndexFn func(ctx context.Context, repoEmbeddingIndexName embeddings.RepoEmbeddingIndexName) (*embeddings.RepoEmbeddingIndex, error)
+type downloadRepoEmbeddingIndexFn func(ctx context.Context, repoName api.RepoName) (*embeddings.QuantizedRepoEmbeddingIndex, error)
 
 type repoEmbeddingIndexCacheEntry struct {
-	index      *embeddings.RepoEmbeddingIndex
+	index      *embeddings.QuantizedRepoEmbeddingIndex
 	finishedAt time.Time
 }
 
@@ -27,14 +27,15 @@ func getCachedRepoEmbeddingIndex(
 	repoStore database.RepoStore,
 	repoEmbeddingJobsStore repo.RepoEmbeddingJobsStore,
 	downloadRepoEmbeddingIndex downloadRepoEmbeddingIndexFn,
+	cacheSize int,
 ) (getRepoEmbeddingIndexFn, error) {
-	cache, err := lru.New(REPO_EMBEDDING_INDEX_CACHE_MAX_ENTRIES)
+	cache, err := lru.New(cacheSize)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating repo embedding index cache")
 	}
 
-	getAndCacheIndex := func(ctx context.Context, repoEmbeddingIndexName embeddings.RepoEmbeddingIndexName, finishedAt *time.Time) (*embeddings.RepoEmbeddingIndex, error) {
-		embeddingIndex, err := downloadRepoEmbeddingIndex(ctx, repoEmbeddingIndexName)
+	getAndCacheIndex := func(ctx context.Context, repoName api.RepoName, repoEmbeddingIndexName embeddings.RepoEmbeddingIndexName, finishedAt *time.Time) (*embeddings.QuantizedRepoEmbeddingIndex, error) {
+		embeddingIndex, err := downloadRepoEmbeddingIndex(ctx, repoName)
 		if err != nil {
 			return nil, err
 		}
@@ -42,7 +43,7 @@ func getCachedRepoEmbeddingIndex(
 		return embeddingIndex, nil
 	}
 
-	return func(ctx context.Context, repoName api.RepoName) (*embeddings.RepoEmbeddingIndex, error) {
+	return func(ctx context.Context, repoName api.RepoName) (*embeddings.QuantizedRepoEmbeddingIndex, error) {
 		repo, err := repoStore.GetByName(ctx, repoName)
 		if err != nil {
 			return nil, err
@@ -61,12 +62,31 @@ func getCachedRepoEmbeddingIndex(
 			// Check if we have a newer finished embedding job. If so, download the new index, cache it, and return it instead.
 			repoEmbeddingIndexCacheEntry := cacheEntry.(repoEmbeddingIndexCacheEntry)
 			if lastFinishedRepoEmbeddingJob.FinishedAt.After(repoEmbeddingIndexCacheEntry.finishedAt) {
-				return getAndCacheIndex(ctx, repoEmbeddingIndexName, lastFinishedRepoEmbeddingJob.FinishedAt)
+				return getAndCacheIndex(ctx, repoName, repoEmbeddingIndexName, lastFinishedRepoEmbeddingJob.FinishedAt)
 			}
 			// Otherwise, return the cached index.
 			return repoEmbeddingIndexCacheEntry.index, nil
 		}
 		// We do not have the index in the cache. Download and cache it.
-		return getAndCacheIndex(ctx, repoEmbeddingIndexName, lastFinishedRepoEmbeddingJob.FinishedAt)
+		return getAndCacheIndex(ctx, repoName, repoEmbeddingIndexName, lastFinishedRepoEmbeddingJob.FinishedAt)
 	}, nil
 }
+
+// downloadQuantizedRepoEmbeddingIndex downloads the quantized embedding index
+// of the repository. Repositories that were embedded before quantized indexes
+// were introduced only have an unquantized index, which is quantized after
+// downloading it. These indexes are searched exhaustively until the repository
+// is embedded again.
+func downloadQuantizedRepoEmbeddingIndex(ctx context.Context, logger log.Logger, uploadStore uploadstore.Store, repoName api.RepoName) (*embeddings.QuantizedRepoEmbeddingIndex, error) {
+	quantizedIndex, err := embeddings.DownloadIndex[embeddings.QuantizedRepoEmbeddingIndex](ctx, uploadStore, string(embeddings.GetQuantizedRepoEmbeddingIndexName(repoName)))
+	if err == nil {
+		return quantizedIndex, nil
+	}
+	logger.Warn("failed to download quantized embedding index, falling back to the unquantized index", log.String("repoName", string(repoName)), log.Error(err))
+
+	index, err := embeddings.DownloadIndex[embeddings.RepoEmbeddingIndex](ctx, uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repoName)))
+	if err != nil {
+		return nil, err
+	}
+	return index.Quantize(), nil
+}
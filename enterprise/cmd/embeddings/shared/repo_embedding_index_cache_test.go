@@ -24,10 +24,10 @@ func TestGetCachedRepoEmbeddingIndex(t *testing.T) {
 	})
 
 	hasDownloadedRepoEmbeddingIndex := false
-	getRepoEmbeddingIndex, err := getCachedRepoEmbeddingIndex(mockRepoStore, mockRepoEmbeddingJobsStore, func(ctx context.Context, repoEmbeddingIndexName embeddings.RepoEmbeddingIndexName) (*embeddings.RepoEmbeddingIndex, error) {
+	getRepoEmbeddingIndex, err := getCachedRepoEmbeddingIndex(mockRepoStore, mockRepoEmbeddingJobsStore, func(ctx context.Context, repoName api.RepoName) (*embeddings.QuantizedRepoEmbeddingIndex, error) {
 		hasDownloadedRepoEmbeddingIndex = true
-		return &embeddings.RepoEmbeddingIndex{}, nil
-	})
+		return &embeddings.QuantizedRepoEmbeddingIndex{}, nil
+	}, 5)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -10,7 +10,7 @@ import (
 )
 
 type readFileFn func(ctx context.Context, repoName api.RepoName, revision api.CommitID, fileName string) ([]byte, error)
-type getRepoEmbeddingIndexFn func(ctx context.Context, repoName api.RepoName) (*embeddings.RepoEmbeddingIndex, error)
+type getRepoEmbeddingIndexFn func(ctx context.Context, repoName api.RepoName) (*embeddings.QuantizedRepoEmbeddingIndex, error)
 type getQueryEmbeddingFn func(query string) ([]float32, error)
 
 func searchRepoEmbeddingIndex(
@@ -48,16 +48,17 @@ func searchEmbeddingIndex(
 	ctx context.Context,
 	repoName api.RepoName,
 	revision api.CommitID,
-	index *embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata],
+	index *embeddings.QuantizedEmbeddingIndex[embeddings.RepoEmbeddingRowMetadata],
 	readFile readFileFn,
 	query []float32,
 	nResults int,
 ) []embeddings.EmbeddingSearchResult {
 	numWorkers := runtime.GOMAXPROCS(0)
-	rows := index.SimilaritySearch(query, nResults, embeddings.WorkerOptions{NumWorkers: numWorkers, MinRowsToSplit: SIMILARITY_SEARCH_MIN_ROWS_TO_SPLIT})
+	rows := index.ScoredSimilaritySearch(query, nResults, embeddings.WorkerOptions{NumWorkers: numWorkers, MinRowsToSplit: SIMILARITY_SEARCH_MIN_ROWS_TO_SPLIT})
 
 	results := make([]embeddings.EmbeddingSearchResult, len(rows))
-	for idx, row := range rows {
+	for idx, scoredRow := range rows {
+		row := scoredRow.Row
 		fileContent, err := readFile(ctx, repoName, revision, row.FileName)
 		if err != nil {
 			continue
@@ -69,10 +70,15 @@ func searchEmbeddingIndex(
 		endLine := max(0, min(len(lines), row.EndLine))
 
 		results[idx] = embeddings.EmbeddingSearchResult{
-			FileName:  row.FileName,
-			StartLine: row.StartLine,
-			EndLine:   row.EndLine,
-			Content:   strings.Join(lines[startLine:endLine], "\n"),
+			RepoName:   repoName,
+			Revision:   revision,
+			FileName:   row.FileName,
+			StartLine:  row.StartLine,
+			EndLine:    row.EndLine,
+			Content:    strings.Join(lines[startLine:endLine], "\n"),
+			SymbolName: row.SymbolName,
+			SymbolKind: row.SymbolKind,
+			Score:      scoredRow.Score,
 		}
 	}
 
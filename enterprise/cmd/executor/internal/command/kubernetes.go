@@ -0,0 +1,439 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sourcegraph/log"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// kubernetesContainerName is the name of the container running the command in
+	// the pod of a job.
+	kubernetesContainerName = "sg-executor-job"
+
+	// kubernetesJobLabel is the label identifying the pod of a job.
+	kubernetesJobLabel = "executor.sourcegraph.com/job"
+
+	// kubernetesExecutorAnnotation and kubernetesKeyAnnotation record the executor
+	// and the command that created a job, for debugging purposes.
+	kubernetesExecutorAnnotation = "executor.sourcegraph.com/executor"
+	kubernetesKeyAnnotation      = "executor.sourcegraph.com/key"
+
+	// kubernetesPollInterval is the interval at which the pod of a job is polled
+	// while waiting for it to start and finish.
+	kubernetesPollInterval = time.Second
+)
+
+type kubernetesRunner struct {
+	dir          string
+	logger       log.Logger
+	cmdLogger    Logger
+	options      Options
+	client       kubernetes.Interface
+	pollInterval time.Duration
+}
+
+var _ Runner = &kubernetesRunner{}
+
+func (r *kubernetesRunner) Setup(ctx context.Context) error {
+	if r.client != nil {
+		return nil
+	}
+
+	client, err := newKubernetesClient(r.options.KubernetesOptions.ConfigPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to create kubernetes client")
+	}
+	r.client = client
+
+	return nil
+}
+
+// Teardown is a no-op, as the job of each command is deleted once it completes.
+func (r *kubernetesRunner) Teardown(ctx context.Context) error {
+	return nil
+}
+
+func (r *kubernetesRunner) Run(ctx context.Context, spec CommandSpec) error {
+	// Commands without an image, such as src-cli steps, are run directly on the
+	// executor as they are by the docker runner.
+	if spec.Image == "" {
+		return runCommand(ctx, formatRawOrDockerCommand(spec, r.dir, r.options, ""), r.cmdLogger)
+	}
+
+	job, err := newKubernetesJob(spec, r.dir, r.options)
+	if err != nil {
+		return err
+	}
+
+	pullSecret, err := newKubernetesPullSecret(job, r.options.DockerOptions.DockerAuthConfig)
+	if err != nil {
+		return err
+	}
+
+	return runKubernetesJob(ctx, r.logger, r.client, r.options.KubernetesOptions.Namespace, job, pullSecret, spec, r.cmdLogger, r.pollInterval)
+}
+
+func newKubernetesClient(configPath string) (kubernetes.Interface, error) {
+	var (
+		config *rest.Config
+		err    error
+	)
+	if configPath == "" {
+		config, err = rest.InClusterConfig()
+	} else {
+		config, err = clientcmd.BuildConfigFromFlags("", configPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(config)
+}
+
+// newKubernetesJob constructs the job that invokes the given spec. The job runs a
+// single pod with the workspace mounted from the configured persistent volume
+// claim, subject to the resource limits specified in the given options. If docker
+// credentials are configured, the image is pulled with the pull secret created by
+// newKubernetesPullSecret.
+func newKubernetesJob(spec CommandSpec, dir string, options Options) (*batchv1.Job, error) {
+	resources, err := kubernetesResources(options.ResourceOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "sg-executor-job-" + uuid.NewString()
+	backoffLimit := int32(0)
+	automountServiceAccountToken := false
+
+	var imagePullSecrets []corev1.LocalObjectReference
+	if len(options.DockerOptions.DockerAuthConfig.Auths) > 0 {
+		imagePullSecrets = []corev1.LocalObjectReference{{Name: name}}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				kubernetesExecutorAnnotation: options.ExecutorName,
+				kubernetesKeyAnnotation:      spec.Key,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			// Commands are not idempotent, so failed pods must not be retried.
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{kubernetesJobLabel: name},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					// 🚨 SECURITY: The pod runs user-supplied code, which must not be
+					// able to use the service account of the executor.
+					AutomountServiceAccountToken: &automountServiceAccountToken,
+					ImagePullSecrets:             imagePullSecrets,
+					Containers: []corev1.Container{
+						{
+							Name:       kubernetesContainerName,
+							Image:      spec.Image,
+							Command:    []string{"/bin/sh", filepath.Join("/data", ScriptsPath, spec.ScriptPath)},
+							WorkingDir: filepath.Join("/data", spec.Dir),
+							Env:        kubernetesEnv(spec.Env),
+							Resources:  resources,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "workspace",
+									MountPath: "/data",
+									SubPath:   filepath.Base(dir),
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "workspace",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: options.KubernetesOptions.PersistentVolumeClaimName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// newKubernetesPullSecret constructs the secret holding the docker credentials the
+// image of the given job is pulled with. The secret has the name of the job. If no
+// credentials are configured, nil is returned.
+func newKubernetesPullSecret(job *batchv1.Job, authConfig types.DockerAuthConfig) (*corev1.Secret, error) {
+	if len(authConfig.Auths) == 0 {
+		return nil, nil
+	}
+
+	dockerConfig, err := json.Marshal(authConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling docker auth config")
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        job.Name,
+			Annotations: job.Annotations,
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig},
+	}, nil
+}
+
+func kubernetesEnv(env []string) []corev1.EnvVar {
+	vars := make([]corev1.EnvVar, 0, len(env))
+	for _, e := range env {
+		name, value, _ := strings.Cut(e, "=")
+		vars = append(vars, corev1.EnvVar{Name: name, Value: value})
+	}
+
+	return vars
+}
+
+// kubernetesResources requests and limits the pod of a job to the configured
+// CPUs and memory. As with docker, a value of zero sets no resource bound.
+func kubernetesResources(options ResourceOptions) (corev1.ResourceRequirements, error) {
+	resources := corev1.ResourceList{}
+	if options.NumCPUs != 0 {
+		resources[corev1.ResourceCPU] = *resource.NewQuantity(int64(options.NumCPUs), resource.DecimalSI)
+	}
+	if options.Memory != "0" && options.Memory != "" {
+		memory, err := kubernetesMemoryQuantity(options.Memory)
+		if err != nil {
+			return corev1.ResourceRequirements{}, err
+		}
+		resources[corev1.ResourceMemory] = memory
+	}
+
+	return corev1.ResourceRequirements{Requests: resources, Limits: resources}, nil
+}
+
+var dockerMemoryPattern = lazyregexp.New(`^(\d+)([bBkKmMgG])$`)
+
+// kubernetesMemoryQuantity parses the given memory amount, which may be given in
+// the docker format (such as 12g or 12G) or as a Kubernetes quantity (such as
+// 12Gi).
+func kubernetesMemoryQuantity(memory string) (resource.Quantity, error) {
+	// Docker units are powers of 1024 regardless of their case, while Kubernetes
+	// treats an uppercase G as a power of 1000 and a lowercase m as milli, so
+	// these are translated before parsing.
+	if match := dockerMemoryPattern.FindStringSubmatch(memory); match != nil {
+		suffix := map[string]string{"b": "", "k": "Ki", "m": "Mi", "g": "Gi"}[strings.ToLower(match[2])]
+		memory = match[1] + suffix
+	}
+
+	quantity, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return resource.Quantity{}, errors.Wrapf(err, "invalid memory %q", memory)
+	}
+	return quantity, nil
+}
+
+// runKubernetesJob creates the given job and waits for its pod to complete. The
+// output of the pod is written to the given logger. If pullSecret is non-nil, it is
+// created before the job. The job and its pull secret are deleted once the job
+// completes, or once the context is canceled.
+func runKubernetesJob(
+	ctx context.Context,
+	logger log.Logger,
+	client kubernetes.Interface,
+	namespace string,
+	job *batchv1.Job,
+	pullSecret *corev1.Secret,
+	spec CommandSpec,
+	cmdLogger Logger,
+	pollInterval time.Duration,
+) (err error) {
+	ctx, _, endObservation := spec.Operation.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	logger = logger.With(log.String("namespace", namespace), log.String("job", job.Name))
+	logger.Info("Creating job", log.String("key", spec.Key), log.String("image", spec.Image))
+
+	if pullSecret != nil {
+		if _, err := client.CoreV1().Secrets(namespace).Create(ctx, pullSecret, metav1.CreateOptions{}); err != nil {
+			return errors.Wrap(err, "creating image pull secret")
+		}
+		defer func() {
+			// As for the job below, delete the secret outside of the command context.
+			if deleteErr := client.CoreV1().Secrets(namespace).Delete(context.Background(), pullSecret.Name, metav1.DeleteOptions{}); deleteErr != nil {
+				logger.Error("Failed to delete image pull secret", log.Error(deleteErr))
+				err = errors.Append(err, errors.Wrap(deleteErr, "deleting image pull secret"))
+			}
+		}()
+	}
+
+	if _, err := client.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{}); err != nil {
+		return errors.Wrap(err, "creating job")
+	}
+	defer func() {
+		// Perform this outside of the command context. If there is a timeout or
+		// cancellation error we don't want to leave the job and its pod behind.
+		propagation := metav1.DeletePropagationBackground
+		if deleteErr := client.BatchV1().Jobs(namespace).Delete(context.Background(), job.Name, metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+		}); deleteErr != nil {
+			logger.Error("Failed to delete job", log.Error(deleteErr))
+			err = errors.Append(err, errors.Wrap(deleteErr, "deleting job"))
+		}
+	}()
+
+	handle := cmdLogger.Log(spec.Key, job.Spec.Template.Spec.Containers[0].Command)
+	defer handle.Close()
+
+	pod, err := waitForKubernetesPod(ctx, client, namespace, job.Name, pollInterval, func(pod *corev1.Pod) (bool, error) {
+		if pod.Status.Phase != corev1.PodPending {
+			return true, nil
+		}
+		return false, kubernetesPodStartError(pod)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := streamKubernetesPodLogs(ctx, client, namespace, pod.Name, handle); err != nil {
+		return err
+	}
+
+	pod, err = waitForKubernetesPod(ctx, client, namespace, job.Name, pollInterval, func(pod *corev1.Pod) (bool, error) {
+		return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	exitCode := kubernetesExitCode(pod)
+	handle.Finalize(exitCode)
+	if exitCode != 0 {
+		return errors.New("command failed")
+	}
+	return nil
+}
+
+// waitForKubernetesPod polls the pod of the given job until the given function
+// returns true or an error.
+func waitForKubernetesPod(
+	ctx context.Context,
+	client kubernetes.Interface,
+	namespace, jobName string,
+	pollInterval time.Duration,
+	done func(pod *corev1.Pod) (bool, error),
+) (*corev1.Pod, error) {
+	for {
+		pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: kubernetesJobLabel + "=" + jobName,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "listing pods")
+		}
+
+		for i := range pods.Items {
+			if ok, err := done(&pods.Items[i]); err != nil || ok {
+				return &pods.Items[i], err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// kubernetesPodStartErrorReasons are the reasons a container is waiting for that
+// will not resolve by themselves.
+var kubernetesPodStartErrorReasons = map[string]struct{}{
+	"CreateContainerConfigError": {},
+	"ErrImagePull":               {},
+	"ImagePullBackOff":           {},
+	"InvalidImageName":           {},
+}
+
+func kubernetesPodStartError(pod *corev1.Pod) error {
+	for _, status := range pod.Status.ContainerStatuses {
+		if waiting := status.State.Waiting; waiting != nil {
+			if _, ok := kubernetesPodStartErrorReasons[waiting.Reason]; ok {
+				return errors.Newf("pod %s failed to start: %s: %s", pod.Name, waiting.Reason, waiting.Message)
+			}
+		}
+	}
+
+	return nil
+}
+
+// streamKubernetesPodLogs writes the output of the given pod to the given writer
+// until the pod terminates. Kubernetes does not separate the output streams, so
+// all of the output is attributed to stdout.
+func streamKubernetesPodLogs(ctx context.Context, client kubernetes.Interface, namespace, podName string, w io.Writer) error {
+	stream, err := client.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: kubernetesContainerName,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		return errors.Wrap(err, "streaming pod logs")
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	// Allocate an initial buffer of 4k and set the maximum size used to buffer a
+	// token to 100M, as for commands run on the host.
+	scanner.Buffer(make([]byte, 4*1024), 100*1024*1024)
+	for scanner.Scan() {
+		if _, err := fmt.Fprintf(w, "stdout: %s\n", scanner.Text()); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		// If the context was canceled, forward ctx.Err().
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return errors.Wrap(err, "reading pod logs")
+	}
+
+	return nil
+}
+
+func kubernetesExitCode(pod *corev1.Pod) int {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == kubernetesContainerName && status.State.Terminated != nil {
+			return int(status.State.Terminated.ExitCode)
+		}
+	}
+
+	// The pod failed without the container terminating, for example because it
+	// was evicted.
+	if pod.Status.Phase == corev1.PodFailed {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,371 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+)
+
+func TestNewKubernetesJob(t *testing.T) {
+	job, err := newKubernetesJob(
+		CommandSpec{
+			Key:        "step.docker.0",
+			Image:      "sourcegraph/scip-go",
+			ScriptPath: "0.sh",
+			Dir:        "subdir",
+			Env: []string{
+				`TEST=true`,
+				`CONTAINS_EQUALS=a=b`,
+			},
+		},
+		"/workspaces/workspace-42-1234",
+		Options{
+			ExecutorName: "executor-1234",
+			KubernetesOptions: KubernetesOptions{
+				Enabled:                   true,
+				Namespace:                 "executors",
+				PersistentVolumeClaimName: "executor-workspaces",
+			},
+			ResourceOptions: ResourceOptions{
+				NumCPUs: 4,
+				Memory:  "12G",
+			},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if job.Spec.BackoffLimit == nil || *job.Spec.BackoffLimit != 0 {
+		t.Errorf("unexpected backoff limit: %v", job.Spec.BackoffLimit)
+	}
+	if have, want := job.Spec.Template.Labels[kubernetesJobLabel], job.Name; have != want {
+		t.Errorf("unexpected job label. want=%q have=%q", want, have)
+	}
+	if have, want := job.Annotations[kubernetesKeyAnnotation], "step.docker.0"; have != want {
+		t.Errorf("unexpected key annotation. want=%q have=%q", want, have)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if podSpec.AutomountServiceAccountToken == nil || *podSpec.AutomountServiceAccountToken {
+		t.Errorf("expected service account token not to be mounted")
+	}
+
+	expectedContainer := corev1.Container{
+		Name:       kubernetesContainerName,
+		Image:      "sourcegraph/scip-go",
+		Command:    []string{"/bin/sh", "/data/.sourcegraph-executor/0.sh"},
+		WorkingDir: "/data/subdir",
+		Env: []corev1.EnvVar{
+			{Name: "TEST", Value: "true"},
+			{Name: "CONTAINS_EQUALS", Value: "a=b"},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("12Gi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("12Gi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "workspace", MountPath: "/data", SubPath: "workspace-42-1234"},
+		},
+	}
+	if diff := cmp.Diff([]corev1.Container{expectedContainer}, podSpec.Containers); diff != "" {
+		t.Errorf("unexpected containers (-want +got):\n%s", diff)
+	}
+
+	expectedVolumes := []corev1.Volume{
+		{
+			Name: "workspace",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "executor-workspaces"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedVolumes, podSpec.Volumes); diff != "" {
+		t.Errorf("unexpected volumes (-want +got):\n%s", diff)
+	}
+	if len(podSpec.ImagePullSecrets) != 0 {
+		t.Errorf("unexpected image pull secrets: %v", podSpec.ImagePullSecrets)
+	}
+}
+
+func TestNewKubernetesJobPullSecret(t *testing.T) {
+	authConfig := types.DockerAuthConfig{
+		Auths: types.DockerAuthConfigAuths{
+			"index.docker.io": types.DockerAuthConfigAuth{Auth: []byte("hunter:hunter2")},
+		},
+	}
+	job, err := newKubernetesJob(
+		CommandSpec{Image: "private/image"},
+		"/workspaces/workspace-42-1234",
+		Options{DockerOptions: DockerOptions{DockerAuthConfig: authConfig}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secret, err := newKubernetesPullSecret(job, authConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if secret.Name != job.Name {
+		t.Errorf("unexpected secret name. want=%q have=%q", job.Name, secret.Name)
+	}
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		t.Errorf("unexpected secret type %q", secret.Type)
+	}
+	if have, want := string(secret.Data[corev1.DockerConfigJsonKey]), `{"auths":{"index.docker.io":{"auth":"aHVudGVyOmh1bnRlcjI="}}}`; have != want {
+		t.Errorf("unexpected docker config. want=%q have=%q", want, have)
+	}
+
+	expectedPullSecrets := []corev1.LocalObjectReference{{Name: secret.Name}}
+	if diff := cmp.Diff(expectedPullSecrets, job.Spec.Template.Spec.ImagePullSecrets); diff != "" {
+		t.Errorf("unexpected image pull secrets (-want +got):\n%s", diff)
+	}
+
+	if secret, err := newKubernetesPullSecret(job, types.DockerAuthConfig{}); err != nil || secret != nil {
+		t.Errorf("expected no secret without credentials, got %v (err=%v)", secret, err)
+	}
+}
+
+func TestNewKubernetesJobNoResourceBounds(t *testing.T) {
+	job, err := newKubernetesJob(
+		CommandSpec{Image: "alpine"},
+		"/workspaces/workspace-42-1234",
+		Options{ResourceOptions: ResourceOptions{Memory: "0"}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resources := job.Spec.Template.Spec.Containers[0].Resources; len(resources.Requests) != 0 || len(resources.Limits) != 0 {
+		t.Errorf("unexpected resources: %v", resources)
+	}
+}
+
+func TestKubernetesMemoryQuantity(t *testing.T) {
+	for memory, expected := range map[string]string{
+		"12G":  "12Gi",
+		"12g":  "12Gi",
+		"12Gi": "12Gi",
+		"512m": "512Mi",
+		"512M": "512Mi",
+		"64k":  "64Ki",
+		"64K":  "64Ki",
+		"1024": "1024",
+		"100b": "100",
+		"100B": "100",
+	} {
+		quantity, err := kubernetesMemoryQuantity(memory)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", memory, err)
+		}
+		if !quantity.Equal(resource.MustParse(expected)) {
+			t.Errorf("unexpected quantity for %q. want=%s have=%s", memory, expected, quantity.String())
+		}
+	}
+
+	if _, err := kubernetesMemoryQuantity("lots"); err == nil {
+		t.Errorf("expected error parsing invalid memory")
+	}
+}
+
+func TestRunKubernetesJob(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := newFakeKubernetesClient(t, func(pod *corev1.Pod) {
+			pod.Status = terminatedPodStatus(corev1.PodSucceeded, 0)
+		})
+		logger, entry, out := newTestKubernetesLogger()
+
+		job := newTestKubernetesJob(t)
+		if err := runKubernetesJob(context.Background(), logtest.Scoped(t), client, "executors", job, nil, CommandSpec{Key: "step.docker.0", Image: "alpine", Operation: makeTestOperation()}, logger, time.Millisecond); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if have, want := out.String(), "stdout: fake logs\n"; have != want {
+			t.Errorf("unexpected output. want=%q have=%q", want, have)
+		}
+		assertFinalized(t, entry, 0)
+		assertJobDeleted(t, client, job)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		client := newFakeKubernetesClient(t, func(pod *corev1.Pod) {
+			pod.Status = terminatedPodStatus(corev1.PodFailed, 3)
+		})
+		logger, entry, _ := newTestKubernetesLogger()
+
+		job := newTestKubernetesJob(t)
+		err := runKubernetesJob(context.Background(), logtest.Scoped(t), client, "executors", job, nil, CommandSpec{Image: "alpine", Operation: makeTestOperation()}, logger, time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "command failed") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		assertFinalized(t, entry, 3)
+		assertJobDeleted(t, client, job)
+	})
+
+	t.Run("image pull error", func(t *testing.T) {
+		client := newFakeKubernetesClient(t, func(pod *corev1.Pod) {
+			pod.Status = corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: kubernetesContainerName,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"},
+					},
+				}},
+			}
+		})
+		logger, _, _ := newTestKubernetesLogger()
+
+		job := newTestKubernetesJob(t)
+		err := runKubernetesJob(context.Background(), logtest.Scoped(t), client, "executors", job, nil, CommandSpec{Image: "alpine", Operation: makeTestOperation()}, logger, time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "ErrImagePull") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		assertJobDeleted(t, client, job)
+	})
+
+	t.Run("pull secret", func(t *testing.T) {
+		client := newFakeKubernetesClient(t, func(pod *corev1.Pod) {
+			pod.Status = terminatedPodStatus(corev1.PodSucceeded, 0)
+		})
+		logger, _, _ := newTestKubernetesLogger()
+
+		job := newTestKubernetesJob(t)
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: job.Name}, Type: corev1.SecretTypeDockerConfigJson}
+		if err := runKubernetesJob(context.Background(), logtest.Scoped(t), client, "executors", job, secret, CommandSpec{Image: "alpine", Operation: makeTestOperation()}, logger, time.Millisecond); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		// The secret must exist before the pod of the job pulls its image.
+		var created []string
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "create" {
+				created = append(created, action.GetResource().Resource)
+			}
+		}
+		if diff := cmp.Diff([]string{"secrets", "jobs"}, created); diff != "" {
+			t.Errorf("unexpected created resources (-want +got):\n%s", diff)
+		}
+		if _, err := client.CoreV1().Secrets("executors").Get(context.Background(), secret.Name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+			t.Errorf("expected secret to be deleted, got error: %v", err)
+		}
+		assertJobDeleted(t, client, job)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		client := newFakeKubernetesClient(t, func(pod *corev1.Pod) {
+			pod.Status = corev1.PodStatus{Phase: corev1.PodPending}
+		})
+		logger, _, _ := newTestKubernetesLogger()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		job := newTestKubernetesJob(t)
+		err := runKubernetesJob(ctx, logtest.Scoped(t), client, "executors", job, nil, CommandSpec{Image: "alpine", Operation: makeTestOperation()}, logger, time.Millisecond)
+		if err != context.DeadlineExceeded {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		assertJobDeleted(t, client, job)
+	})
+}
+
+// newFakeKubernetesClient returns a fake client that creates the pod of a job
+// when the job is created, as the job controller would.
+func newFakeKubernetesClient(t *testing.T, setPodStatus func(pod *corev1.Pod)) *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      job.Name + "-abcde",
+				Namespace: action.GetNamespace(),
+				Labels:    job.Spec.Template.Labels,
+			},
+			Spec: job.Spec.Template.Spec,
+		}
+		setPodStatus(pod)
+		if err := client.Tracker().Add(pod); err != nil {
+			t.Fatalf("failed to add pod: %s", err)
+		}
+
+		// Let the default reactor store the job.
+		return false, nil, nil
+	})
+
+	return client
+}
+
+func newTestKubernetesJob(t *testing.T) *batchv1.Job {
+	job, err := newKubernetesJob(CommandSpec{Image: "alpine"}, "/workspaces/workspace-42-1234", Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return job
+}
+
+func newTestKubernetesLogger() (*MockLogger, *MockLogEntry, *bytes.Buffer) {
+	var out bytes.Buffer
+	entry := NewMockLogEntry()
+	entry.WriteFunc.SetDefaultHook(out.Write)
+	logger := NewMockLogger()
+	logger.LogFunc.SetDefaultReturn(entry)
+	return logger, entry, &out
+}
+
+func terminatedPodStatus(phase corev1.PodPhase, exitCode int32) corev1.PodStatus {
+	return corev1.PodStatus{
+		Phase: phase,
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name: kubernetesContainerName,
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+			},
+		}},
+	}
+}
+
+func assertFinalized(t *testing.T, entry *MockLogEntry, exitCode int) {
+	t.Helper()
+
+	history := entry.FinalizeFunc.History()
+	if len(history) != 1 {
+		t.Fatalf("unexpected number of Finalize calls. want=%d have=%d", 1, len(history))
+	}
+	if have := history[0].Arg0; have != exitCode {
+		t.Errorf("unexpected exit code. want=%d have=%d", exitCode, have)
+	}
+}
+
+func assertJobDeleted(t *testing.T, client *fake.Clientset, job *batchv1.Job) {
+	t.Helper()
+
+	if _, err := client.BatchV1().Jobs("executors").Get(context.Background(), job.Name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected job to be deleted, got error: %v", err)
+	}
+}
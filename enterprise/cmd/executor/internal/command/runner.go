@@ -51,6 +51,9 @@ type Options struct {
 	// FirecrackerOptions configures the behavior of Firecracker virtual machine creation.
 	FirecrackerOptions FirecrackerOptions
 
+	// KubernetesOptions configures the behavior of Kubernetes job creation.
+	KubernetesOptions KubernetesOptions
+
 	// ResourceOptions configures the resource limits of docker container and Firecracker
 	// virtual machines running on the executor.
 	ResourceOptions ResourceOptions
@@ -91,6 +94,24 @@ type FirecrackerOptions struct {
 	DockerRegistryMirrorURLs []string
 }
 
+type KubernetesOptions struct {
+	// Enabled determines if commands will be run in Kubernetes jobs.
+	Enabled bool
+
+	// ConfigPath is the path to the kubeconfig file used to access the cluster. If not
+	// set, the in-cluster configuration of the executor pod is used.
+	ConfigPath string
+
+	// Namespace is the namespace in which jobs are created.
+	Namespace string
+
+	// PersistentVolumeClaimName is the name of the persistent volume claim holding the
+	// workspaces, which is mounted into the pod of each job. The claim must also be
+	// mounted as the temporary directory of the executor, so that each workspace is a
+	// directory at the root of the volume.
+	PersistentVolumeClaimName string
+}
+
 type ResourceOptions struct {
 	// NumCPUs is the number of virtual CPUs a container or VM can use.
 	NumCPUs int
@@ -119,6 +140,16 @@ type ResourceOptions struct {
 
 // NewRunner creates a new runner with the given options.
 func NewRunner(dir string, logger Logger, options Options, operations *Operations) Runner {
+	if options.KubernetesOptions.Enabled {
+		return &kubernetesRunner{
+			dir:          dir,
+			logger:       log.Scoped("kubernetes-runner", ""),
+			cmdLogger:    logger,
+			options:      options,
+			pollInterval: kubernetesPollInterval,
+		}
+	}
+
 	if !options.FirecrackerOptions.Enabled {
 		return &dockerRunner{
 			dir:       dir,
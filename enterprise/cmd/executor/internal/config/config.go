@@ -33,6 +33,10 @@ type Config struct {
 	KeepWorkspaces                 bool
 	DockerHostMountPath            string
 	UseFirecracker                 bool
+	UseKubernetes                  bool
+	KubernetesConfigPath           string
+	KubernetesNamespace            string
+	KubernetesPVCName              string
 	JobNumCPUs                     int
 	JobMemory                      string
 	FirecrackerDiskSpace           string
@@ -62,6 +66,10 @@ func (c *Config) Load() {
 	c.QueuePollInterval = c.GetInterval("EXECUTOR_QUEUE_POLL_INTERVAL", "1s", "Interval between dequeue requests.")
 	c.MaximumNumJobs = c.GetInt("EXECUTOR_MAXIMUM_NUM_JOBS", "1", "Number of virtual machines or containers that can be running at once.")
 	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux"), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only.")
+	c.UseKubernetes = c.GetBool("EXECUTOR_USE_KUBERNETES", "false", "Whether to run commands as Kubernetes jobs instead of docker containers. Requires the executor to run inside a Kubernetes cluster.")
+	c.KubernetesConfigPath = c.GetOptional("EXECUTOR_KUBERNETES_CONFIG_PATH", "The path to the kubeconfig file used to access the Kubernetes API. If not set, the in-cluster configuration is used.")
+	c.KubernetesNamespace = c.Get("EXECUTOR_KUBERNETES_NAMESPACE", "default", "The namespace to run Kubernetes jobs in.")
+	c.KubernetesPVCName = c.GetOptional("EXECUTOR_KUBERNETES_PERSISTENT_VOLUME_CLAIM_NAME", "The name of the persistent volume claim shared by the executor and its Kubernetes jobs. It must be mounted as the temporary directory of the executor.")
 	c.FirecrackerImage = c.Get("EXECUTOR_FIRECRACKER_IMAGE", DefaultFirecrackerImage, "The base image to use for virtual machines.")
 	c.FirecrackerKernelImage = c.Get("EXECUTOR_FIRECRACKER_KERNEL_IMAGE", DefaultFirecrackerKernelImage, "The base image containing the kernel binary to use for virtual machines.")
 	c.FirecrackerSandboxImage = c.Get("EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", DefaultFirecrackerSandboxImage, "The OCI image for the ignite VM sandbox.")
@@ -101,6 +109,15 @@ func (c *Config) Validate() error {
 		c.AddError(errors.Wrap(c.dockerAuthConfigUnmarshalError, "invalid EXECUTOR_DOCKER_AUTH_CONFIG, failed to parse"))
 	}
 
+	if c.UseKubernetes {
+		if c.UseFirecracker {
+			c.AddError(errors.New("EXECUTOR_USE_KUBERNETES and EXECUTOR_USE_FIRECRACKER cannot both be enabled"))
+		}
+		if c.KubernetesPVCName == "" {
+			c.AddError(errors.New("EXECUTOR_KUBERNETES_PERSISTENT_VOLUME_CLAIM_NAME must be set when EXECUTOR_USE_KUBERNETES is enabled"))
+		}
+	}
+
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
 		if runtime.GOOS != "linux" {
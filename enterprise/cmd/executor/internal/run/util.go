@@ -104,6 +104,7 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 		WorkerOptions:      workerOptions(c),
 		DockerOptions:      dockerOptions(c),
 		FirecrackerOptions: firecrackerOptions(c),
+		KubernetesOptions:  kubernetesOptions(c),
 		ResourceOptions:    resourceOptions(c),
 		GitServicePath:     "/.executors/git",
 		QueueOptions:       queueOptions(c, queueTelemetryOptions),
@@ -159,6 +160,15 @@ func firecrackerOptions(c *config.Config) command.FirecrackerOptions {
 	}
 }
 
+func kubernetesOptions(c *config.Config) command.KubernetesOptions {
+	return command.KubernetesOptions{
+		Enabled:                   c.UseKubernetes,
+		ConfigPath:                c.KubernetesConfigPath,
+		Namespace:                 c.KubernetesNamespace,
+		PersistentVolumeClaimName: c.KubernetesPVCName,
+	}
+}
+
 func resourceOptions(c *config.Config) command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:             c.JobNumCPUs,
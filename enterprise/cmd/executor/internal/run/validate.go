@@ -25,7 +25,7 @@ func RunValidate(cliCtx *cli.Context, logger log.Logger, config *config.Config)
 	}
 
 	// Then, validate all tools that are required are installed.
-	if err := validateToolsRequired(config.UseFirecracker); err != nil {
+	if err := validateToolsRequired(config.UseFirecracker, config.UseKubernetes); err != nil {
 		return err
 	}
 
@@ -141,9 +141,13 @@ func latestSrcCLIVersion(ctx context.Context, client *apiclient.BaseClient, opti
 	return v.Version, nil
 }
 
-func validateToolsRequired(useFirecracker bool) error {
+func validateToolsRequired(useFirecracker, useKubernetes bool) error {
 	notFoundTools := []string{}
 	for tool := range config.RequiredCLITools {
+		// Commands that need docker are run as Kubernetes jobs instead.
+		if useKubernetes && tool == "docker" {
+			continue
+		}
 		if found, err := existsPath(tool); err != nil {
 			return err
 		} else if !found {
@@ -0,0 +1,312 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/grafana/regexp"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/opentracing/opentracing-go/log"
+	sglog "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ExportHandler exports a report of all changesets of a batch change as CSV
+// or JSON.
+type ExportHandler struct {
+	logger     sglog.Logger
+	db         database.DB
+	store      ExportStore
+	own        own.Service
+	operations *Operations
+}
+
+type ExportStore interface {
+	GetBatchChange(context.Context, store.GetBatchChangeOpts) (*btypes.BatchChange, error)
+	ListChangesets(context.Context, store.ListChangesetsOpts) (btypes.Changesets, int64, error)
+	ListChangesetSpecs(context.Context, store.ListChangesetSpecsOpts) (btypes.ChangesetSpecs, int64, error)
+	ListChangesetEvents(context.Context, store.ListChangesetEventsOpts) ([]*btypes.ChangesetEvent, int64, error)
+}
+
+// NewExportHandler creates a new ExportHandler.
+func NewExportHandler(db database.DB, store ExportStore, ownService own.Service, operations *Operations) *ExportHandler {
+	return &ExportHandler{
+		logger:     sglog.Scoped("ExportHandler", "Batch Changes report export REST API handler"),
+		db:         db,
+		store:      store,
+		own:        ownService,
+		operations: operations,
+	}
+}
+
+var errExportUnauthenticated = errors.New("not authenticated")
+var errExportBatchChangeNotFound = errors.New("batch change not found")
+
+var nonWordRegex = regexp.MustCompile(`\W+`)
+
+// Export writes the report of the batch change with the ID given in the path.
+// The format is chosen with the format query parameter, which is either csv
+// (the default) or json.
+func (h *ExportHandler) Export() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = "csv"
+		}
+		if format != "csv" && format != "json" {
+			http.Error(w, fmt.Sprintf("unsupported format %q", format), http.StatusBadRequest)
+			return
+		}
+
+		batchChange, rows, statusCode, err := h.export(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		var buf bytes.Buffer
+		if format == "json" {
+			err = writeJSONReport(&buf, rows)
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			err = writeCSVReport(&buf, rows)
+			w.Header().Set("Content-Type", "text/csv")
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to write report: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		escapedName := nonWordRegex.ReplaceAllString(batchChange.Name, "-")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-%s.%s\"", escapedName, time.Now().UTC().Format("20060102T150405Z"), format))
+		w.WriteHeader(http.StatusOK)
+		if _, err := buf.WriteTo(w); err != nil {
+			h.logger.Error("failed to write payload to client", sglog.Error(err))
+		}
+	})
+}
+
+func (h *ExportHandler) export(r *http.Request) (batchChange *btypes.BatchChange, rows []changesetReportRow, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.export.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{LogFields: []log.Field{
+			log.Int("statusCode", statusCode),
+			log.Int("changesets", len(rows)),
+		}})
+	}()
+
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return nil, nil, http.StatusUnauthorized, errExportUnauthenticated
+	}
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, h.db); err != nil {
+		return nil, nil, http.StatusForbidden, err
+	}
+
+	var batchChangeID int64
+	if err := relay.UnmarshalSpec(graphql.ID(mux.Vars(r)["id"]), &batchChangeID); err != nil || batchChangeID == 0 {
+		return nil, nil, http.StatusBadRequest, errors.New("invalid batch change ID")
+	}
+
+	batchChange, err = h.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		if errors.Is(err, store.ErrNoResults) {
+			return nil, nil, http.StatusNotFound, errExportBatchChangeNotFound
+		}
+		return nil, nil, http.StatusInternalServerError, errors.Wrap(err, "loading batch change")
+	}
+
+	rows, err = h.buildReport(ctx, batchChange)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, err
+	}
+
+	return batchChange, rows, http.StatusOK, nil
+}
+
+// buildReport builds the report rows of all changesets of the batch change
+// that are visible to the current user.
+func (h *ExportHandler) buildReport(ctx context.Context, batchChange *btypes.BatchChange) ([]changesetReportRow, error) {
+	// 🚨 SECURITY: We only include changesets in repositories the user has
+	// access to.
+	cs, _, err := h.store.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID: batchChange.ID,
+		EnforceAuthz:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing changesets")
+	}
+	if len(cs) == 0 {
+		return []changesetReportRow{}, nil
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].ID < cs[j].ID })
+
+	repoIDs := make([]api.RepoID, 0, len(cs))
+	var specIDs []int64
+	for _, ch := range cs {
+		repoIDs = append(repoIDs, ch.RepoID)
+		if ch.CurrentSpecID != 0 {
+			specIDs = append(specIDs, ch.CurrentSpecID)
+		}
+	}
+
+	repos, err := h.db.Repos().GetReposSetByIDs(ctx, repoIDs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading repositories")
+	}
+
+	specs := make(map[int64]*btypes.ChangesetSpec, len(specIDs))
+	if len(specIDs) > 0 {
+		ss, _, err := h.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{IDs: specIDs})
+		if err != nil {
+			return nil, errors.Wrap(err, "loading changeset specs")
+		}
+		for _, spec := range ss {
+			specs[spec.ID] = spec
+		}
+	}
+
+	es, _, err := h.store.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{ChangesetIDs: cs.IDs()})
+	if err != nil {
+		return nil, errors.Wrap(err, "loading changeset events")
+	}
+	events := make(map[int64]state.ChangesetEvents, len(cs))
+	for _, e := range es {
+		events[e.ChangesetID] = append(events[e.ChangesetID], e)
+	}
+
+	owners := newOwnerResolver(h.own)
+
+	rows := make([]changesetReportRow, 0, len(cs))
+	for _, ch := range cs {
+		repo, ok := repos[ch.RepoID]
+		if !ok {
+			continue
+		}
+		spec := specs[ch.CurrentSpecID]
+
+		changesetOwners, err := owners.ownersForChangeset(ctx, repo, spec)
+		if err != nil {
+			// Ownership is best effort, a broken CODEOWNERS file shouldn't
+			// prevent the export.
+			h.logger.Warn("resolving changeset owners", sglog.Int64("changesetID", ch.ID), sglog.Error(err))
+		}
+
+		rows = append(rows, newChangesetReportRow(ch, repo, spec, events[ch.ID], changesetOwners))
+	}
+
+	return rows, nil
+}
+
+// ownerResolver determines the owners of the files changed by a changeset
+// spec from the CODEOWNERS file of its repository at the base revision.
+type ownerResolver struct {
+	own      own.Service
+	rulesets map[rulesetKey]*codeowners.Ruleset
+}
+
+type rulesetKey struct {
+	repo api.RepoID
+	rev  string
+}
+
+func newOwnerResolver(ownService own.Service) *ownerResolver {
+	return &ownerResolver{own: ownService, rulesets: map[rulesetKey]*codeowners.Ruleset{}}
+}
+
+func (o *ownerResolver) ownersForChangeset(ctx context.Context, repo *types.Repo, spec *btypes.ChangesetSpec) ([]string, error) {
+	if spec == nil || spec.Type != btypes.ChangesetSpecTypeBranch || spec.BaseRev == "" {
+		return nil, nil
+	}
+
+	key := rulesetKey{repo: repo.ID, rev: spec.BaseRev}
+	ruleset, ok := o.rulesets[key]
+	if !ok {
+		var err error
+		ruleset, err = o.own.RulesetForRepo(ctx, repo.Name, repo.ID, api.CommitID(spec.BaseRev))
+		if err != nil {
+			return nil, errors.Wrap(err, "loading CODEOWNERS")
+		}
+		o.rulesets[key] = ruleset
+	}
+	if ruleset == nil {
+		return nil, nil
+	}
+
+	paths, err := spec.ChangedPaths()
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing diff")
+	}
+
+	var protoOwners []*codeownerspb.Owner
+	for _, path := range paths {
+		if rule := ruleset.Match(path); rule != nil {
+			protoOwners = append(protoOwners, rule.GetOwner()...)
+		}
+	}
+	if len(protoOwners) == 0 {
+		return nil, nil
+	}
+
+	resolved, err := o.own.ResolveOwnersWithType(ctx, protoOwners)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving owners")
+	}
+
+	seen := make(map[string]struct{}, len(resolved))
+	names := make([]string, 0, len(resolved))
+	for _, owner := range resolved {
+		name := ownerName(owner)
+		if _, ok := seen[name]; ok || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ownerName returns the name of the Sourcegraph user or team, if the owner
+// could be resolved to one, and the handle or email from the CODEOWNERS file
+// otherwise.
+func ownerName(owner codeowners.ResolvedOwner) string {
+	var handle, email string
+	switch o := owner.(type) {
+	case *codeowners.Person:
+		if o.User != nil {
+			return o.User.Username
+		}
+		handle, email = o.Handle, o.GetEmail()
+	case *codeowners.Team:
+		if o.Team != nil {
+			return o.Team.Name
+		}
+		handle, email = o.Handle, o.Email
+	default:
+		return owner.Identifier()
+	}
+	if handle != "" {
+		return handle
+	}
+	return email
+}
@@ -11,6 +11,7 @@ type Operations struct {
 	get    *observation.Operation
 	exists *observation.Operation
 	upload *observation.Operation
+	export *observation.Operation
 }
 
 func NewOperations(observationCtx *observation.Context) *Operations {
@@ -33,5 +34,6 @@ func NewOperations(observationCtx *observation.Context) *Operations {
 		get:    op("get"),
 		exists: op("exists"),
 		upload: op("upload"),
+		export: op("export"),
 	}
 }
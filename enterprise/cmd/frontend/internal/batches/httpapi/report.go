@@ -0,0 +1,177 @@
+package httpapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	bgql "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/graphql"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// changesetReportRow is a single changeset in the report of a batch change.
+type changesetReportRow struct {
+	ID                 string     `json:"id"`
+	Repository         string     `json:"repository"`
+	Title              string     `json:"title"`
+	URL                string     `json:"url"`
+	Owners             []string   `json:"owners"`
+	State              string     `json:"state"`
+	ReviewState        string     `json:"reviewState"`
+	CheckState         string     `json:"checkState"`
+	DiffAdded          int32      `json:"diffAdded"`
+	DiffDeleted        int32      `json:"diffDeleted"`
+	CreatedAt          *time.Time `json:"createdAt"`
+	MergedAt           *time.Time `json:"mergedAt"`
+	TimeToMergeSeconds *int64     `json:"timeToMergeSeconds"`
+	LastEventKind      string     `json:"lastEventKind"`
+	LastEventAt        *time.Time `json:"lastEventAt"`
+}
+
+// changesetReportColumns is the CSV header of the report. It must be kept in
+// sync with changesetReportRow.record.
+var changesetReportColumns = []string{
+	"id",
+	"repository",
+	"title",
+	"url",
+	"owners",
+	"state",
+	"review_state",
+	"check_state",
+	"diff_added",
+	"diff_deleted",
+	"created_at",
+	"merged_at",
+	"time_to_merge_seconds",
+	"last_event_kind",
+	"last_event_at",
+}
+
+func (r *changesetReportRow) record() []string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	timeToMerge := ""
+	if r.TimeToMergeSeconds != nil {
+		timeToMerge = strconv.FormatInt(*r.TimeToMergeSeconds, 10)
+	}
+
+	return []string{
+		r.ID,
+		r.Repository,
+		r.Title,
+		r.URL,
+		strings.Join(r.Owners, " "),
+		r.State,
+		r.ReviewState,
+		r.CheckState,
+		strconv.Itoa(int(r.DiffAdded)),
+		strconv.Itoa(int(r.DiffDeleted)),
+		formatTime(r.CreatedAt),
+		formatTime(r.MergedAt),
+		timeToMerge,
+		r.LastEventKind,
+		formatTime(r.LastEventAt),
+	}
+}
+
+// newChangesetReportRow builds the report row of the given changeset. spec is
+// the current spec of the changeset and nil for imported changesets.
+func newChangesetReportRow(ch *btypes.Changeset, repo *types.Repo, spec *btypes.ChangesetSpec, events state.ChangesetEvents, owners []string) changesetReportRow {
+	row := changesetReportRow{
+		ID:         string(bgql.MarshalChangesetID(ch.ID)),
+		Repository: string(repo.Name),
+		Owners:     owners,
+		State:      string(ch.State),
+	}
+	if row.Owners == nil {
+		row.Owners = []string{}
+	}
+
+	if spec != nil {
+		row.Title = spec.Title
+		stat := spec.DiffStat()
+		row.DiffAdded, row.DiffDeleted = stat.Added, stat.Deleted
+	}
+	if stat := ch.DiffStat(); stat != nil {
+		row.DiffAdded, row.DiffDeleted = stat.Added, stat.Deleted
+	}
+
+	if ch.Published() {
+		if title, err := ch.Title(); err == nil {
+			row.Title = title
+		}
+		if url, err := ch.URL(); err == nil {
+			row.URL = url
+		}
+		row.ReviewState = string(ch.ExternalReviewState)
+		row.CheckState = string(ch.ExternalCheckState)
+
+		if createdAt := ch.ExternalCreatedAt(); !createdAt.IsZero() {
+			row.CreatedAt = &createdAt
+		}
+	}
+
+	sort.Sort(events)
+
+	if ch.ExternalState == btypes.ChangesetExternalStateMerged {
+		mergedAt, err := state.MergedAt(ch, events)
+		if err != nil || mergedAt.IsZero() {
+			// Not every code host reports merges as events, so we fall back
+			// to the last update of the changeset.
+			mergedAt = ch.ExternalUpdatedAt
+		}
+		if !mergedAt.IsZero() {
+			row.MergedAt = &mergedAt
+		}
+	}
+
+	if row.CreatedAt != nil && row.MergedAt != nil {
+		seconds := int64(row.MergedAt.Sub(*row.CreatedAt) / time.Second)
+		row.TimeToMergeSeconds = &seconds
+	}
+
+	for i := len(events) - 1; i >= 0; i-- {
+		if ts := events[i].Timestamp(); !ts.IsZero() {
+			row.LastEventKind = string(events[i].Kind)
+			row.LastEventAt = &ts
+			break
+		}
+	}
+
+	return row
+}
+
+// writeCSVReport writes the given rows as CSV, including a header.
+func writeCSVReport(w io.Writer, rows []changesetReportRow) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(changesetReportColumns); err != nil {
+		return err
+	}
+	for i := range rows {
+		if err := cw.Write(rows[i].record()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSONReport writes the given rows as a JSON array.
+func writeJSONReport(w io.Writer, rows []changesetReportRow) error {
+	if rows == nil {
+		rows = []changesetReportRow{}
+	}
+	return json.NewEncoder(w).Encode(rows)
+}
@@ -0,0 +1,135 @@
+package httpapi
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestNewChangesetReportRow(t *testing.T) {
+	createdAt := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(26 * time.Hour)
+	repo := &types.Repo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	t.Run("unpublished", func(t *testing.T) {
+		ch := &btypes.Changeset{
+			ID:               1,
+			RepoID:           repo.ID,
+			PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			State:            btypes.ChangesetStateUnpublished,
+		}
+		spec := &btypes.ChangesetSpec{Title: "Update dependencies", DiffStatAdded: 3, DiffStatDeleted: 1}
+
+		row := newChangesetReportRow(ch, repo, spec, nil, nil)
+
+		assert.Equal(t, "Update dependencies", row.Title)
+		assert.Equal(t, "github.com/sourcegraph/sourcegraph", row.Repository)
+		assert.Equal(t, "UNPUBLISHED", row.State)
+		assert.Equal(t, []string{}, row.Owners)
+		assert.Equal(t, int32(3), row.DiffAdded)
+		assert.Equal(t, int32(1), row.DiffDeleted)
+		assert.Empty(t, row.URL)
+		assert.Nil(t, row.CreatedAt)
+		assert.Nil(t, row.MergedAt)
+		assert.Nil(t, row.TimeToMergeSeconds)
+		assert.Nil(t, row.LastEventAt)
+	})
+
+	t.Run("merged", func(t *testing.T) {
+		added, deleted := int32(10), int32(4)
+		ch := &btypes.Changeset{
+			ID:                  2,
+			RepoID:              repo.ID,
+			PublicationState:    btypes.ChangesetPublicationStatePublished,
+			State:               btypes.ChangesetStateMerged,
+			ExternalState:       btypes.ChangesetExternalStateMerged,
+			ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+			DiffStatAdded:       &added,
+			DiffStatDeleted:     &deleted,
+			Metadata: &github.PullRequest{
+				Title:     "Update dependencies (#123)",
+				URL:       "https://github.com/sourcegraph/sourcegraph/pull/123",
+				CreatedAt: createdAt,
+			},
+		}
+		spec := &btypes.ChangesetSpec{Title: "Update dependencies", DiffStatAdded: 3, DiffStatDeleted: 1}
+		events := []*btypes.ChangesetEvent{
+			{ChangesetID: 2, Kind: btypes.ChangesetEventKindGitHubMerged, Metadata: &github.MergedEvent{CreatedAt: mergedAt}},
+			{ChangesetID: 2, Kind: btypes.ChangesetEventKindGitHubClosed, Metadata: &github.ClosedEvent{CreatedAt: mergedAt.Add(-time.Minute)}},
+		}
+
+		row := newChangesetReportRow(ch, repo, spec, events, []string{"alice", "frontend-team"})
+
+		assert.Equal(t, "Update dependencies (#123)", row.Title)
+		assert.Equal(t, "https://github.com/sourcegraph/sourcegraph/pull/123", row.URL)
+		assert.Equal(t, "MERGED", row.State)
+		assert.Equal(t, "APPROVED", row.ReviewState)
+		assert.Equal(t, "PASSED", row.CheckState)
+		assert.Equal(t, int32(10), row.DiffAdded)
+		assert.Equal(t, int32(4), row.DiffDeleted)
+		assert.Equal(t, []string{"alice", "frontend-team"}, row.Owners)
+		require.NotNil(t, row.CreatedAt)
+		assert.Equal(t, createdAt, *row.CreatedAt)
+		require.NotNil(t, row.MergedAt)
+		assert.Equal(t, mergedAt, *row.MergedAt)
+		require.NotNil(t, row.TimeToMergeSeconds)
+		assert.Equal(t, int64(26*60*60), *row.TimeToMergeSeconds)
+		assert.Equal(t, string(btypes.ChangesetEventKindGitHubMerged), row.LastEventKind)
+		require.NotNil(t, row.LastEventAt)
+		assert.Equal(t, mergedAt, *row.LastEventAt)
+	})
+}
+
+func TestWriteReport(t *testing.T) {
+	createdAt := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+	timeToMerge := int64(3600)
+	mergedAt := createdAt.Add(time.Hour)
+	rows := []changesetReportRow{{
+		ID:                 "Q2hhbmdlc2V0OjI=",
+		Repository:         "github.com/sourcegraph/sourcegraph",
+		Title:              "Update, dependencies",
+		URL:                "https://github.com/sourcegraph/sourcegraph/pull/123",
+		Owners:             []string{"alice", "bob"},
+		State:              "MERGED",
+		ReviewState:        "APPROVED",
+		CheckState:         "PASSED",
+		DiffAdded:          10,
+		DiffDeleted:        4,
+		CreatedAt:          &createdAt,
+		MergedAt:           &mergedAt,
+		TimeToMergeSeconds: &timeToMerge,
+		LastEventKind:      "github:merged",
+		LastEventAt:        &mergedAt,
+	}}
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeCSVReport(&buf, rows))
+
+		want := "id,repository,title,url,owners,state,review_state,check_state,diff_added,diff_deleted,created_at,merged_at,time_to_merge_seconds,last_event_kind,last_event_at\n" +
+			"Q2hhbmdlc2V0OjI=,github.com/sourcegraph/sourcegraph,\"Update, dependencies\",https://github.com/sourcegraph/sourcegraph/pull/123,alice bob,MERGED,APPROVED,PASSED,10,4,2023-01-02T10:00:00Z,2023-01-02T11:00:00Z,3600,github:merged,2023-01-02T11:00:00Z\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeJSONReport(&buf, rows))
+
+		want := `[{"id":"Q2hhbmdlc2V0OjI=","repository":"github.com/sourcegraph/sourcegraph","title":"Update, dependencies","url":"https://github.com/sourcegraph/sourcegraph/pull/123","owners":["alice","bob"],"state":"MERGED","reviewState":"APPROVED","checkState":"PASSED","diffAdded":10,"diffDeleted":4,"createdAt":"2023-01-02T10:00:00Z","mergedAt":"2023-01-02T11:00:00Z","timeToMergeSeconds":3600,"lastEventKind":"github:merged","lastEventAt":"2023-01-02T11:00:00Z"}]` + "\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("empty json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeJSONReport(&buf, nil))
+		assert.Equal(t, "[]\n", buf.String())
+	})
+}
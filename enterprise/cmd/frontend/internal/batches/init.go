@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types/scheduler/window"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -59,5 +60,8 @@ func Init(
 	enterpriseServices.BatchesChangesFileExistsHandler = fileHandler.Exists()
 	enterpriseServices.BatchesChangesFileUploadHandler = fileHandler.Upload()
 
+	exportHandler := httpapi.NewExportHandler(db, bstore, own.NewService(gitserverClient, db), operations)
+	enterpriseServices.BatchesChangesExportHandler = exportHandler.Export()
+
 	return nil
 }
@@ -284,6 +284,30 @@ func (r *batchChangeResolver) CurrentSpec(ctx context.Context) (graphqlbackend.B
 	return &batchSpecResolver{store: r.store, batchSpec: batchSpec}, nil
 }
 
+func (r *batchChangeResolver) Rollout(ctx context.Context) (graphqlbackend.BatchChangeRolloutResolver, error) {
+	batchSpec, err := r.computeBatchSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if batchSpec.Spec == nil || batchSpec.Spec.Rollout == nil {
+		return nil, nil
+	}
+
+	rollout, err := r.store.GetBatchChangeRollout(ctx, store.GetBatchChangeRolloutOpts{BatchChangeID: r.batchChange.ID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchChangeRolloutResolver{
+		rollout: rollout,
+		// The changesets that aren't matched by any wave make up a final wave.
+		waveCount: len(batchSpec.Spec.Rollout.Waves) + 1,
+	}, nil
+}
+
 func (r *batchChangeResolver) BulkOperations(
 	ctx context.Context,
 	args *graphqlbackend.ListBatchChangeBulkOperationArgs,
@@ -0,0 +1,36 @@
+package resolvers
+
+import (
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+type batchChangeRolloutResolver struct {
+	rollout   *btypes.BatchChangeRollout
+	waveCount int
+}
+
+var _ graphqlbackend.BatchChangeRolloutResolver = &batchChangeRolloutResolver{}
+
+func (r *batchChangeRolloutResolver) State() string {
+	return string(r.rollout.State)
+}
+
+func (r *batchChangeRolloutResolver) CurrentWave() int32 {
+	// Completed rollouts point past the last wave.
+	if r.rollout.Wave >= r.waveCount {
+		return int32(r.waveCount)
+	}
+	return int32(r.rollout.Wave + 1)
+}
+
+func (r *batchChangeRolloutResolver) WaveCount() int32 {
+	return int32(r.waveCount)
+}
+
+func (r *batchChangeRolloutResolver) PauseReason() *string {
+	if r.rollout.PauseReason == "" {
+		return nil
+	}
+	return &r.rollout.PauseReason
+}
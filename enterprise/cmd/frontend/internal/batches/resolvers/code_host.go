@@ -31,7 +31,7 @@ func (c *batchChangesCodeHostResolver) RequiresSSH() bool {
 
 func (c *batchChangesCodeHostResolver) RequiresUsername() bool {
 	switch c.codeHost.ExternalServiceType {
-	case extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps:
+	case extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps, extsvc.TypeGerrit, extsvc.TypePerforce:
 		return true
 	}
 
@@ -1053,6 +1053,64 @@ func (r *Resolver) CloseBatchChange(ctx context.Context, args *graphqlbackend.Cl
 	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange}, nil
 }
 
+func (r *Resolver) PauseBatchChangeRollout(ctx context.Context, args *graphqlbackend.PauseBatchChangeRolloutArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.PauseBatchChangeRollout", fmt.Sprintf("BatchChange: %q", args.BatchChange))
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	return r.updateBatchChangeRollout(ctx, args.BatchChange, func(svc *service.Service, id int64) error {
+		// 🚨 SECURITY: PauseBatchChangeRollout checks whether current user is authorized.
+		_, err := svc.PauseBatchChangeRollout(ctx, id)
+		return errors.Wrap(err, "pausing rollout")
+	})
+}
+
+func (r *Resolver) ResumeBatchChangeRollout(ctx context.Context, args *graphqlbackend.ResumeBatchChangeRolloutArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.ResumeBatchChangeRollout", fmt.Sprintf("BatchChange: %q", args.BatchChange))
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	return r.updateBatchChangeRollout(ctx, args.BatchChange, func(svc *service.Service, id int64) error {
+		// 🚨 SECURITY: ResumeBatchChangeRollout checks whether current user is authorized.
+		_, err := svc.ResumeBatchChangeRollout(ctx, id)
+		return errors.Wrap(err, "resuming rollout")
+	})
+}
+
+func (r *Resolver) updateBatchChangeRollout(ctx context.Context, id graphql.ID, update func(svc *service.Service, batchChangeID int64) error) (graphqlbackend.BatchChangeResolver, error) {
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), br.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling batch change id")
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	if err := update(service.New(r.store), batchChangeID); err != nil {
+		return nil, err
+	}
+
+	batchChange, err := r.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "loading batch change")
+	}
+
+	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange}, nil
+}
+
 func (r *Resolver) SyncChangeset(ctx context.Context, args *graphqlbackend.SyncChangesetArgs) (_ *graphqlbackend.EmptyResponse, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.SyncChangeset", fmt.Sprintf("Changeset: %q", args.Changeset))
 	defer func() {
@@ -1246,7 +1304,7 @@ func (r *Resolver) generateAuthenticatorForCredential(ctx context.Context, exter
 			PublicKey:  keypair.PublicKey,
 			Passphrase: keypair.Passphrase,
 		}
-	} else if externalServiceType == extsvc.TypeAzureDevOps {
+	} else if externalServiceType == extsvc.TypeAzureDevOps || externalServiceType == extsvc.TypeGerrit || externalServiceType == extsvc.TypePerforce {
 		a = &extsvcauth.BasicAuthWithSSH{
 			BasicAuth:  extsvcauth.BasicAuth{Username: *username, Password: credential},
 			PrivateKey: keypair.PrivateKey,
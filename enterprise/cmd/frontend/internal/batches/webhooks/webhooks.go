@@ -172,11 +172,20 @@ func (h webhook) upsertChangesetEvent(
 	events, _, err := tx.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{
 		ChangesetIDs: []int64{cs.ID},
 	})
+	wasComplete := cs.Complete()
 	state.SetDerivedState(ctx, tx.Repos(), h.gitserverClient, cs, events)
 	if err := tx.UpdateChangesetCodeHostState(ctx, cs); err != nil {
 		return err
 	}
 
+	// Changesets stacked on this one need to be rebased and retargeted once
+	// it's merged or closed.
+	if !wasComplete && cs.Complete() {
+		if err := tx.EnqueueChangesetsStackedOn(ctx, cs); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
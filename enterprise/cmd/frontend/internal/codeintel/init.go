@@ -12,6 +12,7 @@ import (
 	codenavgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/transport/graphql"
 	policiesgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies/transport/graphql"
 	sentinelgraphql "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/transport/graphql"
+	sentinelhttp "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/transport/http"
 	cigitserver "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/lsifuploadstore"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers"
@@ -126,6 +127,7 @@ func Init(
 		sentinelRootResolver,
 	)
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.VulnerabilityImportHandler = sentinelhttp.NewImportHandler(db, codeIntelServices.SentinelService)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
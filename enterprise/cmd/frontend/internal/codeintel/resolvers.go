@@ -72,6 +72,22 @@ func (r *Resolver) VulnerabilityMatchByID(ctx context.Context, id graphql.ID) (_
 	return r.sentinelRootResolver.VulnerabilityMatchByID(ctx, id)
 }
 
+func (r *Resolver) VulnerabilitySuppressionRules(ctx context.Context, args resolverstubs.GetVulnerabilitySuppressionRulesArgs) (_ resolverstubs.VulnerabilitySuppressionRuleConnectionResolver, err error) {
+	return r.sentinelRootResolver.VulnerabilitySuppressionRules(ctx, args)
+}
+
+func (r *Resolver) TriageVulnerabilityMatch(ctx context.Context, args resolverstubs.TriageVulnerabilityMatchArgs) (_ resolverstubs.VulnerabilityMatchResolver, err error) {
+	return r.sentinelRootResolver.TriageVulnerabilityMatch(ctx, args)
+}
+
+func (r *Resolver) CreateVulnerabilitySuppressionRule(ctx context.Context, args resolverstubs.CreateVulnerabilitySuppressionRuleArgs) (_ resolverstubs.VulnerabilitySuppressionRuleResolver, err error) {
+	return r.sentinelRootResolver.CreateVulnerabilitySuppressionRule(ctx, args)
+}
+
+func (r *Resolver) DeleteVulnerabilitySuppressionRule(ctx context.Context, args resolverstubs.DeleteVulnerabilitySuppressionRuleArgs) (_ *resolverstubs.EmptyResponse, err error) {
+	return r.sentinelRootResolver.DeleteVulnerabilitySuppressionRule(ctx, args)
+}
+
 func (r *Resolver) IndexerKeys(ctx context.Context, opts *resolverstubs.IndexerKeyQueryArgs) (_ []string, err error) {
 	return r.autoIndexingRootResolver.IndexerKeys(ctx, opts)
 }
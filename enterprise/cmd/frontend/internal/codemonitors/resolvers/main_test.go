@@ -141,6 +141,19 @@ func (r *Resolver) insertTestMonitorWithOpts(ctx context.Context, t *testing.T,
 func newTestResolver(t *testing.T, db database.DB) *Resolver {
 	t.Helper()
 
+	// Mirror the default RBAC setup, where every user may create code monitors.
+	ctx := context.Background()
+	p, err := db.Permissions().Create(ctx, database.CreatePermissionOpts{
+		Namespace: types.CodeMonitorsNamespace,
+		Action:    "WRITE",
+	})
+	require.NoError(t, err)
+	err = db.RolePermissions().BulkAssignPermissionsToSystemRoles(ctx, database.BulkAssignPermissionsToSystemRolesOpts{
+		Roles:        []types.SystemRole{types.SiteAdministratorSystemRole, types.UserSystemRole},
+		PermissionID: p.ID,
+	})
+	require.NoError(t, err)
+
 	now := time.Now().UTC().Truncate(time.Microsecond)
 	clock := func() time.Time { return now }
 	return newResolverWithClock(logtest.Scoped(t), db, clock)
@@ -19,6 +19,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -124,6 +125,10 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		return nil, err
 	}
 
+	if err := codemonitors.ValidateQuery(args.Trigger.Query); err != nil {
+		return nil, err
+	}
+
 	// Start transaction.
 	var newMonitor *edb.Monitor
 	err = r.withTransact(ctx, func(tx *Resolver) error {
@@ -227,6 +232,10 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 		return nil, errors.Errorf("update namespace: %w", err)
 	}
 
+	if err := codemonitors.ValidateQuery(args.Trigger.Update.Query); err != nil {
+		return nil, err
+	}
+
 	monitorID, err := unmarshalMonitorID(args.Monitor.Id)
 	if err != nil {
 		return nil, err
@@ -674,12 +683,18 @@ func (r *Resolver) isAllowedToEdit(ctx context.Context, id graphql.ID) error {
 // - she is the owner
 // - she is a member of the organization which is the owner of the monitor
 // - she is a site-admin
+//
+// In addition, she must have the CODE_MONITORS#WRITE permission through one of
+// her roles.
 func (r *Resolver) isAllowedToCreate(ctx context.Context, owner graphql.ID) error {
 	var ownerInt32 int32
 	err := relay.UnmarshalSpec(owner, &ownerInt32)
 	if err != nil {
 		return err
 	}
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.db, rbac.CodeMonitorsWritePermission); err != nil {
+		return err
+	}
 	switch kind := relay.UnmarshalKind(owner); kind {
 	case "User":
 		return auth.CheckSiteAdminOrSameUser(ctx, r.db, ownerInt32)
@@ -980,6 +995,7 @@ func (m *monitorTriggerEvent) ResultCount() int32 {
 	for _, cm := range m.TriggerJob.SearchResults {
 		count += cm.ResultCount()
 	}
+	count += len(m.TriggerJob.ContentChanges)
 	return int32(count)
 }
 
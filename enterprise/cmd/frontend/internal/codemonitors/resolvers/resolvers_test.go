@@ -22,7 +22,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -102,6 +104,38 @@ func TestCreateCodeMonitor(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, monitors.Nodes(), 0) // the transaction should have been rolled back
 	})
+
+	t.Run("unsupported result type", func(t *testing.T) {
+		namespace := relay.MarshalID("User", user.ID)
+		_, err := r.CreateCodeMonitor(ctx, &graphqlbackend.CreateCodeMonitorArgs{
+			Monitor: &graphqlbackend.CreateMonitorArgs{Namespace: namespace},
+			Trigger: &graphqlbackend.CreateTriggerArgs{Query: "type:repo foo"},
+			Actions: []*graphqlbackend.CreateActionArgs{{
+				Email: &graphqlbackend.CreateActionEmailArgs{
+					Enabled:    true,
+					Priority:   "NORMAL",
+					Recipients: []graphql.ID{namespace},
+				},
+			}},
+		})
+		require.ErrorContains(t, err, "code monitors do not support type:repo queries")
+		monitors, err := r.Monitors(ctx, user.ID, &graphqlbackend.ListMonitorsArgs{First: 10})
+		require.NoError(t, err)
+		require.Len(t, monitors.Nodes(), 0)
+	})
+}
+
+func TestCreateCodeMonitorWithoutPermission(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	// The CODE_MONITORS#WRITE permission is not assigned to any role.
+	r := newResolverWithClock(logger, db, timeutil.Now)
+
+	user := insertTestUser(t, db, "cm-user1", false)
+	ctx := actor.WithActor(context.Background(), actor.FromUser(user.ID))
+
+	_, err := r.insertTestMonitorWithOpts(ctx, t)
+	require.ErrorContains(t, err, (&rbac.ErrNotAuthorized{Permission: rbac.CodeMonitorsWritePermission}).Error())
 }
 
 func TestListCodeMonitors(t *testing.T) {
@@ -2,9 +2,14 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
+	"sort"
+
+	"github.com/graph-gophers/graphql-go"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
@@ -15,12 +20,17 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 )
 
 func NewResolver(
 	db database.DB,
 	gitserverClient gitserver.Client,
 	embeddingsClient *embeddings.Client,
+	searchClient client.SearchClient,
 	repoStore repobg.RepoEmbeddingJobsStore,
 	contextDetectionStore contextdetectionbg.ContextDetectionEmbeddingJobsStore,
 ) graphqlbackend.EmbeddingsResolver {
@@ -28,6 +38,7 @@ func NewResolver(
 		db:                        db,
 		gitserverClient:           gitserverClient,
 		embeddingsClient:          embeddingsClient,
+		searchClient:              searchClient,
 		repoEmbeddingJobsStore:    repoStore,
 		contextDetectionJobsStore: contextDetectionStore,
 	}
@@ -37,6 +48,7 @@ type Resolver struct {
 	db                        database.DB
 	gitserverClient           gitserver.Client
 	embeddingsClient          *embeddings.Client
+	searchClient              client.SearchClient
 	repoEmbeddingJobsStore    repobg.RepoEmbeddingJobsStore
 	contextDetectionJobsStore contextdetectionbg.ContextDetectionEmbeddingJobsStore
 }
@@ -69,6 +81,112 @@ func (r *Resolver) EmbeddingsSearch(ctx context.Context, args graphqlbackend.Emb
 	return &embeddingsSearchResultsResolver{results}, nil
 }
 
+// maxEmbeddingsMultiSearchRepos is the maximum number of repositories that
+// can be searched at once.
+const maxEmbeddingsMultiSearchRepos = 64
+
+func (r *Resolver) EmbeddingsMultiSearch(ctx context.Context, args graphqlbackend.EmbeddingsMultiSearchInputArgs) (graphqlbackend.EmbeddingsSearchResultsResolver, error) {
+	if !conf.EmbeddingsEnabled() {
+		return nil, errors.New("embeddings are not configured or disabled")
+	}
+
+	if (args.Repos == nil) == (args.SearchContext == nil) {
+		return nil, errors.New("exactly one of repos and searchContext must be set")
+	}
+
+	var repoNames []api.RepoName
+	var err error
+	if args.Repos != nil {
+		repoNames, err = r.resolveRepoNames(ctx, *args.Repos)
+	} else {
+		repoNames, err = r.resolveSearchContextRepoNames(ctx, *args.SearchContext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(repoNames) > maxEmbeddingsMultiSearchRepos {
+		return nil, errors.Newf("cannot search more than %d repositories at once", maxEmbeddingsMultiSearchRepos)
+	}
+
+	results, err := r.embeddingsClient.MultiSearch(ctx, embeddings.EmbeddingsMultiSearchParameters{
+		RepoNames:        repoNames,
+		Query:            args.Query,
+		CodeResultsCount: int(args.CodeResultsCount),
+		TextResultsCount: int(args.TextResultsCount),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &embeddingsSearchResultsResolver{results}, nil
+}
+
+func (r *Resolver) resolveRepoNames(ctx context.Context, ids []graphql.ID) ([]api.RepoName, error) {
+	repoNames := make([]api.RepoName, 0, len(ids))
+	for _, id := range ids {
+		repoID, err := graphqlbackend.UnmarshalRepositoryID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		repo, err := r.db.Repos().Get(ctx, repoID)
+		if err != nil {
+			return nil, err
+		}
+		repoNames = append(repoNames, repo.Name)
+	}
+	return repoNames, nil
+}
+
+func (r *Resolver) resolveSearchContextRepoNames(ctx context.Context, searchContextSpec string) ([]api.RepoName, error) {
+	// Fail early on unknown search contexts, so that the spec can be used in
+	// the search query below.
+	if _, err := searchcontexts.ResolveSearchContextSpec(ctx, r.db, searchContextSpec); err != nil {
+		return nil, err
+	}
+
+	settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the repositories the same way search does, which also covers
+	// auto-defined search contexts and query-based search contexts, whose
+	// query replaces the context filter.
+	inputs, err := r.searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		fmt.Sprintf("context:%s select:repo count:all", searchContextSpec),
+		search.Precise,
+		search.Streaming,
+		settings,
+		envvar.SourcegraphDotComMode(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Search only returns repositories the user has access to.
+	agg := streaming.NewAggregatingStream()
+	if _, err := r.searchClient.Execute(ctx, agg, inputs); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[api.RepoName]struct{}, len(agg.Results))
+	repoNames := make([]api.RepoName, 0, len(agg.Results))
+	for _, match := range agg.Results {
+		repoName := match.RepoName().Name
+		if _, ok := seen[repoName]; ok {
+			continue
+		}
+		seen[repoName] = struct{}{}
+		repoNames = append(repoNames, repoName)
+	}
+	sort.Slice(repoNames, func(i, j int) bool { return repoNames[i] < repoNames[j] })
+	return repoNames, nil
+}
+
 func (r *Resolver) IsContextRequiredForChatQuery(ctx context.Context, args graphqlbackend.IsContextRequiredForChatQueryInputArgs) (bool, error) {
 	if !conf.EmbeddingsEnabled() {
 		return false, errors.New("embeddings are not configured or disabled")
@@ -181,6 +299,14 @@ type embeddingsSearchResultResolver struct {
 	result embeddings.EmbeddingSearchResult
 }
 
+func (r *embeddingsSearchResultResolver) RepoName(ctx context.Context) string {
+	return string(r.result.RepoName)
+}
+
+func (r *embeddingsSearchResultResolver) Revision(ctx context.Context) string {
+	return string(r.result.Revision)
+}
+
 func (r *embeddingsSearchResultResolver) FileName(ctx context.Context) string {
 	return r.result.FileName
 }
@@ -196,3 +322,21 @@ func (r *embeddingsSearchResultResolver) EndLine(ctx context.Context) int32 {
 func (r *embeddingsSearchResultResolver) Content(ctx context.Context) string {
 	return r.result.Content
 }
+
+func (r *embeddingsSearchResultResolver) SymbolName(ctx context.Context) *string {
+	if r.result.SymbolName == "" {
+		return nil
+	}
+	return &r.result.SymbolName
+}
+
+func (r *embeddingsSearchResultResolver) SymbolKind(ctx context.Context) *string {
+	if r.result.SymbolKind == "" {
+		return nil
+	}
+	return &r.result.SymbolKind
+}
+
+func (r *embeddingsSearchResultResolver) Score(ctx context.Context) float64 {
+	return float64(r.result.Score)
+}
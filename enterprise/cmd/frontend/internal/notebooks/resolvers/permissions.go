@@ -5,10 +5,14 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/notebooks"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func validateNotebookWritePermissionsForUser(ctx context.Context, db database.DB, notebook *notebooks.Notebook, userID int32) error {
+	if err := rbac.CheckCurrentUserHasPermission(ctx, db, rbac.NotebooksWritePermission); err != nil {
+		return err
+	}
 	if notebook.NamespaceUserID != 0 && notebook.NamespaceUserID != userID {
 		// Only the creator has write access to the notebook
 		return errors.New("user does not match the notebook user namespace")
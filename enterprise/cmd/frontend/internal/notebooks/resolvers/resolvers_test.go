@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -175,6 +176,7 @@ func TestSingleNotebookCRUD(t *testing.T) {
 	logger := logtest.Scoped(t)
 	internalCtx := actor.WithInternalActor(context.Background())
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignNotebooksWritePermissionToUserRole(t, db)
 	u := db.Users()
 	o := db.Orgs()
 	om := db.OrgMembers()
@@ -210,6 +212,33 @@ func TestSingleNotebookCRUD(t *testing.T) {
 	testDeleteNotebook(t, db, schema, user1, user2, org)
 }
 
+func TestCreateNotebookWithoutPermission(t *testing.T) {
+	logger := logtest.Scoped(t)
+	internalCtx := actor.WithInternalActor(context.Background())
+	// The NOTEBOOKS#WRITE permission is not assigned to any role.
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	user, err := db.Users().Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := map[string]any{"notebook": notebooksapitest.NotebookToAPIInput(userNotebookFixture(user.ID, true))}
+	var response struct{ CreateNotebook notebooksapitest.Notebook }
+	gotErrors := apitest.Exec(actor.WithActor(context.Background(), actor.FromUser(user.ID)), t, schema, input, &response, createNotebookMutation)
+	if len(gotErrors) == 0 {
+		t.Fatal("expected error, got none")
+	}
+	if want := (&rbac.ErrNotAuthorized{Permission: rbac.NotebooksWritePermission}).Error(); !strings.Contains(gotErrors[0].Message, want) {
+		t.Fatalf("expected error containing '%s', got '%s'", want, gotErrors[0].Message)
+	}
+}
+
 func testGetNotebook(t *testing.T, db database.DB, schema *graphql.Schema, user *types.User) {
 	ctx := actor.WithInternalActor(context.Background())
 	n := notebooks.Notebooks(db)
@@ -544,6 +573,7 @@ func createNotebookStars(t *testing.T, db database.DB, notebookID int64, userIDs
 func TestListNotebooks(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignNotebooksWritePermissionToUserRole(t, db)
 	internalCtx := actor.WithInternalActor(context.Background())
 	u := db.Users()
 	o := db.Orgs()
@@ -705,6 +735,7 @@ func TestListNotebooks(t *testing.T) {
 func TestGetNotebookWithSoftDeletedUserColumns(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignNotebooksWritePermissionToUserRole(t, db)
 	internalCtx := actor.WithInternalActor(context.Background())
 	u := db.Users()
 	n := notebooks.Notebooks(db)
@@ -738,3 +769,25 @@ func TestGetNotebookWithSoftDeletedUserColumns(t *testing.T) {
 	var response struct{ Node notebooksapitest.Notebook }
 	apitest.MustExec(actor.WithActor(context.Background(), actor.FromUser(user1.ID)), t, schema, input, &response, queryNotebook)
 }
+
+// assignNotebooksWritePermissionToUserRole mirrors the default RBAC setup, where
+// every user may create and edit notebooks.
+func assignNotebooksWritePermissionToUserRole(t *testing.T, db database.DB) {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := db.Permissions().Create(ctx, database.CreatePermissionOpts{
+		Namespace: types.NotebooksNamespace,
+		Action:    "WRITE",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.RolePermissions().BulkAssignPermissionsToSystemRoles(ctx, database.BulkAssignPermissionsToSystemRolesOpts{
+		Roles:        []types.SystemRole{types.SiteAdministratorSystemRole, types.UserSystemRole},
+		PermissionID: p.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
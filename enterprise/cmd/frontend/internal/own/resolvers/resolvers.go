@@ -18,10 +18,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -84,55 +86,96 @@ func (r *ownResolver) GitBlobOwnership(
 	repoID, repoName := repo.IDInt32(), repo.RepoName()
 	commitID := api.CommitID(blob.Commit().OID())
 	ownService := r.ownService()
-	rs, err := ownService.RulesetForRepo(ctx, repoName, repoID, commitID)
-	if err != nil {
-		return nil, err
+
+	// Owners are listed once, with all the reasons they own the file for.
+	var entries []*ownerEntry
+	entriesByOwner := make(map[string]*ownerEntry)
+	addReason := func(owner *codeownerspb.Owner, reason graphqlbackend.OwnershipReasonResolver) {
+		text := ownerText(owner)
+		if text == "" {
+			return
+		}
+		entry, ok := entriesByOwner[text]
+		if !ok {
+			entry = &ownerEntry{owner: owner}
+			entriesByOwner[text] = entry
+			entries = append(entries, entry)
+		}
+		entry.reasons = append(entry.reasons, reason)
 	}
-	// No ruleset found.
-	if rs == nil {
-		return &ownershipConnectionResolver{db: r.db}, nil
+
+	if includeReason(args.Reasons, codeownersFileEntryReason) {
+		rs, err := ownService.RulesetForRepo(ctx, repoName, repoID, commitID)
+		if err != nil {
+			return nil, err
+		}
+		if rs != nil {
+			if rule := rs.Match(blob.Path()); rule != nil {
+				reason := &codeownersFileEntryResolver{
+					db:              r.db,
+					gitserverClient: r.gitserver,
+					source:          rs.GetSource(),
+					repo:            blob.Repository(),
+					matchLineNumber: rule.GetLineNumber(),
+				}
+				for _, o := range rule.GetOwner() {
+					addReason(o, reason)
+				}
+			}
+		}
 	}
-	rule := rs.Match(blob.Path())
-	// No match found.
-	if rule == nil {
-		return &ownershipConnectionResolver{db: r.db}, nil
+
+	includeContributors := includeReason(args.Reasons, recentContributorOwnershipSignalReason)
+	includeReviewers := includeReason(args.Reasons, recentReviewerOwnershipSignalReason)
+	if includeContributors || includeReviewers {
+		inferredOwners, err := ownService.InferredOwnersForPaths(ctx, repoID, []string{blob.Path()})
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range inferredOwners[blob.Path()] {
+			switch o.Signal {
+			case owntypes.InferredOwnershipSignalRecentContributor:
+				if includeContributors {
+					addReason(o.Owner(), &inferredOwnershipSignalResolver{owner: o})
+				}
+			case owntypes.InferredOwnershipSignalRecentReviewer:
+				if includeReviewers {
+					addReason(o.Owner(), &inferredOwnershipSignalResolver{owner: o})
+				}
+			}
+		}
 	}
-	owners := rule.GetOwner()
-	sort.Slice(owners, func(i, j int) bool {
-		iText := ownerText(owners[i])
-		jText := ownerText(owners[j])
+
+	sort.Slice(entries, func(i, j int) bool {
+		iText := ownerText(entries[i].owner)
+		jText := ownerText(entries[j].owner)
 		return iText < jText
 	})
-	total := len(owners)
-	for cursor != "" && len(owners) > 0 && ownerText(owners[0]) != cursor {
-		owners = owners[1:]
+	total := len(entries)
+	for cursor != "" && len(entries) > 0 && ownerText(entries[0].owner) != cursor {
+		entries = entries[1:]
 	}
 	var next *string
-	if args.First != nil && len(owners) > int(*args.First) {
-		cursor := ownerText(owners[*args.First])
+	if args.First != nil && len(entries) > int(*args.First) {
+		cursor := ownerText(entries[*args.First].owner)
 		next = &cursor
-		owners = owners[:*args.First]
+		entries = entries[:*args.First]
 	}
-	resolvedOwners, err := ownService.ResolveOwnersWithType(ctx, owners)
-	if err != nil {
-		return nil, err
-	}
-	ownerships := make([]graphqlbackend.OwnershipResolver, 0, len(resolvedOwners))
-	for _, ro := range resolvedOwners {
-		reasons := []graphqlbackend.OwnershipReasonResolver{
-			&codeownersFileEntryResolver{
-				db:              r.db,
-				gitserverClient: r.gitserver,
-				source:          rs.GetSource(),
-				repo:            blob.Repository(),
-				matchLineNumber: rule.GetLineNumber(),
-			},
+	var resolvedOwners []codeowners.ResolvedOwner
+	ownerships := make([]graphqlbackend.OwnershipResolver, 0, len(entries))
+	for _, entry := range entries {
+		resolved, err := ownService.ResolveOwnersWithType(ctx, []*codeownerspb.Owner{entry.owner})
+		if err != nil {
+			return nil, err
+		}
+		for _, ro := range resolved {
+			resolvedOwners = append(resolvedOwners, ro)
+			ownerships = append(ownerships, &ownershipResolver{
+				db:            r.db,
+				resolvedOwner: ro,
+				reasons:       entry.reasons,
+			})
 		}
-		ownerships = append(ownerships, &ownershipResolver{
-			db:            r.db,
-			resolvedOwner: ro,
-			reasons:       reasons,
-		})
 	}
 	return &ownershipConnectionResolver{
 		db:             r.db,
@@ -143,6 +186,32 @@ func (r *ownResolver) GitBlobOwnership(
 	}, nil
 }
 
+// ownerEntry is an owner of a file along with the reasons they own it for.
+type ownerEntry struct {
+	owner   *codeownerspb.Owner
+	reasons []graphqlbackend.OwnershipReasonResolver
+}
+
+const (
+	codeownersFileEntryReason              = "CODEOWNERS_FILE_ENTRY"
+	recentContributorOwnershipSignalReason = "RECENT_CONTRIBUTOR_OWNERSHIP_SIGNAL"
+	recentReviewerOwnershipSignalReason    = "RECENT_REVIEWER_OWNERSHIP_SIGNAL"
+)
+
+// includeReason returns true if ownership for the given reason was requested.
+// All reasons are included if no reasons are given.
+func includeReason(reasons *[]string, reason string) bool {
+	if reasons == nil || len(*reasons) == 0 {
+		return true
+	}
+	for _, r := range *reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ownResolver) PersonOwnerField(person *graphqlbackend.PersonResolver) string {
 	return "owner"
 }
@@ -250,6 +319,14 @@ func (r *codeownersFileEntryResolver) ToCodeownersFileEntry() (graphqlbackend.Co
 	return r, true
 }
 
+func (r *codeownersFileEntryResolver) ToRecentContributorOwnershipSignal() (graphqlbackend.InferredOwnershipSignalResolver, bool) {
+	return nil, false
+}
+
+func (r *codeownersFileEntryResolver) ToRecentReviewerOwnershipSignal() (graphqlbackend.InferredOwnershipSignalResolver, bool) {
+	return nil, false
+}
+
 func (r *codeownersFileEntryResolver) Title(_ context.Context) (string, error) {
 	return "CODEOWNERS", nil
 }
@@ -286,6 +363,52 @@ func (r *codeownersFileEntryResolver) RuleLineMatch(_ context.Context) (int32, e
 	return r.matchLineNumber, nil
 }
 
+type inferredOwnershipSignalResolver struct {
+	owner *owntypes.InferredOwner
+}
+
+func (r *inferredOwnershipSignalResolver) ToCodeownersFileEntry() (graphqlbackend.CodeownersFileEntryResolver, bool) {
+	return nil, false
+}
+
+func (r *inferredOwnershipSignalResolver) ToRecentContributorOwnershipSignal() (graphqlbackend.InferredOwnershipSignalResolver, bool) {
+	return r, r.owner.Signal == owntypes.InferredOwnershipSignalRecentContributor
+}
+
+func (r *inferredOwnershipSignalResolver) ToRecentReviewerOwnershipSignal() (graphqlbackend.InferredOwnershipSignalResolver, bool) {
+	return r, r.owner.Signal == owntypes.InferredOwnershipSignalRecentReviewer
+}
+
+func (r *inferredOwnershipSignalResolver) Title(_ context.Context) (string, error) {
+	switch r.owner.Signal {
+	case owntypes.InferredOwnershipSignalRecentReviewer:
+		return "Recent reviewer", nil
+	default:
+		return "Recent contributor", nil
+	}
+}
+
+func (r *inferredOwnershipSignalResolver) Description(_ context.Context) (string, error) {
+	switch r.owner.Signal {
+	case owntypes.InferredOwnershipSignalRecentReviewer:
+		return "Owner is inferred from recent reviews of changesets that changed the file.", nil
+	default:
+		return "Owner is inferred from recent commits that changed the file.", nil
+	}
+}
+
+func (r *inferredOwnershipSignalResolver) Score(_ context.Context) (float64, error) {
+	return r.owner.Score, nil
+}
+
+func (r *inferredOwnershipSignalResolver) WindowStart(_ context.Context) (gqlutil.DateTime, error) {
+	return gqlutil.DateTime{Time: r.owner.WindowStart}, nil
+}
+
+func (r *inferredOwnershipSignalResolver) WindowEnd(_ context.Context) (gqlutil.DateTime, error) {
+	return gqlutil.DateTime{Time: r.owner.WindowEnd}, nil
+}
+
 func areOwnEndpointsAvailable(ctx context.Context) error {
 	if !featureflag.FromContext(ctx).GetBoolOr("search-ownership", false) {
 		return errors.New("own is not available yet")
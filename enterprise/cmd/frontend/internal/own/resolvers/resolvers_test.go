@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -30,6 +31,7 @@ import (
 
 	enterprisedb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 )
 
 // userCtx returns a context where give user ID identifies logged in user.
@@ -39,15 +41,28 @@ func userCtx(userID int32) context.Context {
 	return actor.WithActor(ctx, a)
 }
 
-// fakeOwnService returns given owners file and resolves owners to UnknownOwner.
+// fakeOwnService returns given owners file and inferred owners, and resolves owners to UnknownOwner.
 type fakeOwnService struct {
-	Ruleset *codeowners.Ruleset
+	Ruleset        *codeowners.Ruleset
+	InferredOwners []*owntypes.InferredOwner
 }
 
 func (s fakeOwnService) RulesetForRepo(context.Context, api.RepoName, api.RepoID, api.CommitID) (*codeowners.Ruleset, error) {
 	return s.Ruleset, nil
 }
 
+func (s fakeOwnService) InferredOwnersForPaths(_ context.Context, _ api.RepoID, paths []string) (map[string][]*owntypes.InferredOwner, error) {
+	owners := make(map[string][]*owntypes.InferredOwner)
+	for _, o := range s.InferredOwners {
+		for _, path := range paths {
+			if o.FilePath == path {
+				owners[path] = append(owners[path], o)
+			}
+		}
+	}
+	return owners, nil
+}
+
 // ResolverOwnersWithType here behaves in line with production
 // OwnService implementation in case handle/email cannot be associated
 // with anything - defaults to a Person with a nil person entity.
@@ -312,6 +327,206 @@ func TestBlobOwnershipPanelQueryIngested(t *testing.T) {
 	})
 }
 
+func TestBlobOwnershipPanelQueryInferred(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fs := fakedb.New()
+	db := database.NewMockDB()
+	fs.Wire(db)
+	repoID := api.RepoID(1)
+	windowEnd := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	windowStart := windowEnd.Add(-90 * 24 * time.Hour)
+	own := fakeOwnService{
+		Ruleset: codeowners.NewRuleset(
+			codeowners.IngestedRulesetSource{ID: int32(repoID)},
+			&codeownerspb.File{
+				Rule: []*codeownerspb.Rule{
+					{
+						Pattern: "*.js",
+						Owner: []*codeownerspb.Owner{
+							{Handle: "js-owner"},
+						},
+						LineNumber: 1,
+					},
+				},
+			}),
+		InferredOwners: []*owntypes.InferredOwner{
+			{
+				RepoID:      repoID,
+				FilePath:    "foo/bar.js",
+				Signal:      owntypes.InferredOwnershipSignalRecentContributor,
+				Handle:      "js-owner",
+				Email:       "js-owner@example.com",
+				Score:       0.75,
+				WindowStart: windowStart,
+				WindowEnd:   windowEnd,
+			},
+			{
+				RepoID:      repoID,
+				FilePath:    "foo/bar.js",
+				Signal:      owntypes.InferredOwnershipSignalRecentReviewer,
+				Handle:      "js-reviewer",
+				Score:       1,
+				WindowStart: windowStart,
+				WindowEnd:   windowEnd,
+			},
+		},
+	}
+	ctx := userCtx(fs.AddUser(types.User{SiteAdmin: true}))
+	ctx = featureflag.WithFlags(ctx, featureflag.NewMemoryStore(map[string]bool{"search-ownership": true}, nil, nil))
+	repos := database.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+	backend.Mocks.Repos.ResolveRev = func(_ context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		return "deadbeef", nil
+	}
+	git := fakeGitserver{}
+	schema, err := graphqlbackend.NewSchema(db, git, nil, graphqlbackend.OptionalResolver{OwnResolver: resolvers.NewWithService(db, git, own, logger)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := `
+		fragment ReasonFields on OwnershipReason {
+			__typename
+			... on CodeownersFileEntry {
+				title
+			}
+			... on RecentContributorOwnershipSignal {
+				title
+				description
+				score
+				windowStart
+				windowEnd
+			}
+			... on RecentReviewerOwnershipSignal {
+				title
+				description
+				score
+			}
+		}
+
+		query FetchOwnership($repo: ID!, $revision: String!, $currentPath: String!, $reasons: [OwnershipReasonType!]) {
+			node(id: $repo) {
+				... on Repository {
+					commit(rev: $revision) {
+						blob(path: $currentPath) {
+							ownership(reasons: $reasons) {
+								totalCount
+								nodes {
+									owner {
+										... on Person {
+											displayName
+										}
+									}
+									reasons {
+										...ReasonFields
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+
+	t.Run("inferred owners are merged with code owners", func(t *testing.T) {
+		graphqlbackend.RunTest(t, &graphqlbackend.Test{
+			Schema:  schema,
+			Context: ctx,
+			Query:   query,
+			ExpectedResult: `{
+				"node": {
+					"commit": {
+						"blob": {
+							"ownership": {
+								"totalCount": 2,
+								"nodes": [
+									{
+										"owner": {
+											"displayName": "js-owner"
+										},
+										"reasons": [
+											{
+												"__typename": "CodeownersFileEntry",
+												"title": "CODEOWNERS"
+											},
+											{
+												"__typename": "RecentContributorOwnershipSignal",
+												"title": "Recent contributor",
+												"description": "Owner is inferred from recent commits that changed the file.",
+												"score": 0.75,
+												"windowStart": "2022-12-01T00:00:00Z",
+												"windowEnd": "2023-03-01T00:00:00Z"
+											}
+										]
+									},
+									{
+										"owner": {
+											"displayName": "js-reviewer"
+										},
+										"reasons": [
+											{
+												"__typename": "RecentReviewerOwnershipSignal",
+												"title": "Recent reviewer",
+												"description": "Owner is inferred from recent reviews of changesets that changed the file.",
+												"score": 1
+											}
+										]
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"repo":        string(relay.MarshalID("Repository", repoID)),
+				"revision":    "revision",
+				"currentPath": "foo/bar.js",
+			},
+		})
+	})
+
+	t.Run("filter by reasons", func(t *testing.T) {
+		graphqlbackend.RunTest(t, &graphqlbackend.Test{
+			Schema:  schema,
+			Context: ctx,
+			Query:   query,
+			ExpectedResult: `{
+				"node": {
+					"commit": {
+						"blob": {
+							"ownership": {
+								"totalCount": 1,
+								"nodes": [
+									{
+										"owner": {
+											"displayName": "js-reviewer"
+										},
+										"reasons": [
+											{
+												"__typename": "RecentReviewerOwnershipSignal",
+												"title": "Recent reviewer",
+												"description": "Owner is inferred from recent reviews of changesets that changed the file.",
+												"score": 1
+											}
+										]
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"repo":        string(relay.MarshalID("Repository", repoID)),
+				"revision":    "revision",
+				"currentPath": "foo/bar.js",
+				"reasons":     []any{"RECENT_REVIEWER_OWNERSHIP_SIGNAL"},
+			},
+		})
+	})
+}
+
 func TestBlobOwnershipPanelQueryTeamResolved(t *testing.T) {
 	logger := logtest.Scoped(t)
 	repo := &types.Repo{Name: "repo-name", ID: 42}
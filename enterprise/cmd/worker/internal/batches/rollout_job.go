@@ -0,0 +1,41 @@
+package batches
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/rollout"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type rolloutJob struct{}
+
+func NewRolloutJob() job.Job {
+	return &rolloutJob{}
+}
+
+func (j *rolloutJob) Description() string {
+	return "Advances the rollouts of batch changes wave by wave."
+}
+
+func (j *rolloutJob) Config() []env.Config {
+	return []env.Config{}
+}
+
+func (j *rolloutJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	workCtx := actor.WithInternalActor(context.Background())
+
+	bstore, err := InitStore()
+	if err != nil {
+		return nil, err
+	}
+
+	routines := []goroutine.BackgroundRoutine{
+		rollout.NewRolloutWorker(workCtx, observationCtx.Logger.Scoped("rollout", "batch change rollout worker"), bstore),
+	}
+
+	return routines, nil
+}
@@ -281,7 +281,12 @@ func (r *batchSpecWorkspaceCreator) process(
 
 		workspace.dbWorkspace.CachedResultFound = true
 
-		rawSpecs, err := cache.ChangesetSpecsFromCache(spec.Spec, workspace.repo, *res.Value, workspace.dbWorkspace.Path, true, changesetAuthor)
+		var stepResults []execution.AfterStepResult
+		for _, r := range workspace.dbWorkspace.StepCacheResults {
+			stepResults = append(stepResults, *r.Value)
+		}
+
+		rawSpecs, err := cache.ChangesetSpecsFromCache(spec.Spec, workspace.repo, *res.Value, stepResults, workspace.dbWorkspace.Path, true, changesetAuthor)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,38 @@
+package codeintel
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type repoDependenciesInventoryJob struct{}
+
+func NewRepoDependenciesInventoryJob() job.Job {
+	return &repoDependenciesInventoryJob{}
+}
+
+func (j *repoDependenciesInventoryJob) Description() string {
+	return "repository dependency inventory"
+}
+
+func (j *repoDependenciesInventoryJob) Config() []env.Config {
+	return []env.Config{
+		dependencies.ConfigInst,
+	}
+}
+
+func (j *repoDependenciesInventoryJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencies.RepoDependenciesInventoryJob(observationCtx, db, gitserver.NewClient()), nil
+}
@@ -14,8 +14,11 @@ import (
 	repoembeddingsbg "github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/split"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 )
@@ -54,31 +57,150 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *repoemb
 		return err
 	}
 
+	embeddingsClient := embed.NewEmbeddingsClient()
+	readFile := func(fileName string) ([]byte, error) {
+		return h.gitserverClient.ReadFile(ctx, nil, repo.Name, record.Revision, fileName)
+	}
+	var getFileSymbols func(fileName string) ([]split.Symbol, error)
+	if conf.Get().Embeddings.Chunking == "symbols" {
+		getFileSymbols = func(fileName string) ([]split.Symbol, error) {
+			return fileSymbols(ctx, repo.Name, record.Revision, fileName)
+		}
+	}
+
+	// If the repository has been embedded before, we only embed the files that
+	// changed since then.
+	previousIndex, err := embeddings.DownloadIndex[embeddings.RepoEmbeddingIndex](ctx, h.uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repo.Name)))
+	if err != nil {
+		logger.Info("no previous embedding index found, embedding the entire repository", log.Error(err))
+	} else if changes, err := h.changedFiles(ctx, repo.Name, previousIndex.Revision, record.Revision); err != nil {
+		logger.Warn("failed to diff against the revision of the previous embedding index, embedding the entire repository", log.Error(err))
+	} else {
+		repoEmbeddingIndex, err := embed.UpdateRepoEmbeddingIndex(ctx, previousIndex, record.Revision, changes, embeddingsClient, splitOptions, readFile, getFileSymbols)
+		if err == nil {
+			return h.uploadIndexes(ctx, repo.Name, repoEmbeddingIndex)
+		}
+		if !errors.Is(err, embed.ErrModelChanged) {
+			return err
+		}
+		logger.Info("embeddings model changed, embedding the entire repository")
+	}
+
 	files, err := h.gitserverClient.ListFiles(ctx, nil, repo.Name, record.Revision, matchEverythingRegexp)
 	if err != nil {
 		return err
 	}
 
+	validFiles, err := h.filterValidFiles(ctx, repo.Name, record.Revision, files)
+	if err != nil {
+		return err
+	}
+
+	repoEmbeddingIndex, err := embed.EmbedRepo(ctx, repo.Name, record.Revision, validFiles, embeddingsClient, splitOptions, readFile, getFileSymbols)
+	if err != nil {
+		return err
+	}
+
+	return h.uploadIndexes(ctx, repo.Name, repoEmbeddingIndex)
+}
+
+// uploadIndexes uploads the embedding index of the repository, which is used
+// to update the index incrementally, as well as its quantized version, which
+// is used by the embeddings service to serve searches.
+func (h *handler) uploadIndexes(ctx context.Context, repoName api.RepoName, index *embeddings.RepoEmbeddingIndex) error {
+	if err := embeddings.UploadIndex(ctx, h.uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repoName)), index); err != nil {
+		return err
+	}
+
+	quantizedIndex := index.Quantize()
+	quantizedIndex.BuildGraphs(embeddings.DefaultHNSWOptions)
+	return embeddings.UploadIndex(ctx, h.uploadStore, string(embeddings.GetQuantizedRepoEmbeddingIndexName(repoName)), quantizedIndex)
+}
+
+// changedFiles returns the files that changed between the given revisions.
+// Files that are too large to be embedded at the new revision are reported as
+// deleted, so that their previous embeddings are dropped.
+func (h *handler) changedFiles(ctx context.Context, repoName api.RepoName, oldRevision, newRevision api.CommitID) (embed.FileChanges, error) {
+	output, err := h.gitserverClient.DiffSymbols(ctx, repoName, oldRevision, newRevision)
+	if err != nil {
+		return embed.FileChanges{}, errors.Wrap(err, "diffing revisions")
+	}
+	changes, err := embed.ParseGitDiffNameStatus(output)
+	if err != nil {
+		return embed.FileChanges{}, errors.Wrap(err, "parsing diff")
+	}
+
+	changes.Added, err = h.filterValidFiles(ctx, repoName, newRevision, changes.Added)
+	if err != nil {
+		return embed.FileChanges{}, err
+	}
+	validModified, err := h.filterValidFiles(ctx, repoName, newRevision, changes.Modified)
+	if err != nil {
+		return embed.FileChanges{}, err
+	}
+	changes.Deleted = append(changes.Deleted, difference(changes.Modified, validModified)...)
+	changes.Modified = validModified
+
+	return changes, nil
+}
+
+// filterValidFiles returns the given files that are no directories and not
+// larger than MAX_FILE_SIZE.
+func (h *handler) filterValidFiles(ctx context.Context, repoName api.RepoName, revision api.CommitID, files []string) ([]string, error) {
 	validFiles := []string{}
 	for _, file := range files {
-		stat, err := h.gitserverClient.Stat(ctx, nil, repo.Name, record.Revision, file)
+		stat, err := h.gitserverClient.Stat(ctx, nil, repoName, revision, file)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if !stat.IsDir() && stat.Size() <= MAX_FILE_SIZE {
 			validFiles = append(validFiles, file)
 		}
 	}
+	return validFiles, nil
+}
 
-	embeddingsClient := embed.NewEmbeddingsClient()
-
-	repoEmbeddingIndex, err := embed.EmbedRepo(ctx, repo.Name, record.Revision, validFiles, embeddingsClient, splitOptions, func(fileName string) ([]byte, error) {
-		return h.gitserverClient.ReadFile(ctx, nil, repo.Name, record.Revision, fileName)
+// MAX_FILE_SYMBOLS is the maximum number of symbols a file is split at.
+const MAX_FILE_SYMBOLS = 1000
+
+// symbolChunkingKinds are the symbol kinds (cf. the symbol selector kinds)
+// that files are split at.
+var symbolChunkingKinds = []string{"function", "method", "constructor", "class", "struct", "interface"}
+
+// fileSymbols returns the definitions of the symbols in the file that the
+// file is split at.
+func fileSymbols(ctx context.Context, repoName api.RepoName, revision api.CommitID, fileName string) ([]split.Symbol, error) {
+	results, err := symbols.DefaultClient.Search(ctx, search.SymbolsParameters{
+		Repo:            repoName,
+		CommitID:        revision,
+		IsRegExp:        true,
+		IncludePatterns: []string{"^" + regexp.QuoteMeta(fileName) + "$"},
+		IncludeKinds:    symbolChunkingKinds,
+		First:           MAX_FILE_SYMBOLS,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return embeddings.UploadIndex(ctx, h.uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repo.Name)), repoEmbeddingIndex)
+	fileSymbols := make([]split.Symbol, 0, len(results))
+	for _, symbol := range results {
+		fileSymbols = append(fileSymbols, split.Symbol{Name: symbol.Name, Kind: symbol.Kind, Line: symbol.Line})
+	}
+	return fileSymbols, nil
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	set := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		set[s] = struct{}{}
+	}
+	var diff []string
+	for _, s := range a {
+		if _, ok := set[s]; !ok {
+			diff = append(diff, s)
+		}
+	}
+	return diff
 }
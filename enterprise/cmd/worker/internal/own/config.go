@@ -0,0 +1,44 @@
+package own
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type inferredOwnershipConfig struct {
+	env.BaseConfig
+
+	Interval         time.Duration
+	Window           time.Duration
+	MaxCommits       int
+	MaxOwnersPerFile int
+}
+
+var inferredOwnershipConfigInst = &inferredOwnershipConfig{}
+
+func (c *inferredOwnershipConfig) Load() {
+	c.Interval = c.GetInterval("OWN_INFERRED_OWNERSHIP_INTERVAL", "24h", "How frequently to infer the ownership of files from recent activity")
+	c.Window = c.GetInterval("OWN_INFERRED_OWNERSHIP_WINDOW", "2160h", "How far back commits and changeset reviews are considered when inferring ownership")
+	c.MaxCommits = c.GetInt("OWN_INFERRED_OWNERSHIP_MAX_COMMITS", "1000", "The maximum number of recent commits per repository considered when inferring ownership")
+	c.MaxOwnersPerFile = c.GetInt("OWN_INFERRED_OWNERSHIP_MAX_OWNERS_PER_FILE", "5", "The maximum number of inferred owners stored per file and signal")
+}
+
+func (c *inferredOwnershipConfig) Validate() error {
+	var errs error
+	errs = errors.Append(errs, c.BaseConfig.Validate())
+	if c.Interval <= 0 {
+		errs = errors.Append(errs, errors.New("OWN_INFERRED_OWNERSHIP_INTERVAL must be greater than 0"))
+	}
+	if c.Window <= 0 {
+		errs = errors.Append(errs, errors.New("OWN_INFERRED_OWNERSHIP_WINDOW must be greater than 0"))
+	}
+	if c.MaxCommits < 1 {
+		errs = errors.Append(errs, errors.New("OWN_INFERRED_OWNERSHIP_MAX_COMMITS must be greater than 0"))
+	}
+	if c.MaxOwnersPerFile < 1 {
+		errs = errors.Append(errs, errors.New("OWN_INFERRED_OWNERSHIP_MAX_OWNERS_PER_FILE must be greater than 0"))
+	}
+	return errs
+}
@@ -0,0 +1,60 @@
+package own
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/batches"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/background"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type inferredOwnershipJob struct{}
+
+func NewInferredOwnershipJob() job.Job {
+	return &inferredOwnershipJob{}
+}
+
+func (j *inferredOwnershipJob) Description() string {
+	return "Infers the ownership of files from recent commit authors and changeset reviewers."
+}
+
+func (j *inferredOwnershipJob) Config() []env.Config {
+	return []env.Config{inferredOwnershipConfigInst}
+}
+
+func (j *inferredOwnershipJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	workCtx := actor.WithInternalActor(context.Background())
+
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	bstore, err := batches.InitStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return []goroutine.BackgroundRoutine{
+		background.NewInferredOwnershipIndexer(
+			workCtx,
+			observationCtx.Logger.Scoped("inferred-ownership-indexer", "infers ownership of files from recent activity"),
+			edb.NewEnterpriseDB(db),
+			gitserver.NewClient(),
+			bstore,
+			inferredOwnershipConfigInst.Interval,
+			background.Options{
+				Window:           inferredOwnershipConfigInst.Window,
+				MaxCommits:       inferredOwnershipConfigInst.MaxCommits,
+				MaxOwnersPerFile: inferredOwnershipConfigInst.MaxOwnersPerFile,
+			},
+		),
+	}, nil
+}
@@ -17,6 +17,7 @@ import (
 	repoembeddings "github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/embeddings/repo"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/executors"
 	workerinsights "github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/insights"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/own"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/permissions"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/telemetry"
 	eiauthz "github.com/sourcegraph/sourcegraph/enterprise/internal/authz"
@@ -36,6 +37,7 @@ var additionalJobs = map[string]job.Job{
 	"insights-data-retention-job":   workerinsights.NewInsightsDataRetentionJob(),
 	"batches-janitor":               batches.NewJanitorJob(),
 	"batches-scheduler":             batches.NewSchedulerJob(),
+	"batches-rollout":               batches.NewRolloutJob(),
 	"batches-reconciler":            batches.NewReconcilerJob(),
 	"batches-bulk-processor":        batches.NewBulkOperationProcessorJob(),
 	"batches-workspace-resolver":    batches.NewWorkspaceResolverJob(),
@@ -45,6 +47,8 @@ var additionalJobs = map[string]job.Job{
 	"bitbucket-project-permissions": permissions.NewBitbucketProjectPermissionsJob(),
 	"export-usage-telemetry":        telemetry.NewTelemetryJob(),
 
+	"own-inferred-ownership-indexer": own.NewInferredOwnershipJob(),
+
 	"codeintel-policies-repository-matcher":       codeintel.NewPoliciesRepositoryMatcherJob(),
 	"codeintel-autoindexing-summary-builder":      codeintel.NewAutoindexingSummaryBuilder(),
 	"codeintel-autoindexing-dependency-scheduler": codeintel.NewAutoindexingDependencySchedulerJob(),
@@ -60,6 +64,7 @@ var additionalJobs = map[string]job.Job{
 	"codeintel-crates-syncer":                     codeintel.NewCratesSyncerJob(),
 	"codeintel-sentinel-cve-scanner":              codeintel.NewSentinelCVEScannerJob(),
 	"codeintel-package-filter-applicator":         codeintel.NewPackagesFilterApplicatorJob(),
+	"codeintel-repo-dependencies-inventory":       codeintel.NewRepoDependenciesInventoryJob(),
 
 	"auth-sourcegraph-operator-cleaner":  auth.NewSourcegraphOperatorCleaner(),
 	"auth-permission-sync-job-cleaner":   auth.NewPermissionSyncJobCleaner(),
@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -39,6 +40,7 @@ func executePlan(ctx context.Context, logger log.Logger, client gitserver.Client
 		tx:                tx,
 		ch:                plan.Changeset,
 		spec:              plan.ChangesetSpec,
+		stackParent:       plan.StackParent,
 	}
 
 	return e.Run(ctx, plan)
@@ -53,6 +55,9 @@ type executor struct {
 	ch                *btypes.Changeset
 	spec              *btypes.ChangesetSpec
 
+	// stackParent is the changeset that spec is stacked on, if any.
+	stackParent *btypes.Changeset
+
 	// targetRepo represents the repo where the changeset should be opened.
 	targetRepo *types.Repo
 
@@ -190,21 +195,45 @@ func (e *executor) pushChangesetPatch(ctx context.Context) (err error) {
 		return err
 	}
 	opts := buildCommitOpts(e.targetRepo, e.spec, pushConf)
+	if err := e.setStackCommits(ctx, &opts); err != nil {
+		return err
+	}
+	if ccss, ok := css.(sources.CommitChangesetSource); ok {
+		ccss.DecorateCommitOpts(&opts, &sources.Changeset{
+			Title:      e.spec.Title,
+			HeadRef:    e.spec.HeadRef,
+			BaseRef:    e.baseRef(),
+			RemoteRepo: remoteRepo,
+			TargetRepo: e.targetRepo,
+			Changeset:  e.ch,
+		})
+	}
 
-	err = e.pushCommit(ctx, opts)
-	var pce pushCommitError
-	if errors.As(err, &pce) {
-		if acss, ok := css.(sources.ArchivableChangesetSource); ok {
-			if acss.IsArchivedPushError(pce.CombinedOutput) {
-				if err := e.handleArchivedRepo(ctx); err != nil {
-					return errors.Wrap(err, "handling archived repo")
+	resp, err := e.pushCommit(ctx, opts)
+	if err != nil {
+		var pce pushCommitError
+		if errors.As(err, &pce) {
+			if acss, ok := css.(sources.ArchivableChangesetSource); ok {
+				if acss.IsArchivedPushError(pce.CombinedOutput) {
+					if err := e.handleArchivedRepo(ctx); err != nil {
+						return errors.Wrap(err, "handling archived repo")
+					}
+					return errCannotPushToArchivedRepo
 				}
-				return errCannotPushToArchivedRepo
 			}
 		}
+		return err
 	}
 
-	return err
+	// Perforce has no branches to open a changeset from: the pushed commit is
+	// shelved as a changelist instead, which is what identifies the changeset
+	// on the code host from now on.
+	if resp.ChangelistID != "" {
+		e.ch.ExternalID = resp.ChangelistID
+		e.ch.ExternalBranch = gitdomain.EnsureRefPrefix(e.spec.HeadRef)
+	}
+
+	return nil
 }
 
 // publishChangeset creates the given changeset on its code host.
@@ -235,7 +264,7 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (err erro
 	cs := &sources.Changeset{
 		Title:      e.spec.Title,
 		Body:       body,
-		BaseRef:    e.spec.BaseRef,
+		BaseRef:    e.baseRef(),
 		HeadRef:    e.spec.HeadRef,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
@@ -378,7 +407,7 @@ func (e *executor) updateChangeset(ctx context.Context) (err error) {
 	cs := sources.Changeset{
 		Title:      e.spec.Title,
 		Body:       body,
-		BaseRef:    e.spec.BaseRef,
+		BaseRef:    e.baseRef(),
 		HeadRef:    e.spec.HeadRef,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
@@ -416,7 +445,7 @@ func (e *executor) reopenChangeset(ctx context.Context) (err error) {
 	cs := sources.Changeset{
 		Title:      e.spec.Title,
 		Body:       e.spec.Body,
-		BaseRef:    e.spec.BaseRef,
+		BaseRef:    e.baseRef(),
 		HeadRef:    e.spec.HeadRef,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
@@ -510,7 +539,7 @@ func (e *executor) undraftChangeset(ctx context.Context) (err error) {
 	cs := &sources.Changeset{
 		Title:      e.spec.Title,
 		Body:       e.spec.Body,
-		BaseRef:    e.spec.BaseRef,
+		BaseRef:    e.baseRef(),
 		HeadRef:    e.spec.HeadRef,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
@@ -595,22 +624,22 @@ func (e pushCommitError) Error() string {
 		e.RepositoryName, e.InternalError, e.Command, strings.TrimSpace(e.CombinedOutput))
 }
 
-func (e *executor) pushCommit(ctx context.Context, opts protocol.CreateCommitFromPatchRequest) error {
-	_, err := e.client.CreateCommitFromPatch(ctx, opts)
+func (e *executor) pushCommit(ctx context.Context, opts protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	resp, err := e.client.CreateCommitFromPatch(ctx, opts)
 	if err != nil {
 		var e *protocol.CreateCommitFromPatchError
 		if errors.As(err, &e) {
 			// Make "patch does not apply" errors a fatal error. Retrying the changeset
 			// rollout won't help here and just causes noise.
 			if strings.Contains(e.CombinedOutput, "patch does not apply") {
-				return errcode.MakeNonRetryable(pushCommitError{e})
+				return nil, errcode.MakeNonRetryable(pushCommitError{e})
 			}
-			return pushCommitError{e}
+			return nil, pushCommitError{e}
 		}
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp, nil
 }
 
 // handleArchivedRepo updates the changeset and repo once it has been
@@ -597,12 +597,12 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 
 			var response string
 			var createCommitFromPatchCalled bool
-			state.MockClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (string, error) {
+			state.MockClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (*gitprotocol.CreateCommitFromPatchResponse, error) {
 				createCommitFromPatchCalled = true
 				if changesetSpec != nil {
 					response = changesetSpec.HeadRef
 				}
-				return response, tc.gitClientErr
+				return &gitprotocol.CreateCommitFromPatchResponse{Rev: response}, tc.gitClientErr
 			})
 
 			// Setup the sourcer that's used to create a Source with which
@@ -1048,9 +1048,9 @@ func TestExecutor_UserCredentialsForGitserver(t *testing.T) {
 
 			gitserverClient := gitserver.NewMockClient()
 			createCommitFromPatchReq := &gitprotocol.CreateCommitFromPatchRequest{}
-			gitserverClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (string, error) {
+			gitserverClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (*gitprotocol.CreateCommitFromPatchResponse, error) {
 				createCommitFromPatchReq = &req
-				return "", nil
+				return &gitprotocol.CreateCommitFromPatchResponse{}, nil
 			})
 
 			err := executePlan(
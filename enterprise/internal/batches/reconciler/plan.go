@@ -98,6 +98,9 @@ type Plan struct {
 	// The Delta between a possible previous ChangesetSpec and the current
 	// ChangesetSpec.
 	Delta *ChangesetSpecDelta
+
+	// The changeset that the ChangesetSpec is stacked on, if any.
+	StackParent *btypes.Changeset
 }
 
 func (p *Plan) AddOp(op btypes.ReconcilerOperation) { p.Ops = append(p.Ops, op) }
@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // Reconciler processes changesets and reconciles their current state — in
@@ -83,6 +84,12 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 		return err
 	}
 
+	plan.StackParent, err = loadStackParent(ctx, tx, ch, curr)
+	if err != nil {
+		return errors.Wrap(err, "loading stack parent")
+	}
+	determineStackPlan(plan, plan.StackParent)
+
 	logger.Info("Reconciler processing changeset", log.Int64("changeset", ch.ID), log.String("operations", fmt.Sprintf("%+v", plan.Ops)))
 
 	return executePlan(
@@ -128,11 +128,11 @@ func TestReconcilerProcess_IntegrationTest(t *testing.T) {
 			}
 			changeset := bt.CreateChangeset(t, ctx, store, changesetOpts)
 
-			state.MockClient.CreateCommitFromPatchFunc.SetDefaultHook(func(context.Context, gitprotocol.CreateCommitFromPatchRequest) (string, error) {
+			state.MockClient.CreateCommitFromPatchFunc.SetDefaultHook(func(context.Context, gitprotocol.CreateCommitFromPatchRequest) (*gitprotocol.CreateCommitFromPatchResponse, error) {
 				if changesetSpec != nil {
-					return changesetSpec.HeadRef, nil
+					return &gitprotocol.CreateCommitFromPatchResponse{Rev: changesetSpec.HeadRef}, nil
 				}
-				return "", nil
+				return &gitprotocol.CreateCommitFromPatchResponse{}, nil
 			})
 
 			// Setup the sourcer that's used to create a Source with which
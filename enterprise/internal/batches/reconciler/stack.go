@@ -0,0 +1,127 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// errStackParentNotPublished is returned when a stacked changeset is pushed
+// before the changeset it's stacked on. It is retryable, since the parent is
+// usually published by the reconciler shortly after.
+var errStackParentNotPublished = errors.New("the changeset this changeset is stacked on has not been published yet")
+
+type getChangesetter interface {
+	GetChangeset(ctx context.Context, opts store.GetChangesetOpts) (*btypes.Changeset, error)
+}
+
+// loadStackParent loads the changeset that the given changeset spec is stacked
+// on. Only changesets created by the same batch change are considered, so that
+// imported changesets and changesets of other batch changes that happen to use
+// the same branch name never become the parent. It returns nil if the spec
+// isn't stacked, or if the parent hasn't been published yet.
+func loadStackParent(ctx context.Context, tx getChangesetter, ch *btypes.Changeset, spec *btypes.ChangesetSpec) (*btypes.Changeset, error) {
+	if spec == nil || spec.StackParentRef == "" || ch.OwnedByBatchChangeID == 0 {
+		return nil, nil
+	}
+
+	parent, err := tx.GetChangeset(ctx, store.GetChangesetOpts{
+		RepoID:               ch.RepoID,
+		ExternalServiceType:  ch.ExternalServiceType,
+		ExternalBranch:       spec.StackParentRef,
+		OwnedByBatchChangeID: ch.OwnedByBatchChangeID,
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parent, nil
+}
+
+// stackParentOpen returns whether the given stack parent is still open, in
+// which case changesets stacked on it are based on its head.
+func stackParentOpen(parent *btypes.Changeset) bool {
+	return parent != nil && parent.Published() && !parent.Complete()
+}
+
+// determineStackPlan adds the operations that rebase and retarget a published
+// stacked changeset onto its base branch, once the changeset it's stacked on
+// has been merged or closed.
+func determineStackPlan(pl *Plan, parent *btypes.Changeset) {
+	ch, spec := pl.Changeset, pl.ChangesetSpec
+	if spec == nil || spec.StackParentRef == "" || parent == nil || stackParentOpen(parent) {
+		return
+	}
+	if !ch.Published() || ch.Complete() || ch.Closing {
+		return
+	}
+	if !btypes.ExternalServiceSupports(ch.ExternalServiceType, btypes.CodehostCapabilityBranchStacking) {
+		return
+	}
+
+	// Once retargeted, the changeset no longer targets the head branch of its
+	// parent and there is nothing left to do.
+	baseRef, err := ch.BaseRef()
+	if err != nil || gitdomain.EnsureRefPrefix(baseRef) != spec.StackParentRef {
+		return
+	}
+
+	pl.AddOp(btypes.ReconcilerOperationPush)
+	pl.AddOp(btypes.ReconcilerOperationUpdate)
+}
+
+// baseRef returns the ref that the changeset should target on the code host:
+// the head branch of the changeset it's stacked on while that is still open,
+// and the base branch of the changeset spec otherwise.
+func (e *executor) baseRef() string {
+	if e.spec.StackParentRef != "" && stackParentOpen(e.stackParent) &&
+		btypes.ExternalServiceSupports(e.ch.ExternalServiceType, btypes.CodehostCapabilityBranchStacking) {
+		return e.spec.StackParentRef
+	}
+	return e.spec.BaseRef
+}
+
+// setStackCommits sets the commits that the commit for a stacked changeset is
+// created on. The diff of a stacked changeset contains the changes of all the
+// changesets below it, so its commit is created on top of the head of its
+// parent. Once the parent has been merged, the commit is moved onto the
+// current head of the base branch.
+func (e *executor) setStackCommits(ctx context.Context, opts *protocol.CreateCommitFromPatchRequest) error {
+	if e.spec.StackParentRef == "" {
+		return nil
+	}
+
+	parent := e.stackParent
+	if parent == nil || !parent.Published() {
+		return errStackParentNotPublished
+	}
+
+	// A parent that has been closed without merging doesn't contribute
+	// anything to the base branch, so the whole diff is applied to it.
+	if parent.Complete() && parent.ExternalState != btypes.ChangesetExternalStateMerged {
+		return nil
+	}
+
+	if parent.SyncState.HeadRefOid == "" {
+		return errors.New("the changeset this changeset is stacked on has not been synced yet")
+	}
+	opts.StackParentCommit = api.CommitID(parent.SyncState.HeadRefOid)
+
+	if parent.ExternalState == btypes.ChangesetExternalStateMerged {
+		onto, err := e.client.ResolveRevision(ctx, e.targetRepo.Name, e.spec.BaseRef, gitserver.ResolveRevisionOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "resolving base ref %q", e.spec.BaseRef)
+		}
+		opts.StackOntoCommit = onto
+	}
+
+	return nil
+}
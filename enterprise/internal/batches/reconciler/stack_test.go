@@ -0,0 +1,226 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+)
+
+func TestDetermineStackPlan(t *testing.T) {
+	t.Parallel()
+
+	spec := &btypes.ChangesetSpec{
+		BaseRef:        "refs/heads/main",
+		HeadRef:        "refs/heads/migration-2",
+		StackParentRef: "refs/heads/migration-1",
+	}
+
+	newChangeset := func(baseRefName string, state btypes.ChangesetExternalState) *btypes.Changeset {
+		return &btypes.Changeset{
+			ExternalServiceType: extsvc.TypeGitHub,
+			PublicationState:    btypes.ChangesetPublicationStatePublished,
+			ExternalState:       state,
+			Metadata:            &github.PullRequest{BaseRefName: baseRefName},
+		}
+	}
+	newParent := func(state btypes.ChangesetExternalState) *btypes.Changeset {
+		return newChangeset("main", state)
+	}
+
+	tcs := []struct {
+		name      string
+		spec      *btypes.ChangesetSpec
+		changeset *btypes.Changeset
+		parent    *btypes.Changeset
+		wantOps   Operations
+	}{
+		{
+			name:      "parent merged",
+			spec:      spec,
+			changeset: newChangeset("migration-1", btypes.ChangesetExternalStateOpen),
+			parent:    newParent(btypes.ChangesetExternalStateMerged),
+			wantOps:   Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:      "parent closed",
+			spec:      spec,
+			changeset: newChangeset("migration-1", btypes.ChangesetExternalStateDraft),
+			parent:    newParent(btypes.ChangesetExternalStateClosed),
+			wantOps:   Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:      "parent open",
+			spec:      spec,
+			changeset: newChangeset("migration-1", btypes.ChangesetExternalStateOpen),
+			parent:    newParent(btypes.ChangesetExternalStateOpen),
+		},
+		{
+			name:      "already retargeted",
+			spec:      spec,
+			changeset: newChangeset("main", btypes.ChangesetExternalStateOpen),
+			parent:    newParent(btypes.ChangesetExternalStateMerged),
+		},
+		{
+			name:      "changeset merged",
+			spec:      spec,
+			changeset: newChangeset("migration-1", btypes.ChangesetExternalStateMerged),
+			parent:    newParent(btypes.ChangesetExternalStateMerged),
+		},
+		{
+			name:      "not stacked",
+			spec:      &btypes.ChangesetSpec{BaseRef: "refs/heads/main", HeadRef: "refs/heads/migration-2"},
+			changeset: newChangeset("migration-1", btypes.ChangesetExternalStateOpen),
+			parent:    newParent(btypes.ChangesetExternalStateMerged),
+		},
+		{
+			name:      "parent not published",
+			spec:      spec,
+			changeset: newChangeset("migration-1", btypes.ChangesetExternalStateOpen),
+		},
+		{
+			name: "code host without branch stacking",
+			spec: spec,
+			changeset: &btypes.Changeset{
+				ExternalServiceType: extsvc.TypeGerrit,
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+			},
+			parent: newParent(btypes.ChangesetExternalStateMerged),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := &Plan{Changeset: tc.changeset, ChangesetSpec: tc.spec}
+			determineStackPlan(pl, tc.parent)
+
+			if diff := cmp.Diff(tc.wantOps, pl.Ops); diff != "" {
+				t.Fatalf("wrong operations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExecutorBaseRef(t *testing.T) {
+	t.Parallel()
+
+	spec := &btypes.ChangesetSpec{
+		BaseRef:        "refs/heads/main",
+		StackParentRef: "refs/heads/migration-1",
+	}
+	ch := &btypes.Changeset{ExternalServiceType: extsvc.TypeGitHub}
+
+	for name, tc := range map[string]struct {
+		ch     *btypes.Changeset
+		parent *btypes.Changeset
+		want   string
+	}{
+		"parent open": {
+			ch:     ch,
+			parent: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateOpen},
+			want:   "refs/heads/migration-1",
+		},
+		"parent merged": {
+			ch:     ch,
+			parent: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateMerged},
+			want:   "refs/heads/main",
+		},
+		"parent not published": {
+			ch:   ch,
+			want: "refs/heads/main",
+		},
+		"code host without branch stacking": {
+			ch:     &btypes.Changeset{ExternalServiceType: extsvc.TypeGerrit},
+			parent: &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateOpen},
+			want:   "refs/heads/main",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := &executor{ch: tc.ch, spec: spec, stackParent: tc.parent}
+			if have := e.baseRef(); have != tc.want {
+				t.Fatalf("wrong base ref: want %q, have %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestLoadStackParent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	spec := &btypes.ChangesetSpec{
+		BaseRef:        "refs/heads/main",
+		HeadRef:        "refs/heads/migration-2",
+		StackParentRef: "refs/heads/migration-1",
+	}
+	newChangeset := func(id, batchChangeID int64, branch string) *btypes.Changeset {
+		return &btypes.Changeset{
+			ID:                   id,
+			RepoID:               1,
+			ExternalServiceType:  extsvc.TypeGitHub,
+			ExternalBranch:       branch,
+			OwnedByBatchChangeID: batchChangeID,
+		}
+	}
+
+	// Both batch changes have a changeset on the branch of the parent, and
+	// an imported changeset uses it too.
+	imported := newChangeset(1, 0, "refs/heads/migration-1")
+	otherParent := newChangeset(2, 2, "refs/heads/migration-1")
+	parent := newChangeset(3, 1, "refs/heads/migration-1")
+	tx := &fakeChangesetGetter{changesets: []*btypes.Changeset{imported, otherParent, parent}}
+
+	t.Run("same batch change", func(t *testing.T) {
+		have, err := loadStackParent(ctx, tx, newChangeset(4, 1, "refs/heads/migration-2"), spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != parent {
+			t.Fatalf("wrong parent: want %d, have %+v", parent.ID, have)
+		}
+	})
+
+	t.Run("no parent in batch change", func(t *testing.T) {
+		have, err := loadStackParent(ctx, tx, newChangeset(4, 3, "refs/heads/migration-2"), spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != nil {
+			t.Fatalf("unexpected parent %d", have.ID)
+		}
+	})
+
+	t.Run("imported changeset", func(t *testing.T) {
+		have, err := loadStackParent(ctx, tx, newChangeset(4, 0, "refs/heads/migration-2"), spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != nil {
+			t.Fatalf("unexpected parent %d", have.ID)
+		}
+	})
+}
+
+// fakeChangesetGetter returns the first of its changesets that matches the
+// options used by loadStackParent.
+type fakeChangesetGetter struct {
+	changesets []*btypes.Changeset
+}
+
+func (f *fakeChangesetGetter) GetChangeset(_ context.Context, opts store.GetChangesetOpts) (*btypes.Changeset, error) {
+	for _, c := range f.changesets {
+		if c.RepoID == opts.RepoID &&
+			c.ExternalServiceType == opts.ExternalServiceType &&
+			c.ExternalBranch == opts.ExternalBranch &&
+			(opts.OwnedByBatchChangeID == 0 || c.OwnedByBatchChangeID == opts.OwnedByBatchChangeID) {
+			return c, nil
+		}
+	}
+	return nil, store.ErrNoResults
+}
@@ -0,0 +1,276 @@
+package rollout
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/global"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const rolloutInterval = 1 * time.Minute
+
+// NewRolloutWorker returns a background routine that advances the rollouts of
+// all batch changes whose batch spec declares a rollout policy: it publishes
+// the changesets wave by wave, merges them once they pass the gates of the
+// policy and pauses the rollout when too many changesets of a wave fail.
+func NewRolloutWorker(ctx context.Context, logger log.Logger, bstore *store.Store) goroutine.BackgroundRoutine {
+	r := &roller{
+		logger:       logger,
+		store:        bstore,
+		resolveRepos: service.ResolveRepositoryIDsMatchingQuery,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		"batchchanges.rollout", "advances the rollouts of batch changes",
+		rolloutInterval,
+		goroutine.HandlerFunc(r.handle),
+	)
+}
+
+type roller struct {
+	logger       log.Logger
+	store        *store.Store
+	resolveRepos func(ctx context.Context, s *store.Store, query string) ([]api.RepoID, error)
+}
+
+func (r *roller) handle(ctx context.Context) error {
+	batchChanges, err := r.store.ListBatchChangesWithRolloutPolicy(ctx)
+	if err != nil {
+		return errors.Wrap(err, "listing batch changes with rollout policy")
+	}
+
+	var errs error
+	for _, bc := range batchChanges {
+		if err := r.advance(ctx, bc); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "advancing rollout of batch change %d", bc.ID))
+		}
+	}
+	return errs
+}
+
+// advance moves the rollout of the given batch change forward as far as the
+// current state of its changesets allows.
+func (r *roller) advance(ctx context.Context, bc *btypes.BatchChange) (err error) {
+	spec, err := r.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: bc.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec")
+	}
+	policy := spec.Spec.Rollout
+	if policy == nil {
+		return nil
+	}
+
+	tx, err := r.store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	rollout, err := tx.GetBatchChangeRollout(ctx, store.GetBatchChangeRolloutOpts{
+		BatchChangeID: bc.ID,
+		ForUpdate:     true,
+	})
+	if err == store.ErrNoResults {
+		rollout = &btypes.BatchChangeRollout{BatchChangeID: bc.ID}
+		if err := tx.CreateBatchChangeRollout(ctx, rollout); err != nil {
+			return errors.Wrap(err, "creating rollout")
+		}
+	} else if err != nil {
+		return errors.Wrap(err, "loading rollout")
+	}
+
+	if rollout.State == btypes.BatchChangeRolloutStatePaused {
+		return nil
+	}
+
+	cs, _, err := tx.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID:        bc.ID,
+		OwnedByBatchChangeID: bc.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing changesets")
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].ID < cs[j].ID })
+
+	rcs, err := tx.ListRolloutChangesets(ctx, rollout.ID)
+	if err != nil {
+		return errors.Wrap(err, "listing rollout changesets")
+	}
+
+	if rcs, err = r.assign(ctx, tx, bc, policy, rollout, cs, rcs); err != nil {
+		return err
+	}
+
+	changesets := make(map[int64]*btypes.Changeset, len(cs))
+	for _, ch := range cs {
+		changesets[ch.ID] = ch
+	}
+
+	for rollout.State == btypes.BatchChangeRolloutStateActive {
+		if err := r.publish(ctx, tx, rollout, changesets, rcs); err != nil {
+			return err
+		}
+
+		res := evaluateWave(policy, rollout.Wave, changesets, rcs)
+		if res.exceedsFailureThreshold(policy) && !rollout.FailuresAccepted {
+			rollout.State = btypes.BatchChangeRolloutStatePaused
+			rollout.PauseReason = res.pauseReason(rollout.Wave)
+			break
+		}
+
+		if err := r.merge(ctx, tx, bc, res.readyToMerge); err != nil {
+			return err
+		}
+
+		if !res.done() {
+			break
+		}
+
+		rollout.Wave++
+		rollout.FailuresAccepted = false
+		if rollout.Wave >= waveCount(policy) {
+			rollout.State = btypes.BatchChangeRolloutStateCompleted
+		}
+	}
+
+	// Changesets that are added to the batch change after the rollout has
+	// completed are published right away.
+	if rollout.State == btypes.BatchChangeRolloutStateCompleted {
+		if err := r.publish(ctx, tx, rollout, changesets, rcs); err != nil {
+			return err
+		}
+	}
+
+	return tx.UpdateBatchChangeRollout(ctx, rollout)
+}
+
+// assign assigns the changesets of the batch change that don't belong to a
+// wave yet to one, and returns all assignments of the rollout.
+func (r *roller) assign(ctx context.Context, tx *store.Store, bc *btypes.BatchChange, policy *batcheslib.RolloutPolicy, rollout *btypes.BatchChangeRollout, cs []*btypes.Changeset, rcs []*btypes.RolloutChangeset) ([]*btypes.RolloutChangeset, error) {
+	assigned := make(map[int64]int, len(rcs))
+	for _, rc := range rcs {
+		assigned[rc.ChangesetID] = rc.Wave
+	}
+
+	unassigned := 0
+	for _, ch := range cs {
+		if _, ok := assigned[ch.ID]; !ok {
+			unassigned++
+		}
+	}
+	if unassigned == 0 {
+		return rcs, nil
+	}
+
+	// Repository queries are resolved with the permissions of the user that
+	// last applied the batch change.
+	queryCtx := actor.WithActor(ctx, actor.FromUser(bc.LastApplierID))
+	matches := make(map[int]map[api.RepoID]struct{})
+	for i, wave := range policy.Waves {
+		if wave.RepositoriesMatchingQuery == "" {
+			continue
+		}
+		ids, err := r.resolveRepos(queryCtx, tx, wave.RepositoriesMatchingQuery)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving repositories of wave %d", i+1)
+		}
+		matches[i] = make(map[api.RepoID]struct{}, len(ids))
+		for _, id := range ids {
+			matches[i][id] = struct{}{}
+		}
+	}
+
+	added := assignWaves(policy, cs, assigned, matches)
+	newRcs := make([]*btypes.RolloutChangeset, 0, len(added))
+	for _, ch := range cs {
+		wave, ok := added[ch.ID]
+		if !ok {
+			continue
+		}
+		newRcs = append(newRcs, &btypes.RolloutChangeset{
+			RolloutID:   rollout.ID,
+			ChangesetID: ch.ID,
+			Wave:        wave,
+		})
+	}
+	if err := tx.CreateRolloutChangesets(ctx, newRcs...); err != nil {
+		return nil, errors.Wrap(err, "assigning changesets to waves")
+	}
+
+	return append(rcs, newRcs...), nil
+}
+
+// publish enqueues the changesets of the waves that have been rolled out so
+// far to be published, unless their publication state has been set in the UI.
+func (r *roller) publish(ctx context.Context, tx *store.Store, rollout *btypes.BatchChangeRollout, changesets map[int64]*btypes.Changeset, rcs []*btypes.RolloutChangeset) error {
+	for _, rc := range rcs {
+		if rollout.State != btypes.BatchChangeRolloutStateCompleted && rc.Wave > rollout.Wave {
+			continue
+		}
+		ch, ok := changesets[rc.ChangesetID]
+		if !ok || ch.Published() || ch.UiPublicationState != nil {
+			continue
+		}
+
+		ch.UiPublicationState = &btypes.ChangesetUiPublicationStatePublished
+
+		// Like the bulk processor, we only update the UiPublicationState and
+		// the reconciler-related columns to not overwrite any other data.
+		if err := tx.UpdateChangesetUiPublicationState(ctx, ch); err != nil {
+			return errors.Wrapf(err, "updating publication state of changeset %d", ch.ID)
+		}
+		if err := tx.EnqueueChangeset(ctx, ch, global.DefaultReconcilerEnqueueState(), ""); err != nil {
+			return errors.Wrapf(err, "enqueueing changeset %d", ch.ID)
+		}
+	}
+	return nil
+}
+
+// merge creates changeset jobs to merge the given changesets on behalf of the
+// user that last applied the batch change.
+func (r *roller) merge(ctx context.Context, tx *store.Store, bc *btypes.BatchChange, rcs []*btypes.RolloutChangeset) error {
+	if len(rcs) == 0 {
+		return nil
+	}
+
+	bulkGroupID, err := store.RandomID()
+	if err != nil {
+		return errors.Wrap(err, "creating bulkGroupID failed")
+	}
+
+	for _, rc := range rcs {
+		job := &btypes.ChangesetJob{
+			BulkGroup:     bulkGroupID,
+			ChangesetID:   rc.ChangesetID,
+			BatchChangeID: bc.ID,
+			UserID:        bc.LastApplierID,
+			State:         btypes.ChangesetJobStateQueued,
+			JobType:       btypes.ChangesetJobTypeMerge,
+			Payload:       &btypes.ChangesetJobMergePayload{},
+		}
+		if err := tx.CreateChangesetJob(ctx, job); err != nil {
+			return errors.Wrapf(err, "creating merge job for changeset %d", rc.ChangesetID)
+		}
+
+		rc.MergeJobID = job.ID
+		rc.MergeJobState = job.State
+		if err := tx.SetRolloutChangesetMergeJob(ctx, rc); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Debug("merging changesets", log.Int64("batchChangeID", bc.ID), log.Int("count", len(rcs)))
+	return nil
+}
@@ -0,0 +1,194 @@
+package rollout
+
+import (
+	"fmt"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+// waveCount returns the number of waves of the given policy, including the
+// implicit final wave that holds the changesets not matched by any wave.
+func waveCount(policy *batcheslib.RolloutPolicy) int {
+	return len(policy.Waves) + 1
+}
+
+// assignWaves assigns the given changesets, which must be sorted by ID, to the
+// waves of the policy. Changesets that already have a wave in assigned keep
+// it. matches holds the IDs of the repositories matching the query of each
+// wave that is defined by a repository query.
+//
+// Percentages are cumulative: a wave with a percentage of 25 makes sure that
+// at least 25% of all changesets are rolled out once it is done. Changesets
+// that aren't matched by any wave are assigned to the implicit final wave.
+//
+// It returns the waves of the changesets that didn't have a wave before.
+func assignWaves(policy *batcheslib.RolloutPolicy, changesets []*btypes.Changeset, assigned map[int64]int, matches map[int]map[api.RepoID]struct{}) map[int64]int {
+	waves := make(map[int64]int, len(changesets))
+	for _, ch := range changesets {
+		if wave, ok := assigned[ch.ID]; ok {
+			waves[ch.ID] = wave
+		}
+	}
+
+	for i, wave := range policy.Waves {
+		if wave.RepositoriesMatchingQuery != "" {
+			for _, ch := range changesets {
+				if _, ok := waves[ch.ID]; ok {
+					continue
+				}
+				if _, ok := matches[i][ch.RepoID]; ok {
+					waves[ch.ID] = i
+				}
+			}
+			continue
+		}
+
+		// Round up, so that small batch changes still roll out at least one
+		// changeset in every percentage wave.
+		target := (wave.Percentage*len(changesets) + 99) / 100
+		for _, ch := range changesets {
+			if len(waves) >= target {
+				break
+			}
+			if _, ok := waves[ch.ID]; !ok {
+				waves[ch.ID] = i
+			}
+		}
+	}
+
+	added := make(map[int64]int)
+	for _, ch := range changesets {
+		if _, ok := assigned[ch.ID]; ok {
+			continue
+		}
+		wave, ok := waves[ch.ID]
+		if !ok {
+			wave = len(policy.Waves)
+		}
+		added[ch.ID] = wave
+	}
+	return added
+}
+
+// outcome is the result of rolling out a single changeset.
+type outcome int
+
+const (
+	outcomePending outcome = iota
+	outcomeSucceeded
+	outcomeFailed
+	// outcomeReadyToMerge means that the changeset passes all gates of the
+	// policy and should be merged.
+	outcomeReadyToMerge
+)
+
+// changesetOutcome determines the outcome of rolling out the given changeset,
+// based on the state derived from its changeset events and the state of the
+// merge job the rollout created for it.
+func changesetOutcome(policy *batcheslib.RolloutPolicy, ch *btypes.Changeset, rc *btypes.RolloutChangeset) outcome {
+	if ch.ReconcilerState == btypes.ReconcilerStateFailed {
+		return outcomeFailed
+	}
+
+	if !ch.Published() {
+		return outcomePending
+	}
+
+	switch ch.ExternalState {
+	case btypes.ChangesetExternalStateMerged:
+		return outcomeSucceeded
+	case btypes.ChangesetExternalStateClosed, btypes.ChangesetExternalStateDeleted, btypes.ChangesetExternalStateReadOnly:
+		return outcomeFailed
+	}
+
+	if policy.RequirePassingChecks {
+		switch ch.ExternalCheckState {
+		case btypes.ChangesetCheckStateFailed:
+			return outcomeFailed
+		case btypes.ChangesetCheckStatePending:
+			return outcomePending
+		}
+	}
+
+	if policy.RequireApproval {
+		switch ch.ExternalReviewState {
+		case btypes.ChangesetReviewStateChangesRequested:
+			return outcomeFailed
+		case btypes.ChangesetReviewStateApproved:
+		default:
+			return outcomePending
+		}
+	}
+
+	if !policy.Merge {
+		return outcomeSucceeded
+	}
+
+	switch rc.MergeJobState {
+	case "":
+		if rc.MergeJobID == 0 {
+			return outcomeReadyToMerge
+		}
+		// The merge job has been deleted, so we wait for the changeset to be
+		// synced.
+		return outcomePending
+	case btypes.ChangesetJobStateFailed:
+		return outcomeFailed
+	default:
+		return outcomePending
+	}
+}
+
+// waveResult summarizes the outcomes of the changesets in a wave.
+type waveResult struct {
+	total        int
+	failed       int
+	pending      int
+	readyToMerge []*btypes.RolloutChangeset
+}
+
+// done returns true if no changeset of the wave is pending anymore.
+func (r waveResult) done() bool {
+	return r.pending == 0 && len(r.readyToMerge) == 0
+}
+
+// exceedsFailureThreshold returns true if the share of failed changesets in
+// the wave is higher than allowed by the policy.
+func (r waveResult) exceedsFailureThreshold(policy *batcheslib.RolloutPolicy) bool {
+	return r.failed > 0 && r.failed*100 > policy.MaxFailurePercentage*r.total
+}
+
+// pauseReason explains why the rollout of the given wave was paused.
+func (r waveResult) pauseReason(wave int) string {
+	return fmt.Sprintf("%d of %d changesets in wave %d failed", r.failed, r.total, wave+1)
+}
+
+// evaluateWave determines the outcomes of the changesets assigned to the given
+// wave.
+func evaluateWave(policy *batcheslib.RolloutPolicy, wave int, changesets map[int64]*btypes.Changeset, rcs []*btypes.RolloutChangeset) waveResult {
+	var res waveResult
+	for _, rc := range rcs {
+		if rc.Wave != wave {
+			continue
+		}
+		ch, ok := changesets[rc.ChangesetID]
+		if !ok {
+			// The changeset has been archived or detached since and is no
+			// longer part of the rollout.
+			continue
+		}
+
+		res.total++
+		switch changesetOutcome(policy, ch, rc) {
+		case outcomeFailed:
+			res.failed++
+		case outcomePending:
+			res.pending++
+		case outcomeReadyToMerge:
+			res.readyToMerge = append(res.readyToMerge, rc)
+		}
+	}
+	return res
+}
@@ -0,0 +1,204 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestAssignWaves(t *testing.T) {
+	t.Parallel()
+
+	changesets := make([]*btypes.Changeset, 0, 10)
+	for i := 1; i <= 10; i++ {
+		changesets = append(changesets, &btypes.Changeset{ID: int64(i), RepoID: api.RepoID(100 + i)})
+	}
+
+	tcs := map[string]struct {
+		policy   *batcheslib.RolloutPolicy
+		assigned map[int64]int
+		matches  map[int]map[api.RepoID]struct{}
+		want     map[int64]int
+	}{
+		"percentages": {
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{{Percentage: 10}, {Percentage: 50}}},
+			want:   map[int64]int{1: 0, 2: 1, 3: 1, 4: 1, 5: 1, 6: 2, 7: 2, 8: 2, 9: 2, 10: 2},
+		},
+		"percentages round up": {
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{{Percentage: 1}, {Percentage: 100}}},
+			want:   map[int64]int{1: 0, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1, 7: 1, 8: 1, 9: 1, 10: 1},
+		},
+		"repository query before percentage": {
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{
+				{RepositoriesMatchingQuery: "repo:internal"},
+				{Percentage: 30},
+			}},
+			matches: map[int]map[api.RepoID]struct{}{0: {105: {}, 110: {}}},
+			want:    map[int64]int{5: 0, 10: 0, 1: 1, 2: 2, 3: 2, 4: 2, 6: 2, 7: 2, 8: 2, 9: 2},
+		},
+		"keeps existing assignments": {
+			policy:   &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{{Percentage: 20}}},
+			assigned: map[int64]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1, 7: 1, 8: 1},
+			want:     map[int64]int{9: 1, 10: 1},
+		},
+		"all assigned": {
+			policy:   &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{{Percentage: 20}}},
+			assigned: map[int64]int{1: 0, 2: 0, 3: 1, 4: 1, 5: 1, 6: 1, 7: 1, 8: 1, 9: 1, 10: 1},
+			want:     map[int64]int{},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			have := assignWaves(tc.policy, changesets, tc.assigned, tc.matches)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf("wrong waves (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangesetOutcome(t *testing.T) {
+	t.Parallel()
+
+	published := func(external btypes.ChangesetExternalState, check btypes.ChangesetCheckState, review btypes.ChangesetReviewState) *btypes.Changeset {
+		return &btypes.Changeset{
+			PublicationState:    btypes.ChangesetPublicationStatePublished,
+			ReconcilerState:     btypes.ReconcilerStateCompleted,
+			ExternalState:       external,
+			ExternalCheckState:  check,
+			ExternalReviewState: review,
+		}
+	}
+	gated := &batcheslib.RolloutPolicy{RequirePassingChecks: true, RequireApproval: true, Merge: true}
+
+	tcs := map[string]struct {
+		policy *batcheslib.RolloutPolicy
+		ch     *btypes.Changeset
+		rc     *btypes.RolloutChangeset
+		want   outcome
+	}{
+		"unpublished": {
+			policy: gated,
+			ch:     &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStateUnpublished, ReconcilerState: btypes.ReconcilerStateQueued},
+			want:   outcomePending,
+		},
+		"reconciler failed": {
+			policy: gated,
+			ch:     &btypes.Changeset{PublicationState: btypes.ChangesetPublicationStateUnpublished, ReconcilerState: btypes.ReconcilerStateFailed},
+			want:   outcomeFailed,
+		},
+		"merged": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateMerged, btypes.ChangesetCheckStateFailed, btypes.ChangesetReviewStatePending),
+			want:   outcomeSucceeded,
+		},
+		"closed": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateClosed, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved),
+			want:   outcomeFailed,
+		},
+		"checks failed": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStateFailed, btypes.ChangesetReviewStateApproved),
+			want:   outcomeFailed,
+		},
+		"checks failed but not required": {
+			policy: &batcheslib.RolloutPolicy{},
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStateFailed, btypes.ChangesetReviewStatePending),
+			want:   outcomeSucceeded,
+		},
+		"checks pending": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStatePending, btypes.ChangesetReviewStateApproved),
+			want:   outcomePending,
+		},
+		"changes requested": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateChangesRequested),
+			want:   outcomeFailed,
+		},
+		"review pending": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStatePending),
+			want:   outcomePending,
+		},
+		"ready to merge": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved),
+			want:   outcomeReadyToMerge,
+		},
+		"no checks reported": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStateUnknown, btypes.ChangesetReviewStateApproved),
+			want:   outcomeReadyToMerge,
+		},
+		"merging": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved),
+			rc:     &btypes.RolloutChangeset{MergeJobID: 1, MergeJobState: btypes.ChangesetJobStateProcessing},
+			want:   outcomePending,
+		},
+		"merge failed": {
+			policy: gated,
+			ch:     published(btypes.ChangesetExternalStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved),
+			rc:     &btypes.RolloutChangeset{MergeJobID: 1, MergeJobState: btypes.ChangesetJobStateFailed},
+			want:   outcomeFailed,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			rc := tc.rc
+			if rc == nil {
+				rc = &btypes.RolloutChangeset{}
+			}
+			if have := changesetOutcome(tc.policy, tc.ch, rc); have != tc.want {
+				t.Fatalf("wrong outcome: want %d, have %d", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestEvaluateWave(t *testing.T) {
+	t.Parallel()
+
+	policy := &batcheslib.RolloutPolicy{MaxFailurePercentage: 25}
+	changesets := map[int64]*btypes.Changeset{
+		1: {ID: 1, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateOpen},
+		2: {ID: 2, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateClosed},
+		3: {ID: 3, PublicationState: btypes.ChangesetPublicationStateUnpublished},
+		4: {ID: 4, PublicationState: btypes.ChangesetPublicationStatePublished, ExternalState: btypes.ChangesetExternalStateMerged},
+	}
+	rcs := []*btypes.RolloutChangeset{
+		{ChangesetID: 1, Wave: 0},
+		{ChangesetID: 2, Wave: 0},
+		{ChangesetID: 3, Wave: 0},
+		{ChangesetID: 4, Wave: 0},
+		{ChangesetID: 5, Wave: 0},
+		{ChangesetID: 6, Wave: 1},
+	}
+
+	res := evaluateWave(policy, 0, changesets, rcs)
+	if res.total != 4 || res.failed != 1 || res.pending != 1 {
+		t.Fatalf("wrong result: %+v", res)
+	}
+	if res.done() {
+		t.Fatal("wave should not be done")
+	}
+	if res.exceedsFailureThreshold(policy) {
+		t.Fatal("failure threshold should not be exceeded")
+	}
+
+	policy.MaxFailurePercentage = 20
+	if !res.exceedsFailureThreshold(policy) {
+		t.Fatal("failure threshold should be exceeded")
+	}
+	if have, want := res.pauseReason(0), "1 of 4 changesets in wave 1 failed"; have != want {
+		t.Fatalf("wrong pause reason: want %q, have %q", want, have)
+	}
+}
@@ -93,6 +93,8 @@ type operations struct {
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
+	pauseBatchChangeRollout              *observation.Operation
+	resumeBatchChangeRollout             *observation.Operation
 }
 
 var (
@@ -143,6 +145,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
+			pauseBatchChangeRollout:              op("PauseBatchChangeRollout"),
+			resumeBatchChangeRollout:             op("ResumeBatchChangeRollout"),
 		}
 	})
 
@@ -1018,6 +1022,78 @@ func (s *Service) CloseBatchChange(ctx context.Context, id int64, closeChangeset
 	return batchChange, nil
 }
 
+// ErrNoRollout is returned by PauseBatchChangeRollout and
+// ResumeBatchChangeRollout if the batch change isn't being rolled out.
+var ErrNoRollout = errors.New("batch change has no rollout")
+
+// PauseBatchChangeRollout pauses the rollout of the batch change with the
+// given ID, so that no further waves are published.
+func (s *Service) PauseBatchChangeRollout(ctx context.Context, id int64) (rollout *btypes.BatchChangeRollout, err error) {
+	ctx, _, endObservation := s.operations.pauseBatchChangeRollout.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return s.updateBatchChangeRollout(ctx, id, func(rollout *btypes.BatchChangeRollout) {
+		if rollout.State != btypes.BatchChangeRolloutStateActive {
+			return
+		}
+		rollout.State = btypes.BatchChangeRolloutStatePaused
+		rollout.PauseReason = ""
+	})
+}
+
+// ResumeBatchChangeRollout resumes the paused rollout of the batch change with
+// the given ID. The failures of the current wave are accepted, so they don't
+// pause the rollout again.
+func (s *Service) ResumeBatchChangeRollout(ctx context.Context, id int64) (rollout *btypes.BatchChangeRollout, err error) {
+	ctx, _, endObservation := s.operations.resumeBatchChangeRollout.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return s.updateBatchChangeRollout(ctx, id, func(rollout *btypes.BatchChangeRollout) {
+		if rollout.State != btypes.BatchChangeRolloutStatePaused {
+			return
+		}
+		rollout.State = btypes.BatchChangeRolloutStateActive
+		rollout.PauseReason = ""
+		rollout.FailuresAccepted = true
+	})
+}
+
+func (s *Service) updateBatchChangeRollout(ctx context.Context, id int64, update func(*btypes.BatchChangeRollout)) (rollout *btypes.BatchChangeRollout, err error) {
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: id})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch change")
+	}
+
+	// 🚨 SECURITY: Only the author of the batch change can pause or resume its
+	// rollout.
+	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	rollout, err = tx.GetBatchChangeRollout(ctx, store.GetBatchChangeRolloutOpts{
+		BatchChangeID: batchChange.ID,
+		ForUpdate:     true,
+	})
+	if err == store.ErrNoResults {
+		return nil, ErrNoRollout
+	} else if err != nil {
+		return nil, errors.Wrap(err, "getting rollout")
+	}
+
+	update(rollout)
+	if err := tx.UpdateBatchChangeRollout(ctx, rollout); err != nil {
+		return nil, err
+	}
+
+	return rollout, nil
+}
+
 // DeleteBatchChange deletes the BatchChange with the given ID if it hasn't been
 // deleted yet.
 func (s *Service) DeleteBatchChange(ctx context.Context, id int64) (err error) {
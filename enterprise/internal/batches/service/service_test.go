@@ -317,6 +317,49 @@ func TestService(t *testing.T) {
 		})
 	})
 
+	t.Run("PauseAndResumeBatchChangeRollout", func(t *testing.T) {
+		spec := testBatchSpec(admin.ID)
+		if err := s.CreateBatchSpec(ctx, spec); err != nil {
+			t.Fatal(err)
+		}
+
+		batchChange := testBatchChange(admin.ID, spec)
+		if err := s.CreateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := svc.PauseBatchChangeRollout(adminCtx, batchChange.ID); err != ErrNoRollout {
+			t.Fatalf("wrong error: %v", err)
+		}
+
+		if err := s.CreateBatchChangeRollout(ctx, &btypes.BatchChangeRollout{BatchChangeID: batchChange.ID}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := svc.PauseBatchChangeRollout(userCtx, batchChange.ID); !errcode.IsUnauthorized(err) {
+			t.Fatalf("expected unauthorized error, got %+v", err)
+		}
+
+		rollout, err := svc.PauseBatchChangeRollout(adminCtx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rollout.State != btypes.BatchChangeRolloutStatePaused {
+			t.Fatalf("wrong state: %q", rollout.State)
+		}
+
+		rollout, err = svc.ResumeBatchChangeRollout(adminCtx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rollout.State != btypes.BatchChangeRolloutStateActive {
+			t.Fatalf("wrong state: %q", rollout.State)
+		}
+		if !rollout.FailuresAccepted {
+			t.Fatal("failures of the current wave should be accepted")
+		}
+	})
+
 	t.Run("EnqueueChangesetSync", func(t *testing.T) {
 		spec := testBatchSpec(user.ID)
 		if err := s.CreateBatchSpec(ctx, spec); err != nil {
@@ -400,6 +400,57 @@ func (wr *workspaceResolver) resolveRepositoriesMatchingQuery(ctx context.Contex
 	return revs, nil
 }
 
+// ResolveRepositoryIDsMatchingQuery returns the IDs of the repositories that
+// match the given search query and that the actor in ctx has access to.
+func ResolveRepositoryIDsMatchingQuery(ctx context.Context, s *store.Store, query string) (_ []api.RepoID, err error) {
+	tr, ctx := trace.New(ctx, "ResolveRepositoryIDsMatchingQuery", "")
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	wr := &workspaceResolver{
+		store:               s,
+		logger:              log.Scoped("batches.workspaceResolver", "The batch changes execution workspace resolver"),
+		frontendInternalURL: internalapi.Client.URL + "/.internal",
+	}
+
+	repoIDs := []api.RepoID{}
+	if err := wr.runSearch(ctx, setDefaultQueryCount(query), func(matches []streamhttp.EventMatch) {
+		for _, match := range matches {
+			switch m := match.(type) {
+			case *streamhttp.EventRepoMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			case *streamhttp.EventContentMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			case *streamhttp.EventPathMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			case *streamhttp.EventSymbolMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			}
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(repoIDs) == 0 {
+		return repoIDs, nil
+	}
+
+	// 🚨 SECURITY: We use database.Repos.List to check whether the user has access to
+	// the repositories or not.
+	accessibleRepos, err := s.Repos().List(ctx, database.ReposListOptions{IDs: repoIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]api.RepoID, 0, len(accessibleRepos))
+	for _, repo := range accessibleRepos {
+		ids = append(ids, repo.ID)
+	}
+	return ids, nil
+}
+
 const internalSearchClientUserAgent = "Batch Changes repository resolver"
 
 func (wr *workspaceResolver) runSearch(ctx context.Context, query string, onMatches func(matches []streamhttp.EventMatch)) (err error) {
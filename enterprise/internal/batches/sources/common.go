@@ -48,6 +48,17 @@ type DraftChangesetSource interface {
 	UndraftChangeset(context.Context, *Changeset) error
 }
 
+// A CommitChangesetSource needs the commit pushed for a changeset to differ
+// from what is pushed to other code hosts, e.g. because changesets are created
+// by pushing to a special ref or are identified by a commit message trailer.
+type CommitChangesetSource interface {
+	ChangesetSource
+
+	// DecorateCommitOpts adjusts the options used to create and push the commit
+	// for the given changeset before the commit is created.
+	DecorateCommitOpts(opts *protocol.CreateCommitFromPatchRequest, cs *Changeset)
+}
+
 type ForkableChangesetSource interface {
 	ChangesetSource
 
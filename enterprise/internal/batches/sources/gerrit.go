@@ -0,0 +1,289 @@
+package sources
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
+
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// GerritSource is a ChangesetSource for Gerrit. Gerrit has no pull requests:
+// a change is created by pushing a commit to the magic refs/for/<branch> ref,
+// and the Change-Id trailer of the commit message identifies the change across
+// patch sets.
+type GerritSource struct {
+	client *gerrit.Client
+}
+
+var (
+	_ DraftChangesetSource  = GerritSource{}
+	_ CommitChangesetSource = GerritSource{}
+)
+
+func NewGerritSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GerritSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GerritConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating external client")
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Gerrit URL")
+	}
+
+	client, err := gerrit.NewClient(svc.URN(), u, &gerrit.AccountCredentials{Username: c.Username, Password: c.Password}, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Gerrit client")
+	}
+
+	return &GerritSource{client: client}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s GerritSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.client.Authenticator())
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s GerritSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth, *auth.BasicAuthWithSSH:
+	default:
+		return nil, newUnsupportedAuthenticatorError("GerritSource", a)
+	}
+
+	return &GerritSource{client: s.client.WithAuthenticator(a)}, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s GerritSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.GetAuthenticatedUserAccount(ctx)
+	return err
+}
+
+// DecorateCommitOpts pushes the commit to refs/for/<base branch>, which makes
+// Gerrit create a change (or a new patch set of an existing change), and adds
+// the Change-Id trailer that ties the commit to its change. The head ref of
+// the changeset is used as the topic of the change.
+func (s GerritSource) DecorateCommitOpts(opts *protocol.CreateCommitFromPatchRequest, cs *Changeset) {
+	pushRef := "refs/for/" + gitdomain.AbbreviateRef(cs.BaseRef)
+	if topic := gitdomain.AbbreviateRef(cs.HeadRef); topic != "" {
+		pushRef += "%topic=" + url.QueryEscape(topic)
+	}
+	opts.PushRef = pushRef
+
+	opts.CommitInfo.Message = withGerritChangeID(opts.CommitInfo.Message, s.changeID(cs))
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s GerritSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting change")
+	}
+
+	return errors.Wrap(s.setChangesetMetadata(change, cs), "setting Gerrit changeset metadata")
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+//
+// The change itself is created when the commit is pushed, so this only loads
+// it from Gerrit.
+func (s GerritSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	change, err := s.client.GetChange(ctx, s.changeID(cs))
+	if err != nil {
+		return false, errors.Wrap(err, "getting change")
+	}
+
+	if err := s.setChangesetMetadata(change, cs); err != nil {
+		return false, errors.Wrap(err, "setting Gerrit changeset metadata")
+	}
+
+	return false, nil
+}
+
+// CreateDraftChangeset creates the given changeset on the code host in draft
+// mode, which in Gerrit means the change is marked as work in progress.
+func (s GerritSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	changeID := s.changeID(cs)
+	if err := s.client.SetWorkInProgress(ctx, changeID); err != nil {
+		return false, errors.Wrap(err, "marking change as work in progress")
+	}
+
+	return s.CreateChangeset(ctx, cs)
+}
+
+// UndraftChangeset will update the Changeset on the source to be not in draft mode anymore.
+func (s GerritSource) UndraftChangeset(ctx context.Context, cs *Changeset) error {
+	if err := s.client.SetReadyForReview(ctx, cs.ExternalID); err != nil {
+		return errors.Wrap(err, "marking change as ready for review")
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost (e.g. "abandoned" on
+// Gerrit).
+func (s GerritSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.AbandonChange(ctx, cs.ExternalID)
+	if err != nil {
+		return errors.Wrap(err, "abandoning change")
+	}
+
+	return errors.Wrap(s.setChangesetMetadata(change, cs), "setting Gerrit changeset metadata")
+}
+
+// UpdateChangeset can update Changesets.
+//
+// The title and description of a Gerrit change are taken from the commit
+// message, so they are updated by pushing a new patch set. The only thing left
+// to update here is the destination branch.
+func (s GerritSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting change")
+	}
+
+	if branch := gitdomain.AbbreviateRef(cs.BaseRef); change.Branch != branch {
+		change, err = s.client.MoveChange(ctx, cs.ExternalID, branch)
+		if err != nil {
+			return errors.Wrap(err, "moving change")
+		}
+	}
+
+	return errors.Wrap(s.setChangesetMetadata(change, cs), "setting Gerrit changeset metadata")
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s GerritSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		return errors.Wrap(err, "getting change")
+	}
+
+	if change.Status == gerrit.ChangeStatusAbandoned {
+		change, err = s.client.RestoreChange(ctx, cs.ExternalID)
+		if err != nil {
+			return errors.Wrap(err, "restoring change")
+		}
+	}
+
+	return errors.Wrap(s.setChangesetMetadata(change, cs), "setting Gerrit changeset metadata")
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s GerritSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	return s.client.WriteReviewComment(ctx, cs.ExternalID, comment)
+}
+
+// MergeChangeset submits the Changeset on the code host, if in a submittable
+// state. Gerrit changes consist of a single commit, so squash has no effect.
+func (s GerritSource) MergeChangeset(ctx context.Context, cs *Changeset, _ bool) error {
+	change, err := s.client.SubmitChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsHTTPErrorCode(err, http.StatusConflict) {
+			return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return errors.Wrap(err, "submitting change")
+	}
+
+	return errors.Wrap(s.setChangesetMetadata(change, cs), "setting Gerrit changeset metadata")
+}
+
+func (s GerritSource) setChangesetMetadata(change *gerrit.Change, cs *Changeset) error {
+	if err := cs.SetMetadata(&gerritbatches.AnnotatedChange{
+		Change:      change,
+		CodeHostURL: s.client.URL.String(),
+	}); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+// changeID returns the Change-Id of the change for the given changeset. Before
+// the changeset is published, it doesn't have an external ID yet, and the
+// Change-Id is derived from the target repo and head ref instead, so that
+// retried pushes update the same change.
+func (s GerritSource) changeID(cs *Changeset) string {
+	if cs.Changeset != nil && cs.ExternalID != "" {
+		return cs.ExternalID
+	}
+	return GenerateGerritChangeID(string(cs.TargetRepo.Name), cs.HeadRef)
+}
+
+// GenerateGerritChangeID returns a deterministic Gerrit Change-Id for the
+// changeset with the given head ref in the given repo. A Change-Id is an "I"
+// followed by the 40 hex digits of a SHA-1.
+func GenerateGerritChangeID(repoName, headRef string) string {
+	sum := sha1.Sum([]byte(repoName + "\x00" + gitdomain.EnsureRefPrefix(headRef)))
+	return "I" + hex.EncodeToString(sum[:])
+}
+
+// withGerritChangeID appends a Change-Id trailer to the given commit message,
+// unless the message already has one.
+func withGerritChangeID(message, changeID string) string {
+	for _, line := range strings.Split(message, "\n") {
+		if strings.HasPrefix(line, "Change-Id:") {
+			return message
+		}
+	}
+
+	message = strings.TrimRight(message, "\n")
+	// Trailers need to be in the last paragraph of the message, so we only
+	// need a separating blank line if the last paragraph isn't one already.
+	lines := strings.Split(message, "\n")
+	if !isGitTrailer(lines[len(lines)-1]) || len(lines) == 1 {
+		message += "\n"
+	}
+	return message + "\nChange-Id: " + changeID + "\n"
+}
+
+var gitTrailerPattern = lazyregexp.New(`^[A-Za-z0-9-]+: `)
+
+func isGitTrailer(line string) bool {
+	return gitTrailerPattern.MatchString(line)
+}
@@ -0,0 +1,177 @@
+package sources
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// p4Execer is the subset of the gitserver client used to run p4 commands.
+type p4Execer interface {
+	P4Exec(ctx context.Context, host, user, password string, args ...string) (io.ReadCloser, http.Header, error)
+}
+
+// PerforceSource is a ChangesetSource for Perforce. Changesets are published as
+// shelved changelists: gitserver shelves the pushed commit and the resulting
+// changelist number becomes the external ID of the changeset. Later pushes
+// shelve the commit in the same changelist again. Reviewing and submitting the
+// changelist happens in Perforce itself.
+type PerforceSource struct {
+	p4Execer p4Execer
+	host     string
+	auther   *auth.BasicAuth
+}
+
+var (
+	_ ChangesetSource       = PerforceSource{}
+	_ CommitChangesetSource = PerforceSource{}
+)
+
+var errPerforceUnsupported = errcode.MakeNonRetryable(errors.New("operation is not supported for Perforce changelists"))
+
+func NewPerforceSource(ctx context.Context, svc *types.ExternalService, client p4Execer) (*PerforceSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.PerforceConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if client == nil {
+		client = gitserver.NewClient()
+	}
+
+	return &PerforceSource{
+		p4Execer: client,
+		host:     c.P4Port,
+		auther:   &auth.BasicAuth{Username: c.P4User, Password: c.P4Passwd},
+	}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s PerforceSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.auther)
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s PerforceSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	var ba *auth.BasicAuth
+	switch av := a.(type) {
+	case *auth.BasicAuth:
+		ba = av
+	case *auth.BasicAuthWithSSH:
+		ba = &av.BasicAuth
+	default:
+		return nil, newUnsupportedAuthenticatorError("PerforceSource", a)
+	}
+
+	return &PerforceSource{p4Execer: s.p4Execer, host: s.host, auther: ba}, nil
+}
+
+// DecorateCommitOpts makes gitserver shelve the commit in the changelist of
+// the changeset, if it has been published, instead of creating a new one.
+func (s PerforceSource) DecorateCommitOpts(opts *protocol.CreateCommitFromPatchRequest, cs *Changeset) {
+	opts.ChangelistID = cs.ExternalID
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s PerforceSource) ValidateAuthenticator(ctx context.Context) error {
+	rc, _, err := s.p4Execer.P4Exec(ctx, s.host, s.auther.Username, s.auther.Password, "users")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	_, err = io.Copy(io.Discard, rc)
+	return err
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s PerforceSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	cl, err := s.describeChangelist(ctx, cs.ExternalID)
+	if err != nil {
+		if errors.Is(err, perforce.ErrChangelistNotFound) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "describing changelist")
+	}
+
+	return errors.Wrap(cs.SetMetadata(cl), "setting Perforce changeset metadata")
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+//
+// The changelist is shelved by gitserver when the commit is pushed, so this
+// only loads it from Perforce.
+func (s PerforceSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	if cs.ExternalID == "" {
+		return false, errors.New("no changelist was shelved for the changeset")
+	}
+
+	if err := s.LoadChangeset(ctx, cs); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// UpdateChangeset can update Changesets. Pushing a commit shelves it in the
+// existing changelist, so this only reloads it from Perforce.
+func (s PerforceSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	return s.LoadChangeset(ctx, cs)
+}
+
+// CloseChangeset is not supported: shelved changelists, and the client
+// workspaces that own them, need to be deleted in Perforce.
+func (s PerforceSource) CloseChangeset(context.Context, *Changeset) error {
+	return errPerforceUnsupported
+}
+
+// ReopenChangeset is not supported for Perforce changelists.
+func (s PerforceSource) ReopenChangeset(context.Context, *Changeset) error {
+	return errPerforceUnsupported
+}
+
+// CreateComment is not supported: Perforce has no comments on changelists.
+func (s PerforceSource) CreateComment(context.Context, *Changeset, string) error {
+	return errPerforceUnsupported
+}
+
+// MergeChangeset is not supported: shelved changelists need to be unshelved
+// and submitted from a workspace.
+func (s PerforceSource) MergeChangeset(context.Context, *Changeset, bool) error {
+	return errPerforceUnsupported
+}
+
+func (s PerforceSource) describeChangelist(ctx context.Context, id string) (*perforce.Changelist, error) {
+	rc, _, err := s.p4Execer.P4Exec(ctx, s.host, s.auther.Username, s.auther.Password, "describe", "-s", "-S", id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	out, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	return perforce.ParseChangelistDescription(string(out))
+}
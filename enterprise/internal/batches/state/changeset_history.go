@@ -96,6 +96,23 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindAzureDevOpsPullRequestRejected,
 }
 
+// MergedAt returns when the given changeset was merged according to its
+// ChangesetEvents, or the zero time if it hasn't been merged.
+// The ChangesetEvents MUST be sorted by their Timestamp.
+func MergedAt(ch *btypes.Changeset, ce ChangesetEvents) (time.Time, error) {
+	history, err := computeHistory(ch, ce)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, s := range history {
+		if s.externalState == btypes.ChangesetExternalStateMerged {
+			return s.t, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
 type changesetStatesAtTime struct {
 	t             time.Time
 	externalState btypes.ChangesetExternalState
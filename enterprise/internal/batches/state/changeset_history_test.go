@@ -0,0 +1,64 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func TestMergedAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	tests := []struct {
+		name      string
+		changeset *btypes.Changeset
+		events    ChangesetEvents
+		want      time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "merged",
+			changeset: ghChangeset(1, daysAgo(5)),
+			events: ChangesetEvents{
+				event(t, daysAgo(3), btypes.ChangesetEventKindGitHubClosed, 1),
+				event(t, daysAgo(2), btypes.ChangesetEventKindGitHubReopened, 1),
+				event(t, daysAgo(1), btypes.ChangesetEventKindGitHubMerged, 1),
+			},
+			want: daysAgo(1),
+		},
+		{
+			name:      "not merged",
+			changeset: ghChangeset(1, daysAgo(5)),
+			events: ChangesetEvents{
+				event(t, daysAgo(3), btypes.ChangesetEventKindGitHubClosed, 1),
+			},
+		},
+		{
+			name:      "not published",
+			changeset: &btypes.Changeset{ID: 1},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := MergedAt(tc.changeset, tc.events)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !have.Equal(tc.want) {
+				t.Fatalf("wrong merge time: want %s, have %s", tc.want, have)
+			}
+		})
+	}
+}
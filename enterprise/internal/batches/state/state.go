@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -24,8 +25,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -118,6 +121,8 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 		return computeBitbucketCloudBuildState(c.UpdatedAt, m, events)
 	case *azuredevops.AnnotatedPullRequest:
 		return computeAzureDevOpsBuildState(m)
+	case *gerritbatches.AnnotatedChange:
+		return computeGerritVerifiedState(m)
 	}
 
 	return btypes.ChangesetCheckStateUnknown
@@ -285,6 +290,25 @@ func parseAzureDevOpsBuildState(s adobatches.PullRequestStatusState) btypes.Chan
 	}
 }
 
+// computeGerritVerifiedState maps the votes on the Verified label, which is
+// where CI systems such as Zuul or Jenkins report back to Gerrit, to a check
+// state.
+func computeGerritVerifiedState(ac *gerritbatches.AnnotatedChange) btypes.ChangesetCheckState {
+	label, ok := ac.Labels[gerrit.LabelVerified]
+	if !ok {
+		return btypes.ChangesetCheckStateUnknown
+	}
+
+	switch {
+	case label.Rejected != nil:
+		return btypes.ChangesetCheckStateFailed
+	case label.Approved != nil:
+		return btypes.ChangesetCheckStatePassed
+	default:
+		return btypes.ChangesetCheckStatePending
+	}
+}
+
 func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
 	// We should only consider the latest commit. This could be from a sync or a webhook that
 	// has occurred later
@@ -564,6 +588,30 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Azure DevOps pull request state: %s", m.Status)
 		}
+	case *gerritbatches.AnnotatedChange:
+		switch m.Status {
+		case gerrit.ChangeStatusAbandoned:
+			s = btypes.ChangesetExternalStateClosed
+		case gerrit.ChangeStatusMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case gerrit.ChangeStatusNew:
+			if m.WorkInProgress {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Gerrit change status: %s", m.Status)
+		}
+	case *perforce.Changelist:
+		switch m.State {
+		case perforce.ChangelistStateSubmitted:
+			s = btypes.ChangesetExternalStateMerged
+		case perforce.ChangelistStatePending, perforce.ChangelistStateShelved:
+			s = btypes.ChangesetExternalStateOpen
+		default:
+			return "", errors.Errorf("unknown Perforce changelist state: %s", m.State)
+		}
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -650,6 +698,23 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 				states[btypes.ChangesetReviewStatePending] = true
 			}
 		}
+	case *gerritbatches.AnnotatedChange:
+		// Gerrit summarizes the votes on a label: the highest and lowest
+		// votes on Code-Review are reported as approved and rejected, and
+		// the intermediate ones as recommended and disliked.
+		label := m.Labels[gerrit.LabelCodeReview]
+		switch {
+		case label.Rejected != nil, label.Disliked != nil:
+			states[btypes.ChangesetReviewStateChangesRequested] = true
+		case label.Approved != nil:
+			states[btypes.ChangesetReviewStateApproved] = true
+		default:
+			states[btypes.ChangesetReviewStatePending] = true
+		}
+	case *perforce.Changelist:
+		// Reviews of changelists happen outside of Perforce itself, e.g. in
+		// Helix Swarm.
+		return btypes.ChangesetReviewStatePending, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
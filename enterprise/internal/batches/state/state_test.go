@@ -9,11 +9,14 @@ import (
 	azuredevops2 "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
@@ -391,6 +394,49 @@ func TestComputeAzureDevOpsBuildStatus(t *testing.T) {
 	}
 }
 
+func TestComputeGerritVerifiedState(t *testing.T) {
+	t.Parallel()
+
+	vote := &gerrit.Account{ID: 1000096}
+
+	tests := []struct {
+		name   string
+		labels map[string]gerrit.LabelInfo
+		want   btypes.ChangesetCheckState
+	}{
+		{
+			name: "no Verified label",
+			want: btypes.ChangesetCheckStateUnknown,
+		},
+		{
+			name:   "no votes",
+			labels: map[string]gerrit.LabelInfo{gerrit.LabelVerified: {}},
+			want:   btypes.ChangesetCheckStatePending,
+		},
+		{
+			name:   "approved",
+			labels: map[string]gerrit.LabelInfo{gerrit.LabelVerified: {Approved: vote}},
+			want:   btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name:   "rejected",
+			labels: map[string]gerrit.LabelInfo{gerrit.LabelVerified: {Approved: vote, Rejected: vote}},
+			want:   btypes.ChangesetCheckStateFailed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have := computeGerritVerifiedState(&gerritbatches.AnnotatedChange{
+				Change: &gerrit.Change{Labels: tc.labels},
+			})
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
 func TestComputeGitLabCheckState(t *testing.T) {
 	t.Parallel()
 
@@ -650,6 +696,34 @@ func TestComputeReviewState(t *testing.T) {
 			},
 			want: btypes.ChangesetReviewStateChangesRequested,
 		},
+		{
+			name:      "gerrit - no votes",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusNew, nil),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
+		{
+			name: "gerrit - approved",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusNew, map[string]gerrit.LabelInfo{
+				gerrit.LabelCodeReview: {Approved: &gerrit.Account{ID: 1}},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name: "gerrit - disliked",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusNew, map[string]gerrit.LabelInfo{
+				gerrit.LabelCodeReview: {Approved: &gerrit.Account{ID: 1}, Disliked: &gerrit.Account{ID: 2}},
+			}),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateChangesRequested,
+		},
+		{
+			name:      "perforce",
+			changeset: perforceChangeset(daysAgo(0), perforce.ChangelistStateShelved),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
 	}
 
 	for i, tc := range tests {
@@ -858,6 +932,36 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateReadOnly,
 		},
+		{
+			name:      "gerrit - new",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusNew, nil),
+			want:      btypes.ChangesetExternalStateOpen,
+		},
+		{
+			name:      "gerrit - work in progress",
+			changeset: setDraft(gerritChangeset(daysAgo(0), gerrit.ChangeStatusNew, nil)),
+			want:      btypes.ChangesetExternalStateDraft,
+		},
+		{
+			name:      "gerrit - merged",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusMerged, nil),
+			want:      btypes.ChangesetExternalStateMerged,
+		},
+		{
+			name:      "gerrit - abandoned",
+			changeset: gerritChangeset(daysAgo(0), gerrit.ChangeStatusAbandoned, nil),
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "perforce - shelved",
+			changeset: perforceChangeset(daysAgo(0), perforce.ChangelistStateShelved),
+			want:      btypes.ChangesetExternalStateOpen,
+		},
+		{
+			name:      "perforce - submitted",
+			changeset: perforceChangeset(daysAgo(0), perforce.ChangelistStateSubmitted),
+			want:      btypes.ChangesetExternalStateMerged,
+		},
 	}
 
 	for i, tc := range tests {
@@ -1013,6 +1117,27 @@ func gitLabChangeset(updatedAt time.Time, state gitlab.MergeRequestState, notes
 	}
 }
 
+func gerritChangeset(updatedAt time.Time, status gerrit.ChangeStatus, labels map[string]gerrit.LabelInfo) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeGerrit,
+		UpdatedAt:           updatedAt,
+		Metadata: &gerritbatches.AnnotatedChange{
+			Change: &gerrit.Change{
+				Status: status,
+				Labels: labels,
+			},
+		},
+	}
+}
+
+func perforceChangeset(updatedAt time.Time, state perforce.ChangelistState) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypePerforce,
+		UpdatedAt:           updatedAt,
+		Metadata:            &perforce.Changelist{State: state},
+	}
+}
+
 func setDeletedAt(c *btypes.Changeset, deletedAt time.Time) *btypes.Changeset {
 	c.ExternalDeletedAt = deletedAt
 	return c
@@ -19,6 +19,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/search"
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -28,8 +29,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -474,6 +478,9 @@ type GetChangesetOpts struct {
 	ExternalBranch      string
 	ReconcilerState     btypes.ReconcilerState
 	PublicationState    btypes.ChangesetPublicationState
+	// OwnedByBatchChangeID restricts the changeset to the ones created by the
+	// given batch change.
+	OwnedByBatchChangeID int64
 }
 
 // GetChangeset gets a changeset matching the given options.
@@ -532,6 +539,9 @@ func getChangesetQuery(opts *GetChangesetOpts) *sqlf.Query {
 	if opts.PublicationState != "" {
 		preds = append(preds, sqlf.Sprintf("changesets.publication_state = %s", opts.PublicationState))
 	}
+	if opts.OwnedByBatchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("changesets.owned_by_batch_change_id = %s", opts.OwnedByBatchChangeID))
+	}
 
 	return sqlf.Sprintf(
 		getChangesetsQueryFmtstr,
@@ -1335,6 +1345,57 @@ updated_records AS (
 SELECT COUNT(id) FROM all_matching WHERE all_matching.reconciler_state = %s
 `
 
+// EnqueueChangesetsStackedOn enqueues the published and open changesets that
+// are stacked on the given changeset, so that the reconciler can rebase and
+// retarget them once the given changeset has been merged or closed.
+func (s *Store) EnqueueChangesetsStackedOn(ctx context.Context, cs *btypes.Changeset) (err error) {
+	ctx, _, endObservation := s.operations.enqueueChangesetsStackedOn.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(cs.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if cs.ExternalBranch == "" {
+		return nil
+	}
+
+	q := sqlf.Sprintf(
+		enqueueChangesetsStackedOnFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		cs.RepoID,
+		gitdomain.EnsureRefPrefix(cs.ExternalBranch),
+		btypes.ChangesetPublicationStatePublished,
+		btypes.ChangesetExternalStateOpen,
+		btypes.ChangesetExternalStateDraft,
+		btypes.ReconcilerStateCompleted.ToDB(),
+	)
+	return s.Exec(ctx, q)
+}
+
+const enqueueChangesetsStackedOnFmtstr = `
+UPDATE
+	changesets
+SET
+	reconciler_state = %s,
+	num_resets = 0,
+	num_failures = 0,
+	updated_at = %s
+FROM
+	changeset_specs
+WHERE
+	changesets.current_spec_id = changeset_specs.id
+	AND
+	changesets.repo_id = %s
+	AND
+	changeset_specs.stack_parent_ref = %s
+	AND
+	changesets.publication_state = %s
+	AND
+	changesets.external_state IN (%s, %s)
+	AND
+	changesets.reconciler_state = %s
+`
+
 // jsonBatchChangeChangesetSet represents a "join table" set as a JSONB object
 // where the keys are the ids and the values are json objects holding the properties.
 // It implements the sql.Scanner interface so it can be used as a scan destination,
@@ -1468,6 +1529,13 @@ func ScanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		// Ensure the inner PR is initialized, it should never be nil.
 		m.PullRequest = &azuredevops.PullRequest{}
 		t.Metadata = m
+	case extsvc.TypeGerrit:
+		m := new(gerritbatches.AnnotatedChange)
+		// Ensure the inner change is initialized, it should never be nil.
+		m.Change = &gerrit.Change{}
+		t.Metadata = m
+	case extsvc.TypePerforce:
+		t.Metadata = new(perforce.Changelist)
 	default:
 		return errors.New("unknown external service type")
 	}
@@ -1022,6 +1022,28 @@ func testStoreChangesets(t *testing.T, ctx context.Context, s *Store, clock bt.C
 			}
 		})
 
+		t.Run("OwnedByBatchChangeID", func(t *testing.T) {
+			for _, c := range changesets {
+				opts := GetChangesetOpts{ExternalBranch: c.ExternalBranch, OwnedByBatchChangeID: c.OwnedByBatchChangeID}
+
+				have, err := s.GetChangeset(ctx, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := c
+
+				if diff := cmp.Diff(have, want); diff != "" {
+					t.Fatal(diff)
+				}
+
+				opts.OwnedByBatchChangeID = c.OwnedByBatchChangeID + 1000
+				_, err = s.GetChangeset(ctx, opts)
+				if err != ErrNoResults {
+					t.Fatalf("unexpected error, want=%q have=%q", ErrNoResults, err)
+				}
+			}
+		})
+
 		t.Run("ReconcilerState", func(t *testing.T) {
 			for _, c := range changesets {
 				opts := GetChangesetOpts{ID: c.ID, ReconcilerState: c.ReconcilerState}
@@ -0,0 +1,298 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeRolloutColumns are used by the rollout related Store methods to
+// query and create batch change rollouts.
+var batchChangeRolloutColumns = []*sqlf.Query{
+	sqlf.Sprintf("batch_change_rollouts.id"),
+	sqlf.Sprintf("batch_change_rollouts.batch_change_id"),
+	sqlf.Sprintf("batch_change_rollouts.wave"),
+	sqlf.Sprintf("batch_change_rollouts.state"),
+	sqlf.Sprintf("batch_change_rollouts.pause_reason"),
+	sqlf.Sprintf("batch_change_rollouts.failures_accepted"),
+	sqlf.Sprintf("batch_change_rollouts.created_at"),
+	sqlf.Sprintf("batch_change_rollouts.updated_at"),
+}
+
+// CreateBatchChangeRollout creates the given rollout.
+func (s *Store) CreateBatchChangeRollout(ctx context.Context, r *btypes.BatchChangeRollout) (err error) {
+	ctx, _, endObservation := s.operations.createBatchChangeRollout.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(r.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = s.now()
+	}
+
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = r.CreatedAt
+	}
+
+	if r.State == "" {
+		r.State = btypes.BatchChangeRolloutStateActive
+	}
+
+	q := sqlf.Sprintf(
+		createBatchChangeRolloutQueryFmtstr,
+		r.BatchChangeID,
+		r.Wave,
+		r.State,
+		dbutil.NewNullString(r.PauseReason),
+		r.FailuresAccepted,
+		r.CreatedAt,
+		r.UpdatedAt,
+		sqlf.Join(batchChangeRolloutColumns, ", "),
+	)
+	return s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeRollout(r, sc) })
+}
+
+var createBatchChangeRolloutQueryFmtstr = `
+INSERT INTO batch_change_rollouts (
+	batch_change_id,
+	wave,
+	state,
+	pause_reason,
+	failures_accepted,
+	created_at,
+	updated_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING %s
+`
+
+// UpdateBatchChangeRollout updates the progress of the given rollout.
+func (s *Store) UpdateBatchChangeRollout(ctx context.Context, r *btypes.BatchChangeRollout) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeRollout.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(r.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateBatchChangeRolloutQueryFmtstr,
+		r.Wave,
+		r.State,
+		dbutil.NewNullString(r.PauseReason),
+		r.FailuresAccepted,
+		r.UpdatedAt,
+		r.ID,
+		sqlf.Join(batchChangeRolloutColumns, ", "),
+	)
+	return s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeRollout(r, sc) })
+}
+
+var updateBatchChangeRolloutQueryFmtstr = `
+UPDATE batch_change_rollouts
+SET
+	wave = %s,
+	state = %s,
+	pause_reason = %s,
+	failures_accepted = %s,
+	updated_at = %s
+WHERE id = %s
+RETURNING %s
+`
+
+// GetBatchChangeRolloutOpts captures the query options needed for getting a
+// batch change rollout.
+type GetBatchChangeRolloutOpts struct {
+	BatchChangeID int64
+
+	// ForUpdate locks the rollout until the end of the transaction, so that
+	// the rollout worker doesn't overwrite a concurrent pause or resume.
+	ForUpdate bool
+}
+
+// GetBatchChangeRollout gets the rollout of a batch change matching the given
+// options. It returns ErrNoResults if the batch change has no rollout.
+func (s *Store) GetBatchChangeRollout(ctx context.Context, opts GetBatchChangeRolloutOpts) (r *btypes.BatchChangeRollout, err error) {
+	ctx, _, endObservation := s.operations.getBatchChangeRollout.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	lock := sqlf.Sprintf("")
+	if opts.ForUpdate {
+		lock = sqlf.Sprintf("FOR UPDATE")
+	}
+
+	q := sqlf.Sprintf(
+		getBatchChangeRolloutQueryFmtstr,
+		sqlf.Join(batchChangeRolloutColumns, ", "),
+		opts.BatchChangeID,
+		lock,
+	)
+
+	var rollout btypes.BatchChangeRollout
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchChangeRollout(&rollout, sc) })
+	if err != nil {
+		return nil, err
+	}
+
+	if rollout.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &rollout, nil
+}
+
+var getBatchChangeRolloutQueryFmtstr = `
+SELECT %s FROM batch_change_rollouts
+WHERE batch_change_id = %s
+%s
+`
+
+// ListBatchChangesWithRolloutPolicy lists the open batch changes whose current
+// batch spec declares a rollout policy.
+func (s *Store) ListBatchChangesWithRolloutPolicy(ctx context.Context) (cs []*btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangesWithRolloutPolicy.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listBatchChangesWithRolloutPolicyQueryFmtstr,
+		sqlf.Join(batchChangeColumns, ", "),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.BatchChange
+		if err := scanBatchChange(&c, sc); err != nil {
+			return err
+		}
+		cs = append(cs, &c)
+		return nil
+	})
+	return cs, err
+}
+
+var listBatchChangesWithRolloutPolicyQueryFmtstr = `
+SELECT %s FROM batch_changes
+INNER JOIN batch_specs ON batch_specs.id = batch_changes.batch_spec_id
+WHERE
+	batch_changes.closed_at IS NULL
+	AND batch_changes.last_applied_at IS NOT NULL
+	AND batch_specs.spec ? 'rollout'
+ORDER BY batch_changes.id ASC
+`
+
+// CreateRolloutChangesets assigns changesets to the waves of a rollout.
+func (s *Store) CreateRolloutChangesets(ctx context.Context, cs ...*btypes.RolloutChangeset) (err error) {
+	ctx, _, endObservation := s.operations.createRolloutChangesets.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("count", len(cs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	inserter := func(inserter *batch.Inserter) error {
+		for _, c := range cs {
+			if err := inserter.Insert(
+				ctx,
+				c.RolloutID,
+				c.ChangesetID,
+				c.Wave,
+				dbutil.NewNullInt64(c.MergeJobID),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return batch.WithInserter(
+		ctx,
+		s.Handle(),
+		"batch_change_rollout_changesets",
+		batch.MaxNumPostgresParameters,
+		[]string{"rollout_id", "changeset_id", "wave", "merge_job_id"},
+		inserter,
+	)
+}
+
+// ListRolloutChangesets lists the changesets assigned to the waves of the
+// rollout with the given ID, along with the state of their merge jobs.
+func (s *Store) ListRolloutChangesets(ctx context.Context, rolloutID int64) (cs []*btypes.RolloutChangeset, err error) {
+	ctx, _, endObservation := s.operations.listRolloutChangesets.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("rolloutID", int(rolloutID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(listRolloutChangesetsQueryFmtstr, rolloutID)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.RolloutChangeset
+		var mergeJobState string
+		if err := sc.Scan(
+			&c.RolloutID,
+			&c.ChangesetID,
+			&c.Wave,
+			&dbutil.NullInt64{N: &c.MergeJobID},
+			&dbutil.NullString{S: &mergeJobState},
+		); err != nil {
+			return err
+		}
+		c.MergeJobState = btypes.ChangesetJobState(mergeJobState)
+		cs = append(cs, &c)
+		return nil
+	})
+	return cs, err
+}
+
+var listRolloutChangesetsQueryFmtstr = `
+SELECT
+	batch_change_rollout_changesets.rollout_id,
+	batch_change_rollout_changesets.changeset_id,
+	batch_change_rollout_changesets.wave,
+	batch_change_rollout_changesets.merge_job_id,
+	UPPER(changeset_jobs.state)
+FROM batch_change_rollout_changesets
+LEFT JOIN changeset_jobs ON changeset_jobs.id = batch_change_rollout_changesets.merge_job_id
+WHERE batch_change_rollout_changesets.rollout_id = %s
+ORDER BY batch_change_rollout_changesets.changeset_id ASC
+`
+
+// SetRolloutChangesetMergeJob records the changeset job that merges the given
+// changeset of a rollout.
+func (s *Store) SetRolloutChangesetMergeJob(ctx context.Context, c *btypes.RolloutChangeset) (err error) {
+	ctx, _, endObservation := s.operations.setRolloutChangesetMergeJob.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("rolloutID", int(c.RolloutID)),
+		log.Int("changesetID", int(c.ChangesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		setRolloutChangesetMergeJobQueryFmtstr,
+		dbutil.NewNullInt64(c.MergeJobID),
+		c.RolloutID,
+		c.ChangesetID,
+	))
+}
+
+var setRolloutChangesetMergeJobQueryFmtstr = `
+UPDATE batch_change_rollout_changesets
+SET merge_job_id = %s
+WHERE rollout_id = %s AND changeset_id = %s
+`
+
+func scanBatchChangeRollout(r *btypes.BatchChangeRollout, s dbutil.Scanner) error {
+	return s.Scan(
+		&r.ID,
+		&r.BatchChangeID,
+		&r.Wave,
+		&r.State,
+		&dbutil.NullString{S: &r.PauseReason},
+		&r.FailuresAccepted,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+}
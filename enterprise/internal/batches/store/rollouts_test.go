@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/log/logtest"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func testStoreBatchChangeRollouts(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	repoStore := database.ReposWith(logger, s)
+	esStore := database.ExternalServicesWith(logger, s)
+
+	repo := bt.TestRepo(t, esStore, extsvc.KindGitHub)
+	if err := repoStore.Create(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+
+	rolloutSpec := &btypes.BatchSpec{
+		UserID:          user.ID,
+		NamespaceUserID: user.ID,
+		Spec: &batcheslib.BatchSpec{
+			Name: "rollout",
+			Rollout: &batcheslib.RolloutPolicy{
+				Waves: []batcheslib.RolloutWave{{Percentage: 10}},
+			},
+		},
+	}
+	if err := s.CreateBatchSpec(ctx, rolloutSpec); err != nil {
+		t.Fatal(err)
+	}
+	rolloutBatchChange := bt.CreateBatchChange(t, ctx, s, "rollout", user.ID, rolloutSpec.ID)
+
+	otherSpec := bt.CreateBatchSpec(t, ctx, s, "other", user.ID, 0)
+	bt.CreateBatchChange(t, ctx, s, "other", user.ID, otherSpec.ID)
+
+	t.Run("ListBatchChangesWithRolloutPolicy", func(t *testing.T) {
+		have, err := s.ListBatchChangesWithRolloutPolicy(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]*btypes.BatchChange{rolloutBatchChange}, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	rollout := &btypes.BatchChangeRollout{BatchChangeID: rolloutBatchChange.ID}
+
+	t.Run("Create", func(t *testing.T) {
+		if err := s.CreateBatchChangeRollout(ctx, rollout); err != nil {
+			t.Fatal(err)
+		}
+
+		if rollout.ID == 0 {
+			t.Fatal("ID should not be zero")
+		}
+		if rollout.State != btypes.BatchChangeRolloutStateActive {
+			t.Fatalf("wrong state: %q", rollout.State)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		rollout.Wave = 1
+		rollout.State = btypes.BatchChangeRolloutStatePaused
+		rollout.PauseReason = "2 of 10 changesets in wave 2 failed"
+		if err := s.UpdateBatchChangeRollout(ctx, rollout); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.GetBatchChangeRollout(ctx, GetBatchChangeRolloutOpts{BatchChangeID: rolloutBatchChange.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(rollout, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Get not found", func(t *testing.T) {
+		_, err := s.GetBatchChangeRollout(ctx, GetBatchChangeRolloutOpts{BatchChangeID: rolloutBatchChange.ID + 1000})
+		if err != ErrNoResults {
+			t.Fatalf("wrong error: %v", err)
+		}
+	})
+
+	t.Run("RolloutChangesets", func(t *testing.T) {
+		changeset := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID, BatchChange: rolloutBatchChange.ID})
+
+		rc := &btypes.RolloutChangeset{RolloutID: rollout.ID, ChangesetID: changeset.ID, Wave: 1}
+		if err := s.CreateRolloutChangesets(ctx, rc); err != nil {
+			t.Fatal(err)
+		}
+
+		job := &btypes.ChangesetJob{
+			UserID:        user.ID,
+			BatchChangeID: rolloutBatchChange.ID,
+			ChangesetID:   changeset.ID,
+			JobType:       btypes.ChangesetJobTypeMerge,
+			Payload:       &btypes.ChangesetJobMergePayload{},
+			State:         btypes.ChangesetJobStateQueued,
+		}
+		if err := s.CreateChangesetJob(ctx, job); err != nil {
+			t.Fatal(err)
+		}
+
+		rc.MergeJobID = job.ID
+		if err := s.SetRolloutChangesetMergeJob(ctx, rc); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.ListRolloutChangesets(ctx, rollout.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []*btypes.RolloutChangeset{{
+			RolloutID:     rollout.ID,
+			ChangesetID:   changeset.ID,
+			Wave:          1,
+			MergeJobID:    job.ID,
+			MergeJobState: btypes.ChangesetJobStateQueued,
+		}}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
@@ -241,6 +241,7 @@ type operations struct {
 	getChangesetExternalIDs           *observation.Operation
 	cancelQueuedBatchChangeChangesets *observation.Operation
 	enqueueChangesetsToClose          *observation.Operation
+	enqueueChangesetsStackedOn        *observation.Operation
 	getChangesetsStats                *observation.Operation
 	getRepoChangesetsStats            *observation.Operation
 	getGlobalChangesetsStats          *observation.Operation
@@ -248,6 +249,14 @@ type operations struct {
 	getChangesetPlaceInSchedulerQueue *observation.Operation
 	cleanDetachedChangesets           *observation.Operation
 
+	createBatchChangeRollout          *observation.Operation
+	updateBatchChangeRollout          *observation.Operation
+	getBatchChangeRollout             *observation.Operation
+	listBatchChangesWithRolloutPolicy *observation.Operation
+	createRolloutChangesets           *observation.Operation
+	listRolloutChangesets             *observation.Operation
+	setRolloutChangesetMergeJob       *observation.Operation
+
 	listCodeHosts         *observation.Operation
 	getExternalServiceIDs *observation.Operation
 
@@ -385,6 +394,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getChangesetExternalIDs:           op("GetChangesetExternalIDs"),
 			cancelQueuedBatchChangeChangesets: op("CancelQueuedBatchChangeChangesets"),
 			enqueueChangesetsToClose:          op("EnqueueChangesetsToClose"),
+			enqueueChangesetsStackedOn:        op("EnqueueChangesetsStackedOn"),
 			getChangesetsStats:                op("GetChangesetsStats"),
 			getRepoChangesetsStats:            op("GetRepoChangesetsStats"),
 			getGlobalChangesetsStats:          op("GetGlobalChangesetsStats"),
@@ -392,6 +402,14 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getChangesetPlaceInSchedulerQueue: op("GetChangesetPlaceInSchedulerQueue"),
 			cleanDetachedChangesets:           op("CleanDetachedChangesets"),
 
+			createBatchChangeRollout:          op("CreateBatchChangeRollout"),
+			updateBatchChangeRollout:          op("UpdateBatchChangeRollout"),
+			getBatchChangeRollout:             op("GetBatchChangeRollout"),
+			listBatchChangesWithRolloutPolicy: op("ListBatchChangesWithRolloutPolicy"),
+			createRolloutChangesets:           op("CreateRolloutChangesets"),
+			listRolloutChangesets:             op("ListRolloutChangesets"),
+			setRolloutChangesetMergeJob:       op("SetRolloutChangesetMergeJob"),
+
 			listCodeHosts:         op("ListCodeHosts"),
 			getExternalServiceIDs: op("GetExternalServiceIDs"),
 
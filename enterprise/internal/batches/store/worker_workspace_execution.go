@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution/cache"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -206,10 +207,12 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 	// Find the result for the last step. This is the one we'll be building the execution
 	// result from.
 	latestStepResult := stepResults[0]
+	allStepResults := make([]execution.AfterStepResult, 0, len(stepResults))
 	for _, r := range stepResults {
 		if r.Value.StepIndex > latestStepResult.Value.StepIndex {
 			latestStepResult = r
 		}
+		allStepResults = append(allStepResults, r.Value)
 	}
 
 	changesetAuthor, err := author.GetChangesetAuthorForUser(ctx, database.UsersWith(s.logger, s), batchSpec.UserID)
@@ -227,6 +230,7 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 			FileMatches: workspace.FileMatches,
 		},
 		latestStepResult.Value,
+		allStepResults,
 		workspace.Path,
 		true,
 		changesetAuthor,
@@ -501,6 +501,8 @@ func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
 // SyncChangeset refreshes the metadata of the given changeset and
 // updates them in the database.
 func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Client, source sources.ChangesetSource, repo *types.Repo, c *btypes.Changeset) (err error) {
+	wasComplete := c.Complete()
+
 	repoChangeset := &sources.Changeset{TargetRepo: repo, Changeset: c}
 	if err := source.LoadChangeset(ctx, repoChangeset); err != nil {
 		if !errors.HasType(err, sources.ChangesetNotFoundError{}) {
@@ -538,5 +540,13 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 		return err
 	}
 
+	// Changesets stacked on this one need to be rebased and retargeted once
+	// it's merged or closed.
+	if !wasComplete && c.Complete() {
+		if err := tx.EnqueueChangesetsStackedOn(ctx, c); err != nil {
+			return err
+		}
+	}
+
 	return tx.UpsertChangesetEvents(ctx, events...)
 }
@@ -15,12 +15,15 @@ import (
 
 	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/lib/batches"
@@ -451,6 +454,25 @@ func (c *Changeset) SetMetadata(meta any) error {
 			c.ExternalForkNamespace = ""
 			c.ExternalForkName = ""
 		}
+	case *gerritbatches.AnnotatedChange:
+		c.Metadata = pr
+		c.ExternalID = pr.ChangeID
+		c.ExternalServiceType = extsvc.TypeGerrit
+		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Topic)
+		c.ExternalUpdatedAt = pr.Updated.Time
+		c.ExternalForkNamespace = ""
+		c.ExternalForkName = ""
+	case *perforce.Changelist:
+		// Changelists aren't tied to a branch, so ExternalBranch is left as
+		// set by the reconciler when the changelist was shelved.
+		c.Metadata = pr
+		c.ExternalID = pr.ID
+		c.ExternalServiceType = extsvc.TypePerforce
+		// Perforce does not record when a changelist was last updated, so we
+		// set the creation time.
+		c.ExternalUpdatedAt = pr.CreationDate
+		c.ExternalForkNamespace = ""
+		c.ExternalForkName = ""
 
 	default:
 		return errors.New("unknown changeset type")
@@ -481,6 +503,10 @@ func (c *Changeset) Title() (string, error) {
 		return m.Title, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.Title, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Subject, nil
+	case *perforce.Changelist:
+		return m.Title, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -502,6 +528,10 @@ func (c *Changeset) AuthorName() (string, error) {
 		return m.Author.Username, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.CreatedBy.UniqueName, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Owner.Username, nil
+	case *perforce.Changelist:
+		return m.Author, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -531,6 +561,12 @@ func (c *Changeset) AuthorEmail() (string, error) {
 		return "", nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.CreatedBy.UniqueName, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Owner.Email, nil
+	case *perforce.Changelist:
+		// Changelists only reference the Perforce user, whose email would
+		// need to be looked up separately.
+		return "", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -551,6 +587,10 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreatedOn
 	case *adobatches.AnnotatedPullRequest:
 		return m.CreationDate
+	case *gerritbatches.AnnotatedChange:
+		return m.Created.Time
+	case *perforce.Changelist:
+		return m.CreationDate
 	default:
 		return time.Time{}
 	}
@@ -569,6 +609,11 @@ func (c *Changeset) Body() (string, error) {
 		return m.Rendered.Description.Raw, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.Description, nil
+	case *gerritbatches.AnnotatedChange:
+		return gerritChangeBody(m.Change), nil
+	case *perforce.Changelist:
+		_, body, _ := strings.Cut(m.Description, "\n")
+		return strings.TrimSpace(body), nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -633,6 +678,16 @@ func (c *Changeset) URL() (s string, err error) {
 		}
 
 		return returnURL.String(), nil
+	case *gerritbatches.AnnotatedChange:
+		u, err := url.Parse(m.CodeHostURL)
+		if err != nil {
+			return "", err
+		}
+		return u.JoinPath("c", m.Project, "+", strconv.Itoa(m.Number)).String(), nil
+	case *perforce.Changelist:
+		// Changelists have no web URL without a separately deployed web
+		// frontend such as Helix Swarm.
+		return "", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -854,6 +909,10 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return m.Source.Commit.Hash, nil
 	case *adobatches.AnnotatedPullRequest:
 		return "", nil
+	case *gerritbatches.AnnotatedChange:
+		return m.CurrentRevision, nil
+	case *perforce.Changelist:
+		return "", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -873,6 +932,10 @@ func (c *Changeset) HeadRef() (string, error) {
 		return "refs/heads/" + m.Source.Branch.Name, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.SourceRefName, nil
+	case *gerritbatches.AnnotatedChange:
+		return "refs/heads/" + m.Topic, nil
+	case *perforce.Changelist:
+		return c.ExternalBranch, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -893,6 +956,13 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return m.Destination.Commit.Hash, nil
 	case *adobatches.AnnotatedPullRequest:
 		return "", nil
+	case *gerritbatches.AnnotatedChange:
+		if rev, ok := m.Revisions[m.CurrentRevision]; ok && rev.Commit != nil && len(rev.Commit.Parents) > 0 {
+			return rev.Commit.Parents[0].Commit, nil
+		}
+		return "", nil
+	case *perforce.Changelist:
+		return "", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -912,6 +982,12 @@ func (c *Changeset) BaseRef() (string, error) {
 		return "refs/heads/" + m.Destination.Branch.Name, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.TargetRefName, nil
+	case *gerritbatches.AnnotatedChange:
+		return "refs/heads/" + m.Branch, nil
+	case *perforce.Changelist:
+		// A shelved changelist consists of a single commit on top of the
+		// depot state it was created from.
+		return c.ExternalBranch + "~1", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -1413,3 +1489,23 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 	}
 	return nil, errors.Errorf("unknown changeset event kind %q", k)
 }
+
+// gerritChangeBody returns the body of the commit message of the current patch
+// set of the change, without the subject and the Change-Id trailer.
+func gerritChangeBody(change *gerrit.Change) string {
+	rev, ok := change.Revisions[change.CurrentRevision]
+	if !ok || rev.Commit == nil {
+		return ""
+	}
+
+	_, body, _ := strings.Cut(rev.Commit.Message, "\n")
+	lines := strings.Split(body, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if strings.HasPrefix(line, "Change-Id:") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
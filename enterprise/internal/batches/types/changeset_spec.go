@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -71,6 +72,7 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		c.HeadRef = spec.HeadRef
 		c.BaseRev = spec.BaseRev
 		c.BaseRef = spec.BaseRef
+		c.StackParentRef = spec.StackParentRef
 		c.CommitMessage = commitMsg
 		c.CommitAuthorName = authorName
 		c.CommitAuthorEmail = authorEmail
@@ -107,6 +109,7 @@ type ChangesetSpec struct {
 	BaseRev           string
 	BaseRef           string
 	HeadRef           string
+	StackParentRef    string
 	Title             string
 	Body              string
 	Published         batcheslib.PublishedValue
@@ -155,6 +158,26 @@ func (cs *ChangesetSpec) computeDiffStat() error {
 	return nil
 }
 
+// ChangedPaths returns the paths of the files changed by the Diff of the
+// ChangesetSpec, in the order in which they appear in the diff.
+func (cs *ChangesetSpec) ChangedPaths() ([]string, error) {
+	fileDiffs, err := godiff.ParseMultiFileDiff(cs.Diff)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(fileDiffs))
+	for _, fileDiff := range fileDiffs {
+		// Deleted files only have an original name.
+		name := fileDiff.NewName
+		if name == "" || name == "/dev/null" {
+			name = fileDiff.OrigName
+		}
+		paths = append(paths, strings.TrimPrefix(strings.TrimPrefix(name, "b/"), "a/"))
+	}
+	return paths, nil
+}
+
 // computeForkNamespace calculates the namespace that the changeset spec will be
 // forked into, if any.
 func (cs *ChangesetSpec) computeForkNamespace() {
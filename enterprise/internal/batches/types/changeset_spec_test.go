@@ -49,3 +49,25 @@ func TestChangesetSpec_SetForkToUser(t *testing.T) {
 }
 
 func strPtr(s string) *string { return &s }
+
+func TestChangesetSpec_ChangedPaths(t *testing.T) {
+	cs := &ChangesetSpec{Diff: []byte(`diff --git a/README.md b/README.md
+index 851b23a..140f333 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1 @@
+-# Hello
++# Hello World
+diff --git a/cmd/old.go b/cmd/old.go
+deleted file mode 100644
+index 851b23a..0000000
+--- a/cmd/old.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package main
+`)}
+
+	paths, err := cs.ChangedPaths()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"README.md", "cmd/old.go"}, paths)
+}
@@ -0,0 +1,58 @@
+package types
+
+import "time"
+
+// BatchChangeRolloutState defines the possible states of a BatchChangeRollout.
+type BatchChangeRolloutState string
+
+// BatchChangeRolloutState constants.
+const (
+	BatchChangeRolloutStateActive    BatchChangeRolloutState = "ACTIVE"
+	BatchChangeRolloutStatePaused    BatchChangeRolloutState = "PAUSED"
+	BatchChangeRolloutStateCompleted BatchChangeRolloutState = "COMPLETED"
+)
+
+// Valid returns true if the given BatchChangeRolloutState is valid.
+func (s BatchChangeRolloutState) Valid() bool {
+	switch s {
+	case BatchChangeRolloutStateActive,
+		BatchChangeRolloutStatePaused,
+		BatchChangeRolloutStateCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// A BatchChangeRollout tracks the progress of publishing the changesets of a
+// batch change in the waves declared by the rollout policy of its batch spec.
+type BatchChangeRollout struct {
+	ID            int64
+	BatchChangeID int64
+
+	// Wave is the zero-based index of the wave that is currently rolled out.
+	Wave  int
+	State BatchChangeRolloutState
+
+	// PauseReason explains why the rollout was paused automatically.
+	PauseReason string
+
+	// FailuresAccepted is set when a paused rollout is resumed, so that the
+	// failures of the current wave don't pause it again.
+	FailuresAccepted bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RolloutChangeset is the assignment of a changeset to a wave of a rollout.
+type RolloutChangeset struct {
+	RolloutID   int64
+	ChangesetID int64
+	Wave        int
+
+	// MergeJobID is the changeset job that merges the changeset, if any, and
+	// MergeJobState its state.
+	MergeJobID    int64
+	MergeJobState ChangesetJobState
+}
@@ -12,6 +12,11 @@ type CodehostCapability string
 const (
 	CodehostCapabilityLabels          CodehostCapability = "Labels"
 	CodehostCapabilityDraftChangesets CodehostCapability = "DraftChangesets"
+	// CodehostCapabilityBranchStacking means that a changeset can target the
+	// head branch of another changeset, which is how stacked changesets are
+	// published. On code hosts without it, stacked changesets target the base
+	// branch and are only stacked through their commits.
+	CodehostCapabilityBranchStacking CodehostCapability = "BranchStacking"
 )
 
 type CodehostCapabilities map[CodehostCapability]bool
@@ -21,11 +26,13 @@ type CodehostCapabilities map[CodehostCapability]bool
 // whose type is not in this list will simply be filtered out from the search
 // results.
 var SupportedExternalServices = map[string]CodehostCapabilities{
-	extsvc.TypeGitHub:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
-	extsvc.TypeBitbucketServer: {},
-	extsvc.TypeGitLab:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
-	extsvc.TypeBitbucketCloud:  {},
-	extsvc.TypeAzureDevOps:     {CodehostCapabilityDraftChangesets: true},
+	extsvc.TypeGitHub:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true, CodehostCapabilityBranchStacking: true},
+	extsvc.TypeBitbucketServer: {CodehostCapabilityBranchStacking: true},
+	extsvc.TypeGitLab:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true, CodehostCapabilityBranchStacking: true},
+	extsvc.TypeBitbucketCloud:  {CodehostCapabilityBranchStacking: true},
+	extsvc.TypeAzureDevOps:     {CodehostCapabilityDraftChangesets: true, CodehostCapabilityBranchStacking: true},
+	extsvc.TypeGerrit:          {CodehostCapabilityDraftChangesets: true},
+	extsvc.TypePerforce:        {},
 }
 
 // IsRepoSupported returns whether the given ExternalRepoSpec is supported by
@@ -0,0 +1,160 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestCSharpGenerator(t *testing.T) {
+	expectedIndexerImage := "my.registry/scip-dotnet@sha256:0123"
+	mockIndexerMap(t, map[string]string{"csharp": expectedIndexerImage})
+
+	job := func(root, file string) config.IndexJob {
+		return csharpIndexJob(expectedIndexerImage, root, file)
+	}
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "single project",
+			repositoryContents: map[string]string{
+				"App.csproj": "",
+				"Program.cs": "",
+			},
+			expected: []config.IndexJob{
+				job("", "App.csproj"),
+			},
+		},
+		generatorTestCase{
+			description: "solution with nested projects",
+			repositoryContents: map[string]string{
+				"Acme.sln":                           "",
+				"src/Acme.Web/Acme.Web.csproj":       "",
+				"src/Acme.Core/Acme.Core.csproj":     "",
+				"src/Acme.Core/bin/Debug/Gen.csproj": "",
+			},
+			expected: []config.IndexJob{
+				job("", "Acme.sln"),
+			},
+		},
+		generatorTestCase{
+			description: "solution referencing sibling projects",
+			repositoryContents: map[string]string{
+				"build/Acme.sln": `
+Microsoft Visual Studio Solution File, Format Version 12.00
+Project("{FAE04EC0-301F-11D3-BF4B-00C04F79EFBC}") = "Acme.Core", "..\src\Acme.Core\Acme.Core.csproj", "{6F3A7C9E-0C4B-4E0A-9B8E-2D0B6F0C1A11}"
+EndProject
+Project("{FAE04EC0-301F-11D3-BF4B-00C04F79EFBC}") = "Acme.Web", "..\src\Acme.Web\Acme.Web.csproj", "{0E2C4F4B-6A53-4B3E-8F61-1C2F7C5E2B22}"
+EndProject
+`,
+				"src/Acme.Core/Acme.Core.csproj": "",
+				"src/Acme.Web/Acme.Web.csproj":   "",
+				"tools/Migrator/Migrator.csproj": "",
+			},
+			expected: []config.IndexJob{
+				job("build", "Acme.sln"),
+				job("tools/Migrator", "Migrator.csproj"),
+			},
+		},
+		generatorTestCase{
+			description: "multiple solutions",
+			repositoryContents: map[string]string{
+				"Acme.sln":                 "",
+				"Acme.Legacy.sln":          "",
+				"samples/Demo/Demo.sln":    "",
+				"samples/Demo/Demo.csproj": "",
+			},
+			expected: []config.IndexJob{
+				job("", "Acme.Legacy.sln"),
+				job("samples/Demo", "Demo.sln"),
+			},
+		},
+	)
+}
+
+func TestCSharpHinter(t *testing.T) {
+	expectedIndexerImage := "my.registry/scip-dotnet@sha256:0123"
+	mockIndexerMap(t, map[string]string{"csharp": expectedIndexerImage})
+
+	testHinters(t,
+		hinterTestCase{
+			description: "basic hints",
+			repositoryContents: map[string]string{
+				"Acme.sln":                          "",
+				"src/Acme.Web/Acme.Web.csproj":      "",
+				"test/Acme.Tests/Acme.Tests.csproj": "",
+			},
+			expected: []config.IndexJobHint{
+				{
+					Root:           "",
+					Indexer:        expectedIndexerImage,
+					HintConfidence: config.HintConfidenceProjectStructureSupported,
+				},
+				{
+					Root:           "src/Acme.Web",
+					Indexer:        expectedIndexerImage,
+					HintConfidence: config.HintConfidenceProjectStructureSupported,
+				},
+			},
+		},
+	)
+}
+
+func TestCSharpDefaultIndexer(t *testing.T) {
+	// No indexerMap is configured, so the default scip-dotnet image is used once it
+	// is pinned to a digest, and nothing is inferred until then
+	repositoryContents := map[string]string{
+		"Acme.sln":                     "",
+		"src/Acme.Web/Acme.Web.csproj": "",
+	}
+
+	expectedJobs := []config.IndexJob{}
+	expectedHints := []config.IndexJobHint{}
+	if expectedIndexerImage, ok := libs.DefaultIndexerForLang("csharp"); ok {
+		expectedJobs = []config.IndexJob{
+			csharpIndexJob(expectedIndexerImage, "", "Acme.sln"),
+		}
+		expectedHints = []config.IndexJobHint{
+			{
+				Root:           "",
+				Indexer:        expectedIndexerImage,
+				HintConfidence: config.HintConfidenceProjectStructureSupported,
+			},
+			{
+				Root:           "src/Acme.Web",
+				Indexer:        expectedIndexerImage,
+				HintConfidence: config.HintConfidenceProjectStructureSupported,
+			},
+		}
+	}
+
+	testGenerators(t, generatorTestCase{
+		description:        "default indexer",
+		repositoryContents: repositoryContents,
+		expected:           expectedJobs,
+	})
+	testHinters(t, hinterTestCase{
+		description:        "default indexer",
+		repositoryContents: repositoryContents,
+		expected:           expectedHints,
+	})
+}
+
+func csharpIndexJob(indexerImage, root, file string) config.IndexJob {
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    indexerImage,
+				Commands: []string{"dotnet restore " + file},
+			},
+		},
+		LocalSteps:       nil,
+		Root:             root,
+		Indexer:          indexerImage,
+		IndexerArgs:      []string{"scip-dotnet", "index", file},
+		Outfile:          "index.scip",
+		RequestedEnvVars: []string{"NUGET_AUTH_TOKEN"},
+	}
+}
@@ -37,6 +37,62 @@ func TestJavaGenerator(t *testing.T) {
 			},
 			expected: []config.IndexJob{},
 		},
+		generatorTestCase{
+			description: "lsif-java.json takes precedence over build files",
+			repositoryContents: map[string]string{
+				"lsif-java.json": "",
+				"build.gradle":   "",
+				"build.sbt":      "",
+			},
+			expected: []config.IndexJob{
+				{
+					Steps:       nil,
+					LocalSteps:  nil,
+					Root:        "",
+					Indexer:     expectedIndexerImage,
+					IndexerArgs: []string{"scip-java", "index", "--build-tool=scip"},
+					Outfile:     "index.scip",
+				},
+			},
+		},
+		generatorTestCase{
+			description: "gradle and sbt multi-project builds",
+			repositoryContents: map[string]string{
+				"settings.gradle.kts":                 "",
+				"build.gradle.kts":                    "",
+				"buildSrc/build.gradle.kts":           "",
+				"app/build.gradle.kts":                "",
+				"app/src/main/kotlin/App.kt":          "",
+				"lib/build.gradle":                    "",
+				"services/api/build.sbt":              "",
+				"services/api/core/build.sbt":         "",
+				"services/api/core/src/Core.scala":    "",
+				"services/worker/build.gradle":        "",
+				"services/worker/settings.gradle":     "",
+				"services/worker/src/Worker.java":     "",
+				"examples/hello/build.sbt":            "",
+				"services/api/project/plugins.sbt":    "",
+				"services/api/target/scala/build.sbt": "",
+			},
+			expected: []config.IndexJob{
+				{
+					Steps:       nil,
+					LocalSteps:  nil,
+					Root:        "",
+					Indexer:     expectedIndexerImage,
+					IndexerArgs: []string{"scip-java", "index", "--build-tool=gradle"},
+					Outfile:     "index.scip",
+				},
+				{
+					Steps:       nil,
+					LocalSteps:  nil,
+					Root:        "services/api",
+					Indexer:     expectedIndexerImage,
+					IndexerArgs: []string{"scip-java", "index", "--build-tool=sbt"},
+					Outfile:     "index.scip",
+				},
+			},
+		},
 	)
 }
 
@@ -0,0 +1,104 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestPHPGenerator(t *testing.T) {
+	expectedIndexerImage := "my.registry/scip-php@sha256:4567"
+	mockIndexerMap(t, map[string]string{"php": expectedIndexerImage})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "scip-php",
+			repositoryContents: map[string]string{
+				"composer.json":                     "",
+				"src/Kernel.php":                    "",
+				"packages/billing/composer.json":    "",
+				"vendor/symfony/http/composer.json": "",
+				"tests/fixtures/composer.json":      "",
+			},
+			expected: []config.IndexJob{
+				phpIndexJob(expectedIndexerImage, ""),
+				phpIndexJob(expectedIndexerImage, "packages/billing"),
+			},
+		},
+	)
+}
+
+func TestPHPHinter(t *testing.T) {
+	expectedIndexerImage := "my.registry/scip-php@sha256:4567"
+	mockIndexerMap(t, map[string]string{"php": expectedIndexerImage})
+
+	testHinters(t,
+		hinterTestCase{
+			description: "basic hints",
+			repositoryContents: map[string]string{
+				"app/composer.json":        "",
+				"vendor/a/b/composer.json": "",
+			},
+			expected: []config.IndexJobHint{
+				{
+					Root:           "app",
+					Indexer:        expectedIndexerImage,
+					HintConfidence: config.HintConfidenceProjectStructureSupported,
+				},
+			},
+		},
+	)
+}
+
+func TestPHPDefaultIndexer(t *testing.T) {
+	// No indexerMap is configured, so the default scip-php image is used once it is
+	// pinned to a digest, and nothing is inferred until then
+	repositoryContents := map[string]string{
+		"composer.json":  "",
+		"src/Kernel.php": "",
+	}
+
+	expectedJobs := []config.IndexJob{}
+	expectedHints := []config.IndexJobHint{}
+	if expectedIndexerImage, ok := libs.DefaultIndexerForLang("php"); ok {
+		expectedJobs = []config.IndexJob{
+			phpIndexJob(expectedIndexerImage, ""),
+		}
+		expectedHints = []config.IndexJobHint{
+			{
+				Root:           "",
+				Indexer:        expectedIndexerImage,
+				HintConfidence: config.HintConfidenceProjectStructureSupported,
+			},
+		}
+	}
+
+	testGenerators(t, generatorTestCase{
+		description:        "default indexer",
+		repositoryContents: repositoryContents,
+		expected:           expectedJobs,
+	})
+	testHinters(t, hinterTestCase{
+		description:        "default indexer",
+		repositoryContents: repositoryContents,
+		expected:           expectedHints,
+	})
+}
+
+func phpIndexJob(indexerImage, root string) config.IndexJob {
+	return config.IndexJob{
+		Steps: []config.DockerStep{
+			{
+				Root:     root,
+				Image:    indexerImage,
+				Commands: []string{"composer install --no-interaction --no-scripts --ignore-platform-reqs"},
+			},
+		},
+		Root:             root,
+		Indexer:          indexerImage,
+		IndexerArgs:      []string{"scip-php"},
+		Outfile:          "index.scip",
+		RequestedEnvVars: []string{"COMPOSER_AUTH"},
+	}
+}
@@ -17,8 +17,10 @@ type indexesAPI struct{}
 
 var defaultIndexers = map[string]string{
 	"clang":      "sourcegraph/lsif-clang",
+	"csharp":     "sourcegraph/scip-dotnet",
 	"go":         "sourcegraph/lsif-go",
 	"java":       "sourcegraph/scip-java",
+	"php":        "sourcegraph/scip-php",
 	"python":     "sourcegraph/scip-python",
 	"rust":       "sourcegraph/scip-rust",
 	"typescript": "sourcegraph/scip-typescript",
@@ -26,6 +28,9 @@ var defaultIndexers = map[string]string{
 }
 
 // To update, run `DOCKER_USER=... DOCKER_PASS=... ./update-shas.sh`
+//
+// An indexer with an empty SHA has not been pinned to a digest yet, and is not
+// used as a default until the script fills it in.
 var defaultIndexerSHAs = map[string]string{
 	"sourcegraph/lsif-clang":      "sha256:ea814e5ab5c6e1e6ab4d001e4f4afddcc7b44128edbeeedf1d97da553813a4c8",
 	"sourcegraph/lsif-go":         "sha256:2194d2652862966f022b537ed81bccf5a9a535ab763534cb4e98a3083c8a1bc6",
@@ -35,6 +40,8 @@ var defaultIndexerSHAs = map[string]string{
 	"sourcegraph/scip-python":     "sha256:4cb64c4f62cfa611fcb217581073c2831fb9350bbb1c8e855f152cc4b3428a00",
 	"sourcegraph/scip-typescript": "sha256:2eb90bf6d52f86608b6a723e14079eafed54121419bfd0129bf61a09e1a0f293",
 	"sourcegraph/scip-ruby":       "sha256:e553fee039973cda8726d4c8c13cdbb851f82a6fca5daa15798a595ee4042906",
+	"sourcegraph/scip-dotnet":     "",
+	"sourcegraph/scip-php":        "",
 }
 
 func DefaultIndexerForLang(language string) (string, bool) {
@@ -47,6 +54,9 @@ func DefaultIndexerForLang(language string) (string, bool) {
 	if !ok {
 		panic(fmt.Sprintf("no SHA set for indexer %q", indexer))
 	}
+	if sha == "" {
+		return "", false
+	}
 
 	return fmt.Sprintf("%s@%s", indexer, sha), true
 }
@@ -5,7 +5,7 @@ set -u
 DOCKER_USER=${DOCKER_USER:?"No DOCKER_USER is set."}
 DOCKER_PASS=${DOCKER_PASS:?"No DOCKER_PASS is set."}
 
-for indexer in lsif-clang lsif-go lsif-rust scip-rust scip-java scip-python scip-typescript scip-ruby; do
+for indexer in lsif-clang lsif-go lsif-rust scip-rust scip-java scip-python scip-typescript scip-ruby scip-dotnet scip-php; do
   tag="latest"
   if [[ "${indexer}" = "scip-python" ]] || [[ "${indexer}" = "scip-typescript" || "${indexer}" = "scip-ruby" ]]; then
     tag="autoindex"
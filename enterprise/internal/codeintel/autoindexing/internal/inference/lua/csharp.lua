@@ -0,0 +1,153 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+-- The default scip-dotnet image is only used once it is pinned to a digest in
+-- libs/indexes.go. Until then, C# projects are only indexed once an image is
+-- configured in codeIntelAutoIndexing.indexerMap.
+local has_indexer, indexer = pcall(require("sg.autoindex.indexes").get, "csharp")
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine(shared.exclude_paths, {
+  pattern.new_path_segment "bin",
+  pattern.new_path_segment "obj",
+})
+
+--- Solution files reference their projects by a path relative to the solution:
+---
+--- Project("{FAE04EC0-301F-11D3-BF4B-00C04F79EFBC}") = "App", "src\App\App.csproj", "{...}"
+local get_project_paths_from_content = function(sln_path, content)
+  local project_paths = {}
+  for project_path in string.gmatch(content or "", 'Project%b()%s*=%s*"[^"]*"%s*,%s*"([^"]*%.csproj)"') do
+    table.insert(project_paths, path.join(path.dirname(sln_path), (string.gsub(project_path, "\\", "/"))))
+  end
+
+  return project_paths
+end
+
+local new_job = function(project_file)
+  local root = path.dirname(project_file)
+  local base = path.basename(project_file)
+
+  return {
+    steps = {
+      {
+        root = root,
+        image = indexer,
+        commands = { "dotnet restore " .. base },
+      },
+    },
+    root = root,
+    indexer = indexer,
+    indexer_args = { "scip-dotnet", "index", base },
+    outfile = outfile,
+    requested_envvars = { "NUGET_AUTH_TOKEN" },
+  }
+end
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_extension "csproj",
+    pattern.new_path_extension "sln",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  patterns_for_content = {
+    -- To determine which projects are already covered by a solution
+    pattern.new_path_extension "sln",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when .csproj or .sln files exist
+  generate = function(_, paths, contents_by_path)
+    if not has_indexer then
+      return {}
+    end
+
+    local jobs = {}
+    local solution_dirs = {}
+    local covered_projects = {}
+
+    local sln_paths = {}
+    local project_paths = {}
+    table.sort(paths)
+    for i = 1, #paths do
+      if paths[i]:match "%.sln$" then
+        table.insert(sln_paths, paths[i])
+      else
+        table.insert(project_paths, paths[i])
+      end
+    end
+
+    -- Index one solution per directory; a solution builds all of its projects
+    for _, sln_path in ipairs(sln_paths) do
+      local dir = path.dirname(sln_path)
+      if solution_dirs[dir] == nil then
+        table.insert(jobs, new_job(sln_path))
+        solution_dirs[dir] = true
+      end
+
+      for _, project_path in ipairs(get_project_paths_from_content(sln_path, contents_by_path[sln_path])) do
+        covered_projects[project_path] = true
+      end
+    end
+
+    local is_covered = function(project_path)
+      if covered_projects[project_path] then
+        return true
+      end
+
+      -- Projects nested under a solution directory are assumed to belong to it
+      local ancestors = path.ancestors(project_path)
+      for i = 1, #ancestors do
+        if solution_dirs[ancestors[i]] then
+          return true
+        end
+      end
+
+      return false
+    end
+
+    -- Index each remaining project that does not belong to any solution
+    local project_dirs = {}
+    for _, project_path in ipairs(project_paths) do
+      if not is_covered(project_path) then
+        local dir = path.dirname(project_path)
+        if project_dirs[dir] == nil then
+          table.insert(jobs, new_job(project_path))
+          project_dirs[dir] = true
+        end
+      end
+    end
+
+    return jobs
+  end,
+
+  -- Invoked when .csproj or .sln files exist
+  hints = function(_, paths)
+    if not has_indexer then
+      return {}
+    end
+
+    local hints = {}
+    local visited = {}
+
+    for i = 1, #paths do
+      local dir = path.dirname(paths[i])
+
+      if visited[dir] == nil then
+        table.insert(hints, {
+          root = dir,
+          indexer = indexer,
+          confidence = "PROJECT_STRUCTURE_SUPPORTED",
+        })
+
+        visited[dir] = true
+      end
+    end
+
+    return hints
+  end,
+}
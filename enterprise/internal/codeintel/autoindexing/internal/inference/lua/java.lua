@@ -2,11 +2,58 @@ local path = require "path"
 local recognizer = require "sg.autoindex.recognizer"
 local pattern = require "sg.autoindex.patterns"
 
+local shared = require "sg.autoindex.shared"
+
 local indexer = require("sg.autoindex.indexes").get "java"
 local outfile = "index.scip"
 
+local exclude_paths = pattern.new_path_combine(shared.exclude_paths, {
+  pattern.new_path_segment "buildSrc",
+  pattern.new_path_segment "target",
+})
+
+local build_tools_by_file = {
+  ["build.gradle"] = "gradle",
+  ["build.gradle.kts"] = "gradle",
+  ["settings.gradle"] = "gradle",
+  ["settings.gradle.kts"] = "gradle",
+  ["build.sbt"] = "sbt",
+}
+
 local is_project_structure_supported = function(base)
-  return base == "pom.xml" or base == "build.gradle" or base == "build.gradle.kts"
+  return base == "pom.xml" or build_tools_by_file[base] ~= nil
+end
+
+-- Returns the directories containing a build file for the given build tool which
+-- are not nested in another such directory. Nested directories are the subprojects
+-- of a multi-project build and are indexed along with their root project.
+local get_build_roots = function(paths, build_tool)
+  local dirs = {}
+  for i = 1, #paths do
+    if build_tools_by_file[path.basename(paths[i])] == build_tool then
+      dirs[path.dirname(paths[i])] = true
+    end
+  end
+
+  local roots = {}
+  for dir in pairs(dirs) do
+    local is_root = true
+    if dir ~= "" then
+      local ancestors = path.ancestors(dir)
+      for i = 1, #ancestors do
+        if dirs[ancestors[i]] then
+          is_root = false
+        end
+      end
+    end
+
+    if is_root then
+      table.insert(roots, dir)
+    end
+  end
+  table.sort(roots)
+
+  return roots
 end
 
 return recognizer.new_path_recognizer {
@@ -17,31 +64,60 @@ return recognizer.new_path_recognizer {
     pattern.new_path_basename "pom.xml",
     pattern.new_path_basename "build.gradle",
     pattern.new_path_basename "build.gradle.kts",
+    pattern.new_path_basename "settings.gradle",
+    pattern.new_path_basename "settings.gradle.kts",
+    pattern.new_path_basename "build.sbt",
   },
 
-  -- Invoked when Java, Scala, Kotlin, or Gradle build files exist
+  -- Invoked when Java, Scala, Kotlin, Gradle, or sbt build files exist
   generate = function(api)
     api:register(recognizer.new_path_recognizer {
       patterns = {
         pattern.new_path_literal "lsif-java.json",
+        pattern.new_path_basename "build.gradle",
+        pattern.new_path_basename "build.gradle.kts",
+        pattern.new_path_basename "settings.gradle",
+        pattern.new_path_basename "settings.gradle.kts",
+        pattern.new_path_basename "build.sbt",
+        pattern.new_path_exclude(exclude_paths),
       },
 
-      -- Invoked when lsif-java.json exists in root of repository
-      generate = function(api, paths)
-        return {
-          steps = {},
-          root = "",
-          indexer = indexer,
-          indexer_args = { "scip-java", "index", "--build-tool=scip" },
-          outfile = outfile,
-        }
+      -- Invoked when lsif-java.json, Gradle, or sbt build files exist
+      generate = function(_, paths)
+        -- An lsif-java.json in the root of the repository explicitly configures the build
+        for i = 1, #paths do
+          if paths[i] == "lsif-java.json" then
+            return {
+              steps = {},
+              root = "",
+              indexer = indexer,
+              indexer_args = { "scip-java", "index", "--build-tool=scip" },
+              outfile = outfile,
+            }
+          end
+        end
+
+        local jobs = {}
+        for _, build_tool in ipairs { "gradle", "sbt" } do
+          for _, root in ipairs(get_build_roots(paths, build_tool)) do
+            table.insert(jobs, {
+              steps = {},
+              root = root,
+              indexer = indexer,
+              indexer_args = { "scip-java", "index", "--build-tool=" .. build_tool },
+              outfile = outfile,
+            })
+          end
+        end
+
+        return jobs
       end,
     })
 
     return {}
   end,
 
-  -- Invoked when Java, Scala, Kotlin, or Gradle build files exist
+  -- Invoked when Java, Scala, Kotlin, Gradle, or sbt build files exist
   hints = function(_, paths)
     local hints = {}
     local visited = {}
@@ -0,0 +1,70 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+-- The default scip-php image is only used once it is pinned to a digest in
+-- libs/indexes.go. Until then, PHP packages are only indexed once an image is
+-- configured in codeIntelAutoIndexing.indexerMap. The image must provide both
+-- composer and the scip-php binary.
+local has_indexer, indexer = pcall(require("sg.autoindex.indexes").get, "php")
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine(shared.exclude_paths, {
+  pattern.new_path_segment "vendor",
+})
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "composer.json",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when composer.json files exist
+  generate = function(_, paths)
+    if not has_indexer then
+      return {}
+    end
+
+    local jobs = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+
+      table.insert(jobs, {
+        steps = {
+          {
+            root = root,
+            image = indexer,
+            commands = { "composer install --no-interaction --no-scripts --ignore-platform-reqs" },
+          },
+        },
+        root = root,
+        indexer = indexer,
+        indexer_args = { "scip-php" },
+        outfile = outfile,
+        requested_envvars = { "COMPOSER_AUTH" },
+      })
+    end
+
+    return jobs
+  end,
+
+  -- Invoked when composer.json files exist
+  hints = function(_, paths)
+    if not has_indexer then
+      return {}
+    end
+
+    local hints = {}
+    for i = 1, #paths do
+      table.insert(hints, {
+        root = path.dirname(paths[i]),
+        indexer = indexer,
+        confidence = "PROJECT_STRUCTURE_SUPPORTED",
+      })
+    end
+
+    return hints
+  end,
+}
@@ -2,8 +2,10 @@ local config = require("sg.autoindex.config").new {}
 
 for _, name in ipairs {
   "clang",
+  "csharp",
   "go",
   "java",
+  "php",
   "python",
   "ruby",
   "rust",
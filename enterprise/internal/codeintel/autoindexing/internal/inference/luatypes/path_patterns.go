@@ -54,16 +54,25 @@ func FlattenPatterns(pathPatterns []*PathPattern, inverted bool) (patterns []Glo
 }
 
 // FlattenPattern returns the set of patterns matching the given inverted flag on this
-// path pattern or any of its descendants.
+// path pattern or any of its descendants. The descendants of an exclude pattern are
+// all treated as inverted.
 func FlattenPattern(pathPattern *PathPattern, inverted bool) (patterns []GlobAndPathspecPattern) {
-	if pathPattern.invert == inverted {
-		if pathPattern.pattern.Glob != "" {
-			patterns = append(patterns, pathPattern.pattern)
+	if pathPattern.invert {
+		if inverted {
+			for _, child := range pathPattern.children {
+				patterns = append(patterns, FlattenPattern(child, false)...)
+			}
 		}
 
-		for _, child := range pathPattern.children {
-			patterns = append(patterns, FlattenPattern(child, inverted)...)
-		}
+		return
+	}
+
+	if !inverted && pathPattern.pattern.Glob != "" {
+		patterns = append(patterns, pathPattern.pattern)
+	}
+
+	for _, child := range pathPattern.children {
+		patterns = append(patterns, FlattenPattern(child, inverted)...)
 	}
 
 	return
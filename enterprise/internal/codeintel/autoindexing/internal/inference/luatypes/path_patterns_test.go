@@ -0,0 +1,106 @@
+package luatypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFlattenPatterns(t *testing.T) {
+	sharedExcludePaths := NewCombinedPattern([]*PathPattern{
+		newPathSegment("example"),
+		newPathSegment("test"),
+		newPathSegment("testdata"),
+	})
+
+	testCases := []struct {
+		name             string
+		pathPatterns     []*PathPattern
+		expected         []GlobAndPathspecPattern
+		expectedInverted []GlobAndPathspecPattern
+	}{
+		{
+			name:         "single pattern",
+			pathPatterns: []*PathPattern{newPathBasename("go.mod")},
+			expected: []GlobAndPathspecPattern{
+				{Glob: "go.mod", Pathspecs: []string{"go.mod", "*/go.mod"}},
+			},
+		},
+		{
+			name: "go",
+			pathPatterns: []*PathPattern{
+				newPathBasename("go.mod"),
+				NewExcludePattern([]*PathPattern{
+					NewCombinedPattern([]*PathPattern{sharedExcludePaths, newPathSegment("vendor")}),
+				}),
+			},
+			expected: []GlobAndPathspecPattern{
+				{Glob: "go.mod", Pathspecs: []string{"go.mod", "*/go.mod"}},
+			},
+			expectedInverted: []GlobAndPathspecPattern{
+				{Glob: "example/", Pathspecs: []string{"example/*", "*/example/*"}},
+				{Glob: "test/", Pathspecs: []string{"test/*", "*/test/*"}},
+				{Glob: "testdata/", Pathspecs: []string{"testdata/*", "*/testdata/*"}},
+				{Glob: "vendor/", Pathspecs: []string{"vendor/*", "*/vendor/*"}},
+			},
+		},
+		{
+			name: "typescript",
+			pathPatterns: []*PathPattern{
+				NewCombinedPattern([]*PathPattern{
+					newPathBasename("package.json"),
+					newPathBasename("tsconfig.json"),
+				}),
+				NewExcludePattern([]*PathPattern{
+					NewCombinedPattern([]*PathPattern{sharedExcludePaths, newPathSegment("node_modules")}),
+				}),
+			},
+			expected: []GlobAndPathspecPattern{
+				{Glob: "package.json", Pathspecs: []string{"package.json", "*/package.json"}},
+				{Glob: "tsconfig.json", Pathspecs: []string{"tsconfig.json", "*/tsconfig.json"}},
+			},
+			expectedInverted: []GlobAndPathspecPattern{
+				{Glob: "example/", Pathspecs: []string{"example/*", "*/example/*"}},
+				{Glob: "test/", Pathspecs: []string{"test/*", "*/test/*"}},
+				{Glob: "testdata/", Pathspecs: []string{"testdata/*", "*/testdata/*"}},
+				{Glob: "node_modules/", Pathspecs: []string{"node_modules/*", "*/node_modules/*"}},
+			},
+		},
+		{
+			name: "exclude nested in combine",
+			pathPatterns: []*PathPattern{
+				NewCombinedPattern([]*PathPattern{
+					newPathBasename("composer.json"),
+					NewExcludePattern([]*PathPattern{newPathSegment("vendor")}),
+				}),
+			},
+			expected: []GlobAndPathspecPattern{
+				{Glob: "composer.json", Pathspecs: []string{"composer.json", "*/composer.json"}},
+			},
+			expectedInverted: []GlobAndPathspecPattern{
+				{Glob: "vendor/", Pathspecs: []string{"vendor/*", "*/vendor/*"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if diff := cmp.Diff(testCase.expected, FlattenPatterns(testCase.pathPatterns, false)); diff != "" {
+				t.Errorf("unexpected patterns (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(testCase.expectedInverted, FlattenPatterns(testCase.pathPatterns, true)); diff != "" {
+				t.Errorf("unexpected inverted patterns (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// newPathSegment mirrors pattern.new_path_segment in lua/patterns.lua.
+func newPathSegment(segment string) *PathPattern {
+	return NewPattern(segment+"/", []string{segment + "/*", "*/" + segment + "/*"})
+}
+
+// newPathBasename mirrors pattern.new_path_basename in lua/patterns.lua.
+func newPathBasename(basename string) *PathPattern {
+	return NewPattern(basename, []string{basename, "*/" + basename})
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestEmptyGenerators(t *testing.T) {
@@ -133,6 +135,12 @@ type generatorTestCase struct {
 	expected           []config.IndexJob
 }
 
+// mockIndexerMap configures codeIntelAutoIndexing.indexerMap for the duration of the test.
+func mockIndexerMap(t *testing.T, indexerMap map[string]string) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{CodeIntelAutoIndexingIndexerMap: indexerMap}})
+	t.Cleanup(func() { conf.Mock(nil) })
+}
+
 func testGenerators(t *testing.T, testCases ...generatorTestCase) {
 	for _, testCase := range testCases {
 		testGenerator(t, testCase)
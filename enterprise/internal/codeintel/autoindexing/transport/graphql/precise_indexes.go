@@ -271,12 +271,12 @@ func (r *rootResolver) PreciseIndexByID(ctx context.Context, id graphql.ID) (_ r
 	return nil, errors.New("invalid identifier")
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) DeletePreciseIndex(ctx context.Context, args *struct{ ID graphql.ID }) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.deletePreciseIndex.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 
@@ -297,12 +297,12 @@ func (r *rootResolver) DeletePreciseIndex(ctx context.Context, args *struct{ ID
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) DeletePreciseIndexes(ctx context.Context, args *resolverstubs.DeletePreciseIndexesArgs) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.deletePreciseIndexes.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 
@@ -362,12 +362,12 @@ func (r *rootResolver) DeletePreciseIndexes(ctx context.Context, args *resolvers
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) ReindexPreciseIndex(ctx context.Context, args *struct{ ID graphql.ID }) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.reindexPreciseIndex.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 
@@ -388,12 +388,12 @@ func (r *rootResolver) ReindexPreciseIndex(ctx context.Context, args *struct{ ID
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) ReindexPreciseIndexes(ctx context.Context, args *resolverstubs.ReindexPreciseIndexesArgs) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.reindexPreciseIndexes.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 
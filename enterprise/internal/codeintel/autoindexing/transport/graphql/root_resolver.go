@@ -78,14 +78,14 @@ func (r *rootResolver) IndexConfiguration(ctx context.Context, id graphql.ID) (_
 	return NewIndexConfigurationResolver(r.autoindexSvc, r.siteAdminChecker, int(repositoryID), traceErrs), nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence index data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence index data
 func (r *rootResolver) DeleteLSIFIndex(ctx context.Context, args *struct{ ID graphql.ID }) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.deleteLsifIndex.With(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("indexID", string(args.ID)),
 	}})
 	defer endObservation(1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
@@ -104,12 +104,12 @@ func (r *rootResolver) DeleteLSIFIndex(ctx context.Context, args *struct{ ID gra
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) DeleteLSIFIndexes(ctx context.Context, args *resolverstubs.DeleteLSIFIndexesArgs) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.deleteLsifIndexes.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
@@ -127,14 +127,14 @@ func (r *rootResolver) DeleteLSIFIndexes(ctx context.Context, args *resolverstub
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence index data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence index data
 func (r *rootResolver) ReindexLSIFIndex(ctx context.Context, args *struct{ ID graphql.ID }) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.reindexLsifIndex.With(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("indexID", string(args.ID)),
 	}})
 	defer endObservation(1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
@@ -153,12 +153,12 @@ func (r *rootResolver) ReindexLSIFIndex(ctx context.Context, args *struct{ ID gr
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) ReindexLSIFIndexes(ctx context.Context, args *resolverstubs.ReindexLSIFIndexesArgs) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.reindexLsifIndexes.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
@@ -240,14 +240,14 @@ func (r *rootResolver) LSIFIndexesByRepo(ctx context.Context, args *resolverstub
 	return sharedresolvers.NewIndexConnectionResolver(r.autoindexSvc, r.uploadSvc, r.policySvc, r.siteAdminChecker, r.repoStore, indexConnectionResolver, r.prefetcherFactory.Create(), r.locationResolverFactory.Create(), traceErrs), nil
 }
 
-// 🚨 SECURITY: Only site admins may infer auto-index jobs
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may infer auto-index jobs
 func (r *rootResolver) InferAutoIndexJobsForRepo(ctx context.Context, args *resolverstubs.InferAutoIndexJobsForRepoArgs) (_ []resolverstubs.AutoIndexJobDescriptionResolver, err error) {
 	ctx, _, endObservation := r.operations.inferAutoIndexJobsForRepo.WithErrors(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("repoID", string(args.Repository)),
 	}})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
@@ -318,14 +318,14 @@ func (r *autoIndexJobDescriptionResolver) Steps() resolverstubs.IndexStepsResolv
 	})
 }
 
-// 🚨 SECURITY: Only site admins may queue auto-index jobs
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may queue auto-index jobs
 func (r *rootResolver) QueueAutoIndexJobsForRepo(ctx context.Context, args *resolverstubs.QueueAutoIndexJobsForRepoArgs) (_ []resolverstubs.LSIFIndexResolver, err error) {
 	ctx, traceErrs, endObservation := r.operations.queueAutoIndexJobsForRepo.WithErrors(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("repoID", string(args.Repository)),
 	}})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
@@ -360,14 +360,14 @@ func (r *rootResolver) QueueAutoIndexJobsForRepo(ctx context.Context, args *reso
 	return lsifIndexResolvers, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence indexing configuration
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence indexing configuration
 func (r *rootResolver) UpdateRepositoryIndexConfiguration(ctx context.Context, args *resolverstubs.UpdateRepositoryIndexConfigurationArgs) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.updateIndexConfiguration.With(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("repoID", string(args.Repository)),
 	}})
 	defer endObservation(1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 	if !autoIndexingEnabled() {
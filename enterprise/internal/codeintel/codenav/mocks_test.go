@@ -2364,7 +2364,7 @@ func NewMockUploadService() *MockUploadService {
 			},
 		},
 		GetUploadIDsWithReferencesFunc: &UploadServiceGetUploadIDsWithReferencesFunc{
-			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) (r0 []int, r1 int, r2 int, r3 error) {
+			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) (r0 []int, r1 error) {
 				return
 			},
 		},
@@ -2391,7 +2391,7 @@ func NewStrictMockUploadService() *MockUploadService {
 			},
 		},
 		GetUploadIDsWithReferencesFunc: &UploadServiceGetUploadIDsWithReferencesFunc{
-			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error) {
+			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error) {
 				panic("unexpected invocation of MockUploadService.GetUploadIDsWithReferences")
 			},
 		},
@@ -2647,24 +2647,24 @@ func (c UploadServiceGetDumpsWithDefinitionsForMonikersFuncCall) Results() []int
 // the GetUploadIDsWithReferences method of the parent MockUploadService
 // instance is invoked.
 type UploadServiceGetUploadIDsWithReferencesFunc struct {
-	defaultHook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error)
-	hooks       []func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error)
+	defaultHook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error)
+	hooks       []func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error)
 	history     []UploadServiceGetUploadIDsWithReferencesFuncCall
 	mutex       sync.Mutex
 }
 
 // GetUploadIDsWithReferences delegates to the next hook function in the
 // queue and stores the parameter and result values of this invocation.
-func (m *MockUploadService) GetUploadIDsWithReferences(v0 context.Context, v1 []precise.QualifiedMonikerData, v2 []int, v3 int, v4 string, v5 int, v6 int) ([]int, int, int, error) {
-	r0, r1, r2, r3 := m.GetUploadIDsWithReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
-	m.GetUploadIDsWithReferencesFunc.appendCall(UploadServiceGetUploadIDsWithReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2, r3})
-	return r0, r1, r2, r3
+func (m *MockUploadService) GetUploadIDsWithReferences(v0 context.Context, v1 []precise.QualifiedMonikerData, v2 []int, v3 int, v4 string, v5 int, v6 int) ([]int, error) {
+	r0, r1 := m.GetUploadIDsWithReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.GetUploadIDsWithReferencesFunc.appendCall(UploadServiceGetUploadIDsWithReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // GetUploadIDsWithReferences method of the parent MockUploadService
 // instance is invoked and the hook queue is empty.
-func (f *UploadServiceGetUploadIDsWithReferencesFunc) SetDefaultHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error)) {
+func (f *UploadServiceGetUploadIDsWithReferencesFunc) SetDefaultHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error)) {
 	f.defaultHook = hook
 }
 
@@ -2673,7 +2673,7 @@ func (f *UploadServiceGetUploadIDsWithReferencesFunc) SetDefaultHook(hook func(c
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *UploadServiceGetUploadIDsWithReferencesFunc) PushHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error)) {
+func (f *UploadServiceGetUploadIDsWithReferencesFunc) PushHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2681,20 +2681,20 @@ func (f *UploadServiceGetUploadIDsWithReferencesFunc) PushHook(hook func(context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *UploadServiceGetUploadIDsWithReferencesFunc) SetDefaultReturn(r0 []int, r1 int, r2 int, r3 error) {
-	f.SetDefaultHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error) {
-		return r0, r1, r2, r3
+func (f *UploadServiceGetUploadIDsWithReferencesFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *UploadServiceGetUploadIDsWithReferencesFunc) PushReturn(r0 []int, r1 int, r2 int, r3 error) {
-	f.PushHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error) {
-		return r0, r1, r2, r3
+func (f *UploadServiceGetUploadIDsWithReferencesFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error) {
+		return r0, r1
 	})
 }
 
-func (f *UploadServiceGetUploadIDsWithReferencesFunc) nextHook() func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, int, int, error) {
+func (f *UploadServiceGetUploadIDsWithReferencesFunc) nextHook() func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2755,13 +2755,7 @@ type UploadServiceGetUploadIDsWithReferencesFuncCall struct {
 	Result0 []int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 int
-	// Result3 is the value of the 4th result returned from this method
-	// invocation.
-	Result3 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
@@ -2773,7 +2767,7 @@ func (c UploadServiceGetUploadIDsWithReferencesFuncCall) Args() []interface{} {
 // Results returns an interface slice containing the results of this
 // invocation.
 func (c UploadServiceGetUploadIDsWithReferencesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // UploadServiceInferClosestUploadsFunc describes the behavior when the
@@ -420,7 +420,7 @@ func (s *Service) getPageRemoteLocations(
 	requestState RequestState,
 ) ([]shared.Location, bool, error) {
 	for len(cursor.UploadBatchIDs) == 0 {
-		if cursor.UploadOffset < 0 {
+		if cursor.AfterUploadID < 0 {
 			// No more batches
 			return nil, false, nil
 		}
@@ -431,25 +431,26 @@ func (s *Service) getPageRemoteLocations(
 		}
 
 		// Find the next batch of indexes to perform a moniker search over
-		referenceUploadIDs, recordsScanned, totalRecords, err := s.uploadSvc.GetUploadIDsWithReferences(
+		referenceUploadIDs, err := s.uploadSvc.GetUploadIDsWithReferences(
 			ctx,
 			orderedMonikers,
 			ignoreIDs,
 			args.RepositoryID,
 			args.Commit,
 			requestState.maximumIndexesPerMonikerSearch,
-			cursor.UploadOffset,
+			cursor.AfterUploadID,
 		)
 		if err != nil {
 			return nil, false, err
 		}
 
 		cursor.UploadBatchIDs = referenceUploadIDs
-		cursor.UploadOffset += recordsScanned
 
-		if cursor.UploadOffset >= totalRecords {
+		if len(referenceUploadIDs) == 0 || len(referenceUploadIDs) < requestState.maximumIndexesPerMonikerSearch {
 			// Signal no batches remaining
-			cursor.UploadOffset = -1
+			cursor.AfterUploadID = -1
+		} else {
+			cursor.AfterUploadID = referenceUploadIDs[len(referenceUploadIDs)-1]
 		}
 	}
 
@@ -490,7 +491,7 @@ func (s *Service) getPageRemoteLocations(
 	// We have another page if we still have results in the current batch of reference indexes, or if
 	// we can query a next batch of reference indexes. We may return true here when we are actually
 	// out of references. This behavior may change in the future.
-	hasAnotherPage := len(cursor.UploadBatchIDs) > 0 || cursor.UploadOffset >= 0
+	hasAnotherPage := len(cursor.UploadBatchIDs) > 0 || cursor.AfterUploadID >= 0
 
 	return filtered, hasAnotherPage, nil
 }
@@ -35,7 +35,7 @@ func TestImplementations(t *testing.T) {
 	mockRequestState.SetLocalGitTreeTranslator(mockGitServer, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
 
 	// Empty result set (prevents nil pointer as scanner is always non-nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, 0, 0, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, nil)
 
 	locations := []shared.Location{
 		{DumpID: 51, Path: "a.go", Range: testRange1},
@@ -99,7 +99,7 @@ func TestImplementationsWithSubRepoPermissions(t *testing.T) {
 	mockRequestState.SetLocalGitTreeTranslator(mockGitServer, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
 
 	// Empty result set (prevents nil pointer as scanner is always non-nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, 0, 0, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, nil)
 
 	locations := []shared.Location{
 		{DumpID: 51, Path: "a.go", Range: testRange1},
@@ -198,8 +198,9 @@ func TestImplementationsRemote(t *testing.T) {
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[:2], nil)
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[2:], nil)
 
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, 0, 4, nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, 0, 2, nil)
+	mockRequestState.SetMaximumIndexesPerMonikerSearch(2)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, nil)
 
 	// upload #150/#250's commits no longer exists; all others do
 	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []codeintelgitserver.RepositoryCommit) (exists []bool, _ error) {
@@ -386,8 +387,9 @@ func TestImplementationsRemoteWithSubRepoPermissions(t *testing.T) {
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[:2], nil)
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[2:], nil)
 
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, 0, 4, nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, 0, 2, nil)
+	mockRequestState.SetMaximumIndexesPerMonikerSearch(2)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, nil)
 
 	// upload #150/#250's commits no longer exists; all others do
 	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []codeintelgitserver.RepositoryCommit) (exists []bool, _ error) {
@@ -54,7 +54,7 @@ func TestReferences(t *testing.T) {
 	mockRequestState.SetUploadsDataLoader(uploads)
 
 	// Empty result set (prevents nil pointer as scanner is always non-nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, 0, 0, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, nil)
 
 	locations := []shared.Location{
 		{DumpID: 51, Path: "a.go", Range: testRange1},
@@ -131,7 +131,7 @@ func TestReferencesWithSubRepoPermissions(t *testing.T) {
 	mockRequestState.SetAuthChecker(checker)
 
 	// Empty result set (prevents nil pointer as scanner is always non-nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, 0, 0, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{}, nil)
 
 	locations := []shared.Location{
 		{DumpID: 51, Path: "a.go", Range: testRange1},
@@ -210,8 +210,9 @@ func TestReferencesRemote(t *testing.T) {
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[:2], nil)
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[2:], nil)
 
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, 0, 4, nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, 0, 2, nil)
+	mockRequestState.SetMaximumIndexesPerMonikerSearch(2)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, nil)
 
 	// upload #150/#250's commits no longer exists; all others do
 	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []codeintelgitserver.RepositoryCommit) (exists []bool, _ error) {
@@ -312,6 +313,17 @@ func TestReferencesRemote(t *testing.T) {
 		}
 	}
 
+	if history := mockUploadSvc.GetUploadIDsWithReferencesFunc.History(); len(history) != 3 {
+		t.Fatalf("unexpected call count for uploadSvc.GetUploadIDsWithReferences. want=%d have=%d", 3, len(history))
+	} else {
+		// Each batch must resume after the last upload of the previous batch
+		for i, expectedAfterUploadID := range []int{0, 251, 253} {
+			if history[i].Arg6 != expectedAfterUploadID {
+				t.Errorf("unexpected upload cursor for batch %d. want=%d have=%d", i, expectedAfterUploadID, history[i].Arg6)
+			}
+		}
+	}
+
 	if history := mockLsifStore.GetBulkMonikerLocationsFunc.History(); len(history) != 3 {
 		t.Fatalf("unexpected call count for lsifstore.BulkMonikerResults. want=%d have=%d", 3, len(history))
 	} else {
@@ -399,8 +411,9 @@ func TestReferencesRemoteWithSubRepoPermissions(t *testing.T) {
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[:2], nil)
 	mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads[2:], nil)
 
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, 0, 4, nil)
-	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, 0, 2, nil)
+	mockRequestState.SetMaximumIndexesPerMonikerSearch(2)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250, 251}, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{252, 253}, nil)
 
 	// upload #150/#250's commits no longer exists; all others do
 	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []codeintelgitserver.RepositoryCommit) (exists []bool, _ error) {
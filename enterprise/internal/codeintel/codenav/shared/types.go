@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"encoding/json"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/types"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
@@ -95,10 +97,52 @@ type LocalCursor struct {
 	LocationOffset int `json:"locationOffset"`
 }
 
-// RemoteCursor is an upload offset, the current batch of uploads, and a location offset within the batch of uploads.
+// RemoteCursor is the last upload of the previous batch, the current batch of uploads, and a location offset
+// within the batch of uploads.
 type RemoteCursor struct {
-	UploadOffset   int   `json:"batchOffset"`
+	// The identifier of the last upload in the previous batch. Batches are ordered by upload identifier
+	// so that uploads processed or deleted while paginating do not shift the remaining pages. A negative
+	// value indicates that there are no batches remaining.
+	AfterUploadID  int   `json:"afterUploadID"`
 	UploadBatchIDs []int `json:"uploadBatchIDs"`
 	// The location offset within the associated batch of uploads.
 	LocationOffset int `json:"locationOffset"`
 }
+
+// UnmarshalJSON decodes a remote cursor. Cursors encoded before batches were ordered by upload
+// identifier store an offset into the moniker search results as batchOffset instead, and are
+// translated so that pagination continues after the current batch of uploads.
+func (c *RemoteCursor) UnmarshalJSON(data []byte) error {
+	type remoteCursor RemoteCursor
+	var decoded struct {
+		remoteCursor
+		BatchOffset *int `json:"batchOffset"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*c = RemoteCursor(decoded.remoteCursor)
+
+	if decoded.BatchOffset == nil {
+		return nil
+	}
+
+	switch {
+	case *decoded.BatchOffset < 0:
+		// No batches remaining
+		c.AfterUploadID = -1
+	case len(c.UploadBatchIDs) > 0:
+		// Batches were also ordered by upload identifier, so the next batch
+		// starts after the last upload of the current one.
+		for _, id := range c.UploadBatchIDs {
+			if id > c.AfterUploadID {
+				c.AfterUploadID = id
+			}
+		}
+	default:
+		// The uploads of the previous batch are unknown, so start over. This
+		// may return references again, but doesn't skip any.
+		c.AfterUploadID = 0
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/codenav/shared"
+)
+
+func TestReferencesCursorRoundTrip(t *testing.T) {
+	cursor := shared.ReferencesCursor{
+		Phase: "remote",
+		RemoteCursor: shared.RemoteCursor{
+			AfterUploadID:  42,
+			UploadBatchIDs: []int{43, 44},
+			LocationOffset: 5,
+		},
+	}
+
+	decoded, err := decodeReferencesCursor(encodeReferencesCursor(cursor))
+	if err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	}
+	if diff := cmp.Diff(cursor, decoded); diff != "" {
+		t.Errorf("unexpected cursor (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecodeLegacyReferencesCursor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      string
+		expected shared.RemoteCursor
+	}{
+		{
+			name:     "current batch",
+			raw:      `{"phase":"remote","remoteCursor":{"batchOffset":120,"uploadBatchIDs":[12,7,9],"locationOffset":3}}`,
+			expected: shared.RemoteCursor{AfterUploadID: 12, UploadBatchIDs: []int{12, 7, 9}, LocationOffset: 3},
+		},
+		{
+			name:     "last batch",
+			raw:      `{"phase":"remote","remoteCursor":{"batchOffset":-1,"uploadBatchIDs":[7,9],"locationOffset":3}}`,
+			expected: shared.RemoteCursor{AfterUploadID: -1, UploadBatchIDs: []int{7, 9}, LocationOffset: 3},
+		},
+		{
+			name:     "between batches",
+			raw:      `{"phase":"remote","remoteCursor":{"batchOffset":120,"uploadBatchIDs":[],"locationOffset":0}}`,
+			expected: shared.RemoteCursor{AfterUploadID: 0, UploadBatchIDs: []int{}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cursor, err := decodeReferencesCursor(base64.RawURLEncoding.EncodeToString([]byte(testCase.raw)))
+			if err != nil {
+				t.Fatalf("unexpected error decoding cursor: %s", err)
+			}
+			if diff := cmp.Diff(testCase.expected, cursor.RemoteCursor); diff != "" {
+				t.Errorf("unexpected remote cursor (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
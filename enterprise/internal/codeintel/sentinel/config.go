@@ -1,9 +1,13 @@
 package sentinel
 
 import (
+	"strings"
 	"time"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type sentinelConfig struct {
@@ -12,12 +16,39 @@ type sentinelConfig struct {
 	DownloaderInterval time.Duration
 	MatcherInterval    time.Duration
 	BatchSize          int
+
+	Sources               []string
+	ImportDir             string
+	ImportNamespace       string
+	AdvisoryFeedURL       string
+	AdvisoryFeedNamespace string
 }
 
 var ConfigInst = &sentinelConfig{}
 
+// knownSources are the advisory databases that can be enabled by name.
+var knownSources = []string{"github", "govulndb"}
+
 func (c *sentinelConfig) Load() {
 	c.DownloaderInterval = c.GetInterval("CODEINTEL_SENTINEL_DOWNLOADER_INTERVAL", "1h", "How frequently to sync the vulnerability database.")
 	c.MatcherInterval = c.GetInterval("CODEINTEL_SENTINEL_MATCHER_INTERVAL", "1s", "How frequently to match existing records against known vulnerabilities.")
 	c.BatchSize = c.GetInt("CODEINTEL_SENTINEL_BATCH_SIZE", "100", "How many precise indexes to scan at once for vulnerabilities.")
+
+	c.Sources = nil
+	for _, source := range strings.Split(c.Get("CODEINTEL_SENTINEL_SOURCES", "github", "Comma-separated list of public advisory databases to download (github, govulndb), or none (e.g. in air-gapped environments)."), ",") {
+		source = strings.TrimSpace(source)
+		if source == "" || source == "none" {
+			continue
+		}
+		if !slices.Contains(knownSources, source) {
+			c.AddError(errors.Errorf("invalid CODEINTEL_SENTINEL_SOURCES: unknown source %q", source))
+			continue
+		}
+		c.Sources = append(c.Sources, source)
+	}
+
+	c.ImportDir = c.GetOptional("CODEINTEL_SENTINEL_IMPORT_DIR", "A local directory from which OSV-format JSON files and zip archives of them are imported.")
+	c.ImportNamespace = c.Get("CODEINTEL_SENTINEL_IMPORT_NAMESPACE", "local", "The namespace of vulnerabilities imported from CODEINTEL_SENTINEL_IMPORT_DIR.")
+	c.AdvisoryFeedURL = c.GetOptional("CODEINTEL_SENTINEL_ADVISORY_FEED_URL", "The URL of an additional advisory feed serving an OSV-format JSON file or zip archive.")
+	c.AdvisoryFeedNamespace = c.Get("CODEINTEL_SENTINEL_ADVISORY_FEED_NAMESPACE", "internal", "The namespace of vulnerabilities imported from CODEINTEL_SENTINEL_ADVISORY_FEED_URL.")
 }
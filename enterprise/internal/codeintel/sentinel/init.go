@@ -34,7 +34,29 @@ func CVEScannerJob(observationCtx *observation.Context, service *Service) []goro
 	}
 
 	return []goroutine.BackgroundRoutine{
-		background.NewCVEDownloader(service.store, metrics, ConfigInst.DownloaderInterval),
+		background.NewCVEDownloader(service.store, vulnerabilitySources(), metrics, ConfigInst.DownloaderInterval),
 		background.NewCVEMatcher(service.store, metrics, ConfigInst.MatcherInterval, ConfigInst.BatchSize),
 	}
 }
+
+func vulnerabilitySources() []background.VulnerabilitySource {
+	parser := background.NewCVEParser()
+
+	var sources []background.VulnerabilitySource
+	for _, name := range ConfigInst.Sources {
+		switch name {
+		case "github":
+			sources = append(sources, background.NewGitHubAdvisorySource(parser))
+		case "govulndb":
+			sources = append(sources, background.NewGovulndbSource(parser))
+		}
+	}
+	if ConfigInst.ImportDir != "" {
+		sources = append(sources, background.NewOSVDirectorySource(parser, ConfigInst.ImportDir, ConfigInst.ImportNamespace))
+	}
+	if ConfigInst.AdvisoryFeedURL != "" {
+		sources = append(sources, background.NewOSVFeedSource(parser, ConfigInst.AdvisoryFeedURL, ConfigInst.AdvisoryFeedNamespace))
+	}
+
+	return sources
+}
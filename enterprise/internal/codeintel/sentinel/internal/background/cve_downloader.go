@@ -7,39 +7,48 @@ import (
 	logger "github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
-	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func NewCVEDownloader(store store.Store, metrics *Metrics, interval time.Duration) goroutine.BackgroundRoutine {
-	cveParser := &CVEParser{
-		store:  store,
-		logger: logger.Scoped("sentinel.parser", ""),
-	}
+func NewCVEDownloader(store store.Store, sources []VulnerabilitySource, metrics *Metrics, interval time.Duration) goroutine.BackgroundRoutine {
+	log := logger.Scoped("sentinel.downloader", "")
 
 	return goroutine.NewPeriodicGoroutine(
 		context.Background(),
-		"codeintel.sentinel-cve-downloader", "Periodically syncs GitHub advisory records into Postgres.",
+		"codeintel.sentinel-cve-downloader", "Periodically syncs vulnerability records from the configured sources into Postgres.",
 		interval,
-		goroutine.HandlerFunc(func(ctx context.Context) error {
-			vulnerabilities, err := cveParser.handle(ctx)
-			if err != nil {
-				return err
-			}
-
-			numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
-			if err != nil {
-				return err
+		goroutine.HandlerFunc(func(ctx context.Context) (errs error) {
+			// A failing source must not prevent the other sources from being synced
+			for _, source := range sources {
+				vulnerabilities, err := source.Vulnerabilities(ctx)
+				if err != nil {
+					errs = errors.Append(errs, errors.Wrapf(err, "reading vulnerabilities from %s", source.Name()))
+					continue
+				}
+
+				numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
+				if err != nil {
+					errs = errors.Append(errs, errors.Wrapf(err, "inserting vulnerabilities from %s", source.Name()))
+					continue
+				}
+
+				if numVulnerabilitiesInserted > 0 {
+					log.Info(
+						"inserted vulnerabilities",
+						logger.String("source", source.Name()),
+						logger.Int("count", numVulnerabilitiesInserted),
+					)
+				}
+				metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
 			}
 
-			metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
-			return nil
+			return errs
 		}),
 	)
 }
 
 type CVEParser struct {
-	store  store.Store
 	logger logger.Logger
 }
 
@@ -48,7 +57,3 @@ func NewCVEParser() *CVEParser {
 		logger: logger.Scoped("sentinel.parser", ""),
 	}
 }
-
-func (parser *CVEParser) handle(ctx context.Context) ([]shared.Vulnerability, error) {
-	return parser.ReadGitHubAdvisoryDB(ctx, false)
-}
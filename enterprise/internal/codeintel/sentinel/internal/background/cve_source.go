@@ -0,0 +1,130 @@
+package background
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// VulnerabilitySource provides the vulnerability records of a single advisory database.
+type VulnerabilitySource interface {
+	// Name identifies the source in logs and errors.
+	Name() string
+
+	// Vulnerabilities returns all vulnerability records currently provided by the source.
+	Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error)
+}
+
+// NewGitHubAdvisorySource returns a source that downloads the GitHub Security Advisories database.
+func NewGitHubAdvisorySource(parser *CVEParser) VulnerabilitySource {
+	return &funcSource{name: "github", f: func(ctx context.Context) ([]shared.Vulnerability, error) {
+		return parser.ReadGitHubAdvisoryDB(ctx, false)
+	}}
+}
+
+// NewGovulndbSource returns a source that downloads the Go Vulnerability Database.
+func NewGovulndbSource(parser *CVEParser) VulnerabilitySource {
+	return &funcSource{name: "govulndb", f: func(ctx context.Context) ([]shared.Vulnerability, error) {
+		return parser.ReadGoVulnDb(ctx, false)
+	}}
+}
+
+type funcSource struct {
+	name string
+	f    func(ctx context.Context) ([]shared.Vulnerability, error)
+}
+
+func (s *funcSource) Name() string { return s.name }
+
+func (s *funcSource) Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error) {
+	return s.f(ctx)
+}
+
+// NewOSVDirectorySource returns a source that reads OSV bundles from the given local
+// directory, which allows importing advisories without network access. Every file
+// with a .json or .zip extension is read as a bundle (see ParseOSVBundle); other files
+// are ignored. Vulnerabilities are attributed to the given namespace.
+func NewOSVDirectorySource(parser *CVEParser, dir, namespace string) VulnerabilitySource {
+	return &osvDirectorySource{parser: parser, dir: dir, namespace: namespace}
+}
+
+type osvDirectorySource struct {
+	parser    *CVEParser
+	dir       string
+	namespace string
+}
+
+func (s *osvDirectorySource) Name() string { return "directory:" + s.dir }
+
+func (s *osvDirectorySource) Vulnerabilities(ctx context.Context) (vulns []shared.Vulnerability, err error) {
+	err = filepath.WalkDir(s.dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return ctx.Err()
+		}
+		if ext := strings.ToLower(filepath.Ext(path)); ext != ".json" && ext != ".zip" {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		bundleVulns, err := s.parser.ParseOSVBundle(f, s.namespace)
+		if err != nil {
+			return errors.Wrapf(err, "reading OSV bundle %q", path)
+		}
+
+		vulns = append(vulns, bundleVulns...)
+		return nil
+	})
+
+	return vulns, err
+}
+
+// NewOSVFeedSource returns a source that downloads an OSV bundle (see ParseOSVBundle)
+// from the given URL, such as an internal advisory feed. Vulnerabilities are attributed
+// to the given namespace.
+func NewOSVFeedSource(parser *CVEParser, url, namespace string) VulnerabilitySource {
+	return &osvFeedSource{parser: parser, doer: httpcli.ExternalDoer, url: url, namespace: namespace}
+}
+
+type osvFeedSource struct {
+	parser    *CVEParser
+	doer      httpcli.Doer
+	url       string
+	namespace string
+}
+
+func (s *osvFeedSource) Name() string { return "feed:" + s.namespace }
+
+func (s *osvFeedSource) Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Newf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	return s.parser.ParseOSVBundle(resp.Body, s.namespace)
+}
@@ -42,7 +42,7 @@ func (parser *CVEParser) ReadGoVulnDb(ctx context.Context, useLocalCache bool) (
 		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
 	}
 
-	return parser.ParseGitHubAdvisoryDB(resp.Body)
+	return parser.ParseGovulndbAdvisoryDB(resp.Body)
 }
 
 func (parser *CVEParser) ParseGovulndbAdvisoryDB(govulndbReader io.Reader) (vulns []shared.Vulnerability, err error) {
@@ -1,7 +1,13 @@
 package background
 
 import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -9,6 +15,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 
 	gocvss20 "github.com/pandatix/go-cvss/20"
 	gocvss30 "github.com/pandatix/go-cvss/30"
@@ -179,8 +186,7 @@ func (parser *CVEParser) osvToVuln(o OSV, dataSourceHandler DataSourceHandler) (
 					"unexpected number of affected versions (>1)",
 					log.String("type", "dataWarning"),
 					log.String("sourceID", v.SourceID),
-					log.String("actual", v.CVSSVector),
-					log.String("err", err.Error()),
+					log.String("actualCount", fmt.Sprint(len(affected.Versions))),
 				)
 			}
 			ap.VersionConstraint = append(ap.VersionConstraint, "="+affected.Versions[0])
@@ -194,6 +200,115 @@ func (parser *CVEParser) osvToVuln(o OSV, dataSourceHandler DataSourceHandler) (
 	return v, nil
 }
 
+// ParseOSVBundle reads vulnerabilities in the plain OSV format from a bundle, which is
+// either a zip archive of .json files or a single JSON document. Each JSON document
+// holds a single OSV record or an array of them. Vulnerabilities are attributed to the
+// given namespace, as provider-specific data is not interpreted.
+func (parser *CVEParser) ParseOSVBundle(r io.Reader, namespace string) (vulns []shared.Vulnerability, err error) {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(4); bytes.Equal(magic, []byte("PK\x03\x04")) {
+		return parser.parseOSVArchive(br, namespace)
+	}
+
+	return parser.parseOSVDocument(br, namespace)
+}
+
+func (parser *CVEParser) parseOSVArchive(r io.Reader, namespace string) (vulns []shared.Vulnerability, err error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+
+		fileVulns, err := func() ([]shared.Vulnerability, error) {
+			r, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+
+			return parser.parseOSVDocument(r, namespace)
+		}()
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing %q", f.Name)
+		}
+
+		vulns = append(vulns, fileVulns...)
+	}
+
+	return vulns, nil
+}
+
+func (parser *CVEParser) parseOSVDocument(r io.Reader, namespace string) (vulns []shared.Vulnerability, err error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	var osvVulns []OSV
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(raw, &osvVulns); err != nil {
+			return nil, err
+		}
+	} else {
+		var osvVuln OSV
+		if err := json.Unmarshal(raw, &osvVuln); err != nil {
+			return nil, err
+		}
+		osvVulns = append(osvVulns, osvVuln)
+	}
+
+	handler := osvHandler{namespace: namespace}
+	for _, osvVuln := range osvVulns {
+		if osvVuln.ID == "" {
+			return nil, errors.New("OSV record without an id")
+		}
+
+		convertedVuln, err := parser.osvToVuln(osvVuln, handler)
+		if err != nil {
+			return nil, err
+		}
+
+		vulns = append(vulns, convertedVuln)
+	}
+
+	return vulns, nil
+}
+
+// osvHandler handles OSV records without provider-specific data, such as those
+// from local bundles and internal advisory feeds.
+type osvHandler struct {
+	namespace string
+}
+
+func (h osvHandler) topLevelHandler(o OSV, v *shared.Vulnerability) error {
+	for _, reference := range o.References {
+		if reference.Type == "ADVISORY" {
+			v.DataSource = reference.URL
+			break
+		}
+	}
+
+	return nil
+}
+
+func (h osvHandler) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPackage) error {
+	// OSV ecosystem names are shared with the GitHub advisory database
+	affectedPackage.Language = githubEcosystemToLanguage(a.Package.Ecosystem)
+	affectedPackage.Namespace = h.namespace + ":" + a.Package.Ecosystem
+
+	return nil
+}
+
 var cvssTrailingSlash = lazyregexp.New(`/$`)
 
 func parseCVSS(cvssVector string) (score string, severity string, err error) {
@@ -0,0 +1,150 @@
+package background
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+)
+
+const testOSVRecord = `{
+	"id": "INTERNAL-2023-0001",
+	"summary": "Deserialization of untrusted data in acme-config",
+	"published": "2023-03-01T00:00:00Z",
+	"aliases": ["CVE-2023-0001"],
+	"severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}],
+	"affected": [{
+		"package": {"ecosystem": "npm", "name": "acme-config"},
+		"ranges": [{"type": "SEMVER", "events": [{"introduced": "1.0.0"}, {"fixed": "1.4.2"}]}]
+	}],
+	"references": [
+		{"type": "WEB", "url": "https://example.com/blog"},
+		{"type": "ADVISORY", "url": "https://advisories.example.com/INTERNAL-2023-0001"}
+	]
+}`
+
+const testOSVRecordArray = `[
+	{"id": "INTERNAL-2023-0002", "affected": [{"package": {"ecosystem": "Go", "name": "example.com/acme"}, "versions": ["v0.1.0", "v0.1.1"]}]},
+	{"id": "INTERNAL-2023-0003"}
+]`
+
+func TestParseOSVBundle(t *testing.T) {
+	parser := NewCVEParser()
+
+	vulns, err := parser.ParseOSVBundle(strings.NewReader(testOSVRecord), "internal")
+	if err != nil {
+		t.Fatalf("unexpected error parsing bundle: %s", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("unexpected number of vulnerabilities. want=%d have=%d", 1, len(vulns))
+	}
+
+	v := vulns[0]
+	if v.SourceID != "INTERNAL-2023-0001" {
+		t.Errorf("unexpected source id %q", v.SourceID)
+	}
+	if v.DataSource != "https://advisories.example.com/INTERNAL-2023-0001" {
+		t.Errorf("unexpected data source %q", v.DataSource)
+	}
+	if v.Severity != "CRITICAL" || v.CVSSScore != "9.8" {
+		t.Errorf("unexpected severity %q (%s)", v.Severity, v.CVSSScore)
+	}
+	if len(v.AffectedPackages) != 1 {
+		t.Fatalf("unexpected number of affected packages. want=%d have=%d", 1, len(v.AffectedPackages))
+	}
+	ap := v.AffectedPackages[0]
+	if ap.Namespace != "internal:npm" || ap.Language != "Javascript" || ap.PackageName != "acme-config" {
+		t.Errorf("unexpected affected package %+v", ap)
+	}
+	if diff := cmp.Diff([]string{">=1.0.0", "<1.4.2"}, ap.VersionConstraint); diff != "" {
+		t.Errorf("unexpected version constraint (-want +got):\n%s", diff)
+	}
+
+	vulns, err = parser.ParseOSVBundle(strings.NewReader(testOSVRecordArray), "internal")
+	if err != nil {
+		t.Fatalf("unexpected error parsing bundle: %s", err)
+	}
+	if diff := cmp.Diff([]string{"INTERNAL-2023-0002", "INTERNAL-2023-0003"}, sourceIDs(vulns)); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+
+	vulns, err = parser.ParseOSVBundle(bytes.NewReader(makeZip(t, map[string]string{
+		"advisories/a.json":   testOSVRecord,
+		"advisories/b.json":   testOSVRecordArray,
+		"advisories/README":   "not a record",
+		"advisories/ignored/": "",
+	})), "internal")
+	if err != nil {
+		t.Fatalf("unexpected error parsing bundle: %s", err)
+	}
+	if diff := cmp.Diff([]string{"INTERNAL-2023-0001", "INTERNAL-2023-0002", "INTERNAL-2023-0003"}, sourceIDs(vulns)); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+
+	if _, err := parser.ParseOSVBundle(strings.NewReader(`{"summary": "no id"}`), "internal"); err == nil {
+		t.Errorf("expected error parsing record without id")
+	}
+}
+
+func TestOSVDirectorySource(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string][]byte{
+		"a.json":        []byte(testOSVRecord),
+		"nested/b.zip":  makeZip(t, map[string]string{"b.json": testOSVRecordArray}),
+		"nested/c.json": []byte(`{"id": "INTERNAL-2023-0004"}`),
+		"notes.txt":     []byte("ignored"),
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	vulns, err := NewOSVDirectorySource(NewCVEParser(), dir, "local").Vulnerabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error reading directory: %s", err)
+	}
+	if diff := cmp.Diff([]string{"INTERNAL-2023-0001", "INTERNAL-2023-0002", "INTERNAL-2023-0003", "INTERNAL-2023-0004"}, sourceIDs(vulns)); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
+
+func makeZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func sourceIDs(vulns []shared.Vulnerability) []string {
+	ids := make([]string, 0, len(vulns))
+	for _, v := range vulns {
+		ids = append(ids, v.SourceID)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
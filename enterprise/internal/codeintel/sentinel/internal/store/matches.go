@@ -20,7 +20,7 @@ func (s *store) VulnerabilityMatchByID(ctx context.Context, id int) (_ shared.Vu
 	ctx, _, endObservation := s.operations.vulnerabilityMatchByID.With(ctx, &err, observation.Args{})
 	defer endObservation(1, observation.Args{})
 
-	matches, _, err := scanVulnerabilityMatchesAndCount(s.db.Query(ctx, sqlf.Sprintf(vulnerabilityMatchByIDQuery, id)))
+	matches, _, err := scanVulnerabilityMatchesAndCount(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityMatchesQuery, sqlf.Sprintf("m.id = %s", id), 1, 0)))
 	if err != nil || len(matches) == 0 {
 		return shared.VulnerabilityMatch{}, false, err
 	}
@@ -28,25 +28,21 @@ func (s *store) VulnerabilityMatchByID(ctx context.Context, id int) (_ shared.Vu
 	return matches[0], true, nil
 }
 
-const vulnerabilityMatchByIDQuery = `
-SELECT
-	m.id,
-	m.upload_id,
-	vap.vulnerability_id,
-	` + vulnerabilityAffectedPackageFields + `,
-	` + vulnerabilityAffectedSymbolFields + `,
-	0 AS count
-FROM vulnerability_matches m
-LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
-LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_package_id = vap.id
-WHERE m.id = %s
-`
-
 func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVulnerabilityMatchesArgs) (_ []shared.VulnerabilityMatch, _ int, err error) {
 	ctx, _, endObservation := s.operations.getVulnerabilityMatches.With(ctx, &err, observation.Args{})
 	defer endObservation(1, observation.Args{})
 
-	return scanVulnerabilityMatchesAndCount(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityMatchesQuery, args.Limit, args.Offset)))
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if len(args.States) > 0 {
+		states := make([]string, 0, len(args.States))
+		for _, state := range args.States {
+			states = append(states, string(state))
+		}
+
+		conds = append(conds, sqlf.Sprintf("t.state = ANY(%s)", pq.Array(states)))
+	}
+
+	return scanVulnerabilityMatchesAndCount(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityMatchesQuery, sqlf.Join(conds, " AND "), args.Limit, args.Offset)))
 }
 
 const getVulnerabilityMatchesQuery = `
@@ -55,9 +51,16 @@ WITH limited_matches AS (
 		m.id,
 		m.upload_id,
 		m.vulnerability_affected_package_id,
+		t.state,
+		t.justification,
+		t.triaged_by,
+		t.triaged_at,
+		t.suppression_rule_id,
 		COUNT(*) OVER() AS count
 	FROM vulnerability_matches m
-	ORDER BY id
+	` + vulnerabilityMatchTriageJoin + `
+	WHERE %s
+	ORDER BY m.id
 	LIMIT %s OFFSET %s
 )
 SELECT
@@ -66,6 +69,11 @@ SELECT
 	vap.vulnerability_id,
 	` + vulnerabilityAffectedPackageFields + `,
 	` + vulnerabilityAffectedSymbolFields + `,
+	m.state,
+	m.justification,
+	m.triaged_by,
+	m.triaged_at,
+	m.suppression_rule_id,
 	m.count
 FROM limited_matches m
 LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
@@ -73,6 +81,74 @@ LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_packa
 ORDER BY m.id, vap.id, vas.id
 `
 
+// vulnerabilityMatchTriageJoin determines the effective triage state of each match m
+// as t. A state assigned to the match itself takes precedence over the most recently
+// created suppression rule covering the match.
+const vulnerabilityMatchTriageJoin = `
+JOIN lsif_uploads u ON u.id = m.upload_id
+JOIN vulnerability_affected_packages tvap ON tvap.id = m.vulnerability_affected_package_id
+JOIN vulnerabilities v ON v.id = tvap.vulnerability_id
+LEFT JOIN LATERAL (
+	SELECT sr.id, sr.state, sr.justification
+	FROM vulnerability_suppression_rules sr
+	WHERE
+		sr.repository_id = u.repository_id AND
+		(sr.vulnerability = v.source_id OR sr.vulnerability = ANY(v.aliases)) AND
+		(sr.package_name IS NULL OR sr.package_name = tvap.package_name)
+	ORDER BY sr.created_at DESC, sr.id DESC
+	LIMIT 1
+) sr ON m.triage_state IS NULL
+CROSS JOIN LATERAL (
+	SELECT
+		COALESCE(m.triage_state, sr.state, 'open') AS state,
+		COALESCE(m.triage_justification, sr.justification, '') AS justification,
+		m.triaged_by,
+		m.triaged_at,
+		sr.id AS suppression_rule_id
+) t
+`
+
+func (s *store) UpdateVulnerabilityMatchTriage(ctx context.Context, id int, state shared.VulnerabilityMatchState, justification string, userID int32) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.updateVulnerabilityMatchTriage.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	if state == shared.VulnerabilityMatchStateOpen {
+		_, ok, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(clearVulnerabilityMatchTriageQuery, id)))
+		return ok, err
+	}
+
+	_, ok, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(
+		updateVulnerabilityMatchTriageQuery,
+		state,
+		justification,
+		dbutil.NullInt32Column(userID),
+		id,
+	)))
+	return ok, err
+}
+
+const updateVulnerabilityMatchTriageQuery = `
+UPDATE vulnerability_matches
+SET
+	triage_state = %s,
+	triage_justification = %s,
+	triaged_by = %s,
+	triaged_at = NOW()
+WHERE id = %s
+RETURNING id
+`
+
+const clearVulnerabilityMatchTriageQuery = `
+UPDATE vulnerability_matches
+SET
+	triage_state = NULL,
+	triage_justification = NULL,
+	triaged_by = NULL,
+	triaged_at = NULL
+WHERE id = %s
+RETURNING id
+`
+
 var flattenMatches = func(ms []shared.VulnerabilityMatch) []shared.VulnerabilityMatch {
 	flattened := []shared.VulnerabilityMatch{}
 	for _, m := range ms {
@@ -114,6 +190,11 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 			&dbutil.NullString{S: &fixedIn},
 			&dbutil.NullString{S: &vas.Path},
 			pq.Array(vas.Symbols),
+			&match.Triage.State,
+			&match.Triage.Justification,
+			&match.Triage.TriagedBy,
+			&match.Triage.TriagedAt,
+			&match.Triage.SuppressionRuleID,
 			&count,
 		); err != nil {
 			return shared.VulnerabilityMatch{}, 0, err
@@ -50,6 +50,7 @@ func TestVulnerabilityMatchByID(t *testing.T) {
 		UploadID:        52,
 		VulnerabilityID: 1,
 		AffectedPackage: badConfig,
+		Triage:          openTriage,
 	}
 	if diff := cmp.Diff(expectedMatch, match); diff != "" {
 		t.Errorf("unexpected vulnerability match (-want +got):\n%s", diff)
@@ -85,16 +86,19 @@ func TestGetVulnerabilityMatches(t *testing.T) {
 					UploadID:        50,
 					VulnerabilityID: 1,
 					AffectedPackage: badConfig,
+					Triage:          openTriage,
 				}, {
 					ID:              2,
 					UploadID:        51,
 					VulnerabilityID: 1,
 					AffectedPackage: badConfig,
+					Triage:          openTriage,
 				}, {
 					ID:              3,
 					UploadID:        52,
 					VulnerabilityID: 1,
 					AffectedPackage: badConfig,
+					Triage:          openTriage,
 				},
 			},
 		},
@@ -137,6 +141,71 @@ func TestGetVulnerabilityMatches(t *testing.T) {
 	}
 }
 
+func TestScanMatchesAfterInsertingVulnerabilities(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	// Scan all uploads before the vulnerability affecting them is known
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities[1:]); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, numMatches, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	} else if numMatches != 0 {
+		t.Fatalf("unexpected number of matches. want=%d have=%d", 0, numMatches)
+	}
+
+	countScannedUploads := func() int {
+		count, _, err := basestore.ScanFirstInt(db.QueryContext(ctx, `SELECT COUNT(*) FROM lsif_uploads_vulnerability_scan`))
+		if err != nil {
+			t.Fatalf("unexpected error counting scanned uploads: %s", err)
+		}
+		return count
+	}
+	numScannedUploads := countScannedUploads()
+	if numScannedUploads == 0 {
+		t.Fatalf("expected uploads to be scanned")
+	}
+
+	// Re-inserting known vulnerabilities does not cause a rescan
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities[1:]); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if n := countScannedUploads(); n != numScannedUploads {
+		t.Fatalf("unexpected number of scanned uploads. want=%d have=%d", numScannedUploads, n)
+	}
+
+	// A new vulnerability for a package that is not referenced does not cause a rescan
+	unrelatedVulnerability := shared.Vulnerability{
+		SourceID: "CVE-UNRELATED",
+		AffectedPackages: []shared.AffectedPackage{
+			{Language: "go", PackageName: "go-nacelle/log", VersionConstraint: []string{"<= v1.0.0"}},
+		},
+	}
+	if _, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{unrelatedVulnerability}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if n := countScannedUploads(); n != numScannedUploads {
+		t.Fatalf("unexpected number of scanned uploads. want=%d have=%d", numScannedUploads, n)
+	}
+
+	// A new vulnerability causes the uploads referencing its package to be matched again
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities[:1]); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, numMatches, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	} else if numMatches != 3 {
+		t.Fatalf("unexpected number of matches. want=%d have=%d", 3, numMatches)
+	}
+}
+
+var openTriage = shared.VulnerabilityMatchTriage{State: shared.VulnerabilityMatchStateOpen}
+
 func setupReferences(t *testing.T, db database.DB) {
 	store := basestore.NewWithHandle(db.Handle())
 
@@ -15,6 +15,11 @@ type operations struct {
 	vulnerabilityMatchByID  *observation.Operation
 	getVulnerabilityMatches *observation.Operation
 	scanMatches             *observation.Operation
+
+	updateVulnerabilityMatchTriage     *observation.Operation
+	getVulnerabilitySuppressionRules   *observation.Operation
+	createVulnerabilitySuppressionRule *observation.Operation
+	deleteVulnerabilitySuppressionRule *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -45,5 +50,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 		vulnerabilityMatchByID:  op("VulnerabilityMatchByID"),
 		getVulnerabilityMatches: op("GetVulnerabilityMatches"),
 		scanMatches:             op("ScanMatches"),
+
+		updateVulnerabilityMatchTriage:     op("UpdateVulnerabilityMatchTriage"),
+		getVulnerabilitySuppressionRules:   op("GetVulnerabilitySuppressionRules"),
+		createVulnerabilitySuppressionRule: op("CreateVulnerabilitySuppressionRule"),
+		deleteVulnerabilitySuppressionRule: op("DeleteVulnerabilitySuppressionRule"),
 	}
 }
@@ -15,11 +15,22 @@ type Store interface {
 	VulnerabilityByID(ctx context.Context, id int) (_ shared.Vulnerability, _ bool, err error)
 	GetVulnerabilitiesByIDs(ctx context.Context, ids ...int) (_ []shared.Vulnerability, err error)
 	GetVulnerabilities(ctx context.Context, args shared.GetVulnerabilitiesArgs) (_ []shared.Vulnerability, _ int, err error)
+	// InsertVulnerabilities inserts the given vulnerabilities, skipping those that already exist,
+	// and returns the number of new vulnerabilities. If there are new vulnerabilities, all uploads
+	// are matched against them again.
 	InsertVulnerabilities(ctx context.Context, vulnerabilities []shared.Vulnerability) (_ int, err error)
 
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
 	GetVulnerabilityMatches(ctx context.Context, args shared.GetVulnerabilityMatchesArgs) ([]shared.VulnerabilityMatch, int, error)
 	ScanMatches(ctx context.Context, batchSize int) (numReferencesScanned int, numVulnerabilityMatches int, _ error)
+	// UpdateVulnerabilityMatchTriage assigns a triage state to a single match, which takes
+	// precedence over the suppression rules of its repository. Reopening a match clears its
+	// triage state, so that the suppression rules apply to it again.
+	UpdateVulnerabilityMatchTriage(ctx context.Context, id int, state shared.VulnerabilityMatchState, justification string, userID int32) (bool, error)
+
+	GetVulnerabilitySuppressionRules(ctx context.Context, args shared.GetVulnerabilitySuppressionRulesArgs) ([]shared.VulnerabilitySuppressionRule, int, error)
+	CreateVulnerabilitySuppressionRule(ctx context.Context, rule shared.VulnerabilitySuppressionRule) (shared.VulnerabilitySuppressionRule, error)
+	DeleteVulnerabilitySuppressionRule(ctx context.Context, id int) (bool, error)
 }
 
 type store struct {
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetVulnerabilitySuppressionRules(ctx context.Context, args shared.GetVulnerabilitySuppressionRulesArgs) (_ []shared.VulnerabilitySuppressionRule, _ int, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilitySuppressionRules.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if args.RepositoryID != 0 {
+		conds = append(conds, sqlf.Sprintf("sr.repository_id = %s", args.RepositoryID))
+	}
+
+	return scanVulnerabilitySuppressionRulesAndCount(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilitySuppressionRulesQuery, sqlf.Join(conds, " AND "), args.Limit, args.Offset)))
+}
+
+const getVulnerabilitySuppressionRulesQuery = `
+SELECT
+	` + vulnerabilitySuppressionRuleFields + `,
+	COUNT(*) OVER() AS count
+FROM vulnerability_suppression_rules sr
+WHERE %s
+ORDER BY sr.id
+LIMIT %s OFFSET %s
+`
+
+const vulnerabilitySuppressionRuleFields = `
+	sr.id,
+	sr.repository_id,
+	sr.vulnerability,
+	sr.package_name,
+	sr.state,
+	sr.justification,
+	sr.created_by,
+	sr.created_at
+`
+
+// CreateVulnerabilitySuppressionRule inserts a suppression rule. The rule applies to the
+// existing and future matches of the vulnerability in the repository that have not been
+// triaged individually.
+func (s *store) CreateVulnerabilitySuppressionRule(ctx context.Context, rule shared.VulnerabilitySuppressionRule) (_ shared.VulnerabilitySuppressionRule, err error) {
+	ctx, _, endObservation := s.operations.createVulnerabilitySuppressionRule.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	var createdBy int32
+	if rule.CreatedBy != nil {
+		createdBy = *rule.CreatedBy
+	}
+
+	rules, _, err := scanVulnerabilitySuppressionRulesAndCount(s.db.Query(ctx, sqlf.Sprintf(
+		createVulnerabilitySuppressionRuleQuery,
+		rule.RepositoryID,
+		rule.Vulnerability,
+		rule.PackageName,
+		rule.State,
+		rule.Justification,
+		dbutil.NullInt32Column(createdBy),
+	)))
+	if err != nil || len(rules) == 0 {
+		return shared.VulnerabilitySuppressionRule{}, err
+	}
+
+	return rules[0], nil
+}
+
+const createVulnerabilitySuppressionRuleQuery = `
+INSERT INTO vulnerability_suppression_rules AS sr (repository_id, vulnerability, package_name, state, justification, created_by)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING
+	` + vulnerabilitySuppressionRuleFields + `,
+	0 AS count
+`
+
+func (s *store) DeleteVulnerabilitySuppressionRule(ctx context.Context, id int) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.deleteVulnerabilitySuppressionRule.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	_, ok, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(deleteVulnerabilitySuppressionRuleQuery, id)))
+	return ok, err
+}
+
+const deleteVulnerabilitySuppressionRuleQuery = `
+DELETE FROM vulnerability_suppression_rules
+WHERE id = %s
+RETURNING id
+`
+
+var scanVulnerabilitySuppressionRulesAndCount = basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (rule shared.VulnerabilitySuppressionRule, count int, _ error) {
+	err := s.Scan(
+		&rule.ID,
+		&rule.RepositoryID,
+		&rule.Vulnerability,
+		&rule.PackageName,
+		&rule.State,
+		&rule.Justification,
+		&rule.CreatedBy,
+		&rule.CreatedAt,
+		&count,
+	)
+	return rule, count, err
+})
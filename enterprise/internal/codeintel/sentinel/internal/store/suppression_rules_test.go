@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestVulnerabilityMatchTriage(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+	insertRepo(t, db, 51, "")
+
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	createRule := func(repositoryID int, vulnerability string, packageName *string) shared.VulnerabilitySuppressionRule {
+		rule, err := store.CreateVulnerabilitySuppressionRule(ctx, shared.VulnerabilitySuppressionRule{
+			RepositoryID:  repositoryID,
+			Vulnerability: vulnerability,
+			PackageName:   packageName,
+			State:         shared.VulnerabilityMatchStateNotAffected,
+			Justification: "vulnerable code not in execute path",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error creating suppression rule: %s", err)
+		}
+		return rule
+	}
+
+	// Rules of other repositories and packages do not apply
+	otherPackage := "go-nacelle/log"
+	createRule(51, "CVE-ABC", nil)
+	createRule(50, "CVE-ABC", &otherPackage)
+	rule := createRule(50, "CVE-ABC", nil)
+
+	if ok, err := store.UpdateVulnerabilityMatchTriage(ctx, 2, shared.VulnerabilityMatchStateAcceptedRisk, "no fix available", 0); err != nil {
+		t.Fatalf("unexpected error triaging match: %s", err)
+	} else if !ok {
+		t.Fatalf("expected match to exist")
+	}
+	if ok, err := store.UpdateVulnerabilityMatchTriage(ctx, 42, shared.VulnerabilityMatchStateAcceptedRisk, "no fix available", 0); err != nil {
+		t.Fatalf("unexpected error triaging match: %s", err)
+	} else if ok {
+		t.Fatalf("expected match not to exist")
+	}
+
+	// The only match triaged individually
+	triagedMatchID := 2
+
+	matchStates := func(states ...shared.VulnerabilityMatchState) map[int]shared.VulnerabilityMatchTriage {
+		matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, States: states})
+		if err != nil {
+			t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+		}
+
+		triages := map[int]shared.VulnerabilityMatchTriage{}
+		for _, match := range matches {
+			if match.Triage.TriagedAt != nil && match.Triage.SuppressionRuleID != nil {
+				t.Errorf("expected match %d to be triaged either individually or by a rule: %+v", match.ID, match.Triage)
+			}
+			if (match.Triage.TriagedAt != nil) != (match.ID == triagedMatchID) {
+				t.Errorf("unexpected triage time for match %d: %v", match.ID, match.Triage.TriagedAt)
+			}
+			match.Triage.TriagedAt = nil
+			triages[match.ID] = match.Triage
+		}
+		return triages
+	}
+
+	suppressed := shared.VulnerabilityMatchTriage{
+		State:             shared.VulnerabilityMatchStateNotAffected,
+		Justification:     "vulnerable code not in execute path",
+		SuppressionRuleID: &rule.ID,
+	}
+	acceptedRisk := shared.VulnerabilityMatchTriage{
+		State:         shared.VulnerabilityMatchStateAcceptedRisk,
+		Justification: "no fix available",
+	}
+	expected := map[int]shared.VulnerabilityMatchTriage{1: suppressed, 2: acceptedRisk, 3: suppressed}
+	if diff := cmp.Diff(expected, matchStates()); diff != "" {
+		t.Errorf("unexpected triage states (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[int]shared.VulnerabilityMatchTriage{2: acceptedRisk}, matchStates(shared.VulnerabilityMatchStateAcceptedRisk, shared.VulnerabilityMatchStateFixed)); diff != "" {
+		t.Errorf("unexpected triage states (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[int]shared.VulnerabilityMatchTriage{}, matchStates(shared.VulnerabilityMatchStateOpen)); diff != "" {
+		t.Errorf("unexpected triage states (-want +got):\n%s", diff)
+	}
+
+	// Matches are open again once the rule is deleted
+	if ok, err := store.DeleteVulnerabilitySuppressionRule(ctx, rule.ID); err != nil {
+		t.Fatalf("unexpected error deleting suppression rule: %s", err)
+	} else if !ok {
+		t.Fatalf("expected suppression rule to exist")
+	}
+	expected = map[int]shared.VulnerabilityMatchTriage{1: openTriage, 3: openTriage}
+	if diff := cmp.Diff(expected, matchStates(shared.VulnerabilityMatchStateOpen)); diff != "" {
+		t.Errorf("unexpected triage states (-want +got):\n%s", diff)
+	}
+
+	// Reopening a match clears its triage state, so rules created afterwards apply to it
+	if ok, err := store.UpdateVulnerabilityMatchTriage(ctx, 2, shared.VulnerabilityMatchStateOpen, "reopened", 0); err != nil {
+		t.Fatalf("unexpected error triaging match: %s", err)
+	} else if !ok {
+		t.Fatalf("expected match to exist")
+	}
+	triagedMatchID = 0
+	expected = map[int]shared.VulnerabilityMatchTriage{1: openTriage, 2: openTriage, 3: openTriage}
+	if diff := cmp.Diff(expected, matchStates()); diff != "" {
+		t.Errorf("unexpected triage states (-want +got):\n%s", diff)
+	}
+
+	rule = createRule(50, "CVE-ABC", nil)
+	suppressed.SuppressionRuleID = &rule.ID
+	expected = map[int]shared.VulnerabilityMatchTriage{1: suppressed, 2: suppressed, 3: suppressed}
+	if diff := cmp.Diff(expected, matchStates()); diff != "" {
+		t.Errorf("unexpected triage states (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetVulnerabilitySuppressionRules(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	for _, repositoryID := range []int{50, 51, 50} {
+		insertRepo(t, db, repositoryID, "")
+
+		if _, err := store.CreateVulnerabilitySuppressionRule(ctx, shared.VulnerabilitySuppressionRule{
+			RepositoryID:  repositoryID,
+			Vulnerability: "CVE-ABC",
+			State:         shared.VulnerabilityMatchStateFixed,
+			Justification: "patched in vendored copy",
+		}); err != nil {
+			t.Fatalf("unexpected error creating suppression rule: %s", err)
+		}
+	}
+
+	for _, testCase := range []struct {
+		repositoryID int
+		expectedIDs  []int
+	}{
+		{0, []int{1, 2, 3}},
+		{50, []int{1, 3}},
+		{51, []int{2}},
+		{52, nil},
+	} {
+		rules, totalCount, err := store.GetVulnerabilitySuppressionRules(ctx, shared.GetVulnerabilitySuppressionRulesArgs{
+			RepositoryID: testCase.repositoryID,
+			Limit:        10,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error getting suppression rules: %s", err)
+		}
+		if totalCount != len(testCase.expectedIDs) {
+			t.Errorf("unexpected total count for repository %d. want=%d have=%d", testCase.repositoryID, len(testCase.expectedIDs), totalCount)
+		}
+
+		var ids []int
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		if diff := cmp.Diff(testCase.expectedIDs, ids); diff != "" {
+			t.Errorf("unexpected suppression rules for repository %d (-want +got):\n%s", testCase.repositoryID, diff)
+		}
+	}
+}
@@ -325,6 +325,14 @@ func (s *store) InsertVulnerabilities(ctx context.Context, vulnerabilities []sha
 		return 0, err
 	}
 
+	// Uploads that have already been scanned need to be matched against the new
+	// vulnerabilities, so we forget the scan state of the uploads that reference
+	// their packages. This must happen before the vulnerabilities are inserted
+	// so that we can tell which of them are new.
+	if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesResetScansQuery)); err != nil {
+		return 0, err
+	}
+
 	count, _, err := basestore.ScanFirstInt(tx.Query(ctx, sqlf.Sprintf(insertVulnerabilitiesUpdateQuery)))
 	if err != nil {
 		return 0, err
@@ -463,6 +471,17 @@ SELECT c.id, c.path, c.symbols FROM candidates c
 ON CONFLICT DO NOTHING
 `
 
+const insertVulnerabilitiesResetScansQuery = `
+DELETE FROM lsif_uploads_vulnerability_scan uvs
+WHERE uvs.upload_id IN (
+	SELECT r.dump_id
+	FROM t_vulnerability_affected_packages tvap
+	-- NOTE: This must match the package names as loosely as scanMatchesQuery
+	JOIN lsif_references r ON r.name LIKE '%%' || tvap.package_name || '%%'
+	WHERE NOT EXISTS (SELECT 1 FROM vulnerabilities v WHERE v.source_id = tvap.source_id)
+)
+`
+
 func canonicalizeVulnerabilities(vs []shared.Vulnerability) []shared.Vulnerability {
 	for i, v := range vs {
 		vs[i] = canonicalizeVulnerability(v)
@@ -2,7 +2,9 @@ package sentinel
 
 import (
 	"context"
+	"io"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/background"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -42,3 +44,32 @@ func (s *Service) GetVulnerabilities(ctx context.Context, args shared.GetVulnera
 func (s *Service) GetVulnerabilityMatches(ctx context.Context, args shared.GetVulnerabilityMatchesArgs) ([]shared.VulnerabilityMatch, int, error) {
 	return s.store.GetVulnerabilityMatches(ctx, args)
 }
+
+func (s *Service) UpdateVulnerabilityMatchTriage(ctx context.Context, id int, state shared.VulnerabilityMatchState, justification string, userID int32) (bool, error) {
+	return s.store.UpdateVulnerabilityMatchTriage(ctx, id, state, justification, userID)
+}
+
+func (s *Service) GetVulnerabilitySuppressionRules(ctx context.Context, args shared.GetVulnerabilitySuppressionRulesArgs) ([]shared.VulnerabilitySuppressionRule, int, error) {
+	return s.store.GetVulnerabilitySuppressionRules(ctx, args)
+}
+
+func (s *Service) CreateVulnerabilitySuppressionRule(ctx context.Context, rule shared.VulnerabilitySuppressionRule) (shared.VulnerabilitySuppressionRule, error) {
+	return s.store.CreateVulnerabilitySuppressionRule(ctx, rule)
+}
+
+func (s *Service) DeleteVulnerabilitySuppressionRule(ctx context.Context, id int) (bool, error) {
+	return s.store.DeleteVulnerabilitySuppressionRule(ctx, id)
+}
+
+// ImportVulnerabilities inserts the vulnerabilities of an OSV bundle, which is either a zip
+// archive of OSV-format JSON files or a single such file, attributing them to the given
+// namespace. It returns the number of new vulnerabilities, which existing uploads will be
+// matched against by the CVE matcher.
+func (s *Service) ImportVulnerabilities(ctx context.Context, namespace string, r io.Reader) (int, error) {
+	vulnerabilities, err := background.NewCVEParser().ParseOSVBundle(r, namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.InsertVulnerabilities(ctx, vulnerabilities)
+}
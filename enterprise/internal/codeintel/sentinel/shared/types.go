@@ -52,6 +52,7 @@ type AffectedSymbol struct {
 type GetVulnerabilityMatchesArgs struct {
 	Limit  int
 	Offset int
+	States []VulnerabilityMatchState // if empty, matches in any state are returned
 }
 
 type VulnerabilityMatch struct {
@@ -59,4 +60,54 @@ type VulnerabilityMatch struct {
 	UploadID        int
 	VulnerabilityID int
 	AffectedPackage AffectedPackage
+	Triage          VulnerabilityMatchTriage
+}
+
+// VulnerabilityMatchState is the triage state of a vulnerability match.
+type VulnerabilityMatchState string
+
+const (
+	VulnerabilityMatchStateOpen         VulnerabilityMatchState = "open"
+	VulnerabilityMatchStateNotAffected  VulnerabilityMatchState = "not_affected"
+	VulnerabilityMatchStateAcceptedRisk VulnerabilityMatchState = "accepted_risk"
+	VulnerabilityMatchStateFixed        VulnerabilityMatchState = "fixed"
+)
+
+// VulnerabilityMatchStates are the valid triage states of a vulnerability match.
+var VulnerabilityMatchStates = []VulnerabilityMatchState{
+	VulnerabilityMatchStateOpen,
+	VulnerabilityMatchStateNotAffected,
+	VulnerabilityMatchStateAcceptedRisk,
+	VulnerabilityMatchStateFixed,
+}
+
+// VulnerabilityMatchTriage is the effective triage state of a match. A state assigned
+// to the match itself takes precedence over the suppression rules of the repository.
+// Matches that are covered by neither are open.
+type VulnerabilityMatchTriage struct {
+	State             VulnerabilityMatchState
+	Justification     string
+	TriagedBy         *int32 // set if the state was assigned to the match itself
+	TriagedAt         *time.Time
+	SuppressionRuleID *int // set if the state was derived from a suppression rule
+}
+
+type GetVulnerabilitySuppressionRulesArgs struct {
+	RepositoryID int // if zero, rules of all repositories are returned
+	Limit        int
+	Offset       int
+}
+
+// VulnerabilitySuppressionRule is a VEX-style statement about the exploitability of a
+// vulnerability in a repository. It applies to all current and future matches of the
+// vulnerability in the precise indexes of the repository.
+type VulnerabilitySuppressionRule struct {
+	ID            int
+	RepositoryID  int
+	Vulnerability string  // source ID or alias of the vulnerability
+	PackageName   *string // if nil, the rule applies to all affected packages
+	State         VulnerabilityMatchState
+	Justification string
+	CreatedBy     *int32
+	CreatedAt     time.Time
 }
@@ -12,4 +12,8 @@ type SentinelService interface {
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
 	GetVulnerabilities(ctx context.Context, args shared.GetVulnerabilitiesArgs) ([]shared.Vulnerability, int, error)
 	GetVulnerabilityMatches(ctx context.Context, args shared.GetVulnerabilityMatchesArgs) ([]shared.VulnerabilityMatch, int, error)
+	UpdateVulnerabilityMatchTriage(ctx context.Context, id int, state shared.VulnerabilityMatchState, justification string, userID int32) (bool, error)
+	GetVulnerabilitySuppressionRules(ctx context.Context, args shared.GetVulnerabilitySuppressionRulesArgs) ([]shared.VulnerabilitySuppressionRule, int, error)
+	CreateVulnerabilitySuppressionRule(ctx context.Context, rule shared.VulnerabilitySuppressionRule) (shared.VulnerabilitySuppressionRule, error)
+	DeleteVulnerabilitySuppressionRule(ctx context.Context, id int) (bool, error)
 }
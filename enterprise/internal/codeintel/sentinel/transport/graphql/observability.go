@@ -12,6 +12,10 @@ type operations struct {
 	getMatches             *observation.Operation
 	vulnerabilityByID      *observation.Operation
 	vulnerabilityMatchByID *observation.Operation
+	getSuppressionRules    *observation.Operation
+	triageMatch            *observation.Operation
+	createSuppressionRule  *observation.Operation
+	deleteSuppressionRule  *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -35,5 +39,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getMatches:             op("Matches"),
 		vulnerabilityByID:      op("VulnerabilityByID"),
 		vulnerabilityMatchByID: op("VulnerabilityMatchByID"),
+		getSuppressionRules:    op("SuppressionRules"),
+		triageMatch:            op("TriageMatch"),
+		createSuppressionRule:  op("CreateSuppressionRule"),
+		deleteSuppressionRule:  op("DeleteSuppressionRule"),
 	}
 }
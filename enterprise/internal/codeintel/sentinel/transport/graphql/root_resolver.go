@@ -3,17 +3,21 @@ package graphql
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/opentracing/opentracing-go/log"
+	"golang.org/x/exp/slices"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/sentinel/shared"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type rootResolver struct {
@@ -107,9 +111,22 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 		offset = after
 	}
 
+	var states []shared.VulnerabilityMatchState
+	if args.States != nil {
+		for _, state := range *args.States {
+			s, err := toVulnerabilityMatchState(state)
+			if err != nil {
+				return nil, err
+			}
+
+			states = append(states, s)
+		}
+	}
+
 	matches, totalCount, err := r.sentinelSvc.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{
 		Limit:  limit,
 		Offset: offset,
+		States: states,
 	})
 	if err != nil {
 		return nil, err
@@ -187,6 +204,175 @@ func (r *rootResolver) VulnerabilityMatchByID(ctx context.Context, gqlID graphql
 	}, nil
 }
 
+func (r *rootResolver) VulnerabilitySuppressionRules(ctx context.Context, args resolverstubs.GetVulnerabilitySuppressionRulesArgs) (_ resolverstubs.VulnerabilitySuppressionRuleConnectionResolver, err error) {
+	ctx, _, endObservation := r.operations.getSuppressionRules.WithErrors(ctx, &err, observation.Args{LogFields: []log.Field{}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	// 🚨 SECURITY: Only site admins may view suppression rules
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := 50
+	if args.First != nil {
+		limit = int(*args.First)
+	}
+
+	offset := 0
+	if args.After != nil {
+		after, err := strconv.Atoi(*args.After)
+		if err != nil {
+			return nil, err
+		}
+
+		offset = after
+	}
+
+	repositoryID := 0
+	if args.Repository != nil {
+		id, err := sharedresolvers.UnmarshalRepositoryID(*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+
+		repositoryID = int(id)
+	}
+
+	rules, totalCount, err := r.sentinelSvc.GetVulnerabilitySuppressionRules(ctx, shared.GetVulnerabilitySuppressionRulesArgs{
+		RepositoryID: repositoryID,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vulnerabilitySuppressionRuleConnectionResolver{
+		repoStore:  r.repoStore,
+		rules:      rules,
+		offset:     offset,
+		totalCount: totalCount,
+	}, nil
+}
+
+// 🚨 SECURITY: Only site admins may triage vulnerability matches
+func (r *rootResolver) TriageVulnerabilityMatch(ctx context.Context, args resolverstubs.TriageVulnerabilityMatchArgs) (_ resolverstubs.VulnerabilityMatchResolver, err error) {
+	ctx, _, endObservation := r.operations.triageMatch.WithErrors(ctx, &err, observation.Args{LogFields: []log.Field{}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalVulnerabilityMatchGQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := toVulnerabilityMatchState(args.State)
+	if err != nil {
+		return nil, err
+	}
+
+	justification := ""
+	if args.Justification != nil {
+		justification = strings.TrimSpace(*args.Justification)
+	}
+	if justification == "" && state != shared.VulnerabilityMatchStateOpen {
+		return nil, errors.Newf("a justification is required to mark a match as %s", args.State)
+	}
+
+	ok, err := r.sentinelSvc.UpdateVulnerabilityMatchTriage(ctx, id, state, justification, actor.FromContext(ctx).UID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Newf("vulnerability match %d not found", id)
+	}
+
+	return r.VulnerabilityMatchByID(ctx, args.ID)
+}
+
+// 🚨 SECURITY: Only site admins may modify suppression rules
+func (r *rootResolver) CreateVulnerabilitySuppressionRule(ctx context.Context, args resolverstubs.CreateVulnerabilitySuppressionRuleArgs) (_ resolverstubs.VulnerabilitySuppressionRuleResolver, err error) {
+	ctx, _, endObservation := r.operations.createSuppressionRule.WithErrors(ctx, &err, observation.Args{LogFields: []log.Field{}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	repositoryID, err := sharedresolvers.UnmarshalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := toVulnerabilityMatchState(args.State)
+	if err != nil {
+		return nil, err
+	}
+	if state == shared.VulnerabilityMatchStateOpen {
+		return nil, errors.New("suppression rules cannot mark matches as open")
+	}
+
+	vulnerability := strings.TrimSpace(args.Vulnerability)
+	if vulnerability == "" {
+		return nil, errors.New("no vulnerability supplied")
+	}
+
+	justification := strings.TrimSpace(args.Justification)
+	if justification == "" {
+		return nil, errors.New("no justification supplied")
+	}
+
+	var packageName *string
+	if args.PackageName != nil {
+		if name := strings.TrimSpace(*args.PackageName); name != "" {
+			packageName = &name
+		}
+	}
+
+	userID := actor.FromContext(ctx).UID
+	rule, err := r.sentinelSvc.CreateVulnerabilitySuppressionRule(ctx, shared.VulnerabilitySuppressionRule{
+		RepositoryID:  int(repositoryID),
+		Vulnerability: vulnerability,
+		PackageName:   packageName,
+		State:         state,
+		Justification: justification,
+		CreatedBy:     &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vulnerabilitySuppressionRuleResolver{repoStore: r.repoStore, rule: rule}, nil
+}
+
+// 🚨 SECURITY: Only site admins may modify suppression rules
+func (r *rootResolver) DeleteVulnerabilitySuppressionRule(ctx context.Context, args resolverstubs.DeleteVulnerabilitySuppressionRuleArgs) (_ *resolverstubs.EmptyResponse, err error) {
+	ctx, _, endObservation := r.operations.deleteSuppressionRule.WithErrors(ctx, &err, observation.Args{LogFields: []log.Field{}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalVulnerabilitySuppressionRuleGQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := r.sentinelSvc.DeleteVulnerabilitySuppressionRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Newf("vulnerability suppression rule %d not found", id)
+	}
+
+	return &resolverstubs.EmptyResponse{}, nil
+}
+
 //
 //
 
@@ -345,9 +531,66 @@ func (r *vulnerabilityMatchResolver) PreciseIndex(ctx context.Context) (resolver
 	)
 }
 
+func (r *vulnerabilityMatchResolver) State() string {
+	return strings.ToUpper(string(r.m.Triage.State))
+}
+
+func (r *vulnerabilityMatchResolver) Justification() *string {
+	if r.m.Triage.Justification == "" {
+		return nil
+	}
+
+	return &r.m.Triage.Justification
+}
+
+func (r *vulnerabilityMatchResolver) TriagedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.m.Triage.TriagedAt)
+}
+
+func (r *vulnerabilityMatchResolver) SuppressedByRule() bool {
+	return r.m.Triage.SuppressionRuleID != nil
+}
+
+//
+//
+
+type vulnerabilitySuppressionRuleResolver struct {
+	repoStore database.RepoStore
+	rule      shared.VulnerabilitySuppressionRule
+}
+
+func (r *vulnerabilitySuppressionRuleResolver) ID() graphql.ID {
+	return marshalVulnerabilitySuppressionRuleGQLID(r.rule.ID)
+}
+
+func (r *vulnerabilitySuppressionRuleResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return sharedresolvers.NewRepositoryFromID(ctx, r.repoStore, r.rule.RepositoryID)
+}
+
+func (r *vulnerabilitySuppressionRuleResolver) Vulnerability() string { return r.rule.Vulnerability }
+func (r *vulnerabilitySuppressionRuleResolver) PackageName() *string  { return r.rule.PackageName }
+func (r *vulnerabilitySuppressionRuleResolver) Justification() string { return r.rule.Justification }
+
+func (r *vulnerabilitySuppressionRuleResolver) State() string {
+	return strings.ToUpper(string(r.rule.State))
+}
+
+func (r *vulnerabilitySuppressionRuleResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.rule.CreatedAt}
+}
+
 //
 //
 
+func toVulnerabilityMatchState(state string) (shared.VulnerabilityMatchState, error) {
+	s := shared.VulnerabilityMatchState(strings.ToLower(state))
+	if !slices.Contains(shared.VulnerabilityMatchStates, s) {
+		return "", errors.Newf("unknown vulnerability match state %q", state)
+	}
+
+	return s, nil
+}
+
 func unmarshalVulnerabilityGQLID(id graphql.ID) (vulnerabilityID int, err error) {
 	err = relay.UnmarshalSpec(id, &vulnerabilityID)
 	return vulnerabilityID, err
@@ -366,6 +609,15 @@ func marshalVulnerabilityMatchGQLID(vulnerabilityMatchID int) graphql.ID {
 	return relay.MarshalID("VulnerabilityMatch", vulnerabilityMatchID)
 }
 
+func unmarshalVulnerabilitySuppressionRuleGQLID(id graphql.ID) (ruleID int, err error) {
+	err = relay.UnmarshalSpec(id, &ruleID)
+	return ruleID, err
+}
+
+func marshalVulnerabilitySuppressionRuleGQLID(ruleID int) graphql.ID {
+	return relay.MarshalID("VulnerabilitySuppressionRule", ruleID)
+}
+
 //
 //
 
@@ -447,3 +699,38 @@ func (r *vulnerabilityMatchConnectionResolver) PageInfo() resolverstubs.PageInfo
 
 	return sharedresolvers.HasNextPage(false)
 }
+
+//
+//
+
+type vulnerabilitySuppressionRuleConnectionResolver struct {
+	repoStore  database.RepoStore
+	rules      []shared.VulnerabilitySuppressionRule
+	offset     int
+	totalCount int
+}
+
+func (r *vulnerabilitySuppressionRuleConnectionResolver) Nodes() []resolverstubs.VulnerabilitySuppressionRuleResolver {
+	var resolvers []resolverstubs.VulnerabilitySuppressionRuleResolver
+	for _, rule := range r.rules {
+		resolvers = append(resolvers, &vulnerabilitySuppressionRuleResolver{
+			repoStore: r.repoStore,
+			rule:      rule,
+		})
+	}
+
+	return resolvers
+}
+
+func (r *vulnerabilitySuppressionRuleConnectionResolver) TotalCount() *int32 {
+	v := int32(r.totalCount)
+	return &v
+}
+
+func (r *vulnerabilitySuppressionRuleConnectionResolver) PageInfo() resolverstubs.PageInfo {
+	if r.offset+len(r.rules) < r.totalCount {
+		return sharedresolvers.NextPageCursor(strconv.Itoa(r.offset + len(r.rules)))
+	}
+
+	return sharedresolvers.HasNextPage(false)
+}
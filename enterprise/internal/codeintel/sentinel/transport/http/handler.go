@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// maxImportSize bounds the size of an uploaded bundle, which is parsed in memory.
+	maxImportSize = 512 << 20 // 512MB
+
+	defaultImportNamespace = "upload"
+)
+
+// NewImportHandler returns a handler that imports the vulnerabilities of an uploaded OSV
+// bundle. The request body is either a zip archive of OSV-format JSON files or a single
+// such file. The optional namespace query parameter sets the namespace the vulnerabilities
+// are attributed to.
+func NewImportHandler(db database.DB, sentinelSvc SentinelService) http.Handler {
+	logger := log.Scoped("VulnerabilityImportHandler", "imports uploaded vulnerability bundles")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 🚨 SECURITY: Only site admins may import vulnerabilities.
+		if err := auth.CheckCurrentUserIsSiteAdmin(r.Context(), db); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		namespace := r.URL.Query().Get("namespace")
+		if namespace == "" {
+			namespace = defaultImportNamespace
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+		numInserted, err := sentinelSvc.ImportVulnerabilities(r.Context(), namespace, r.Body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "bundle exceeds 512MB limit", http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			logger.Warn("failed to import vulnerabilities", log.Error(err))
+			http.Error(w, errors.Wrap(err, "importing vulnerabilities").Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(importResponse{Inserted: numInserted}); err != nil {
+			logger.Error("failed to write json payload to client", log.Error(err))
+		}
+	})
+}
+
+type importResponse struct {
+	// Inserted is the number of vulnerabilities that were not known before.
+	Inserted int `json:"inserted"`
+}
@@ -0,0 +1,10 @@
+package http
+
+import (
+	"context"
+	"io"
+)
+
+type SentinelService interface {
+	ImportVulnerabilities(ctx context.Context, namespace string, r io.Reader) (int, error)
+}
@@ -5,10 +5,14 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 )
 
 type SiteAdminChecker interface {
 	CheckCurrentUserIsSiteAdmin(ctx context.Context) error
+	// CheckCurrentUserCanManageCodeIntel returns an error if the current user is neither a
+	// site admin nor has a role that grants them the permission to manage uploads and indexes.
+	CheckCurrentUserCanManageCodeIntel(ctx context.Context) error
 }
 
 type siteAdminChecker struct {
@@ -24,3 +28,7 @@ func NewSiteAdminChecker(db database.DB) SiteAdminChecker {
 func (c *siteAdminChecker) CheckCurrentUserIsSiteAdmin(ctx context.Context) error {
 	return auth.CheckCurrentUserIsSiteAdmin(ctx, c.db)
 }
+
+func (c *siteAdminChecker) CheckCurrentUserCanManageCodeIntel(ctx context.Context) error {
+	return rbac.CheckCurrentUserIsSiteAdminOrHasPermission(ctx, c.db, rbac.CodeIntelWritePermission)
+}
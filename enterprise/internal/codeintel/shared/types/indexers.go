@@ -42,6 +42,7 @@ var allIndexers = []CodeIntelIndexer{
 	makeIndexer("OCaml", "lsif-ocaml", "github.com/rvantonder/lsif-ocaml"),
 
 	// PHP
+	makeIndexer("PHP", "scip-php", "github.com/davidrjenni/scip-php", "composer"),
 	makeIndexer("PHP", "lsif-php", "github.com/davidrjenni/lsif-php", "davidrjenni/lsif-php"),
 
 	// Python
@@ -97,6 +97,7 @@ func NewUploadProcessorJob(
 
 	return background.NewUploadProcessorWorker(
 		observationCtx,
+		db,
 		uploadSvc.store,
 		uploadSvc.lsifstore,
 		uploadSvc.gitserverClient,
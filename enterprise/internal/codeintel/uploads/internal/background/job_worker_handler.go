@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/jackc/pgconn"
 	"github.com/keegancsmith/sqlf"
 	otlog "github.com/opentracing/opentracing-go/log"
@@ -20,10 +21,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -32,6 +35,7 @@ import (
 
 func NewUploadProcessorWorker(
 	observationCtx *observation.Context,
+	db database.DB,
 	store store.Store,
 	lsifStore lsifstore.LsifStore,
 	gitserverClient GitserverClient,
@@ -47,6 +51,7 @@ func NewUploadProcessorWorker(
 
 	handler := NewUploadProcessorHandler(
 		observationCtx,
+		db,
 		store,
 		lsifStore,
 		gitserverClient,
@@ -70,6 +75,7 @@ func NewUploadProcessorWorker(
 }
 
 type handler struct {
+	db              database.DB
 	store           store.Store
 	lsifStore       lsifstore.LsifStore
 	gitserverClient GitserverClient
@@ -90,6 +96,7 @@ var (
 
 func NewUploadProcessorHandler(
 	observationCtx *observation.Context,
+	db database.DB,
 	store store.Store,
 	lsifStore lsifstore.LsifStore,
 	gitserverClient GitserverClient,
@@ -101,6 +108,7 @@ func NewUploadProcessorHandler(
 	operations := newWorkerOperations(observationCtx)
 
 	return &handler{
+		db:              db,
 		store:           store,
 		lsifStore:       lsifStore,
 		gitserverClient: gitserverClient,
@@ -128,10 +136,35 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, upload codeinte
 	}()
 
 	requeued, err = h.HandleRawUpload(ctx, logger, upload, h.uploadStore, otLogger)
+	if !requeued {
+		h.enqueueWebhook(ctx, logger, upload, err)
+	}
 
 	return err
 }
 
+// enqueueWebhook enqueues the outbound webhook event for an upload that has
+// finished processing, successfully or not. Uploads are not retried, so an
+// error here is final.
+func (h *handler) enqueueWebhook(ctx context.Context, logger log.Logger, upload codeinteltypes.Upload, err error) {
+	eventType := outbound.SCIPUploadComplete
+	payload := outbound.SCIPUploadPayload{
+		ID:             relay.MarshalID("PreciseIndex", fmt.Sprintf("U:%d", upload.ID)),
+		RepositoryID:   relay.MarshalID("Repository", upload.RepositoryID),
+		RepositoryName: upload.RepositoryName,
+		Commit:         upload.Commit,
+		Root:           upload.Root,
+		Indexer:        upload.Indexer,
+	}
+	if err != nil {
+		eventType = outbound.SCIPUploadError
+		payload.Error = err.Error()
+	}
+
+	scope := upload.RepositoryName
+	outbound.EnqueueEvent(ctx, logger, h.db, eventType, &scope, payload)
+}
+
 func (h *handler) PreDequeue(_ context.Context, _ log.Logger) (bool, any, error) {
 	if !h.enableBudget {
 		return true, nil, nil
@@ -279,7 +312,7 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 				return errors.Wrap(err, "store.DeleteOverlappingDumps")
 			}
 
-			packages, packageReferences, err := readPackageAndPackageReferences(ctx, correlatedSCIPData)
+			packages, packageReferences, symbolReferences, err := readPackageAndPackageReferences(ctx, correlatedSCIPData)
 			if err != nil {
 				return err
 			}
@@ -293,6 +326,11 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 			if err := tx.UpdatePackageReferences(ctx, upload.ID, packageReferences); err != nil {
 				return errors.Wrap(err, "store.UpdatePackageReferences")
 			}
+			trace.AddEvent("TODO Domain Owner", attribute.Int("symbolReferences", len(symbolReferences)))
+			// Update the global symbol index to support cross-repo queries for unpublished packages.
+			if err := tx.UpdateSymbolReferences(ctx, upload.ID, symbolReferences); err != nil {
+				return errors.Wrap(err, "store.UpdateSymbolReferences")
+			}
 
 			// Insert a companion record to this upload that will asynchronously trigger other workers to
 			// sync/create referenced dependency repositories and queue auto-index records for the monikers
@@ -180,6 +180,14 @@ func TestHandle(t *testing.T) {
 		}
 	}
 
+	if len(mockDBStore.UpdateSymbolReferencesFunc.History()) != 1 {
+		t.Errorf("unexpected number of UpdateSymbolReferences calls. want=%d have=%d", 1, len(mockDBStore.UpdateSymbolReferencesFunc.History()))
+	} else if mockDBStore.UpdateSymbolReferencesFunc.History()[0].Arg1 != 42 {
+		t.Errorf("unexpected value for upload id. want=%d have=%d", 42, mockDBStore.UpdateSymbolReferencesFunc.History()[0].Arg1)
+	} else if len(mockDBStore.UpdateSymbolReferencesFunc.History()[0].Arg2) == 0 {
+		t.Errorf("expected symbol references to be written")
+	}
+
 	if len(mockDBStore.InsertDependencySyncingJobFunc.History()) != 1 {
 		t.Errorf("unexpected number of InsertDependencyIndexingJob calls. want=%d have=%d", 1, len(mockDBStore.InsertDependencySyncingJobFunc.History()))
 	} else if mockDBStore.InsertDependencySyncingJobFunc.History()[0].Arg1 != 42 {
@@ -24,9 +24,9 @@ import (
 //
 // As a side-effect of processing documents, a symbol map is built to determine which symbols should
 // be advertised as part of our cross-index/cross-repository metadata. Consumers must expect to consume
-// the set of processed documents *before* accessing the package or package reference channels - they
-// will not be written to until the documents channel has been closed. Consumers should process both
-// package and package reference channels concurrently.
+// the set of processed documents *before* accessing the package, package reference, or symbol reference
+// channels - they will not be written to until the documents channel has been closed. Consumers should
+// process all three channels concurrently.
 func correlateSCIP(
 	ctx context.Context,
 	r io.Reader,
@@ -47,6 +47,7 @@ func correlateSCIP(
 		documents             = make(chan lsifstore.ProcessedSCIPDocument)
 		packages              = make(chan precise.Package)
 		packageReferences     = make(chan precise.PackageReference)
+		symbolReferences      = make(chan string)
 		externalSymbolsByName = readExternalSymbols(index)
 	)
 
@@ -54,6 +55,7 @@ func correlateSCIP(
 		defer close(documents)
 
 		packageSet := map[precise.Package]bool{}
+		symbolSet := map[string]bool{}
 		for _, document := range codeinteltypes.SortDocuments(codeinteltypes.FlattenDocuments(index.Documents)) {
 			if _, ok := ignorePaths[document.RelativePath]; ok {
 				continue
@@ -78,6 +80,9 @@ func correlateSCIP(
 					if pkg, ok := packageFromSymbol(relationship.Symbol); ok {
 						packageSet[pkg] = false
 					}
+					if _, ok := symbolSet[relationship.Symbol]; !ok && !scip.IsLocalSymbol(relationship.Symbol) {
+						symbolSet[relationship.Symbol] = false
+					}
 				}
 			}
 
@@ -86,16 +91,21 @@ func correlateSCIP(
 					continue
 				}
 
+				isDefinition := scip.SymbolRole_Definition.Matches(occurrence)
 				if pkg, ok := packageFromSymbol(occurrence.Symbol); ok {
-					isDefinition := scip.SymbolRole_Definition.Matches(occurrence)
 					packageSet[pkg] = packageSet[pkg] || isDefinition
 				}
+
+				// Similarly, stash each exact symbol name so that symbols we only reference can be
+				// found from other indexes, even if no index defines their package.
+				symbolSet[occurrence.Symbol] = symbolSet[occurrence.Symbol] || isDefinition
 			}
 		}
 
 		go func() {
 			defer close(packages)
 			defer close(packageReferences)
+			defer close(symbolReferences)
 
 			// Now that we've populated our index-global packages map, separate them into ones that
 			// we define and ones that we simply reference. The closing of the documents channel at
@@ -116,6 +126,16 @@ func correlateSCIP(
 					}
 				}
 			}
+
+			for symbolName, hasDefinition := range symbolSet {
+				if !hasDefinition {
+					select {
+					case symbolReferences <- symbolName:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
 		}()
 	}()
 
@@ -132,20 +152,21 @@ func correlateSCIP(
 		Documents:         documents,
 		Packages:          packages,
 		PackageReferences: packageReferences,
+		SymbolReferences:  symbolReferences,
 	}, nil
 }
 
-// readPackageAndPackageReferences reads content from the package and package reference channels of
-// the output of `correlateSCIP` and returns them as slices categorized by type. See the implementations
-// notes on that function for details.
+// readPackageAndPackageReferences reads content from the package, package reference, and symbol reference
+// channels of the output of `correlateSCIP` and returns them as slices categorized by type. See the
+// implementations notes on that function for details.
 func readPackageAndPackageReferences(
 	ctx context.Context,
 	correlatedSCIPData lsifstore.ProcessedSCIPData,
-) (packages []precise.Package, packageReferences []precise.PackageReference, _ error) {
-	// Perform the following loop while both the package and package reference channels are
-	// open. Since the producer of both channels are from the same thread, we have to be able
-	// to read from either channel as values are being produced. Once one channel closes, we
-	// switch to reading from the still open channel until it is closed as well.
+) (packages []precise.Package, packageReferences []precise.PackageReference, symbolReferences []string, _ error) {
+	// Perform the following loop while all of the channels are open. Since the producer of
+	// the channels is a single thread, we have to be able to read from any channel as values
+	// are being produced. Once one channel closes, the producer has finished writing, so we
+	// switch to draining the remaining channels.
 
 loop:
 	for {
@@ -162,18 +183,27 @@ loop:
 			}
 			packageReferences = append(packageReferences, packageReference)
 
+		case symbolReference, ok := <-correlatedSCIPData.SymbolReferences:
+			if !ok {
+				break loop
+			}
+			symbolReferences = append(symbolReferences, symbolReference)
+
 		case <-ctx.Done():
-			return nil, nil, ctx.Err()
+			return nil, nil, nil, ctx.Err()
 		}
 	}
 
-	// Drain both channels in case anything is left
+	// Drain all channels in case anything is left
 	for pkg := range correlatedSCIPData.Packages {
 		packages = append(packages, pkg)
 	}
 	for packageReference := range correlatedSCIPData.PackageReferences {
 		packageReferences = append(packageReferences, packageReference)
 	}
+	for symbolReference := range correlatedSCIPData.SymbolReferences {
+		symbolReferences = append(symbolReferences, symbolReference)
+	}
 
 	// Sort prior to return to get deterministic output
 	sort.Slice(packages, func(i, j int) bool {
@@ -182,8 +212,9 @@ loop:
 	sort.Slice(packageReferences, func(i, j int) bool {
 		return comparePackages(packageReferences[i].Package, packageReferences[j].Package)
 	})
+	sort.Strings(symbolReferences)
 
-	return packages, packageReferences, nil
+	return packages, packageReferences, symbolReferences, nil
 }
 
 // readIndex unmarshals a SCIP index from the given reader.
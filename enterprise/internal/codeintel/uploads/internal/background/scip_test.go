@@ -5,6 +5,7 @@ import (
 	"context"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -37,7 +38,7 @@ func TestCorrelateSCIP(t *testing.T) {
 	for document := range correlatedSCIPData.Documents {
 		documents = append(documents, document)
 	}
-	packages, packageReferences, err := readPackageAndPackageReferences(ctx, correlatedSCIPData)
+	packages, packageReferences, symbolReferences, err := readPackageAndPackageReferences(ctx, correlatedSCIPData)
 	if err != nil {
 		t.Fatalf("unexpected error reading processed SCIP: %s", err)
 	}
@@ -156,6 +157,26 @@ func TestCorrelateSCIP(t *testing.T) {
 	if diff := cmp.Diff(expectedReferences, packageReferences); diff != "" {
 		t.Errorf("unexpected references (-want +got):\n%s", diff)
 	}
+
+	// Check symbol reference values
+	if !sort.StringsAreSorted(symbolReferences) {
+		t.Errorf("expected symbol references to be sorted")
+	}
+	symbolReferenceSet := map[string]struct{}{}
+	for _, symbolName := range symbolReferences {
+		if strings.HasPrefix(symbolName, "scip-typescript npm template ") {
+			t.Errorf("unexpected reference to defined symbol %q", symbolName)
+		}
+		symbolReferenceSet[symbolName] = struct{}{}
+	}
+	for _, symbolName := range []string{
+		"scip-typescript npm js-base64 3.7.1 `base64.d.ts`/decode.",
+		"scip-typescript npm sourcegraph 25.5.0 src/`sourcegraph.d.ts`/`'sourcegraph'`/commands/executeCommand().",
+	} {
+		if _, ok := symbolReferenceSet[symbolName]; !ok {
+			t.Errorf("expected symbol reference %q", symbolName)
+		}
+	}
 }
 
 var testedInvertedRangeIndex = []codeinteltypes.InvertedRangeIndex{
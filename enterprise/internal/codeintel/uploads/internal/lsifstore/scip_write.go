@@ -31,6 +31,7 @@ type ProcessedSCIPData struct {
 	Documents         <-chan ProcessedSCIPDocument
 	Packages          <-chan precise.Package
 	PackageReferences <-chan precise.PackageReference
+	SymbolReferences  <-chan string
 }
 
 type ProcessedMetadata struct {
@@ -70,6 +70,7 @@ type operations struct {
 
 	// References
 	updatePackageReferences *observation.Operation
+	updateSymbolReferences  *observation.Operation
 	referencesForUpload     *observation.Operation
 
 	// Audit logs
@@ -166,6 +167,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 		// References
 		updatePackageReferences: op("UpdatePackageReferences"),
+		updateSymbolReferences:  op("UpdateSymbolReferences"),
 		referencesForUpload:     op("ReferencesForUpload"),
 
 		// Audit logs
@@ -80,6 +80,73 @@ func loadReferencesChannel(references []precise.PackageReference) <-chan []any {
 	return ch
 }
 
+// UpdateSymbolReferences inserts the SCIP symbols referenced by the given upload into the global
+// symbol index. Unlike package references, this index allows cross-repository navigation to
+// symbols whose packages are not published by any upload.
+func (s *store) UpdateSymbolReferences(ctx context.Context, dumpID int, symbolNames []string) (err error) {
+	ctx, _, endObservation := s.operations.updateSymbolReferences.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numSymbolNames", len(symbolNames)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(symbolNames) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	// Create temporary table symmetric to codeintel_symbol_references without the dump id
+	if err := tx.Exec(ctx, sqlf.Sprintf(updateSymbolReferencesTemporaryTableQuery)); err != nil {
+		return err
+	}
+
+	// Bulk insert all the unique column values into the temporary table
+	if err := batch.InsertValues(
+		ctx,
+		tx.Handle(),
+		"t_codeintel_symbol_references",
+		batch.MaxNumPostgresParameters,
+		[]string{"symbol_name"},
+		loadSymbolNamesChannel(symbolNames),
+	); err != nil {
+		return err
+	}
+
+	// Insert the values from the temporary table into the target table. We select a
+	// parameterized dump id here since it is the same for all rows in this operation.
+	return tx.Exec(ctx, sqlf.Sprintf(updateSymbolReferencesInsertQuery, dumpID))
+}
+
+const updateSymbolReferencesTemporaryTableQuery = `
+CREATE TEMPORARY TABLE t_codeintel_symbol_references (
+	symbol_name text NOT NULL
+) ON COMMIT DROP
+`
+
+const updateSymbolReferencesInsertQuery = `
+INSERT INTO codeintel_symbol_references (upload_id, symbol_name)
+SELECT DISTINCT %s, source.symbol_name
+FROM t_codeintel_symbol_references source
+`
+
+func loadSymbolNamesChannel(symbolNames []string) <-chan []any {
+	ch := make(chan []any, len(symbolNames))
+
+	go func() {
+		defer close(ch)
+
+		for _, symbolName := range symbolNames {
+			ch <- []any{symbolName}
+		}
+	}()
+
+	return ch
+}
+
 // ReferencesForUpload returns the set of import monikers attached to the given upload identifier.
 func (s *store) ReferencesForUpload(ctx context.Context, uploadID int) (_ shared.PackageReferenceScanner, err error) {
 	ctx, _, endObservation := s.operations.referencesForUpload.With(ctx, &err, observation.Args{LogFields: []log.Field{
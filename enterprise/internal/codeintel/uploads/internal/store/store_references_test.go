@@ -48,6 +48,32 @@ func TestUpdatePackageReferences(t *testing.T) {
 	}
 }
 
+func TestUpdateSymbolReferences(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	// for foreign key relation
+	insertUploads(t, db, types.Upload{ID: 42})
+
+	if err := store.UpdateSymbolReferences(context.Background(), 42, []string{
+		"scip-go gomod leftpad 0.1.0 `leftpad`/Pad().",
+		"scip-go gomod leftpad 0.1.0 `leftpad`/PadLeft().",
+		"scip-go gomod leftpad 0.1.0 `leftpad`/PadRight().",
+		"scip-go gomod leftpad 0.1.0 `leftpad`/Pad().",
+	}); err != nil {
+		t.Fatalf("unexpected error updating symbol references: %s", err)
+	}
+
+	count, _, err := basestore.ScanFirstInt(db.QueryContext(context.Background(), "SELECT COUNT(*) FROM codeintel_symbol_references WHERE upload_id = 42"))
+	if err != nil {
+		t.Fatalf("unexpected error checking symbol reference count: %s", err)
+	}
+	if count != 3 {
+		t.Errorf("unexpected symbol reference count. want=%d have=%d", 3, count)
+	}
+}
+
 func TestReferencesForUpload(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
@@ -1348,12 +1348,17 @@ SET state = 'deleted'
 WHERE id IN (SELECT id FROM candidates)
 `
 
-// GetUploadIDsWithReferences returns uploads that probably contain an import
-// or implementation moniker whose identifier matches any of the given monikers' identifiers. This method
-// will not return uploads for commits which are unknown to gitserver, nor will it return uploads which
-// are listed in the given ignored identifier slice. This method also returns the number of records
-// scanned (but possibly filtered out from the return slice) from the database (the offset for the
-// subsequent request) and the total number of records in the database.
+// GetUploadIDsWithReferences returns uploads that probably contain an import or implementation moniker
+// whose identifier matches any of the given monikers' identifiers. An upload matches if it refers (via
+// package information) to any of the given monikers' packages, or if it references the exact SCIP symbol
+// of any of the given monikers as recorded in the global symbol index. The latter covers symbols whose
+// packages are not published anywhere. This method will not return uploads for commits which are unknown
+// to gitserver, nor will it return uploads which are listed in the given ignored identifier slice.
+//
+// Uploads are returned in ascending identifier order, beginning after the given upload identifier, so
+// that the next page can be requested by passing the last identifier of the current page. Unlike an
+// offset, this cursor does not shift as other uploads are processed or deleted between requests. If
+// fewer than limit identifiers are returned, there are no further pages.
 func (s *store) GetUploadIDsWithReferences(
 	ctx context.Context,
 	orderedMonikers []precise.QualifiedMonikerData,
@@ -1361,66 +1366,76 @@ func (s *store) GetUploadIDsWithReferences(
 	repositoryID int,
 	commit string,
 	limit int,
-	offset int,
+	afterUploadID int,
 	trace observation.TraceLogger,
-) (ids []int, recordsScanned int, totalCount int, err error) {
-	scanner, totalCount, err := s.GetVisibleUploadsMatchingMonikers(ctx, repositoryID, commit, orderedMonikers, limit, offset)
-	if err != nil {
-		return nil, 0, 0, errors.Wrap(err, "dbstore.ReferenceIDs")
+) (ids []int, err error) {
+	if len(orderedMonikers) == 0 {
+		return nil, nil
 	}
 
-	defer func() {
-		if closeErr := scanner.Close(); closeErr != nil {
-			err = errors.Append(err, errors.Wrap(closeErr, "dbstore.ReferenceIDs.Close"))
-		}
-	}()
-
-	ignoreIDsMap := map[int]struct{}{}
-	for _, id := range ignoreIDs {
-		ignoreIDsMap[id] = struct{}{}
+	qs := make([]*sqlf.Query, 0, len(orderedMonikers))
+	symbolNames := make([]string, 0, len(orderedMonikers))
+	for _, moniker := range orderedMonikers {
+		qs = append(qs, sqlf.Sprintf("(%s, %s, %s, %s)", moniker.Scheme, moniker.Manager, moniker.Name, moniker.Version))
+		symbolNames = append(symbolNames, moniker.Identifier)
 	}
 
-	filtered := map[int]struct{}{}
-
-	for len(filtered) < limit {
-		packageReference, exists, err := scanner.Next()
-		if err != nil {
-			return nil, 0, 0, errors.Wrap(err, "dbstore.GetUploadIDsWithReferences.Next")
-		}
-		if !exists {
-			break
-		}
-		recordsScanned++
-
-		if _, ok := filtered[packageReference.DumpID]; ok {
-			// This index includes a definition so we can skip testing the filters here. The index
-			// will be included in the moniker search regardless if it contains additional references.
-			continue
-		}
-
-		if _, ok := ignoreIDsMap[packageReference.DumpID]; ok {
-			// Ignore this dump
-			continue
-		}
+	if ignoreIDs == nil {
+		ignoreIDs = []int{}
+	}
 
-		filtered[packageReference.DumpID] = struct{}{}
+	authzConds, err := database.AuthzQueryConds(ctx, database.NewDBWith(s.logger, s.db))
+	if err != nil {
+		return nil, err
 	}
 
-	if trace != nil {
-		trace.AddEvent("TODO Domain Owner",
-			attribute.Int("uploadIDsWithReferences.numFiltered", len(filtered)),
-			attribute.Int("uploadIDsWithReferences.numRecordsScanned", recordsScanned))
+	ids, err = basestore.ScanInts(s.db.Query(ctx, sqlf.Sprintf(
+		uploadIDsWithReferencesQuery,
+		makeVisibleUploadsQuery(repositoryID, commit),
+		repositoryID,
+		sqlf.Join(qs, ", "),
+		pq.Array(symbolNames),
+		afterUploadID,
+		pq.Array(ignoreIDs),
+		authzConds,
+		limit,
+	)))
+	if err != nil {
+		return nil, errors.Wrap(err, "dbstore.GetUploadIDsWithReferences")
 	}
 
-	flattened := make([]int, 0, len(filtered))
-	for k := range filtered {
-		flattened = append(flattened, k)
+	if trace != nil {
+		trace.AddEvent("TODO Domain Owner", attribute.Int("uploadIDsWithReferences.numIDs", len(ids)))
 	}
-	sort.Ints(flattened)
 
-	return flattened, recordsScanned, totalCount, nil
+	return ids, nil
 }
 
+const uploadIDsWithReferencesQuery = referenceIDsCTEDefinitions + `,
+candidates AS (
+	SELECT r.dump_id AS upload_id
+	FROM lsif_references r
+	WHERE (r.scheme, r.manager, r.name, r.version) IN (%s)
+
+	UNION
+
+	SELECT sr.upload_id
+	FROM codeintel_symbol_references sr
+	WHERE sr.symbol_name = ANY(%s)
+)
+SELECT u.id
+FROM candidates c
+JOIN lsif_dumps u ON u.id = c.upload_id
+JOIN repo ON repo.id = u.repository_id
+WHERE
+	u.id > %s AND
+	u.id != ALL(%s) AND
+	u.id IN (SELECT * FROM visible_uploads) AND
+	%s -- authz conds
+ORDER BY u.id
+LIMIT %s
+`
+
 // GetVisibleUploadsMatchingMonikers returns visible uploads that refer (via package information) to any of the
 // given monikers' packages.
 //
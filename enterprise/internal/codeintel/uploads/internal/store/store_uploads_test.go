@@ -1891,6 +1891,117 @@ func TestGetVisibleUploadsMatchingMonikers(t *testing.T) {
 	})
 }
 
+func TestGetUploadIDsWithReferences(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	insertUploads(t, db,
+		types.Upload{ID: 1, Commit: makeCommit(2), Root: "sub1/"},
+		types.Upload{ID: 2, Commit: makeCommit(3), Root: "sub2/"},
+		types.Upload{ID: 3, Commit: makeCommit(4), Root: "sub3/"},
+		types.Upload{ID: 4, Commit: makeCommit(3), Root: "sub4/"},
+		types.Upload{ID: 5, Commit: makeCommit(2), Root: "sub5/"},
+	)
+
+	insertNearestUploads(t, db, 50, map[string][]commitgraph.UploadMeta{
+		makeCommit(1): {
+			{UploadID: 1, Distance: 1},
+			{UploadID: 2, Distance: 2},
+			{UploadID: 3, Distance: 3},
+			{UploadID: 4, Distance: 2},
+			{UploadID: 5, Distance: 1},
+		},
+	})
+
+	// Uploads 1 and 3 refer to the package; uploads 2, 3, and 4 refer to the exact symbol
+	insertPackageReferences(t, store, []shared.PackageReference{
+		{Package: shared.Package{DumpID: 1, Scheme: "gomod", Name: "leftpad", Version: "0.1.0"}},
+		{Package: shared.Package{DumpID: 3, Scheme: "gomod", Name: "leftpad", Version: "0.1.0"}},
+	})
+
+	symbolName := "scip-go gomod leftpad 0.1.0 `leftpad`/Pad()."
+	for _, uploadID := range []int{2, 3, 4} {
+		if err := store.UpdateSymbolReferences(context.Background(), uploadID, []string{symbolName}); err != nil {
+			t.Fatalf("unexpected error updating symbol references: %s", err)
+		}
+	}
+
+	moniker := precise.QualifiedMonikerData{
+		MonikerData: precise.MonikerData{
+			Scheme:     "gomod",
+			Identifier: symbolName,
+		},
+		PackageInformationData: precise.PackageInformationData{
+			Name:    "leftpad",
+			Version: "0.1.0",
+		},
+	}
+
+	testCases := []struct {
+		ignoreIDs     []int
+		limit         int
+		afterUploadID int
+		expected      []int
+	}{
+		{nil, 5, 0, []int{1, 2, 3, 4}},
+		{[]int{2}, 5, 0, []int{1, 3, 4}},
+		{nil, 2, 0, []int{1, 2}},
+		{nil, 2, 2, []int{3, 4}},
+		{nil, 2, 4, nil},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("i=%d", i), func(t *testing.T) {
+			ids, err := store.GetUploadIDsWithReferences(context.Background(), []precise.QualifiedMonikerData{moniker}, testCase.ignoreIDs, 50, makeCommit(1), testCase.limit, testCase.afterUploadID, nil)
+			if err != nil {
+				t.Fatalf("unexpected error getting upload ids: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, ids); diff != "" {
+				t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("stable across deleted uploads", func(t *testing.T) {
+		ids, err := store.GetUploadIDsWithReferences(context.Background(), []precise.QualifiedMonikerData{moniker}, nil, 50, makeCommit(1), 2, 0, nil)
+		if err != nil {
+			t.Fatalf("unexpected error getting upload ids: %s", err)
+		}
+
+		// Remove an upload from the first page; the second page must not skip upload 3
+		if _, err := db.ExecContext(context.Background(), "DELETE FROM lsif_uploads WHERE id = 1"); err != nil {
+			t.Fatalf("unexpected error deleting upload: %s", err)
+		}
+
+		ids, err = store.GetUploadIDsWithReferences(context.Background(), []precise.QualifiedMonikerData{moniker}, nil, 50, makeCommit(1), 2, ids[len(ids)-1], nil)
+		if err != nil {
+			t.Fatalf("unexpected error getting upload ids: %s", err)
+		}
+		if diff := cmp.Diff([]int{3, 4}, ids); diff != "" {
+			t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("enforce repository permissions", func(t *testing.T) {
+		// Enable permissions user mapping forces checking repository permissions
+		// against permissions tables in the database, which should effectively block
+		// all access because permissions tables are empty.
+		before := globals.PermissionsUserMapping()
+		globals.SetPermissionsUserMapping(&schema.PermissionsUserMapping{Enabled: true})
+		defer globals.SetPermissionsUserMapping(before)
+
+		ids, err := store.GetUploadIDsWithReferences(context.Background(), []precise.QualifiedMonikerData{moniker}, nil, 50, makeCommit(1), 50, 0, nil)
+		if err != nil {
+			t.Fatalf("unexpected error getting upload ids: %s", err)
+		}
+		if len(ids) != 0 {
+			t.Errorf("unexpected upload ids. want=%v have=%v", nil, ids)
+		}
+	})
+}
+
 func TestCommitGraphMetadata(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
@@ -217,6 +217,9 @@ type MockStore struct {
 	// UpdateSourcedCommitsFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSourcedCommits.
 	UpdateSourcedCommitsFunc *StoreUpdateSourcedCommitsFunc
+	// UpdateSymbolReferencesFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateSymbolReferences.
+	UpdateSymbolReferencesFunc *StoreUpdateSymbolReferencesFunc
 	// UpdateUploadRetentionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateUploadRetention.
 	UpdateUploadRetentionFunc *StoreUpdateUploadRetentionFunc
@@ -359,7 +362,7 @@ func NewMockStore() *MockStore {
 			},
 		},
 		GetUploadIDsWithReferencesFunc: &StoreGetUploadIDsWithReferencesFunc{
-			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) (r0 []int, r1 int, r2 int, r3 error) {
+			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) (r0 []int, r1 error) {
 				return
 			},
 		},
@@ -508,6 +511,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UpdateSymbolReferencesFunc: &StoreUpdateSymbolReferencesFunc{
+			defaultHook: func(context.Context, int, []string) (r0 error) {
+				return
+			},
+		},
 		UpdateUploadRetentionFunc: &StoreUpdateUploadRetentionFunc{
 			defaultHook: func(context.Context, []int, []int) (r0 error) {
 				return
@@ -656,7 +664,7 @@ func NewStrictMockStore() *MockStore {
 			},
 		},
 		GetUploadIDsWithReferencesFunc: &StoreGetUploadIDsWithReferencesFunc{
-			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error) {
+			defaultHook: func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error) {
 				panic("unexpected invocation of MockStore.GetUploadIDsWithReferences")
 			},
 		},
@@ -805,6 +813,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.UpdateSourcedCommits")
 			},
 		},
+		UpdateSymbolReferencesFunc: &StoreUpdateSymbolReferencesFunc{
+			defaultHook: func(context.Context, int, []string) error {
+				panic("unexpected invocation of MockStore.UpdateSymbolReferences")
+			},
+		},
 		UpdateUploadRetentionFunc: &StoreUpdateUploadRetentionFunc{
 			defaultHook: func(context.Context, []int, []int) error {
 				panic("unexpected invocation of MockStore.UpdateUploadRetention")
@@ -992,6 +1005,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		UpdateSourcedCommitsFunc: &StoreUpdateSourcedCommitsFunc{
 			defaultHook: i.UpdateSourcedCommits,
 		},
+		UpdateSymbolReferencesFunc: &StoreUpdateSymbolReferencesFunc{
+			defaultHook: i.UpdateSymbolReferences,
+		},
 		UpdateUploadRetentionFunc: &StoreUpdateUploadRetentionFunc{
 			defaultHook: i.UpdateUploadRetention,
 		},
@@ -3817,24 +3833,24 @@ func (c StoreGetUploadByIDFuncCall) Results() []interface{} {
 // GetUploadIDsWithReferences method of the parent MockStore instance is
 // invoked.
 type StoreGetUploadIDsWithReferencesFunc struct {
-	defaultHook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error)
-	hooks       []func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error)
+	defaultHook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error)
+	hooks       []func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error)
 	history     []StoreGetUploadIDsWithReferencesFuncCall
 	mutex       sync.Mutex
 }
 
 // GetUploadIDsWithReferences delegates to the next hook function in the
 // queue and stores the parameter and result values of this invocation.
-func (m *MockStore) GetUploadIDsWithReferences(v0 context.Context, v1 []precise.QualifiedMonikerData, v2 []int, v3 int, v4 string, v5 int, v6 int, v7 observation.TraceLogger) ([]int, int, int, error) {
-	r0, r1, r2, r3 := m.GetUploadIDsWithReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6, v7)
-	m.GetUploadIDsWithReferencesFunc.appendCall(StoreGetUploadIDsWithReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, v7, r0, r1, r2, r3})
-	return r0, r1, r2, r3
+func (m *MockStore) GetUploadIDsWithReferences(v0 context.Context, v1 []precise.QualifiedMonikerData, v2 []int, v3 int, v4 string, v5 int, v6 int, v7 observation.TraceLogger) ([]int, error) {
+	r0, r1 := m.GetUploadIDsWithReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6, v7)
+	m.GetUploadIDsWithReferencesFunc.appendCall(StoreGetUploadIDsWithReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, v7, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // GetUploadIDsWithReferences method of the parent MockStore instance is
 // invoked and the hook queue is empty.
-func (f *StoreGetUploadIDsWithReferencesFunc) SetDefaultHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error)) {
+func (f *StoreGetUploadIDsWithReferencesFunc) SetDefaultHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error)) {
 	f.defaultHook = hook
 }
 
@@ -3843,7 +3859,7 @@ func (f *StoreGetUploadIDsWithReferencesFunc) SetDefaultHook(hook func(context.C
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *StoreGetUploadIDsWithReferencesFunc) PushHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error)) {
+func (f *StoreGetUploadIDsWithReferencesFunc) PushHook(hook func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3851,20 +3867,20 @@ func (f *StoreGetUploadIDsWithReferencesFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetUploadIDsWithReferencesFunc) SetDefaultReturn(r0 []int, r1 int, r2 int, r3 error) {
-	f.SetDefaultHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error) {
-		return r0, r1, r2, r3
+func (f *StoreGetUploadIDsWithReferencesFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetUploadIDsWithReferencesFunc) PushReturn(r0 []int, r1 int, r2 int, r3 error) {
-	f.PushHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error) {
-		return r0, r1, r2, r3
+func (f *StoreGetUploadIDsWithReferencesFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error) {
+		return r0, r1
 	})
 }
 
-func (f *StoreGetUploadIDsWithReferencesFunc) nextHook() func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, int, int, error) {
+func (f *StoreGetUploadIDsWithReferencesFunc) nextHook() func(context.Context, []precise.QualifiedMonikerData, []int, int, string, int, int, observation.TraceLogger) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3927,13 +3943,7 @@ type StoreGetUploadIDsWithReferencesFuncCall struct {
 	Result0 []int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 int
-	// Result3 is the value of the 4th result returned from this method
-	// invocation.
-	Result3 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
@@ -3945,7 +3955,7 @@ func (c StoreGetUploadIDsWithReferencesFuncCall) Args() []interface{} {
 // Results returns an interface slice containing the results of this
 // invocation.
 func (c StoreGetUploadIDsWithReferencesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // StoreGetUploadsFunc describes the behavior when the GetUploads method of
@@ -7176,6 +7186,115 @@ func (c StoreUpdateSourcedCommitsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreUpdateSymbolReferencesFunc describes the behavior when the
+// UpdateSymbolReferences method of the parent MockStore instance is
+// invoked.
+type StoreUpdateSymbolReferencesFunc struct {
+	defaultHook func(context.Context, int, []string) error
+	hooks       []func(context.Context, int, []string) error
+	history     []StoreUpdateSymbolReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateSymbolReferences delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) UpdateSymbolReferences(v0 context.Context, v1 int, v2 []string) error {
+	r0 := m.UpdateSymbolReferencesFunc.nextHook()(v0, v1, v2)
+	m.UpdateSymbolReferencesFunc.appendCall(StoreUpdateSymbolReferencesFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateSymbolReferences method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreUpdateSymbolReferencesFunc) SetDefaultHook(hook func(context.Context, int, []string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateSymbolReferences method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreUpdateSymbolReferencesFunc) PushHook(hook func(context.Context, int, []string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUpdateSymbolReferencesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUpdateSymbolReferencesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []string) error {
+		return r0
+	})
+}
+
+func (f *StoreUpdateSymbolReferencesFunc) nextHook() func(context.Context, int, []string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUpdateSymbolReferencesFunc) appendCall(r0 StoreUpdateSymbolReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUpdateSymbolReferencesFuncCall objects
+// describing the invocations of this function.
+func (f *StoreUpdateSymbolReferencesFunc) History() []StoreUpdateSymbolReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUpdateSymbolReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUpdateSymbolReferencesFuncCall is an object that describes an
+// invocation of method UpdateSymbolReferences on an instance of MockStore.
+type StoreUpdateSymbolReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUpdateSymbolReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUpdateSymbolReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreUpdateUploadRetentionFunc describes the behavior when the
 // UpdateUploadRetention method of the parent MockStore instance is invoked.
 type StoreUpdateUploadRetentionFunc struct {
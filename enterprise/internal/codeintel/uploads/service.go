@@ -100,8 +100,8 @@ func (s *Service) GetUploadsByIDs(ctx context.Context, ids ...int) ([]types.Uplo
 	return s.store.GetUploadsByIDs(ctx, ids...)
 }
 
-func (s *Service) GetUploadIDsWithReferences(ctx context.Context, orderedMonikers []precise.QualifiedMonikerData, ignoreIDs []int, repositoryID int, commit string, limit int, offset int) ([]int, int, int, error) {
-	return s.store.GetUploadIDsWithReferences(ctx, orderedMonikers, ignoreIDs, repositoryID, commit, limit, offset, nil)
+func (s *Service) GetUploadIDsWithReferences(ctx context.Context, orderedMonikers []precise.QualifiedMonikerData, ignoreIDs []int, repositoryID int, commit string, limit int, afterUploadID int) ([]int, error) {
+	return s.store.GetUploadIDsWithReferences(ctx, orderedMonikers, ignoreIDs, repositoryID, commit, limit, afterUploadID, nil)
 }
 
 func (s *Service) DeleteUploadByID(ctx context.Context, id int) (bool, error) {
@@ -104,14 +104,14 @@ func (r *rootResolver) LSIFUploadsByRepo(ctx context.Context, args *resolverstub
 	return sharedresolvers.NewUploadConnectionResolver(r.uploadSvc, r.autoindexSvc, r.policySvc, r.siteAdminChecker, r.repoStore, uploadsResolver, r.prefetcherFactory.Create(), r.locationResolverFactory.Create(), traceErrs), nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) DeleteLSIFUpload(ctx context.Context, args *struct{ ID graphql.ID }) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.deleteLsifUpload.With(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("uploadID", string(args.ID)),
 	}})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 
@@ -127,12 +127,12 @@ func (r *rootResolver) DeleteLSIFUpload(ctx context.Context, args *struct{ ID gr
 	return &resolverstubs.EmptyResponse{}, nil
 }
 
-// 🚨 SECURITY: Only site admins may modify code intelligence upload data
+// 🚨 SECURITY: Only site admins and users with the CODEINTEL#WRITE permission may modify code intelligence upload data
 func (r *rootResolver) DeleteLSIFUploads(ctx context.Context, args *resolverstubs.DeleteLSIFUploadsArgs) (_ *resolverstubs.EmptyResponse, err error) {
 	ctx, _, endObservation := r.operations.deleteLsifUploads.With(ctx, &err, observation.Args{})
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
-	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+	if err := r.siteAdminChecker.CheckCurrentUserCanManageCodeIntel(ctx); err != nil {
 		return nil, err
 	}
 
@@ -3,6 +3,7 @@ package background
 import (
 	"net/url"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -18,4 +19,8 @@ type actionArgs struct {
 	Query          string
 	Results        []*result.CommitMatch
 	IncludeResults bool
+
+	// ContentChanges is set instead of Results for monitors with a content or
+	// symbol query.
+	ContentChanges []*edb.ContentMatchChange
 }
@@ -0,0 +1,59 @@
+package background
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func truncateContentChanges(changes []*edb.ContentMatchChange, maxResults int) (_ []*edb.ContentMatchChange, totalCount, truncatedCount int) {
+	if len(changes) <= maxResults {
+		return changes, len(changes), 0
+	}
+	return changes[:maxResults], len(changes), len(changes) - maxResults
+}
+
+// toDisplayResultForContentChange converts a match of a content or symbol query
+// that appeared or disappeared into a DisplayResult that links to the matched
+// file.
+func toDisplayResultForContentChange(change *edb.ContentMatchChange, externalURL *url.URL, utmSource string) *DisplayResult {
+	resultType := "Added"
+	if change.Kind == edb.ContentMatchRemoved {
+		resultType = "Removed"
+	}
+
+	location := change.Path
+	if change.LineNumber > 0 {
+		location += ":" + strconv.Itoa(change.LineNumber)
+	}
+
+	content := location
+	if change.SymbolName != "" {
+		content = fmt.Sprintf("%s\n%s %s", location, change.SymbolKind, change.SymbolName)
+	} else if change.Preview != "" {
+		content = fmt.Sprintf("%s\n%s", location, truncateString(change.Preview))
+	}
+
+	return &DisplayResult{
+		ResultType: resultType,
+		CommitURL:  getFileURL(externalURL, change.RepoName, change.CommitID, change.Path, change.LineNumber, utmSource),
+		RepoName:   string(change.RepoName),
+		CommitID:   change.CommitID.Short(),
+		Content:    content,
+	}
+}
+
+func getFileURL(externalURL *url.URL, repoName api.RepoName, commitID api.CommitID, path string, line int, utmSource string) string {
+	u := externalURL.ResolveReference(&url.URL{Path: fmt.Sprintf("%s@%s/-/blob/%s", repoName, commitID, path)})
+	q := url.Values{}
+	q.Set("utm_source", utmSource)
+	u.RawQuery = q.Encode()
+	if line > 0 {
+		// Line anchors are value-less query parameters, which url.Values cannot encode.
+		u.RawQuery = "L" + strconv.Itoa(line) + "&" + u.RawQuery
+	}
+	return u.String()
+}
@@ -0,0 +1,130 @@
+package background
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+)
+
+var contentChangesMock = []*edb.ContentMatchChange{
+	{
+		Kind: edb.ContentMatchAdded,
+		ContentMatch: edb.ContentMatch{
+			RepoID:     1,
+			RepoName:   "github.com/test/test",
+			CommitID:   "7815187511872asbasdfa",
+			Path:       "internal/http/client.go",
+			LineNumber: 12,
+			Preview:    "\tdata, _ := ioutil.ReadAll(resp.Body)",
+		},
+	},
+	{
+		Kind: edb.ContentMatchRemoved,
+		ContentMatch: edb.ContentMatch{
+			RepoID:     1,
+			RepoName:   "github.com/test/test",
+			CommitID:   "1a2b3c4d5e6f7a8b9c0d",
+			Path:       "internal/util/io.go",
+			LineNumber: 3,
+			SymbolName: "ReadAll",
+			SymbolKind: "function",
+		},
+	},
+}
+
+func TestContentChanges(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	action := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		MonitorID:          42,
+		UTMSource:          "code-monitor-slack-webhook",
+		Query:              "repo:camdentest ioutil.ReadAll",
+		ContentChanges:     contentChangesMock,
+		IncludeResults:     true,
+	}
+
+	t.Run("email", func(t *testing.T) {
+		data, err := NewTemplateDataForNewSearchResults(action, &edb.EmailAction{Monitor: 42})
+		require.NoError(t, err)
+		require.Equal(t, 2, data.TotalCount)
+		require.Equal(t, []*DisplayResult{
+			{
+				ResultType: "Added",
+				CommitURL:  "https://sourcegraph.com/github.com/test/test@7815187511872asbasdfa/-/blob/internal/http/client.go?L12&utm_source=code-monitoring-email",
+				RepoName:   "github.com/test/test",
+				CommitID:   "7815187",
+				Content:    "internal/http/client.go:12\n\tdata, _ := ioutil.ReadAll(resp.Body)",
+			},
+			{
+				ResultType: "Removed",
+				CommitURL:  "https://sourcegraph.com/github.com/test/test@1a2b3c4d5e6f7a8b9c0d/-/blob/internal/util/io.go?L3&utm_source=code-monitoring-email",
+				RepoName:   "github.com/test/test",
+				CommitID:   "1a2b3c4",
+				Content:    "internal/util/io.go:3\nfunction ReadAll",
+			},
+		}, data.TruncatedResults)
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		b, err := json.Marshal(slackPayload(action))
+		require.NoError(t, err)
+
+		var payload struct {
+			Blocks []struct {
+				Text struct {
+					Text string `json:"text"`
+				} `json:"text"`
+			} `json:"blocks"`
+		}
+		require.NoError(t, json.Unmarshal(b, &payload))
+
+		texts := make([]string, len(payload.Blocks))
+		for i, block := range payload.Blocks {
+			texts[i] = block.Text.Text
+		}
+		require.Equal(t, []string{
+			"Camden Cheek's Sourcegraph Code monitor, *My test monitor*, detected *2* changed matches.",
+			"Added match: <https://sourcegraph.com/github.com/test/test@7815187511872asbasdfa/-/blob/internal/http/client.go?L12&utm_source=code-monitor-slack-webhook|github.com/test/test@7815187>",
+			"```internal/http/client.go:12\n\tdata, _ := ioutil.ReadAll(resp.Body)```",
+			"Removed match: <https://sourcegraph.com/github.com/test/test@1a2b3c4d5e6f7a8b9c0d/-/blob/internal/util/io.go?L3&utm_source=code-monitor-slack-webhook|github.com/test/test@1a2b3c4>",
+			"```internal/util/io.go:3\nfunction ReadAll```",
+			"If you are Camden Cheek, you can <https://sourcegraph.com/code-monitoring/Q29kZU1vbml0b3I6NDI=?utm_source=code-monitor-slack-webhook|edit your code monitor>",
+		}, texts)
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		payload := generateWebhookPayload(action)
+		require.Empty(t, payload.Results)
+		require.Equal(t, []webhookContentChange{
+			{
+				Kind:       "added",
+				Repository: "github.com/test/test",
+				Commit:     "7815187511872asbasdfa",
+				Path:       "internal/http/client.go",
+				LineNumber: 12,
+				Preview:    "\tdata, _ := ioutil.ReadAll(resp.Body)",
+			},
+			{
+				Kind:       "removed",
+				Repository: "github.com/test/test",
+				Commit:     "1a2b3c4d5e6f7a8b9c0d",
+				Path:       "internal/util/io.go",
+				LineNumber: 3,
+				SymbolName: "ReadAll",
+				SymbolKind: "function",
+			},
+		}, payload.ContentChanges)
+
+		withoutResults := action
+		withoutResults.IncludeResults = false
+		require.Empty(t, generateWebhookPayload(withoutResults).ContentChanges)
+	})
+}
@@ -78,11 +78,24 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *edb.EmailAction)
 		priority = ""
 	}
 
-	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
-
-	displayResults := make([]*DisplayResult, len(truncatedResults))
-	for i, result := range truncatedResults {
-		displayResults[i] = toDisplayResult(result, args.ExternalURL)
+	var (
+		displayResults             []*DisplayResult
+		totalCount, truncatedCount int
+	)
+	if len(args.ContentChanges) > 0 {
+		var truncatedChanges []*edb.ContentMatchChange
+		truncatedChanges, totalCount, truncatedCount = truncateContentChanges(args.ContentChanges, 5)
+		displayResults = make([]*DisplayResult, len(truncatedChanges))
+		for i, change := range truncatedChanges {
+			displayResults[i] = toDisplayResultForContentChange(change, args.ExternalURL, utmSourceEmail)
+		}
+	} else {
+		var truncatedResults []*searchresult.CommitMatch
+		truncatedResults, totalCount, truncatedCount = truncateResults(args.Results, 5)
+		displayResults = make([]*DisplayResult, len(truncatedResults))
+		for i, result := range truncatedResults {
+			displayResults[i] = toDisplayResult(result, args.ExternalURL)
+		}
 	}
 
 	return &TemplateDataNewSearchResults{
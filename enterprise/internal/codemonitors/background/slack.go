@@ -11,6 +11,7 @@ import (
 
 	"github.com/slack-go/slack"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	searchresult "github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -25,37 +26,62 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 		return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
 	}
 
-	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+	var (
+		displayResults             []*DisplayResult
+		totalCount, truncatedCount int
+		summary                    = "new"
+	)
+	if len(args.ContentChanges) > 0 {
+		var truncatedChanges []*edb.ContentMatchChange
+		truncatedChanges, totalCount, truncatedCount = truncateContentChanges(args.ContentChanges, 5)
+		for _, change := range truncatedChanges {
+			displayResults = append(displayResults, toDisplayResultForContentChange(change, args.ExternalURL, args.UTMSource))
+		}
+		summary = "changed"
+	} else {
+		var truncatedResults []*searchresult.CommitMatch
+		truncatedResults, totalCount, truncatedCount = truncateResults(args.Results, 5)
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			var contentRaw string
+			if result.DiffPreview != nil {
+				contentRaw = truncateString(result.DiffPreview.Content)
+			} else {
+				contentRaw = truncateString(result.MessagePreview.Content)
+			}
+			displayResults = append(displayResults, &DisplayResult{
+				ResultType: resultType,
+				CommitURL:  getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+				RepoName:   string(result.Repo.Name),
+				CommitID:   result.Commit.ID.Short(),
+				Content:    contentRaw,
+			})
+		}
+	}
 
 	blocks := []slack.Block{
 		newMarkdownSection(fmt.Sprintf(
-			"%s's Sourcegraph Code monitor, *%s*, detected *%d* new matches.",
+			"%s's Sourcegraph Code monitor, *%s*, detected *%d* %s matches.",
 			args.MonitorOwnerName,
 			args.MonitorDescription,
 			totalCount,
+			summary,
 		)),
 	}
 
 	if args.IncludeResults {
-		for _, result := range truncatedResults {
-			resultType := "Message"
-			if result.DiffPreview != nil {
-				resultType = "Diff"
-			}
+		for _, result := range displayResults {
 			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
 				"%s match: <%s|%s@%s>",
-				resultType,
-				getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
-				result.Repo.Name,
-				result.Commit.ID.Short(),
+				result.ResultType,
+				result.CommitURL,
+				result.RepoName,
+				result.CommitID,
 			)))
-			var contentRaw string
-			if result.DiffPreview != nil {
-				contentRaw = truncateString(result.DiffPreview.Content)
-			} else {
-				contentRaw = truncateString(result.MessagePreview.Content)
-			}
-			blocks = append(blocks, newMarkdownSection(formatCodeBlock(contentRaw)))
+			blocks = append(blocks, newMarkdownSection(formatCodeBlock(result.Content)))
 		}
 		if truncatedCount > 0 {
 			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
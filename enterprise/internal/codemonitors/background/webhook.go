@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -61,6 +62,10 @@ type webhookPayload struct {
 	MonitorURL         string          `json:"monitorURL"`
 	Query              string          `json:"query"`
 	Results            []webhookResult `json:"results,omitempty"`
+
+	// ContentChanges is set instead of Results for monitors with a content or
+	// symbol query.
+	ContentChanges []webhookContentChange `json:"contentChanges,omitempty"`
 }
 
 func generateWebhookPayload(args actionArgs) webhookPayload {
@@ -72,6 +77,7 @@ func generateWebhookPayload(args actionArgs) webhookPayload {
 
 	if args.IncludeResults {
 		p.Results = generateResults(args.Results)
+		p.ContentChanges = generateContentChanges(args.ContentChanges)
 	}
 
 	return p
@@ -106,6 +112,37 @@ func generateResults(in []*result.CommitMatch) []webhookResult {
 	return out
 }
 
+type webhookContentChange struct {
+	Kind       string `json:"kind"`
+	Repository string `json:"repository"`
+	Commit     string `json:"commit"`
+	Path       string `json:"path"`
+	LineNumber int    `json:"lineNumber,omitempty"`
+	Preview    string `json:"preview,omitempty"`
+	SymbolName string `json:"symbolName,omitempty"`
+	SymbolKind string `json:"symbolKind,omitempty"`
+}
+
+func generateContentChanges(in []*edb.ContentMatchChange) []webhookContentChange {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]webhookContentChange, len(in))
+	for i, change := range in {
+		out[i] = webhookContentChange{
+			Kind:       string(change.Kind),
+			Repository: string(change.RepoName),
+			Commit:     string(change.CommitID),
+			Path:       change.Path,
+			LineNumber: change.LineNumber,
+			Preview:    change.Preview,
+			SymbolName: change.SymbolName,
+			SymbolKind: change.SymbolKind,
+		}
+	}
+	return out
+}
+
 func rangesToInts(ranges result.Ranges) [][2]int {
 	out := make([][2]int, len(ranges))
 	for i, r := range ranges {
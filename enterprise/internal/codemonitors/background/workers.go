@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/keegancsmith/sqlf"
 
 	"github.com/sourcegraph/log"
@@ -20,10 +21,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 const (
@@ -156,20 +159,46 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 		}
 	}()
 
-	s, err := r.db.CodeMonitors().Transact(ctx)
+	webhook, err := r.handle(ctx, logger, triggerJob)
 	if err != nil {
 		return err
 	}
+
+	// The outbound webhook event is only enqueued once the transaction of the
+	// trigger job is committed, so that receivers are not notified of a
+	// trigger that was rolled back.
+	if webhook != nil {
+		r.enqueueTriggerWebhook(ctx, logger, webhook)
+	}
+	return nil
+}
+
+// triggerWebhook is an outbound webhook event for a monitor that was triggered
+// by new results, waiting to be enqueued.
+type triggerWebhook struct {
+	monitor     *edb.Monitor
+	query       string
+	resultCount int
+}
+
+// handle runs the query of the trigger job in a transaction. If the monitor was
+// triggered, the outbound webhook event to enqueue once the transaction is
+// committed is returned.
+func (r *queryRunner) handle(ctx context.Context, logger log.Logger, triggerJob *edb.TriggerJob) (_ *triggerWebhook, err error) {
+	s, err := r.db.CodeMonitors().Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
 	defer func() { err = s.Done(err) }()
 
 	q, err := s.GetQueryTriggerForJob(ctx, triggerJob.ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	m, err := s.GetMonitor(ctx, q.Monitor)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// SECURITY: set the actor to the user that owns the code monitor.
@@ -180,7 +209,11 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 
 	settings, err := codemonitors.Settings(ctx)
 	if err != nil {
-		return errors.Wrap(err, "query settings")
+		return nil, errors.Wrap(err, "query settings")
+	}
+
+	if codemonitors.IsContentQuery(q.QueryString) {
+		return r.handleContentQuery(ctx, logger, s, triggerJob, q, m, settings)
 	}
 
 	query := q.QueryString
@@ -194,27 +227,99 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	newLatestResult := latestResultTime(q.LatestResult, results, searchErr)
 	err = s.SetQueryTriggerNextRun(ctx, q.ID, s.Clock()().Add(5*time.Minute), newLatestResult.UTC())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// After setting the next run, check the error value
 	if searchErr != nil {
-		return errors.Wrap(searchErr, "execute search")
+		return nil, errors.Wrap(searchErr, "execute search")
 	}
 
 	// Log the actual query we ran and whether we got any new results.
 	err = s.UpdateTriggerJobWithResults(ctx, triggerJob.ID, query, results)
 	if err != nil {
-		return errors.Wrap(err, "UpdateTriggerJobWithResults")
+		return nil, errors.Wrap(err, "UpdateTriggerJobWithResults")
 	}
 
-	if len(results) > 0 {
-		_, err := s.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID)
+	if len(results) == 0 {
+		return nil, nil
+	}
+	if _, err := s.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID); err != nil {
+		return nil, errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+	}
+	return &triggerWebhook{monitor: m, query: query, resultCount: len(results)}, nil
+}
+
+// handleContentQuery runs a content or symbol query and compares its matches
+// to the matches of the previous run. The first run only records the matches.
+func (r *queryRunner) handleContentQuery(ctx context.Context, logger log.Logger, s edb.CodeMonitorStore, triggerJob *edb.TriggerJob, q *edb.QueryTrigger, m *edb.Monitor, settings *schema.Settings) (*triggerWebhook, error) {
+	matches, searchErr := codemonitors.SearchContent(ctx, logger, r.db, r.enterpriseJobs, q.QueryString, settings)
+
+	var changes []*edb.ContentMatchChange
+	if searchErr == nil {
+		previous, ok, err := s.GetContentSnapshot(ctx, m.ID)
 		if err != nil {
-			return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+			return nil, errors.Wrap(err, "GetContentSnapshot")
+		}
+		if ok {
+			changes = codemonitors.DiffContentMatches(previous, matches)
+		}
+		if err := s.UpsertContentSnapshot(ctx, m.ID, matches); err != nil {
+			return nil, errors.Wrap(err, "UpsertContentSnapshot")
 		}
 	}
-	return nil
+
+	// Log next_run and latest_result to table cm_queries.
+	now := s.Clock()()
+	newLatestResult := now
+	if len(changes) == 0 && q.LatestResult != nil {
+		newLatestResult = *q.LatestResult
+	}
+	err := s.SetQueryTriggerNextRun(ctx, q.ID, now.Add(5*time.Minute), newLatestResult.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	// After setting the next run, check the error value
+	if searchErr != nil {
+		return nil, errors.Wrap(searchErr, "execute search")
+	}
+
+	err = s.UpdateTriggerJobWithContentChanges(ctx, triggerJob.ID, q.QueryString, changes)
+	if err != nil {
+		return nil, errors.Wrap(err, "UpdateTriggerJobWithContentChanges")
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	if _, err := s.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID); err != nil {
+		return nil, errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+	}
+	return &triggerWebhook{monitor: m, query: q.QueryString, resultCount: len(changes)}, nil
+}
+
+// enqueueTriggerWebhook enqueues the outbound webhook event for a monitor that
+// was triggered by new results. A failure to enqueue the event is logged rather
+// than returned, as the trigger job has already been committed.
+func (r *queryRunner) enqueueTriggerWebhook(ctx context.Context, logger log.Logger, webhook *triggerWebhook) {
+	m := webhook.monitor
+	externalURL, err := getExternalURL(ctx)
+	if err != nil {
+		logger.Warn("failed to get external URL for outbound webhook", log.Error(err))
+		return
+	}
+
+	id := relay.MarshalID(MonitorKind, m.ID)
+	scope := string(id)
+	outbound.EnqueueEvent(ctx, logger, r.db, outbound.CodeMonitorTrigger, &scope, outbound.CodeMonitorPayload{
+		ID:          id,
+		Description: m.Description,
+		Owner:       relay.MarshalID("User", m.UserID),
+		Query:       webhook.query,
+		ResultCount: webhook.resultCount,
+		URL:         getCodeMonitorURL(externalURL, m.ID, "code-monitor-outbound-webhook"),
+	})
 }
 
 type actionRunner struct {
@@ -276,6 +381,7 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *edb.ActionJob) error
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     e.IncludeResults,
 	}
 
@@ -329,6 +435,7 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *edb.ActionJob) erro
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     w.IncludeResults,
 	}
 
@@ -365,6 +472,7 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *edb.ActionJob)
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     w.IncludeResults,
 	}
 
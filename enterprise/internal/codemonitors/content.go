@@ -0,0 +1,232 @@
+package codemonitors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// IsContentQuery returns true if the query of a code monitor is an ordinary
+// content, path or symbol query instead of a type:diff or type:commit query.
+// Monitors with a content query fire when the set of matches changes between
+// runs rather than for each new matching commit.
+func IsContentQuery(q string) bool {
+	plan, err := query.ParseStandard(q)
+	if err != nil {
+		// Let the search report the invalid query.
+		return false
+	}
+
+	return !isCommitPlan(plan)
+}
+
+func isCommitPlan(plan query.Q) bool {
+	isCommitQuery := false
+	query.VisitField(plan, query.FieldType, func(value string, _ bool, _ query.Annotation) {
+		if value == "diff" || value == "commit" {
+			isCommitQuery = true
+		}
+	})
+	return isCommitQuery
+}
+
+// ValidateQuery returns an error if a code monitor cannot notify about the
+// results of the query. Content queries must only return file, path or symbol
+// matches, so that type:repo or select:repo are rejected, and type:diff and
+// type:commit queries cannot be combined with other result types.
+func ValidateQuery(q string) error {
+	plan, err := query.ParseStandard(q)
+	if err != nil {
+		// Let the search report the invalid query.
+		return nil
+	}
+
+	isCommitQuery := isCommitPlan(plan)
+	supportedTypes := map[string]struct{}{"file": {}, "path": {}, "symbol": {}}
+	if isCommitQuery {
+		supportedTypes = map[string]struct{}{"diff": {}, "commit": {}}
+	}
+
+	query.VisitField(plan, query.FieldType, func(value string, _ bool, _ query.Annotation) {
+		if _, ok := supportedTypes[value]; !ok && err == nil {
+			err = errors.Errorf("code monitors do not support type:%s queries. Use a type:diff or type:commit query, or a content, path or symbol query", value)
+		}
+	})
+	if err != nil || isCommitQuery {
+		return err
+	}
+
+	query.VisitField(plan, query.FieldSelect, func(value string, _ bool, _ query.Annotation) {
+		if !isSupportedContentSelect(value) && err == nil {
+			err = errors.Errorf("code monitors do not support select:%s queries. Content queries can only select content, files or symbols", value)
+		}
+	})
+	return err
+}
+
+// isSupportedContentSelect returns true if the select path of a content query
+// yields file, path or symbol matches.
+func isSupportedContentSelect(value string) bool {
+	sp, err := filter.SelectPathFromString(value)
+	if err != nil {
+		// Let the search report the invalid select path.
+		return true
+	}
+	switch sp.Root() {
+	case filter.Content, filter.Symbol:
+		return true
+	case filter.File:
+		return sp.String() != "file.owners"
+	default:
+		return false
+	}
+}
+
+var errContentLimitHit = errors.New("code monitor query has more matches than the result limit, which would make its notifications unreliable. Narrow down the query or add a count: filter")
+
+// SearchContent runs a content or symbol query and returns all of its matches.
+func SearchContent(ctx context.Context, logger log.Logger, db database.DB, enterpriseJobs jobutil.EnterpriseJobs, query string, settings *schema.Settings) ([]*edb.ContentMatch, error) {
+	searchClient := client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs(), enterpriseJobs)
+	inputs, err := searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		query,
+		search.Precise,
+		search.Streaming,
+		settings,
+		envvar.SourcegraphDotComMode(),
+	)
+	if err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	agg := streaming.NewAggregatingStream()
+	_, err = searchClient.Execute(ctx, agg, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the matches are truncated, different matches can be returned on
+	// each run, which would be reported as changes.
+	if agg.Stats.IsLimitHit {
+		return nil, errcode.MakeNonRetryable(errContentLimitHit)
+	}
+
+	return contentMatchesFromResults(agg.Results)
+}
+
+func contentMatchesFromResults(results result.Matches) ([]*edb.ContentMatch, error) {
+	var matches []*edb.ContentMatch
+	for _, res := range results {
+		fm, ok := res.(*result.FileMatch)
+		if !ok {
+			return nil, errors.Errorf("expected search to only return file, path or symbol matches, but got type %T", res)
+		}
+
+		newMatch := func() *edb.ContentMatch {
+			return &edb.ContentMatch{
+				RepoID:   fm.Repo.ID,
+				RepoName: fm.Repo.Name,
+				CommitID: fm.CommitID,
+				Path:     fm.Path,
+			}
+		}
+
+		found := false
+		for _, lm := range fm.ChunkMatches.AsLineMatches() {
+			if len(lm.OffsetAndLengths) == 0 {
+				// Context line of a multiline match
+				continue
+			}
+			m := newMatch()
+			m.LineNumber = int(lm.LineNumber) + 1
+			m.Preview = lm.Preview
+			matches = append(matches, m)
+			found = true
+		}
+		for _, sm := range fm.Symbols {
+			m := newMatch()
+			m.LineNumber = sm.Symbol.Range().Start.Line + 1
+			m.SymbolName = sm.Symbol.Name
+			m.SymbolKind = sm.Symbol.Kind
+			matches = append(matches, m)
+			found = true
+		}
+		if !found {
+			matches = append(matches, newMatch())
+		}
+	}
+	return matches, nil
+}
+
+// DiffContentMatches returns the matches that appeared in current and the
+// matches that disappeared from previous. Matches are compared by repository,
+// path and the matched line or symbol, but not by line number, so that edits
+// which only move a match around a file are not reported while copying or
+// renaming a file is. Added matches are returned first, in the order of
+// current, followed by removed matches in the order of previous.
+func DiffContentMatches(previous, current []*edb.ContentMatch) []*edb.ContentMatchChange {
+	unmatched := make(map[contentMatchKey][]int, len(previous))
+	for i, m := range previous {
+		k := keyOf(m)
+		unmatched[k] = append(unmatched[k], i)
+	}
+
+	var changes []*edb.ContentMatchChange
+	for _, m := range current {
+		k := keyOf(m)
+		if idxs := unmatched[k]; len(idxs) > 0 {
+			unmatched[k] = idxs[1:]
+			continue
+		}
+		changes = append(changes, &edb.ContentMatchChange{Kind: edb.ContentMatchAdded, ContentMatch: *m})
+	}
+
+	removed := make(map[int]struct{})
+	for _, idxs := range unmatched {
+		for _, i := range idxs {
+			removed[i] = struct{}{}
+		}
+	}
+	for i, m := range previous {
+		if _, ok := removed[i]; ok {
+			changes = append(changes, &edb.ContentMatchChange{Kind: edb.ContentMatchRemoved, ContentMatch: *m})
+		}
+	}
+	return changes
+}
+
+type contentMatchKey struct {
+	repoID     api.RepoID
+	path       string
+	preview    string
+	symbolName string
+	symbolKind string
+}
+
+func keyOf(m *edb.ContentMatch) contentMatchKey {
+	return contentMatchKey{
+		repoID:     m.RepoID,
+		path:       m.Path,
+		preview:    strings.TrimSpace(m.Preview),
+		symbolName: m.SymbolName,
+		symbolKind: m.SymbolKind,
+	}
+}
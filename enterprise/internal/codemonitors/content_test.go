@@ -0,0 +1,166 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestIsContentQuery(t *testing.T) {
+	for q, want := range map[string]bool{
+		"ioutil.ReadAll":                   true,
+		"repo:foo type:symbol Handler":     true,
+		"type:path file:\\.pem$":           true,
+		"type:diff ioutil.ReadAll":         false,
+		"repo:foo type:commit fix":         false,
+		"(type:diff a) or (type:commit b)": false,
+	} {
+		t.Run(q, func(t *testing.T) {
+			require.Equal(t, want, IsContentQuery(q))
+		})
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	for q, wantErr := range map[string]bool{
+		"ioutil.ReadAll":                      false,
+		"repo:foo type:symbol Handler":        false,
+		"type:path file:\\.pem$":              false,
+		"ioutil.ReadAll select:file":          false,
+		"ioutil.ReadAll select:symbol.method": false,
+		"type:diff ioutil.ReadAll":            false,
+		"repo:foo type:commit fix":            false,
+		"type:repo foo":                       true,
+		"foo select:repo":                     true,
+		"foo select:file.owners":              true,
+		"(type:diff a) or (type:repo b)":      true,
+		"(type:diff a) or (type:file b)":      true,
+	} {
+		t.Run(q, func(t *testing.T) {
+			if err := ValidateQuery(q); (err != nil) != wantErr {
+				t.Errorf("unexpected error. wantErr=%t have=%v", wantErr, err)
+			}
+		})
+	}
+}
+
+func TestContentMatchesFromResults(t *testing.T) {
+	file := result.File{
+		Repo:     types.MinimalRepo{ID: 1, Name: "github.com/foo/bar"},
+		CommitID: "abc",
+		Path:     "main.go",
+	}
+
+	t.Run("lines, symbols and paths", func(t *testing.T) {
+		pathOnly := file
+		pathOnly.Path = "README.md"
+
+		matches, err := contentMatchesFromResults(result.Matches{
+			&result.FileMatch{
+				File: file,
+				ChunkMatches: result.ChunkMatches{{
+					Content:      "data, _ := ioutil.ReadAll(r)\nreturn data",
+					ContentStart: result.Location{Line: 9},
+					Ranges: result.Ranges{{
+						Start: result.Location{Line: 9, Column: 11},
+						End:   result.Location{Line: 9, Column: 25},
+					}},
+				}},
+				Symbols: []*result.SymbolMatch{{
+					Symbol: result.Symbol{Name: "readAll", Kind: "function", Line: 8},
+				}},
+			},
+			&result.FileMatch{File: pathOnly},
+		})
+		require.NoError(t, err)
+
+		base := edb.ContentMatch{RepoID: 1, RepoName: "github.com/foo/bar", CommitID: "abc", Path: "main.go"}
+		line, symbol, path := base, base, base
+		line.LineNumber = 10
+		line.Preview = "data, _ := ioutil.ReadAll(r)"
+		symbol.LineNumber = 8
+		symbol.SymbolName = "readAll"
+		symbol.SymbolKind = "function"
+		path.Path = "README.md"
+		require.Equal(t, []*edb.ContentMatch{&line, &symbol, &path}, matches)
+	})
+
+	t.Run("symbols of Zoekt and the symbols service", func(t *testing.T) {
+		// Zoekt reports 1-based lines. The symbols service reports 0-based
+		// lines, which backend.Symbols.ListTags converts to 1-based lines for
+		// both the searcher and symbol.Compute.
+		zoektSymbol := result.NewSymbolMatch(&file, 9, -1, "readAll", "function", "", "", "Go", "func readAll(r io.Reader) {", false)
+		symbolsServiceSymbol := &result.SymbolMatch{
+			File:   &file,
+			Symbol: result.Symbol{Name: "readAll", Kind: "function", Path: "main.go", Line: 8 + 1},
+		}
+
+		for _, sm := range []*result.SymbolMatch{zoektSymbol, symbolsServiceSymbol} {
+			matches, err := contentMatchesFromResults(result.Matches{
+				&result.FileMatch{File: file, Symbols: []*result.SymbolMatch{sm}},
+			})
+			require.NoError(t, err)
+			require.Len(t, matches, 1)
+			require.Equal(t, 9, matches[0].LineNumber)
+		}
+	})
+
+	t.Run("errors on commit matches", func(t *testing.T) {
+		_, err := contentMatchesFromResults(result.Matches{&result.CommitMatch{}})
+		require.Error(t, err)
+	})
+}
+
+func TestDiffContentMatches(t *testing.T) {
+	newMatch := func(path string, line int, preview string) *edb.ContentMatch {
+		return &edb.ContentMatch{RepoID: 1, RepoName: "github.com/foo/bar", Path: path, LineNumber: line, Preview: preview}
+	}
+	added := func(m *edb.ContentMatch) *edb.ContentMatchChange {
+		return &edb.ContentMatchChange{Kind: edb.ContentMatchAdded, ContentMatch: *m}
+	}
+	removed := func(m *edb.ContentMatch) *edb.ContentMatchChange {
+		return &edb.ContentMatchChange{Kind: edb.ContentMatchRemoved, ContentMatch: *m}
+	}
+
+	previous := []*edb.ContentMatch{
+		newMatch("a.go", 10, "\tioutil.ReadAll(r)"),
+		newMatch("b.go", 3, "ioutil.ReadAll(r)"),
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		require.Empty(t, DiffContentMatches(previous, previous))
+	})
+
+	t.Run("moved lines and indentation are ignored", func(t *testing.T) {
+		require.Empty(t, DiffContentMatches(previous, []*edb.ContentMatch{
+			newMatch("b.go", 30, "ioutil.ReadAll(r)"),
+			newMatch("a.go", 12, "ioutil.ReadAll(r)"),
+		}))
+	})
+
+	t.Run("renamed file", func(t *testing.T) {
+		renamed := newMatch("c.go", 3, "ioutil.ReadAll(r)")
+		require.Equal(t, []*edb.ContentMatchChange{
+			added(renamed),
+			removed(previous[1]),
+		}, DiffContentMatches(previous, []*edb.ContentMatch{previous[0], renamed}))
+	})
+
+	t.Run("duplicated line", func(t *testing.T) {
+		duplicate := newMatch("b.go", 4, "ioutil.ReadAll(r)")
+		require.Equal(t, []*edb.ContentMatchChange{
+			added(duplicate),
+		}, DiffContentMatches(previous, append(previous, duplicate)))
+	})
+
+	t.Run("all matches removed", func(t *testing.T) {
+		require.Equal(t, []*edb.ContentMatchChange{
+			removed(previous[0]),
+			removed(previous[1]),
+		}, DiffContentMatches(previous, nil))
+	})
+}
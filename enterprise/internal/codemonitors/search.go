@@ -186,8 +186,15 @@ func Search(ctx context.Context, logger log.Logger, db database.DB, enterpriseJo
 
 // Snapshot runs a dummy search that just saves the current state of the searched repos in the database.
 // On subsequent runs, this allows us to treat all new repos or sets of args as something new that should
-// be searched from the beginning.
+// be searched from the beginning. For content queries, it resets the matches of the previous run.
 func Snapshot(ctx context.Context, logger log.Logger, db database.DB, enterpriseJobs jobutil.EnterpriseJobs, query string, monitorID int64, settings *schema.Settings) error {
+	if IsContentQuery(query) {
+		// Content queries have no per-repo state to snapshot. Instead, forget the
+		// matches of the previous query so the next run records a new baseline
+		// without notifying.
+		return edb.NewEnterpriseDB(db).CodeMonitors().DeleteContentSnapshot(ctx, monitorID)
+	}
+
 	searchClient := client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs(), enterpriseJobs)
 	inputs, err := searchClient.Plan(
 		ctx,
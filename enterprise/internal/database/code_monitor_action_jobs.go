@@ -40,6 +40,10 @@ type ActionJobMetadata struct {
 	Results     []*result.CommitMatch
 	OwnerName   string
 
+	// The matches that appeared or disappeared, for monitors with a content
+	// or symbol query.
+	ContentChanges []*ContentMatchChange
+
 	// The query with after: filter.
 	Query string
 }
@@ -228,6 +232,7 @@ SELECT
 	ctj.query_string,
 	cm.id AS monitorID,
 	ctj.search_results,
+	ctj.content_changes,
 	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
@@ -240,15 +245,20 @@ WHERE caj.id = %s
 // GetActionJobMetada returns the set of fields needed to execute all action jobs
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
-	var resultsJSON []byte
+	var resultsJSON, contentChangesJSON []byte
 	m := &ActionJobMetadata{}
-	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName)
+	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &contentChangesJSON, &m.OwnerName)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
 		return nil, err
 	}
+	if len(contentChangesJSON) > 0 {
+		if err := json.Unmarshal(contentChangesJSON, &m.ContentChanges); err != nil {
+			return nil, err
+		}
+	}
 	return m, nil
 }
 
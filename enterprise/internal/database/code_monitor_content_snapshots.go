@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ContentMatch is a single match of a code monitor with a content or symbol
+// query: a matched line, a matched symbol, or a matched path.
+type ContentMatch struct {
+	RepoID   api.RepoID   `json:"repoID"`
+	RepoName api.RepoName `json:"repoName"`
+	CommitID api.CommitID `json:"commitID"`
+	Path     string       `json:"path"`
+
+	// LineNumber is the 1-based number of the matched line, or of the line
+	// the matched symbol is defined on. It is 0 for path matches.
+	LineNumber int `json:"lineNumber,omitempty"`
+	// Preview is the content of the matched line.
+	Preview string `json:"preview,omitempty"`
+
+	SymbolName string `json:"symbolName,omitempty"`
+	SymbolKind string `json:"symbolKind,omitempty"`
+}
+
+type ContentMatchChangeKind string
+
+const (
+	ContentMatchAdded   ContentMatchChangeKind = "added"
+	ContentMatchRemoved ContentMatchChangeKind = "removed"
+)
+
+// ContentMatchChange is a match of a code monitor with a content or symbol
+// query that appeared or disappeared since the previous run of the query.
+type ContentMatchChange struct {
+	Kind ContentMatchChangeKind `json:"kind"`
+	ContentMatch
+}
+
+// GetContentSnapshot returns the matches of the last run of the content or
+// symbol query of the code monitor. The returned bool is false if the query
+// has not run since the monitor was created or its query was changed.
+func (s *codeMonitorStore) GetContentSnapshot(ctx context.Context, monitorID int64) ([]*ContentMatch, bool, error) {
+	rawQuery := `
+	SELECT matches
+	FROM cm_content_snapshots
+	WHERE monitor_id = %s
+	`
+
+	var matchesJSON []byte
+	err := s.QueryRow(ctx, sqlf.Sprintf(rawQuery, monitorID)).Scan(&matchesJSON)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var matches []*ContentMatch
+	if err := json.Unmarshal(matchesJSON, &matches); err != nil {
+		return nil, false, err
+	}
+	return matches, true, nil
+}
+
+func (s *codeMonitorStore) UpsertContentSnapshot(ctx context.Context, monitorID int64, matches []*ContentMatch) error {
+	rawQuery := `
+	INSERT INTO cm_content_snapshots (monitor_id, matches, updated_at)
+	VALUES (%s, %s, %s)
+	ON CONFLICT (monitor_id) DO UPDATE
+	SET matches = EXCLUDED.matches,
+		updated_at = EXCLUDED.updated_at
+	`
+
+	// Appease non-null constraint on column
+	if matches == nil {
+		matches = []*ContentMatch{}
+	}
+	matchesJSON, err := json.Marshal(matches)
+	if err != nil {
+		return err
+	}
+	return s.Exec(ctx, sqlf.Sprintf(rawQuery, monitorID, matchesJSON, s.Now()))
+}
+
+func (s *codeMonitorStore) DeleteContentSnapshot(ctx context.Context, monitorID int64) error {
+	rawQuery := `
+	DELETE FROM cm_content_snapshots
+	WHERE monitor_id = %s
+	`
+
+	return s.Exec(ctx, sqlf.Sprintf(rawQuery, monitorID))
+}
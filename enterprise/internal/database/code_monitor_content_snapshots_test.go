@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreContentSnapshots(t *testing.T) {
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+	fixtures := populateCodeMonitorFixtures(t, db)
+	cm := db.CodeMonitors()
+
+	// Missing
+	_, ok, err := cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Insert empty
+	err = cm.UpsertContentSnapshot(ctx, fixtures.Monitor.ID, nil)
+	require.NoError(t, err)
+	matches, ok, err := cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, matches)
+
+	// Update
+	want := []*ContentMatch{
+		{RepoID: fixtures.Repo.ID, RepoName: fixtures.Repo.Name, CommitID: "abc", Path: "main.go", LineNumber: 3, Preview: "ioutil.ReadAll(r)"},
+		{RepoID: fixtures.Repo.ID, RepoName: fixtures.Repo.Name, CommitID: "abc", Path: "util.go", LineNumber: 1, SymbolName: "ReadAll", SymbolKind: "function"},
+	}
+	err = cm.UpsertContentSnapshot(ctx, fixtures.Monitor.ID, want)
+	require.NoError(t, err)
+	matches, ok, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, want, matches)
+
+	// Delete
+	err = cm.DeleteContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	_, ok, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -84,6 +84,28 @@ func TestUpdateTriggerJob(t *testing.T) {
 		err = db.CodeMonitors().UpdateTriggerJobWithResults(ctx, jobs[0].ID, "", nil)
 		require.NoError(t, err)
 	})
+
+	t.Run("content changes", func(t *testing.T) {
+		ctx := context.Background()
+		db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+		f := populateCodeMonitorFixtures(t, db)
+		jobs, err := db.CodeMonitors().EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+
+		changes := []*ContentMatchChange{{
+			Kind:         ContentMatchAdded,
+			ContentMatch: ContentMatch{RepoID: f.Repo.ID, RepoName: f.Repo.Name, CommitID: "abc", Path: "main.go", LineNumber: 3, Preview: "ioutil.ReadAll(r)"},
+		}}
+		err = db.CodeMonitors().UpdateTriggerJobWithContentChanges(ctx, jobs[0].ID, "ioutil.ReadAll", changes)
+		require.NoError(t, err)
+
+		js, err := db.CodeMonitors().ListQueryTriggerJobs(ctx, ListTriggerJobsOpts{QueryID: &f.Query.ID})
+		require.NoError(t, err)
+		require.Len(t, js, 1)
+		require.Equal(t, changes, js[0].ContentChanges)
+		require.Empty(t, js[0].SearchResults)
+	})
 }
 
 func TestListTriggerJobs(t *testing.T) {
@@ -50,6 +50,7 @@ type CodeMonitorStore interface {
 	CountQueryTriggerJobs(ctx context.Context, queryID int64) (int32, error)
 
 	UpdateTriggerJobWithResults(ctx context.Context, triggerJobID int32, queryString string, results []*result.CommitMatch) error
+	UpdateTriggerJobWithContentChanges(ctx context.Context, triggerJobID int32, queryString string, changes []*ContentMatchChange) error
 	DeleteOldTriggerJobs(ctx context.Context, retentionInDays int) error
 
 	UpdateEmailAction(_ context.Context, id int64, _ *EmailActionArgs) (*EmailAction, error)
@@ -90,6 +91,13 @@ type CodeMonitorStore interface {
 	HasAnyLastSearched(ctx context.Context, monitorID int64) (bool, error)
 	UpsertLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID, lastSearched []string) error
 	GetLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID) ([]string, error)
+
+	// GetContentSnapshot, UpsertContentSnapshot and DeleteContentSnapshot manage the
+	// matches of the last run of code monitors with a content or symbol query, which
+	// are compared to the matches of the next run to detect changes.
+	GetContentSnapshot(ctx context.Context, monitorID int64) ([]*ContentMatch, bool, error)
+	UpsertContentSnapshot(ctx context.Context, monitorID int64, matches []*ContentMatch) error
+	DeleteContentSnapshot(ctx context.Context, monitorID int64) error
 }
 
 // codeMonitorStore exposes methods to read and write codemonitors domain models
@@ -19,6 +19,7 @@ type EnterpriseDB interface {
 	Perms() PermsStore
 	SubRepoPerms() SubRepoPermsStore
 	Codeowners() CodeownersStore
+	InferredOwnership() InferredOwnershipStore
 }
 
 func NewEnterpriseDB(db database.DB) EnterpriseDB {
@@ -52,6 +53,10 @@ func (edb *enterpriseDB) Codeowners() CodeownersStore {
 	return CodeownersWith(basestore.NewWithHandle(edb.Handle()))
 }
 
+func (edb *enterpriseDB) InferredOwnership() InferredOwnershipStore {
+	return InferredOwnershipWith(basestore.NewWithHandle(edb.Handle()))
+}
+
 type InsightsDB interface {
 	dbutil.DB
 	basestore.ShareableStore
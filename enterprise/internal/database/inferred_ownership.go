@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+type InferredOwnershipStore interface {
+	basestore.ShareableStore
+	Done(error) error
+
+	// ReplaceInferredOwners replaces all owners of the given repo that were inferred from the given signal.
+	ReplaceInferredOwners(ctx context.Context, repoID api.RepoID, signal types.InferredOwnershipSignal, owners []*types.InferredOwner) error
+	// ListInferredOwners lists inferred owners given the options, ordered by file path, signal and descending score.
+	ListInferredOwners(ctx context.Context, opts ListInferredOwnersOpts) ([]*types.InferredOwner, error)
+	// DeleteInferredOwnersForRepos deletes all inferred owners of the given repos.
+	DeleteInferredOwnersForRepos(ctx context.Context, ids ...api.RepoID) error
+}
+
+type inferredOwnershipStore struct {
+	*basestore.Store
+}
+
+var inferredOwnersInsertColumns = []string{
+	"repo_id",
+	"file_path",
+	"signal",
+	"owner_handle",
+	"owner_email",
+	"score",
+	"window_start",
+	"window_end",
+	"updated_at",
+}
+
+func (s *inferredOwnershipStore) ReplaceInferredOwners(ctx context.Context, repoID api.RepoID, signal types.InferredOwnershipSignal, owners []*types.InferredOwner) error {
+	return s.Store.WithTransact(ctx, func(tx *basestore.Store) error {
+		q := sqlf.Sprintf(deleteInferredOwnersForSignalQueryFmtStr, repoID, signal)
+		if err := tx.Exec(ctx, q); err != nil {
+			return err
+		}
+
+		now := timeutil.Now()
+		return batch.WithInserter(
+			ctx,
+			tx.Handle(),
+			"own_inferred_owners",
+			batch.MaxNumPostgresParameters,
+			inferredOwnersInsertColumns,
+			func(inserter *batch.Inserter) error {
+				for _, owner := range owners {
+					if owner.UpdatedAt.IsZero() {
+						owner.UpdatedAt = now
+					}
+					if err := inserter.Insert(
+						ctx,
+						repoID,
+						owner.FilePath,
+						signal,
+						owner.Handle,
+						owner.Email,
+						owner.Score,
+						owner.WindowStart,
+						owner.WindowEnd,
+						owner.UpdatedAt,
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	})
+}
+
+const deleteInferredOwnersForSignalQueryFmtStr = `
+DELETE FROM own_inferred_owners
+WHERE repo_id = %s AND signal = %s
+`
+
+type ListInferredOwnersOpts struct {
+	// RepoID is the repository to list inferred owners of. It is required.
+	RepoID api.RepoID
+	// Only return owners of these files, if set.
+	Paths []string
+	// Only return owners inferred from these signals, if set.
+	Signals []types.InferredOwnershipSignal
+}
+
+var inferredOwnersColumns = []*sqlf.Query{
+	sqlf.Sprintf("repo_id"),
+	sqlf.Sprintf("file_path"),
+	sqlf.Sprintf("signal"),
+	sqlf.Sprintf("owner_handle"),
+	sqlf.Sprintf("owner_email"),
+	sqlf.Sprintf("score"),
+	sqlf.Sprintf("window_start"),
+	sqlf.Sprintf("window_end"),
+	sqlf.Sprintf("updated_at"),
+}
+
+func (s *inferredOwnershipStore) ListInferredOwners(ctx context.Context, opts ListInferredOwnersOpts) ([]*types.InferredOwner, error) {
+	where := []*sqlf.Query{
+		sqlf.Sprintf("repo_id = %s", opts.RepoID),
+	}
+	if len(opts.Paths) > 0 {
+		where = append(where, sqlf.Sprintf("file_path = ANY (%s)", pq.Array(opts.Paths)))
+	}
+	if len(opts.Signals) > 0 {
+		signals := make([]string, 0, len(opts.Signals))
+		for _, signal := range opts.Signals {
+			signals = append(signals, string(signal))
+		}
+		where = append(where, sqlf.Sprintf("signal = ANY (%s)", pq.Array(signals)))
+	}
+
+	q := sqlf.Sprintf(
+		listInferredOwnersQueryFmtStr,
+		sqlf.Join(inferredOwnersColumns, ", "),
+		sqlf.Join(where, "AND"),
+	)
+	return scanInferredOwners(s.Query(ctx, q))
+}
+
+const listInferredOwnersQueryFmtStr = `
+SELECT %s
+FROM own_inferred_owners
+WHERE %s
+ORDER BY
+    file_path ASC,
+    signal ASC,
+    score DESC,
+    owner_handle ASC,
+    owner_email ASC
+`
+
+func (s *inferredOwnershipStore) DeleteInferredOwnersForRepos(ctx context.Context, ids ...api.RepoID) error {
+	q := sqlf.Sprintf(deleteInferredOwnersForReposQueryFmtStr, pq.Array(ids))
+	return s.Exec(ctx, q)
+}
+
+const deleteInferredOwnersForReposQueryFmtStr = `
+DELETE FROM own_inferred_owners
+WHERE repo_id = ANY (%s)
+`
+
+func InferredOwnershipWith(other basestore.ShareableStore) InferredOwnershipStore {
+	return &inferredOwnershipStore{
+		Store: basestore.NewWithHandle(other.Handle()),
+	}
+}
+
+func (s *inferredOwnershipStore) With(other basestore.ShareableStore) InferredOwnershipStore {
+	return &inferredOwnershipStore{
+		Store: s.Store.With(other),
+	}
+}
+
+func (s *inferredOwnershipStore) WithTransact(ctx context.Context, f func(store InferredOwnershipStore) error) error {
+	return s.Store.WithTransact(ctx, func(tx *basestore.Store) error {
+		return f(&inferredOwnershipStore{
+			Store: tx,
+		})
+	})
+}
+
+var scanInferredOwners = basestore.NewSliceScanner(func(s dbutil.Scanner) (*types.InferredOwner, error) {
+	var o types.InferredOwner
+	err := s.Scan(
+		&o.RepoID,
+		&o.FilePath,
+		&o.Signal,
+		&o.Handle,
+		&o.Email,
+		&o.Score,
+		&o.WindowStart,
+		&o.WindowEnd,
+		&o.UpdatedAt,
+	)
+	return &o, err
+})
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestInferredOwnership(t *testing.T) {
+	ctx := context.Background()
+
+	logger := logtest.NoOp(t)
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+
+	createRepos(t, ctx, db.Repos(), 2)
+	store := db.InferredOwnership()
+
+	windowEnd := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	windowStart := windowEnd.Add(-90 * 24 * time.Hour)
+	newOwner := func(repoID api.RepoID, path string, signal owntypes.InferredOwnershipSignal, handle, email string, score float64) *owntypes.InferredOwner {
+		return &owntypes.InferredOwner{
+			RepoID:      repoID,
+			FilePath:    path,
+			Signal:      signal,
+			Handle:      handle,
+			Email:       email,
+			Score:       score,
+			WindowStart: windowStart,
+			WindowEnd:   windowEnd,
+		}
+	}
+	ownerKeys := func(owners []*owntypes.InferredOwner) []string {
+		keys := make([]string, 0, len(owners))
+		for _, o := range owners {
+			keys = append(keys, o.FilePath+" "+string(o.Signal)+" "+o.Handle+o.Email)
+		}
+		return keys
+	}
+
+	contributor := owntypes.InferredOwnershipSignalRecentContributor
+	reviewer := owntypes.InferredOwnershipSignalRecentReviewer
+
+	require.NoError(t, store.ReplaceInferredOwners(ctx, 1, contributor, []*owntypes.InferredOwner{
+		newOwner(1, "README.md", contributor, "", "alice@example.com", 0.25),
+		newOwner(1, "README.md", contributor, "bob", "bob@example.com", 0.75),
+		newOwner(1, "main.go", contributor, "", "alice@example.com", 1),
+	}))
+	require.NoError(t, store.ReplaceInferredOwners(ctx, 1, reviewer, []*owntypes.InferredOwner{
+		newOwner(1, "main.go", reviewer, "carol", "", 1),
+	}))
+	require.NoError(t, store.ReplaceInferredOwners(ctx, 2, contributor, []*owntypes.InferredOwner{
+		newOwner(2, "README.md", contributor, "", "dave@example.com", 1),
+	}))
+
+	t.Run("list all owners of a repo", func(t *testing.T) {
+		owners, err := store.ListInferredOwners(ctx, ListInferredOwnersOpts{RepoID: 1})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"README.md recent-contributor bobbob@example.com",
+			"README.md recent-contributor alice@example.com",
+			"main.go recent-contributor alice@example.com",
+			"main.go recent-reviewer carol",
+		}, ownerKeys(owners))
+		require.Equal(t, windowStart, owners[0].WindowStart.UTC())
+		require.Equal(t, windowEnd, owners[0].WindowEnd.UTC())
+		require.False(t, owners[0].UpdatedAt.IsZero())
+	})
+
+	t.Run("filter by paths and signals", func(t *testing.T) {
+		owners, err := store.ListInferredOwners(ctx, ListInferredOwnersOpts{
+			RepoID:  1,
+			Paths:   []string{"main.go"},
+			Signals: []owntypes.InferredOwnershipSignal{reviewer},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"main.go recent-reviewer carol"}, ownerKeys(owners))
+	})
+
+	t.Run("replace owners of a signal", func(t *testing.T) {
+		require.NoError(t, store.ReplaceInferredOwners(ctx, 1, contributor, []*owntypes.InferredOwner{
+			newOwner(1, "main.go", contributor, "bob", "bob@example.com", 1),
+		}))
+		owners, err := store.ListInferredOwners(ctx, ListInferredOwnersOpts{RepoID: 1})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"main.go recent-contributor bobbob@example.com",
+			"main.go recent-reviewer carol",
+		}, ownerKeys(owners))
+	})
+
+	t.Run("delete owners of repos", func(t *testing.T) {
+		require.NoError(t, store.DeleteInferredOwnersForRepos(ctx, 1))
+		owners, err := store.ListInferredOwners(ctx, ListInferredOwnersOpts{RepoID: 1})
+		require.NoError(t, err)
+		require.Empty(t, owners)
+
+		owners, err = store.ListInferredOwners(ctx, ListInferredOwnersOpts{RepoID: 2})
+		require.NoError(t, err)
+		require.Len(t, owners, 1)
+	})
+}
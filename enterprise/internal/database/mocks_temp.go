@@ -68,6 +68,9 @@ type MockCodeMonitorStore struct {
 	// CreateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateWebhookAction.
 	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
+	// DeleteContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteContentSnapshot.
+	DeleteContentSnapshotFunc *CodeMonitorStoreDeleteContentSnapshotFunc
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
@@ -106,6 +109,9 @@ type MockCodeMonitorStore struct {
 	// GetActionJobMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method GetActionJobMetadata.
 	GetActionJobMetadataFunc *CodeMonitorStoreGetActionJobMetadataFunc
+	// GetContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method GetContentSnapshot.
+	GetContentSnapshotFunc *CodeMonitorStoreGetContentSnapshotFunc
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
@@ -183,6 +189,10 @@ type MockCodeMonitorStore struct {
 	// UpdateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSlackWebhookAction.
 	UpdateSlackWebhookActionFunc *CodeMonitorStoreUpdateSlackWebhookActionFunc
+	// UpdateTriggerJobWithContentChangesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// UpdateTriggerJobWithContentChanges.
+	UpdateTriggerJobWithContentChangesFunc *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc
 	// UpdateTriggerJobWithResultsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// UpdateTriggerJobWithResults.
@@ -190,6 +200,9 @@ type MockCodeMonitorStore struct {
 	// UpdateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateWebhookAction.
 	UpdateWebhookActionFunc *CodeMonitorStoreUpdateWebhookActionFunc
+	// UpsertContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertContentSnapshot.
+	UpsertContentSnapshotFunc *CodeMonitorStoreUpsertContentSnapshotFunc
 	// UpsertLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertLastSearched.
 	UpsertLastSearchedFunc *CodeMonitorStoreUpsertLastSearchedFunc
@@ -265,6 +278,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteContentSnapshotFunc: &CodeMonitorStoreDeleteContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (r0 error) {
+				return
+			},
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: func(context.Context, []int64, int64) (r0 error) {
 				return
@@ -325,6 +343,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (r0 []*ContentMatch, r1 bool, r2 error) {
+				return
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *EmailAction, r1 error) {
 				return
@@ -450,6 +473,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, []*ContentMatchChange) (r0 error) {
+				return
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) (r0 error) {
 				return
@@ -460,6 +488,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpsertContentSnapshotFunc: &CodeMonitorStoreUpsertContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, []*ContentMatch) (r0 error) {
+				return
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) (r0 error) {
 				return
@@ -537,6 +570,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateWebhookAction")
 			},
 		},
+		DeleteContentSnapshotFunc: &CodeMonitorStoreDeleteContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteContentSnapshot")
+			},
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: func(context.Context, []int64, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
@@ -597,6 +635,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetActionJobMetadata")
 			},
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) ([]*ContentMatch, bool, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetContentSnapshot")
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (*EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
@@ -722,6 +765,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, []*ContentMatchChange) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithContentChanges")
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithResults")
@@ -732,6 +780,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateWebhookAction")
 			},
 		},
+		UpsertContentSnapshotFunc: &CodeMonitorStoreUpsertContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, []*ContentMatch) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpsertContentSnapshot")
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastSearched")
@@ -784,6 +837,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: i.CreateWebhookAction,
 		},
+		DeleteContentSnapshotFunc: &CodeMonitorStoreDeleteContentSnapshotFunc{
+			defaultHook: i.DeleteContentSnapshot,
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
@@ -820,6 +876,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		GetActionJobMetadataFunc: &CodeMonitorStoreGetActionJobMetadataFunc{
 			defaultHook: i.GetActionJobMetadata,
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: i.GetContentSnapshot,
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
@@ -895,12 +954,18 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
 			defaultHook: i.UpdateSlackWebhookAction,
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: i.UpdateTriggerJobWithContentChanges,
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: i.UpdateTriggerJobWithResults,
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
 			defaultHook: i.UpdateWebhookAction,
 		},
+		UpsertContentSnapshotFunc: &CodeMonitorStoreUpsertContentSnapshotFunc{
+			defaultHook: i.UpsertContentSnapshot,
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: i.UpsertLastSearched,
 		},
@@ -2369,6 +2434,115 @@ func (c CodeMonitorStoreCreateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreDeleteContentSnapshotFunc describes the behavior when the
+// DeleteContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreDeleteContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []CodeMonitorStoreDeleteContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteContentSnapshot delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteContentSnapshot(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteContentSnapshotFunc.nextHook()(v0, v1)
+	m.DeleteContentSnapshotFunc.appendCall(CodeMonitorStoreDeleteContentSnapshotFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) PushHook(hook func(context.Context, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) nextHook() func(context.Context, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) appendCall(r0 CodeMonitorStoreDeleteContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteContentSnapshotFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteContentSnapshotFunc) History() []CodeMonitorStoreDeleteContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteContentSnapshotFuncCall is an object that describes
+// an invocation of method DeleteContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreDeleteContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreDeleteContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteEmailActionsFunc describes the behavior when the
 // DeleteEmailActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -3694,6 +3868,120 @@ func (c CodeMonitorStoreGetActionJobMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetContentSnapshotFunc describes the behavior when the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64) ([]*ContentMatch, bool, error)
+	hooks       []func(context.Context, int64) ([]*ContentMatch, bool, error)
+	history     []CodeMonitorStoreGetContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// GetContentSnapshot delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetContentSnapshot(v0 context.Context, v1 int64) ([]*ContentMatch, bool, error) {
+	r0, r1, r2 := m.GetContentSnapshotFunc.nextHook()(v0, v1)
+	m.GetContentSnapshotFunc.appendCall(CodeMonitorStoreGetContentSnapshotFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetContentSnapshot
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64) ([]*ContentMatch, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushHook(hook func(context.Context, int64) ([]*ContentMatch, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultReturn(r0 []*ContentMatch, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int64) ([]*ContentMatch, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushReturn(r0 []*ContentMatch, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int64) ([]*ContentMatch, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeMonitorStoreGetContentSnapshotFunc) nextHook() func(context.Context, int64) ([]*ContentMatch, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetContentSnapshotFunc) appendCall(r0 CodeMonitorStoreGetContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetContentSnapshotFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) History() []CodeMonitorStoreGetContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetContentSnapshotFuncCall is an object that describes an
+// invocation of method GetContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*ContentMatch
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // CodeMonitorStoreGetEmailActionFunc describes the behavior when the
 // GetEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -6460,37 +6748,37 @@ func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreUpdateTriggerJobWithResultsFunc describes the behavior
-// when the UpdateTriggerJobWithResults method of the parent
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc describes the
+// behavior when the UpdateTriggerJobWithContentChanges method of the parent
 // MockCodeMonitorStore instance is invoked.
-type CodeMonitorStoreUpdateTriggerJobWithResultsFunc struct {
-	defaultHook func(context.Context, int32, string, []*result.CommitMatch) error
-	hooks       []func(context.Context, int32, string, []*result.CommitMatch) error
-	history     []CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc struct {
+	defaultHook func(context.Context, int32, string, []*ContentMatchChange) error
+	hooks       []func(context.Context, int32, string, []*ContentMatchChange) error
+	history     []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateTriggerJobWithResults delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateTriggerJobWithResults(v0 context.Context, v1 int32, v2 string, v3 []*result.CommitMatch) error {
-	r0 := m.UpdateTriggerJobWithResultsFunc.nextHook()(v0, v1, v2, v3)
-	m.UpdateTriggerJobWithResultsFunc.appendCall(CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall{v0, v1, v2, v3, r0})
+// UpdateTriggerJobWithContentChanges delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTriggerJobWithContentChanges(v0 context.Context, v1 int32, v2 string, v3 []*ContentMatchChange) error {
+	r0 := m.UpdateTriggerJobWithContentChangesFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateTriggerJobWithContentChangesFunc.appendCall(CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// UpdateTriggerJobWithResults method of the parent MockCodeMonitorStore
-// instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) SetDefaultHook(hook func(context.Context, int32, string, []*result.CommitMatch) error) {
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultHook(hook func(context.Context, int32, string, []*ContentMatchChange) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateTriggerJobWithResults method of the parent MockCodeMonitorStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) PushHook(hook func(context.Context, int32, string, []*result.CommitMatch) error) {
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushHook(hook func(context.Context, int32, string, []*ContentMatchChange) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6498,20 +6786,20 @@ func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) PushHook(hook func(con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, string, []*result.CommitMatch) error {
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string, []*ContentMatchChange) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, string, []*result.CommitMatch) error {
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string, []*ContentMatchChange) error {
 		return r0
 	})
 }
 
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) nextHook() func(context.Context, int32, string, []*result.CommitMatch) error {
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) nextHook() func(context.Context, int32, string, []*ContentMatchChange) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6524,28 +6812,28 @@ func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) nextHook() func(contex
 	return hook
 }
 
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) appendCall(r0 CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) {
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) appendCall(r0 CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall objects describing
-// the invocations of this function.
-func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) History() []CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall {
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) History() []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall is an object that
-// describes an invocation of method UpdateTriggerJobWithResults on an
-// instance of MockCodeMonitorStore.
-type CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall struct {
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall is an object
+// that describes an invocation of method UpdateTriggerJobWithContentChanges
+// on an instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6557,7 +6845,7 @@ type CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 []*result.CommitMatch
+	Arg3 []*ContentMatchChange
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -6565,34 +6853,149 @@ type CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// CodeMonitorStoreUpdateWebhookActionFunc describes the behavior when the
-// UpdateWebhookAction method of the parent MockCodeMonitorStore instance is
-// invoked.
-type CodeMonitorStoreUpdateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
-	history     []CodeMonitorStoreUpdateWebhookActionFuncCall
+// CodeMonitorStoreUpdateTriggerJobWithResultsFunc describes the behavior
+// when the UpdateTriggerJobWithResults method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpdateTriggerJobWithResultsFunc struct {
+	defaultHook func(context.Context, int32, string, []*result.CommitMatch) error
+	hooks       []func(context.Context, int32, string, []*result.CommitMatch) error
+	history     []CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateWebhookAction delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*WebhookAction, error) {
-	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
-	return r0, r1
-}
-
+// UpdateTriggerJobWithResults delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTriggerJobWithResults(v0 context.Context, v1 int32, v2 string, v3 []*result.CommitMatch) error {
+	r0 := m.UpdateTriggerJobWithResultsFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateTriggerJobWithResultsFunc.appendCall(CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTriggerJobWithResults method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) SetDefaultHook(hook func(context.Context, int32, string, []*result.CommitMatch) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTriggerJobWithResults method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) PushHook(hook func(context.Context, int32, string, []*result.CommitMatch) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string, []*result.CommitMatch) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string, []*result.CommitMatch) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) nextHook() func(context.Context, int32, string, []*result.CommitMatch) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) appendCall(r0 CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreUpdateTriggerJobWithResultsFunc) History() []CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall is an object that
+// describes an invocation of method UpdateTriggerJobWithResults on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []*result.CommitMatch
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreUpdateWebhookActionFunc describes the behavior when the
+// UpdateWebhookAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreUpdateWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
+	history     []CodeMonitorStoreUpdateWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateWebhookAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*WebhookAction, error) {
+	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
 // SetDefaultHook sets function that is called when the UpdateWebhookAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
@@ -6695,6 +7098,118 @@ func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpsertContentSnapshotFunc describes the behavior when the
+// UpsertContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreUpsertContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64, []*ContentMatch) error
+	hooks       []func(context.Context, int64, []*ContentMatch) error
+	history     []CodeMonitorStoreUpsertContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertContentSnapshot delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpsertContentSnapshot(v0 context.Context, v1 int64, v2 []*ContentMatch) error {
+	r0 := m.UpsertContentSnapshotFunc.nextHook()(v0, v1, v2)
+	m.UpsertContentSnapshotFunc.appendCall(CodeMonitorStoreUpsertContentSnapshotFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpsertContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64, []*ContentMatch) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) PushHook(hook func(context.Context, int64, []*ContentMatch) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, []*ContentMatch) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, []*ContentMatch) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) nextHook() func(context.Context, int64, []*ContentMatch) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) appendCall(r0 CodeMonitorStoreUpsertContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpsertContentSnapshotFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) History() []CodeMonitorStoreUpsertContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpsertContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpsertContentSnapshotFuncCall is an object that describes
+// an invocation of method UpsertContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpsertContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []*ContentMatch
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpsertContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpsertContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpsertLastSearchedFunc describes the behavior when the
 // UpsertLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -7843,6 +8358,9 @@ type MockEnterpriseDB struct {
 	// AccessTokensFunc is an instance of a mock function object controlling
 	// the behavior of the method AccessTokens.
 	AccessTokensFunc *EnterpriseDBAccessTokensFunc
+	// AuditLogsFunc is an instance of a mock function object controlling
+	// the behavior of the method AuditLogs.
+	AuditLogsFunc *EnterpriseDBAuditLogsFunc
 	// AuthzFunc is an instance of a mock function object controlling the
 	// behavior of the method Authz.
 	AuthzFunc *EnterpriseDBAuthzFunc
@@ -7892,6 +8410,9 @@ type MockEnterpriseDB struct {
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *EnterpriseDBHandleFunc
+	// InferredOwnershipFunc is an instance of a mock function object
+	// controlling the behavior of the method InferredOwnership.
+	InferredOwnershipFunc *EnterpriseDBInferredOwnershipFunc
 	// NamespacePermissionsFunc is an instance of a mock function object
 	// controlling the behavior of the method NamespacePermissions.
 	NamespacePermissionsFunc *EnterpriseDBNamespacePermissionsFunc
@@ -8016,6 +8537,11 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		AuditLogsFunc: &EnterpriseDBAuditLogsFunc{
+			defaultHook: func() (r0 database.AuditLogStore) {
+				return
+			},
+		},
 		AuthzFunc: &EnterpriseDBAuthzFunc{
 			defaultHook: func() (r0 database.AuthzStore) {
 				return
@@ -8096,6 +8622,11 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		InferredOwnershipFunc: &EnterpriseDBInferredOwnershipFunc{
+			defaultHook: func() (r0 InferredOwnershipStore) {
+				return
+			},
+		},
 		NamespacePermissionsFunc: &EnterpriseDBNamespacePermissionsFunc{
 			defaultHook: func() (r0 database.NamespacePermissionStore) {
 				return
@@ -8293,6 +8824,11 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.AccessTokens")
 			},
 		},
+		AuditLogsFunc: &EnterpriseDBAuditLogsFunc{
+			defaultHook: func() database.AuditLogStore {
+				panic("unexpected invocation of MockEnterpriseDB.AuditLogs")
+			},
+		},
 		AuthzFunc: &EnterpriseDBAuthzFunc{
 			defaultHook: func() database.AuthzStore {
 				panic("unexpected invocation of MockEnterpriseDB.Authz")
@@ -8373,6 +8909,11 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.Handle")
 			},
 		},
+		InferredOwnershipFunc: &EnterpriseDBInferredOwnershipFunc{
+			defaultHook: func() InferredOwnershipStore {
+				panic("unexpected invocation of MockEnterpriseDB.InferredOwnership")
+			},
+		},
 		NamespacePermissionsFunc: &EnterpriseDBNamespacePermissionsFunc{
 			defaultHook: func() database.NamespacePermissionStore {
 				panic("unexpected invocation of MockEnterpriseDB.NamespacePermissions")
@@ -8567,6 +9108,9 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		AccessTokensFunc: &EnterpriseDBAccessTokensFunc{
 			defaultHook: i.AccessTokens,
 		},
+		AuditLogsFunc: &EnterpriseDBAuditLogsFunc{
+			defaultHook: i.AuditLogs,
+		},
 		AuthzFunc: &EnterpriseDBAuthzFunc{
 			defaultHook: i.Authz,
 		},
@@ -8615,6 +9159,9 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		HandleFunc: &EnterpriseDBHandleFunc{
 			defaultHook: i.Handle,
 		},
+		InferredOwnershipFunc: &EnterpriseDBInferredOwnershipFunc{
+			defaultHook: i.InferredOwnership,
+		},
 		NamespacePermissionsFunc: &EnterpriseDBNamespacePermissionsFunc{
 			defaultHook: i.NamespacePermissions,
 		},
@@ -8924,34 +9471,35 @@ func (c EnterpriseDBAccessTokensFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBAuthzFunc describes the behavior when the Authz method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBAuthzFunc struct {
-	defaultHook func() database.AuthzStore
-	hooks       []func() database.AuthzStore
-	history     []EnterpriseDBAuthzFuncCall
+// EnterpriseDBAuditLogsFunc describes the behavior when the AuditLogs
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBAuditLogsFunc struct {
+	defaultHook func() database.AuditLogStore
+	hooks       []func() database.AuditLogStore
+	history     []EnterpriseDBAuditLogsFuncCall
 	mutex       sync.Mutex
 }
 
-// Authz delegates to the next hook function in the queue and stores the
+// AuditLogs delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Authz() database.AuthzStore {
-	r0 := m.AuthzFunc.nextHook()()
-	m.AuthzFunc.appendCall(EnterpriseDBAuthzFuncCall{r0})
+func (m *MockEnterpriseDB) AuditLogs() database.AuditLogStore {
+	r0 := m.AuditLogsFunc.nextHook()()
+	m.AuditLogsFunc.appendCall(EnterpriseDBAuditLogsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Authz method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBAuthzFunc) SetDefaultHook(hook func() database.AuthzStore) {
+// SetDefaultHook sets function that is called when the AuditLogs method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBAuditLogsFunc) SetDefaultHook(hook func() database.AuditLogStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Authz method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// AuditLogs method of the parent MockEnterpriseDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *EnterpriseDBAuthzFunc) PushHook(hook func() database.AuthzStore) {
+func (f *EnterpriseDBAuditLogsFunc) PushHook(hook func() database.AuditLogStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8959,20 +9507,20 @@ func (f *EnterpriseDBAuthzFunc) PushHook(hook func() database.AuthzStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBAuthzFunc) SetDefaultReturn(r0 database.AuthzStore) {
-	f.SetDefaultHook(func() database.AuthzStore {
+func (f *EnterpriseDBAuditLogsFunc) SetDefaultReturn(r0 database.AuditLogStore) {
+	f.SetDefaultHook(func() database.AuditLogStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBAuthzFunc) PushReturn(r0 database.AuthzStore) {
-	f.PushHook(func() database.AuthzStore {
+func (f *EnterpriseDBAuditLogsFunc) PushReturn(r0 database.AuditLogStore) {
+	f.PushHook(func() database.AuditLogStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBAuthzFunc) nextHook() func() database.AuthzStore {
+func (f *EnterpriseDBAuditLogsFunc) nextHook() func() database.AuditLogStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8985,46 +9533,144 @@ func (f *EnterpriseDBAuthzFunc) nextHook() func() database.AuthzStore {
 	return hook
 }
 
-func (f *EnterpriseDBAuthzFunc) appendCall(r0 EnterpriseDBAuthzFuncCall) {
+func (f *EnterpriseDBAuditLogsFunc) appendCall(r0 EnterpriseDBAuditLogsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBAuthzFuncCall objects
+// History returns a sequence of EnterpriseDBAuditLogsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBAuthzFunc) History() []EnterpriseDBAuthzFuncCall {
+func (f *EnterpriseDBAuditLogsFunc) History() []EnterpriseDBAuditLogsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBAuthzFuncCall, len(f.history))
+	history := make([]EnterpriseDBAuditLogsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBAuthzFuncCall is an object that describes an invocation of
-// method Authz on an instance of MockEnterpriseDB.
-type EnterpriseDBAuthzFuncCall struct {
+// EnterpriseDBAuditLogsFuncCall is an object that describes an invocation
+// of method AuditLogs on an instance of MockEnterpriseDB.
+type EnterpriseDBAuditLogsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.AuthzStore
+	Result0 database.AuditLogStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBAuthzFuncCall) Args() []interface{} {
+func (c EnterpriseDBAuditLogsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBAuthzFuncCall) Results() []interface{} {
+func (c EnterpriseDBAuditLogsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBBitbucketProjectPermissionsFunc describes the behavior when
-// the BitbucketProjectPermissions method of the parent MockEnterpriseDB
-// instance is invoked.
+// EnterpriseDBAuthzFunc describes the behavior when the Authz method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBAuthzFunc struct {
+	defaultHook func() database.AuthzStore
+	hooks       []func() database.AuthzStore
+	history     []EnterpriseDBAuthzFuncCall
+	mutex       sync.Mutex
+}
+
+// Authz delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Authz() database.AuthzStore {
+	r0 := m.AuthzFunc.nextHook()()
+	m.AuthzFunc.appendCall(EnterpriseDBAuthzFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authz method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBAuthzFunc) SetDefaultHook(hook func() database.AuthzStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authz method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBAuthzFunc) PushHook(hook func() database.AuthzStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBAuthzFunc) SetDefaultReturn(r0 database.AuthzStore) {
+	f.SetDefaultHook(func() database.AuthzStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBAuthzFunc) PushReturn(r0 database.AuthzStore) {
+	f.PushHook(func() database.AuthzStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBAuthzFunc) nextHook() func() database.AuthzStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBAuthzFunc) appendCall(r0 EnterpriseDBAuthzFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBAuthzFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBAuthzFunc) History() []EnterpriseDBAuthzFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBAuthzFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBAuthzFuncCall is an object that describes an invocation of
+// method Authz on an instance of MockEnterpriseDB.
+type EnterpriseDBAuthzFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.AuthzStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBAuthzFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBAuthzFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBBitbucketProjectPermissionsFunc describes the behavior when
+// the BitbucketProjectPermissions method of the parent MockEnterpriseDB
+// instance is invoked.
 type EnterpriseDBBitbucketProjectPermissionsFunc struct {
 	defaultHook func() database.BitbucketProjectPermissionsStore
 	hooks       []func() database.BitbucketProjectPermissionsStore
@@ -10540,6 +11186,107 @@ func (c EnterpriseDBHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// EnterpriseDBInferredOwnershipFunc describes the behavior when the
+// InferredOwnership method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBInferredOwnershipFunc struct {
+	defaultHook func() InferredOwnershipStore
+	hooks       []func() InferredOwnershipStore
+	history     []EnterpriseDBInferredOwnershipFuncCall
+	mutex       sync.Mutex
+}
+
+// InferredOwnership delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) InferredOwnership() InferredOwnershipStore {
+	r0 := m.InferredOwnershipFunc.nextHook()()
+	m.InferredOwnershipFunc.appendCall(EnterpriseDBInferredOwnershipFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the InferredOwnership
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBInferredOwnershipFunc) SetDefaultHook(hook func() InferredOwnershipStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InferredOwnership method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBInferredOwnershipFunc) PushHook(hook func() InferredOwnershipStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBInferredOwnershipFunc) SetDefaultReturn(r0 InferredOwnershipStore) {
+	f.SetDefaultHook(func() InferredOwnershipStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBInferredOwnershipFunc) PushReturn(r0 InferredOwnershipStore) {
+	f.PushHook(func() InferredOwnershipStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBInferredOwnershipFunc) nextHook() func() InferredOwnershipStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBInferredOwnershipFunc) appendCall(r0 EnterpriseDBInferredOwnershipFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBInferredOwnershipFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBInferredOwnershipFunc) History() []EnterpriseDBInferredOwnershipFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBInferredOwnershipFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBInferredOwnershipFuncCall is an object that describes an
+// invocation of method InferredOwnership on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBInferredOwnershipFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 InferredOwnershipStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBInferredOwnershipFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBInferredOwnershipFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // EnterpriseDBNamespacePermissionsFunc describes the behavior when the
 // NamespacePermissions method of the parent MockEnterpriseDB instance is
 // invoked.
@@ -14179,6 +14926,663 @@ func (c EnterpriseDBZoektReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockInferredOwnershipStore is a mock implementation of the
+// InferredOwnershipStore interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/internal/database) used for
+// unit testing.
+type MockInferredOwnershipStore struct {
+	// DeleteInferredOwnersForReposFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteInferredOwnersForRepos.
+	DeleteInferredOwnersForReposFunc *InferredOwnershipStoreDeleteInferredOwnersForReposFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *InferredOwnershipStoreDoneFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *InferredOwnershipStoreHandleFunc
+	// ListInferredOwnersFunc is an instance of a mock function object
+	// controlling the behavior of the method ListInferredOwners.
+	ListInferredOwnersFunc *InferredOwnershipStoreListInferredOwnersFunc
+	// ReplaceInferredOwnersFunc is an instance of a mock function object
+	// controlling the behavior of the method ReplaceInferredOwners.
+	ReplaceInferredOwnersFunc *InferredOwnershipStoreReplaceInferredOwnersFunc
+}
+
+// NewMockInferredOwnershipStore creates a new mock of the
+// InferredOwnershipStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockInferredOwnershipStore() *MockInferredOwnershipStore {
+	return &MockInferredOwnershipStore{
+		DeleteInferredOwnersForReposFunc: &InferredOwnershipStoreDeleteInferredOwnersForReposFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &InferredOwnershipStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		HandleFunc: &InferredOwnershipStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListInferredOwnersFunc: &InferredOwnershipStoreListInferredOwnersFunc{
+			defaultHook: func(context.Context, ListInferredOwnersOpts) (r0 []*types.InferredOwner, r1 error) {
+				return
+			},
+		},
+		ReplaceInferredOwnersFunc: &InferredOwnershipStoreReplaceInferredOwnersFunc{
+			defaultHook: func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockInferredOwnershipStore creates a new mock of the
+// InferredOwnershipStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockInferredOwnershipStore() *MockInferredOwnershipStore {
+	return &MockInferredOwnershipStore{
+		DeleteInferredOwnersForReposFunc: &InferredOwnershipStoreDeleteInferredOwnersForReposFunc{
+			defaultHook: func(context.Context, ...api.RepoID) error {
+				panic("unexpected invocation of MockInferredOwnershipStore.DeleteInferredOwnersForRepos")
+			},
+		},
+		DoneFunc: &InferredOwnershipStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockInferredOwnershipStore.Done")
+			},
+		},
+		HandleFunc: &InferredOwnershipStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockInferredOwnershipStore.Handle")
+			},
+		},
+		ListInferredOwnersFunc: &InferredOwnershipStoreListInferredOwnersFunc{
+			defaultHook: func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error) {
+				panic("unexpected invocation of MockInferredOwnershipStore.ListInferredOwners")
+			},
+		},
+		ReplaceInferredOwnersFunc: &InferredOwnershipStoreReplaceInferredOwnersFunc{
+			defaultHook: func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error {
+				panic("unexpected invocation of MockInferredOwnershipStore.ReplaceInferredOwners")
+			},
+		},
+	}
+}
+
+// NewMockInferredOwnershipStoreFrom creates a new mock of the
+// MockInferredOwnershipStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockInferredOwnershipStoreFrom(i InferredOwnershipStore) *MockInferredOwnershipStore {
+	return &MockInferredOwnershipStore{
+		DeleteInferredOwnersForReposFunc: &InferredOwnershipStoreDeleteInferredOwnersForReposFunc{
+			defaultHook: i.DeleteInferredOwnersForRepos,
+		},
+		DoneFunc: &InferredOwnershipStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		HandleFunc: &InferredOwnershipStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListInferredOwnersFunc: &InferredOwnershipStoreListInferredOwnersFunc{
+			defaultHook: i.ListInferredOwners,
+		},
+		ReplaceInferredOwnersFunc: &InferredOwnershipStoreReplaceInferredOwnersFunc{
+			defaultHook: i.ReplaceInferredOwners,
+		},
+	}
+}
+
+// InferredOwnershipStoreDeleteInferredOwnersForReposFunc describes the
+// behavior when the DeleteInferredOwnersForRepos method of the parent
+// MockInferredOwnershipStore instance is invoked.
+type InferredOwnershipStoreDeleteInferredOwnersForReposFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) error
+	hooks       []func(context.Context, ...api.RepoID) error
+	history     []InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteInferredOwnersForRepos delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) DeleteInferredOwnersForRepos(v0 context.Context, v1 ...api.RepoID) error {
+	r0 := m.DeleteInferredOwnersForReposFunc.nextHook()(v0, v1...)
+	m.DeleteInferredOwnersForReposFunc.appendCall(InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteInferredOwnersForRepos method of the parent
+// MockInferredOwnershipStore instance is invoked and the hook queue is
+// empty.
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteInferredOwnersForRepos method of the parent
+// MockInferredOwnershipStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) nextHook() func(context.Context, ...api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) appendCall(r0 InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall objects
+// describing the invocations of this function.
+func (f *InferredOwnershipStoreDeleteInferredOwnersForReposFunc) History() []InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall is an object
+// that describes an invocation of method DeleteInferredOwnersForRepos on an
+// instance of MockInferredOwnershipStore.
+type InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreDeleteInferredOwnersForReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// InferredOwnershipStoreDoneFunc describes the behavior when the Done
+// method of the parent MockInferredOwnershipStore instance is invoked.
+type InferredOwnershipStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []InferredOwnershipStoreDoneFuncCall
+	mutex       sync.Mutex
+}
+
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(InferredOwnershipStoreDoneFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockInferredOwnershipStore instance is invoked and the hook queue
+// is empty.
+func (f *InferredOwnershipStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Done method of the parent MockInferredOwnershipStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *InferredOwnershipStoreDoneFunc) PushHook(hook func(error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
+	})
+}
+
+func (f *InferredOwnershipStoreDoneFunc) nextHook() func(error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreDoneFunc) appendCall(r0 InferredOwnershipStoreDoneFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of InferredOwnershipStoreDoneFuncCall objects
+// describing the invocations of this function.
+func (f *InferredOwnershipStoreDoneFunc) History() []InferredOwnershipStoreDoneFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreDoneFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreDoneFuncCall is an object that describes an
+// invocation of method Done on an instance of MockInferredOwnershipStore.
+type InferredOwnershipStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InferredOwnershipStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// InferredOwnershipStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockInferredOwnershipStore instance is invoked.
+type InferredOwnershipStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []InferredOwnershipStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(InferredOwnershipStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockInferredOwnershipStore instance is invoked and the hook queue
+// is empty.
+func (f *InferredOwnershipStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockInferredOwnershipStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *InferredOwnershipStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *InferredOwnershipStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreHandleFunc) appendCall(r0 InferredOwnershipStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of InferredOwnershipStoreHandleFuncCall
+// objects describing the invocations of this function.
+func (f *InferredOwnershipStoreHandleFunc) History() []InferredOwnershipStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockInferredOwnershipStore.
+type InferredOwnershipStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InferredOwnershipStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// InferredOwnershipStoreListInferredOwnersFunc describes the behavior when
+// the ListInferredOwners method of the parent MockInferredOwnershipStore
+// instance is invoked.
+type InferredOwnershipStoreListInferredOwnersFunc struct {
+	defaultHook func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error)
+	hooks       []func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error)
+	history     []InferredOwnershipStoreListInferredOwnersFuncCall
+	mutex       sync.Mutex
+}
+
+// ListInferredOwners delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) ListInferredOwners(v0 context.Context, v1 ListInferredOwnersOpts) ([]*types.InferredOwner, error) {
+	r0, r1 := m.ListInferredOwnersFunc.nextHook()(v0, v1)
+	m.ListInferredOwnersFunc.appendCall(InferredOwnershipStoreListInferredOwnersFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListInferredOwners
+// method of the parent MockInferredOwnershipStore instance is invoked and
+// the hook queue is empty.
+func (f *InferredOwnershipStoreListInferredOwnersFunc) SetDefaultHook(hook func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListInferredOwners method of the parent MockInferredOwnershipStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *InferredOwnershipStoreListInferredOwnersFunc) PushHook(hook func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreListInferredOwnersFunc) SetDefaultReturn(r0 []*types.InferredOwner, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreListInferredOwnersFunc) PushReturn(r0 []*types.InferredOwner, r1 error) {
+	f.PushHook(func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error) {
+		return r0, r1
+	})
+}
+
+func (f *InferredOwnershipStoreListInferredOwnersFunc) nextHook() func(context.Context, ListInferredOwnersOpts) ([]*types.InferredOwner, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreListInferredOwnersFunc) appendCall(r0 InferredOwnershipStoreListInferredOwnersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// InferredOwnershipStoreListInferredOwnersFuncCall objects describing the
+// invocations of this function.
+func (f *InferredOwnershipStoreListInferredOwnersFunc) History() []InferredOwnershipStoreListInferredOwnersFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreListInferredOwnersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreListInferredOwnersFuncCall is an object that
+// describes an invocation of method ListInferredOwners on an instance of
+// MockInferredOwnershipStore.
+type InferredOwnershipStoreListInferredOwnersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListInferredOwnersOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.InferredOwner
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InferredOwnershipStoreListInferredOwnersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreListInferredOwnersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// InferredOwnershipStoreReplaceInferredOwnersFunc describes the behavior
+// when the ReplaceInferredOwners method of the parent
+// MockInferredOwnershipStore instance is invoked.
+type InferredOwnershipStoreReplaceInferredOwnersFunc struct {
+	defaultHook func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error
+	hooks       []func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error
+	history     []InferredOwnershipStoreReplaceInferredOwnersFuncCall
+	mutex       sync.Mutex
+}
+
+// ReplaceInferredOwners delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) ReplaceInferredOwners(v0 context.Context, v1 api.RepoID, v2 types.InferredOwnershipSignal, v3 []*types.InferredOwner) error {
+	r0 := m.ReplaceInferredOwnersFunc.nextHook()(v0, v1, v2, v3)
+	m.ReplaceInferredOwnersFunc.appendCall(InferredOwnershipStoreReplaceInferredOwnersFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// ReplaceInferredOwners method of the parent MockInferredOwnershipStore
+// instance is invoked and the hook queue is empty.
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) SetDefaultHook(hook func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReplaceInferredOwners method of the parent MockInferredOwnershipStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) PushHook(hook func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error {
+		return r0
+	})
+}
+
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) nextHook() func(context.Context, api.RepoID, types.InferredOwnershipSignal, []*types.InferredOwner) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) appendCall(r0 InferredOwnershipStoreReplaceInferredOwnersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// InferredOwnershipStoreReplaceInferredOwnersFuncCall objects describing
+// the invocations of this function.
+func (f *InferredOwnershipStoreReplaceInferredOwnersFunc) History() []InferredOwnershipStoreReplaceInferredOwnersFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreReplaceInferredOwnersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreReplaceInferredOwnersFuncCall is an object that
+// describes an invocation of method ReplaceInferredOwners on an instance of
+// MockInferredOwnershipStore.
+type InferredOwnershipStoreReplaceInferredOwnersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 types.InferredOwnershipSignal
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []*types.InferredOwner
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InferredOwnershipStoreReplaceInferredOwnersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreReplaceInferredOwnersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockPermsStore is a mock implementation of the PermsStore interface (from
 // the package
 // github.com/sourcegraph/sourcegraph/enterprise/internal/database) used for
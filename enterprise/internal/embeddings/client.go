@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/sourcegraph/conc/pool"
+
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -52,6 +54,13 @@ type EmbeddingsSearchParameters struct {
 	TextResultsCount int          `json:"textResultsCount"`
 }
 
+type EmbeddingsMultiSearchParameters struct {
+	RepoNames        []api.RepoName `json:"repoNames"`
+	Query            string         `json:"query"`
+	CodeResultsCount int            `json:"codeResultsCount"`
+	TextResultsCount int            `json:"textResultsCount"`
+}
+
 type IsContextRequiredForChatQueryParameters struct {
 	Query string `json:"query"`
 }
@@ -85,6 +94,52 @@ func (c *Client) Search(ctx context.Context, args EmbeddingsSearchParameters) (*
 	return &response, nil
 }
 
+// MULTI_SEARCH_MAX_CONCURRENCY is the maximum number of repositories searched
+// concurrently by MultiSearch.
+const MULTI_SEARCH_MAX_CONCURRENCY = 8
+
+// MultiSearch searches the embedding indexes of several repositories and
+// merges the results. Every repository is searched by the embeddings service
+// replica its index is cached on. Repositories that cannot be searched, for
+// example because they have not been embedded yet, are skipped. An error is
+// only returned if none of the repositories could be searched.
+func (c *Client) MultiSearch(ctx context.Context, args EmbeddingsMultiSearchParameters) (*EmbeddingSearchResults, error) {
+	results := make([]*EmbeddingSearchResults, len(args.RepoNames))
+	errs := make([]error, len(args.RepoNames))
+
+	p := pool.New().WithMaxGoroutines(MULTI_SEARCH_MAX_CONCURRENCY)
+	for i, repoName := range args.RepoNames {
+		i, repoName := i, repoName
+		p.Go(func() {
+			results[i], errs[i] = c.Search(ctx, EmbeddingsSearchParameters{
+				RepoName:         repoName,
+				Query:            args.Query,
+				CodeResultsCount: args.CodeResultsCount,
+				TextResultsCount: args.TextResultsCount,
+			})
+			if errs[i] != nil {
+				errs[i] = errors.Wrapf(errs[i], "searching %s", repoName)
+			}
+		})
+	}
+	p.Wait()
+
+	var err error
+	succeeded := 0
+	for i := range args.RepoNames {
+		if errs[i] != nil {
+			err = errors.Append(err, errs[i])
+		} else {
+			succeeded++
+		}
+	}
+	if succeeded == 0 && err != nil {
+		return nil, err
+	}
+
+	return MergeEmbeddingSearchResults(results, args.CodeResultsCount, args.TextResultsCount), nil
+}
+
 func (c *Client) IsContextRequiredForChatQuery(ctx context.Context, args IsContextRequiredForChatQueryParameters) (bool, error) {
 	resp, err := c.httpPost(ctx, "isContextRequiredForChatQuery", "", args)
 	if err != nil {
@@ -0,0 +1,100 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/endpoint"
+)
+
+func TestMergeEmbeddingSearchResults(t *testing.T) {
+	result := func(repoName api.RepoName, fileName string, score float32) EmbeddingSearchResult {
+		return EmbeddingSearchResult{RepoName: repoName, FileName: fileName, Score: score}
+	}
+
+	merged := MergeEmbeddingSearchResults([]*EmbeddingSearchResults{
+		{
+			CodeResults: []EmbeddingSearchResult{result("a", "a.go", 0.9), result("a", "b.go", 0.5)},
+			TextResults: []EmbeddingSearchResult{result("a", "README.md", 0.4)},
+		},
+		nil,
+		{
+			CodeResults: []EmbeddingSearchResult{result("b", "c.go", 0.7), result("b", "d.go", 0.5)},
+		},
+	}, 3, 2)
+
+	require.Equal(t, []EmbeddingSearchResult{result("a", "a.go", 0.9), result("b", "c.go", 0.7), result("a", "b.go", 0.5)}, merged.CodeResults)
+	require.Equal(t, []EmbeddingSearchResult{result("a", "README.md", 0.4)}, merged.TextResults)
+
+	// Results are ordered by their raw score across repositories, and results
+	// with the same score keep the order of their repositories.
+	interleaved := MergeEmbeddingSearchResults([]*EmbeddingSearchResults{
+		{CodeResults: []EmbeddingSearchResult{result("a", "a.go", 0.8), result("a", "b.go", 0.2)}},
+		{CodeResults: []EmbeddingSearchResult{result("b", "c.go", 0.6), result("b", "d.go", 0.4)}},
+		{CodeResults: []EmbeddingSearchResult{result("c", "e.go", 0.6), result("c", "f.go", 0.1)}},
+	}, 10, 0)
+	require.Equal(t, []EmbeddingSearchResult{
+		result("a", "a.go", 0.8),
+		result("b", "c.go", 0.6),
+		result("c", "e.go", 0.6),
+		result("b", "d.go", 0.4),
+		result("a", "b.go", 0.2),
+		result("c", "f.go", 0.1),
+	}, interleaved.CodeResults)
+	require.Equal(t, []EmbeddingSearchResult{}, interleaved.TextResults)
+
+	empty := MergeEmbeddingSearchResults(nil, 3, 2)
+	require.Equal(t, []EmbeddingSearchResult{}, empty.CodeResults)
+	require.Equal(t, []EmbeddingSearchResult{}, empty.TextResults)
+}
+
+func TestClientMultiSearch(t *testing.T) {
+	scores := map[api.RepoName]float32{"a": 0.3, "b": 0.8}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var args EmbeddingsSearchParameters
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&args))
+
+		score, ok := scores[args.RepoName]
+		if !ok {
+			http.Error(w, "error searching embedding index", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(EmbeddingSearchResults{
+			CodeResults: []EmbeddingSearchResult{{RepoName: args.RepoName, FileName: "main.go", Score: score}},
+			TextResults: []EmbeddingSearchResult{},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := &Client{Endpoints: endpoint.Static(server.URL), HTTPClient: http.DefaultClient}
+
+	t.Run("merges results", func(t *testing.T) {
+		results, err := client.MultiSearch(context.Background(), EmbeddingsMultiSearchParameters{
+			RepoNames:        []api.RepoName{"a", "b", "not-embedded"},
+			Query:            "query",
+			CodeResultsCount: 5,
+			TextResultsCount: 5,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []EmbeddingSearchResult{
+			{RepoName: "b", FileName: "main.go", Score: 0.8},
+			{RepoName: "a", FileName: "main.go", Score: 0.3},
+		}, results.CodeResults)
+		require.Empty(t, results.TextResults)
+	})
+
+	t.Run("all repositories fail", func(t *testing.T) {
+		_, err := client.MultiSearch(context.Background(), EmbeddingsMultiSearchParameters{
+			RepoNames:        []api.RepoName{"not-embedded"},
+			Query:            "query",
+			CodeResultsCount: 5,
+		})
+		require.Error(t, err)
+	})
+}
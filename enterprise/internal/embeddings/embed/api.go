@@ -1,37 +1,55 @@
 package embed
 
 import (
-	"bytes"
-	"encoding/json"
-	"io"
 	"math"
-	"net/http"
-	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
-type EmbeddingAPIRequest struct {
-	Model string   `json:"model"`
-	Input []string `json:"input"`
+type EmbeddingsClient interface {
+	GetEmbeddingsWithRetries(texts []string, maxRetries int) ([]float32, error)
+	GetDimensions() (int, error)
+	// GetModel returns the provider and the model that embed texts.
+	GetModel() (provider string, model string, err error)
 }
 
-type EmbeddingAPIResponse struct {
-	Data []struct {
-		Index     int       `json:"index"`
-		Embedding []float32 `json:"embedding"`
-	} `json:"data"`
+// EmbeddingsProvider embeds texts with an embeddings model.
+type EmbeddingsProvider interface {
+	// GetEmbeddings returns the embeddings of the given texts, concatenated
+	// in the order of the texts.
+	GetEmbeddings(texts []string) ([]float32, error)
 }
 
-type EmbeddingsClient interface {
-	GetEmbeddingsWithRetries(texts []string, maxRetries int) ([]float32, error)
-	GetDimensions() (int, error)
+// ProviderOptions limit the texts sent to an EmbeddingsProvider.
+type ProviderOptions struct {
+	// Dimensions is the dimensionality of the embeddings returned by the
+	// provider.
+	Dimensions int
+	// BatchSize is the maximum number of texts embedded in a single request.
+	BatchSize int
+	// TokenLimit is the maximum number of tokens of a single text. Longer
+	// texts are truncated. If it is 0, texts are never truncated.
+	TokenLimit int
+}
+
+const (
+	OPENAI_PROVIDER = "openai"
+	FAKE_PROVIDER   = "fake"
+)
+
+// defaultProviderOptions are the batch size and token limit of every provider
+// that are used unless they are set in the site configuration.
+var defaultProviderOptions = map[string]ProviderOptions{
+	// The limits of the OpenAI text-embedding-ada-002 model.
+	OPENAI_PROVIDER: {BatchSize: 512, TokenLimit: 8191},
+	FAKE_PROVIDER:   {BatchSize: 512},
 }
 
 func NewEmbeddingsClient() EmbeddingsClient {
@@ -47,6 +65,13 @@ func NewEmbeddingsClient() EmbeddingsClient {
 	return client
 }
 
+// NewFakeEmbeddingsClient returns a client that embeds texts with the fake
+// provider, which doesn't depend on an external service. It is meant to be
+// used in tests.
+func NewFakeEmbeddingsClient(dimensions int) EmbeddingsClient {
+	return &embeddingsClient{config: &schema.Embeddings{Enabled: true, Provider: FAKE_PROVIDER, Dimensions: dimensions}}
+}
+
 type embeddingsClient struct {
 	config *schema.Embeddings
 }
@@ -66,21 +91,50 @@ func (c *embeddingsClient) GetDimensions() (int, error) {
 	return c.config.Dimensions, nil
 }
 
-// GetEmbeddingsWithRetries tries to embed the given texts using the external service specified in the config.
-// In case of failure, it retries the embedding procedure up to maxRetries. This due to the OpenAI API which
-// often hangs up when downloading large embedding responses.
+func (c *embeddingsClient) GetModel() (string, string, error) {
+	if c.isDisabled() {
+		return "", "", errors.New("embeddings are not configured or disabled")
+	}
+	return providerName(c.config), c.config.Model, nil
+}
+
+// GetEmbeddingsWithRetries tries to embed the given texts using the provider specified in the config.
+// The texts are sent to the provider in batches of the configured batch size, and texts exceeding the
+// configured token limit are truncated. In case of failure, it retries embedding a batch up to maxRetries.
+// This due to the OpenAI API which often hangs up when downloading large embedding responses.
 func (c *embeddingsClient) GetEmbeddingsWithRetries(texts []string, maxRetries int) ([]float32, error) {
 	if c.isDisabled() {
 		return nil, errors.New("embeddings are not configured or disabled")
 	}
 
-	embeddings, err := getEmbeddings(texts, c.config)
+	provider, options, err := newEmbeddingsProvider(c.config)
+	if err != nil {
+		return nil, err
+	}
+
+	embeddings := make([]float32, 0, len(texts)*options.Dimensions)
+	for i := 0; i < len(texts); i += options.BatchSize {
+		batch := truncateTexts(texts[i:min(len(texts), i+options.BatchSize)], options.TokenLimit)
+		batchEmbeddings, err := getEmbeddingsWithRetries(provider, batch, maxRetries)
+		if err != nil {
+			return nil, err
+		}
+		if len(batchEmbeddings) != len(batch)*options.Dimensions {
+			return nil, errors.Newf("expected %d embeddings with %d dimensions, got %d values", len(batch), options.Dimensions, len(batchEmbeddings))
+		}
+		embeddings = append(embeddings, batchEmbeddings...)
+	}
+	return embeddings, nil
+}
+
+func getEmbeddingsWithRetries(provider EmbeddingsProvider, texts []string, maxRetries int) ([]float32, error) {
+	embeddings, err := provider.GetEmbeddings(texts)
 	if err == nil {
 		return embeddings, nil
 	}
 
 	for i := 0; i < maxRetries; i++ {
-		embeddings, err = getEmbeddings(texts, c.config)
+		embeddings, err = provider.GetEmbeddings(texts)
 		if err == nil {
 			return embeddings, nil
 		} else {
@@ -93,51 +147,65 @@ func (c *embeddingsClient) GetEmbeddingsWithRetries(texts []string, maxRetries i
 	return nil, err
 }
 
-func getEmbeddings(texts []string, config *schema.Embeddings) ([]float32, error) {
-	// Replace newlines, which can negatively affect performance.
-	augmentedTexts := make([]string, len(texts))
-	for idx, text := range texts {
-		augmentedTexts[idx] = strings.ReplaceAll(text, "\n", " ")
+// newEmbeddingsProvider returns the provider specified in the config and its
+// options, which default to the ones of the provider.
+func newEmbeddingsProvider(config *schema.Embeddings) (EmbeddingsProvider, ProviderOptions, error) {
+	name := providerName(config)
+	options, ok := defaultProviderOptions[name]
+	if !ok {
+		return nil, ProviderOptions{}, errors.Newf("unknown embeddings provider: %s", name)
 	}
-
-	request := EmbeddingAPIRequest{Model: config.Model, Input: augmentedTexts}
-
-	bodyBytes, err := json.Marshal(request)
-	if err != nil {
-		return nil, err
+	options.Dimensions = config.Dimensions
+	if config.BatchSize > 0 {
+		options.BatchSize = config.BatchSize
 	}
-
-	req, err := http.NewRequest("POST", config.Url, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return nil, err
+	if config.TokenLimit > 0 {
+		options.TokenLimit = config.TokenLimit
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.AccessToken)
-
-	resp, err := httpcli.ExternalDoer.Do(req)
-	if err != nil {
-		return nil, err
+	if options.Dimensions <= 0 {
+		return nil, ProviderOptions{}, errors.New("embeddings dimensions must be greater than 0")
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, errors.Errorf("embeddings: %s %q: failed with status %d: %s", req.Method, req.URL.String(), resp.StatusCode, string(respBody))
+	switch name {
+	case OPENAI_PROVIDER:
+		if config.Url == "" {
+			return nil, ProviderOptions{}, errors.New("the url of the openai embeddings provider is not configured")
+		}
+		return NewOpenAIEmbeddingsProvider(httpcli.ExternalDoer, config.Url, config.Model, config.AccessToken), options, nil
+	default:
+		return NewFakeEmbeddingsProvider(options.Dimensions), options, nil
 	}
+}
 
-	var response EmbeddingAPIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, err
+// providerName returns the provider specified in the config, which defaults
+// to OPENAI_PROVIDER.
+func providerName(config *schema.Embeddings) string {
+	if config.Provider == "" {
+		return OPENAI_PROVIDER
 	}
+	return config.Provider
+}
 
-	// Ensure embedding responses are sorted in the original order.
-	sort.Slice(response.Data, func(i, j int) bool {
-		return response.Data[i].Index < response.Data[j].Index
-	})
+// truncateTexts truncates the texts that exceed the token limit. Tokens are
+// estimated with embeddings.EstimateTokens.
+func truncateTexts(texts []string, tokenLimit int) []string {
+	if tokenLimit <= 0 {
+		return texts
+	}
 
-	embeddings := make([]float32, 0, len(response.Data)*config.Dimensions)
-	for _, embedding := range response.Data {
-		embeddings = append(embeddings, embedding.Embedding...)
+	maxChars := tokenLimit * embeddings.CHARS_PER_TOKEN
+	var truncated []string
+	for i, text := range texts {
+		if len(text) <= maxChars {
+			continue
+		}
+		if truncated == nil {
+			truncated = append([]string{}, texts...)
+		}
+		truncated[i] = strings.ToValidUTF8(text[:maxChars], "")
 	}
-	return embeddings, nil
+	if truncated == nil {
+		return texts
+	}
+	return truncated
 }
@@ -0,0 +1,151 @@
+package embed
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// newOpenAIServer returns a server implementing the OpenAI embeddings API. It
+// embeds every text as a vector of the given dimensions holding the length of
+// the text, and returns the embeddings in reverse order.
+func newOpenAIServer(t *testing.T, dimensions int, requests *[]*http.Request, inputs *[][]string) *httptest.Server {
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request EmbeddingAPIRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		mu.Lock()
+		*requests = append(*requests, r)
+		*inputs = append(*inputs, request.Input)
+		mu.Unlock()
+
+		var response EmbeddingAPIResponse
+		for i := len(request.Input) - 1; i >= 0; i-- {
+			embedding := make([]float32, dimensions)
+			for j := range embedding {
+				embedding[j] = float32(len(request.Input[i]))
+			}
+			response.Data = append(response.Data, struct {
+				Index     int       `json:"index"`
+				Embedding []float32 `json:"embedding"`
+			}{Index: i, Embedding: embedding})
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOpenAIEmbeddingsProvider(t *testing.T) {
+	var requests []*http.Request
+	var inputs [][]string
+	server := newOpenAIServer(t, 2, &requests, &inputs)
+
+	t.Run("with access token", func(t *testing.T) {
+		requests, inputs = nil, nil
+		provider := NewOpenAIEmbeddingsProvider(http.DefaultClient, server.URL, "model", "token")
+
+		embeddings, err := provider.GetEmbeddings([]string{"a", "b\nb"})
+		require.NoError(t, err)
+		require.Equal(t, []float32{1, 1, 3, 3}, embeddings)
+		require.Equal(t, [][]string{{"a", "b b"}}, inputs)
+		require.Equal(t, "Bearer token", requests[0].Header.Get("Authorization"))
+	})
+
+	t.Run("without access token", func(t *testing.T) {
+		requests, inputs = nil, nil
+		provider := NewOpenAIEmbeddingsProvider(http.DefaultClient, server.URL, "model", "")
+
+		_, err := provider.GetEmbeddings([]string{"a"})
+		require.NoError(t, err)
+		require.Empty(t, requests[0].Header.Get("Authorization"))
+	})
+}
+
+func TestEmbeddingsClient(t *testing.T) {
+	var requests []*http.Request
+	var inputs [][]string
+	server := newOpenAIServer(t, 2, &requests, &inputs)
+
+	t.Run("batches and truncates texts", func(t *testing.T) {
+		requests, inputs = nil, nil
+		client := &embeddingsClient{config: &schema.Embeddings{
+			Enabled:    true,
+			Provider:   OPENAI_PROVIDER,
+			Url:        server.URL,
+			Dimensions: 2,
+			BatchSize:  2,
+			TokenLimit: 1,
+		}}
+
+		embeddings, err := client.GetEmbeddingsWithRetries([]string{"a", "bb", "ccccc"}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []float32{1, 1, 2, 2, 4, 4}, embeddings)
+		require.Equal(t, [][]string{{"a", "bb"}, {"cccc"}}, inputs)
+	})
+
+	t.Run("dimensions mismatch", func(t *testing.T) {
+		client := &embeddingsClient{config: &schema.Embeddings{
+			Enabled:    true,
+			Url:        server.URL,
+			Dimensions: 3,
+		}}
+
+		_, err := client.GetEmbeddingsWithRetries([]string{"a"}, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		client := &embeddingsClient{config: &schema.Embeddings{Enabled: true, Provider: "unknown", Dimensions: 2}}
+
+		_, err := client.GetEmbeddingsWithRetries([]string{"a"}, 0)
+		require.EqualError(t, err, "unknown embeddings provider: unknown")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		client := &embeddingsClient{config: &schema.Embeddings{Enabled: false}}
+
+		_, err := client.GetEmbeddingsWithRetries([]string{"a"}, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestFakeEmbeddingsProvider(t *testing.T) {
+	dimensions := 64
+	client := NewFakeEmbeddingsClient(dimensions)
+
+	texts := []string{
+		"func parseQuery(query string) error",
+		"func parseQuery(query string) (Query, error)",
+		"The quick brown fox jumps over the lazy dog",
+		"",
+	}
+	embeddings, err := client.GetEmbeddingsWithRetries(texts, 0)
+	require.NoError(t, err)
+	require.Len(t, embeddings, len(texts)*dimensions)
+
+	again, err := client.GetEmbeddingsWithRetries(texts, 0)
+	require.NoError(t, err)
+	require.Equal(t, embeddings, again, "embeddings are not deterministic")
+
+	embedding := func(i int) []float32 { return embeddings[i*dimensions : (i+1)*dimensions] }
+	similarity := func(a, b []float32) float32 {
+		var dot float32
+		for i := range a {
+			dot += a[i] * b[i]
+		}
+		return dot
+	}
+
+	for i := range texts {
+		require.InDelta(t, 1, similarity(embedding(i), embedding(i)), 1e-5, "embedding %d is not normalized", i)
+	}
+	require.Greater(t, similarity(embedding(0), embedding(1)), similarity(embedding(0), embedding(2)))
+}
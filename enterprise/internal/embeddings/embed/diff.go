@@ -0,0 +1,47 @@
+package embed
+
+import (
+	"bytes"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// FileChanges are the files that changed between two revisions of a
+// repository.
+type FileChanges struct {
+	Added    []string
+	Modified []string
+	Deleted  []string
+}
+
+var nul = []byte{0}
+
+// ParseGitDiffNameStatus parses the output of `git diff -z --name-status
+// --no-renames`, which consists of a repeated sequence of `<status> NUL <path>
+// NUL` where NUL is the 0 byte.
+func ParseGitDiffNameStatus(output []byte) (changes FileChanges, _ error) {
+	if len(output) == 0 {
+		return FileChanges{}, nil
+	}
+
+	slices := bytes.Split(bytes.TrimRight(output, string(nul)), nul)
+	if len(slices)%2 != 0 {
+		return changes, errors.Newf("uneven pairs")
+	}
+
+	for i := 0; i < len(slices); i += 2 {
+		if len(slices[i]) == 0 {
+			return changes, errors.Newf("empty status for path %q", slices[i+1])
+		}
+		switch slices[i][0] {
+		case 'A':
+			changes.Added = append(changes.Added, string(slices[i+1]))
+		case 'M', 'T':
+			changes.Modified = append(changes.Modified, string(slices[i+1]))
+		case 'D':
+			changes.Deleted = append(changes.Deleted, string(slices[i+1]))
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,31 @@
+package embed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitDiffNameStatus(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		changes, err := ParseGitDiffNameStatus(nil)
+		require.NoError(t, err)
+		require.Equal(t, FileChanges{}, changes)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		output := []byte("A\x00a.go\x00M\x00b.md\x00D\x00c.java\x00T\x00d.go\x00")
+		changes, err := ParseGitDiffNameStatus(output)
+		require.NoError(t, err)
+		require.Equal(t, FileChanges{
+			Added:    []string{"a.go"},
+			Modified: []string{"b.md", "d.go"},
+			Deleted:  []string{"c.java"},
+		}, changes)
+	})
+
+	t.Run("uneven pairs", func(t *testing.T) {
+		_, err := ParseGitDiffNameStatus([]byte("A\x00a.go\x00M\x00"))
+		require.Error(t, err)
+	})
+}
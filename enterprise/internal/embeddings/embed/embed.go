@@ -20,6 +20,11 @@ const EMBEDDING_BATCH_SIZE = 512
 
 type readFile func(fileName string) ([]byte, error)
 
+// getFileSymbols returns the definitions of the functions, methods, classes
+// and similar symbols in a code file. If it is set, code files are split into
+// chunks at the definitions.
+type getFileSymbols func(fileName string) ([]split.Symbol, error)
+
 // EmbedRepo embeds file contents from the given file names for a repository.
 // It separates the file names into code files and text files and embeds them separately.
 // It returns a RepoEmbeddingIndex containing the embeddings and metadata.
@@ -31,27 +36,124 @@ func EmbedRepo(
 	client EmbeddingsClient,
 	splitOptions split.SplitOptions,
 	readFile readFile,
+	getFileSymbols getFileSymbols,
 ) (*embeddings.RepoEmbeddingIndex, error) {
-	codeFileNames, textFileNames := []string{}, []string{}
-	for _, fileName := range fileNames {
-		if isValidTextFile(fileName) {
-			textFileNames = append(textFileNames, fileName)
-		} else if isValidCodeFile(fileName) {
-			codeFileNames = append(codeFileNames, fileName)
-		}
+	provider, model, err := client.GetModel()
+	if err != nil {
+		return nil, err
+	}
+
+	codeFileNames, textFileNames := partitionFileNames(fileNames)
+
+	codeIndex, err := embedFiles(codeFileNames, client, splitOptions, readFile, getFileSymbols, MAX_CODE_EMBEDDING_VECTORS)
+	if err != nil {
+		return nil, err
+	}
+
+	textIndex, err := embedFiles(textFileNames, client, splitOptions, readFile, nil, MAX_TEXT_EMBEDDING_VECTORS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &embeddings.RepoEmbeddingIndex{
+		RepoName:           repoName,
+		Revision:           revision,
+		EmbeddingsProvider: provider,
+		EmbeddingsModel:    model,
+		CodeIndex:          codeIndex,
+		TextIndex:          textIndex,
+	}, nil
+}
+
+// UpdateRepoEmbeddingIndex updates the given index of a repository to the
+// given revision. Instead of embedding the entire repository again, it only
+// embeds the files that were added or modified since the revision of the
+// index and drops the rows of modified and deleted files. The given index is
+// not modified. If the index was created by a different provider or model, or
+// with different dimensions, ErrModelChanged is returned.
+func UpdateRepoEmbeddingIndex(
+	ctx context.Context,
+	index *embeddings.RepoEmbeddingIndex,
+	revision api.CommitID,
+	changes FileChanges,
+	client EmbeddingsClient,
+	splitOptions split.SplitOptions,
+	readFile readFile,
+	getFileSymbols getFileSymbols,
+) (*embeddings.RepoEmbeddingIndex, error) {
+	provider, model, err := client.GetModel()
+	if err != nil {
+		return nil, err
+	}
+	if index.EmbeddingsProvider != provider || index.EmbeddingsModel != model {
+		return nil, ErrModelChanged
+	}
+	dimensions, err := client.GetDimensions()
+	if err != nil {
+		return nil, err
+	}
+	if index.CodeIndex.ColumnDimension != dimensions || index.TextIndex.ColumnDimension != dimensions {
+		return nil, ErrModelChanged
 	}
 
-	codeIndex, err := embedFiles(codeFileNames, client, splitOptions, readFile, MAX_CODE_EMBEDDING_VECTORS)
+	changed := make(map[string]struct{}, len(changes.Modified)+len(changes.Deleted))
+	for _, fileName := range changes.Modified {
+		changed[fileName] = struct{}{}
+	}
+	for _, fileName := range changes.Deleted {
+		changed[fileName] = struct{}{}
+	}
+	unchanged := func(metadata embeddings.RepoEmbeddingRowMetadata) bool {
+		_, ok := changed[metadata.FileName]
+		return !ok
+	}
+	codeIndex := index.CodeIndex.Filter(unchanged)
+	textIndex := index.TextIndex.Filter(unchanged)
+
+	// Modified files are embedded again, just like added ones.
+	codeFileNames, textFileNames := partitionFileNames(append(append([]string{}, changes.Added...), changes.Modified...))
+
+	addedCodeIndex, err := embedFiles(codeFileNames, client, splitOptions, readFile, getFileSymbols, max(0, MAX_CODE_EMBEDDING_VECTORS-len(codeIndex.RowMetadata)))
 	if err != nil {
 		return nil, err
 	}
+	codeIndex.Append(addedCodeIndex)
 
-	textIndex, err := embedFiles(textFileNames, client, splitOptions, readFile, MAX_TEXT_EMBEDDING_VECTORS)
+	addedTextIndex, err := embedFiles(textFileNames, client, splitOptions, readFile, nil, max(0, MAX_TEXT_EMBEDDING_VECTORS-len(textIndex.RowMetadata)))
 	if err != nil {
 		return nil, err
 	}
+	textIndex.Append(addedTextIndex)
+
+	return &embeddings.RepoEmbeddingIndex{
+		RepoName:           index.RepoName,
+		Revision:           revision,
+		EmbeddingsProvider: provider,
+		EmbeddingsModel:    model,
+		CodeIndex:          codeIndex,
+		TextIndex:          textIndex,
+	}, nil
+}
+
+// ErrModelChanged is returned by UpdateRepoEmbeddingIndex if the embeddings
+// of the index were created by a different provider or model, or have
+// different dimensions, than the ones of the embeddings client. Embeddings of
+// different models cannot be compared, so the index has to be recreated.
+var ErrModelChanged = errors.New("the embeddings model of the index differs from the embeddings client")
 
-	return &embeddings.RepoEmbeddingIndex{RepoName: repoName, Revision: revision, CodeIndex: codeIndex, TextIndex: textIndex}, nil
+// partitionFileNames separates the given file names into code files and text
+// files, which are embedded separately. File names that are neither are
+// dropped.
+func partitionFileNames(fileNames []string) (codeFileNames, textFileNames []string) {
+	codeFileNames, textFileNames = []string{}, []string{}
+	for _, fileName := range fileNames {
+		if isValidTextFile(fileName) {
+			textFileNames = append(textFileNames, fileName)
+		} else if isValidCodeFile(fileName) {
+			codeFileNames = append(codeFileNames, fileName)
+		}
+	}
+	return codeFileNames, textFileNames
 }
 
 func createEmptyEmbeddingIndex(columnDimension int) embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata] {
@@ -70,6 +172,7 @@ func embedFiles(
 	client EmbeddingsClient,
 	splitOptions split.SplitOptions,
 	readFile readFile,
+	getFileSymbols getFileSymbols,
 	maxEmbeddingVectors int,
 ) (embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata], error) {
 	dimensions, err := client.GetDimensions()
@@ -97,7 +200,13 @@ func embedFiles(
 			batchChunks := make([]string, len(batch))
 			for idx, chunk := range batch {
 				batchChunks[idx] = chunk.Content
-				index.RowMetadata = append(index.RowMetadata, embeddings.RepoEmbeddingRowMetadata{FileName: chunk.FileName, StartLine: chunk.StartLine, EndLine: chunk.EndLine})
+				index.RowMetadata = append(index.RowMetadata, embeddings.RepoEmbeddingRowMetadata{
+					FileName:   chunk.FileName,
+					StartLine:  chunk.StartLine,
+					EndLine:    chunk.EndLine,
+					SymbolName: chunk.SymbolName,
+					SymbolKind: chunk.SymbolKind,
+				})
 			}
 
 			batchEmbeddings, err := client.GetEmbeddingsWithRetries(batchChunks, GET_EMBEDDINGS_MAX_RETRIES)
@@ -112,7 +221,7 @@ func embedFiles(
 	embeddableChunks := []split.EmbeddableChunk{}
 	for _, fileName := range fileNames {
 		// This is a fail-safe measure to prevent producing an extremely large index for large repositories.
-		if len(index.RowMetadata) > maxEmbeddingVectors {
+		if len(index.RowMetadata) >= maxEmbeddingVectors {
 			break
 		}
 
@@ -128,7 +237,7 @@ func embedFiles(
 			continue
 		}
 
-		embeddableChunks = append(embeddableChunks, split.SplitIntoEmbeddableChunks(content, fileName, splitOptions)...)
+		embeddableChunks = append(embeddableChunks, splitFile(content, fileName, splitOptions, getFileSymbols)...)
 
 		if len(embeddableChunks) > EMBEDDING_BATCHES*EMBEDDING_BATCH_SIZE {
 			err := addEmbeddableChunks(embeddableChunks, EMBEDDING_BATCH_SIZE)
@@ -148,3 +257,18 @@ func embedFiles(
 
 	return index, nil
 }
+
+// splitFile splits the file into chunks at the definitions of its symbols if
+// getFileSymbols is set, and by line counts otherwise. Symbols are best
+// effort: if they cannot be determined, for example because the language is
+// not supported by the symbols service, the file is split by line counts.
+func splitFile(content string, fileName string, splitOptions split.SplitOptions, getFileSymbols getFileSymbols) []split.EmbeddableChunk {
+	if getFileSymbols == nil {
+		return split.SplitIntoEmbeddableChunks(content, fileName, splitOptions)
+	}
+	symbols, err := getFileSymbols(fileName)
+	if err != nil {
+		return split.SplitIntoEmbeddableChunks(content, fileName, splitOptions)
+	}
+	return split.SplitIntoEmbeddableChunksBySymbols(content, fileName, symbols, splitOptions)
+}
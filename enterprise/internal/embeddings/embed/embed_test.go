@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/split"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 )
@@ -69,14 +70,16 @@ func TestEmbedRepo(t *testing.T) {
 	}
 
 	t.Run("no files", func(t *testing.T) {
-		index, err := EmbedRepo(ctx, repoName, revision, []string{}, client, splitOptions, readFile)
+		index, err := EmbedRepo(ctx, repoName, revision, []string{}, client, splitOptions, readFile, nil)
 		require.NoError(t, err)
+		require.Equal(t, "mock", index.EmbeddingsProvider)
+		require.Equal(t, "mock-model", index.EmbeddingsModel)
 		require.Len(t, index.CodeIndex.Embeddings, 0)
 		require.Len(t, index.TextIndex.Embeddings, 0)
 	})
 
 	t.Run("code files only", func(t *testing.T) {
-		index, err := EmbedRepo(ctx, repoName, revision, []string{"a.go"}, client, splitOptions, readFile)
+		index, err := EmbedRepo(ctx, repoName, revision, []string{"a.go"}, client, splitOptions, readFile, nil)
 		require.NoError(t, err)
 		require.Len(t, index.TextIndex.Embeddings, 0)
 		require.Len(t, index.CodeIndex.Embeddings, 6)
@@ -84,7 +87,7 @@ func TestEmbedRepo(t *testing.T) {
 	})
 
 	t.Run("text files only", func(t *testing.T) {
-		index, err := EmbedRepo(ctx, repoName, revision, []string{"b.md"}, client, splitOptions, readFile)
+		index, err := EmbedRepo(ctx, repoName, revision, []string{"b.md"}, client, splitOptions, readFile, nil)
 		require.NoError(t, err)
 		require.Len(t, index.CodeIndex.Embeddings, 0)
 		require.Len(t, index.TextIndex.Embeddings, 6)
@@ -93,13 +96,161 @@ func TestEmbedRepo(t *testing.T) {
 
 	t.Run("mixed code and text files", func(t *testing.T) {
 		files := []string{"a.go", "b.md", "c.java", "autogen.py", "empty.rb", "lines_too_long.c", "binary.bin"}
-		index, err := EmbedRepo(ctx, repoName, revision, files, client, splitOptions, readFile)
+		index, err := EmbedRepo(ctx, repoName, revision, files, client, splitOptions, readFile, nil)
 		require.NoError(t, err)
 		require.Len(t, index.CodeIndex.Embeddings, 15)
 		require.Len(t, index.CodeIndex.RowMetadata, 5)
 		require.Len(t, index.TextIndex.Embeddings, 6)
 		require.Len(t, index.TextIndex.RowMetadata, 2)
 	})
+
+	t.Run("split by symbols", func(t *testing.T) {
+		getFileSymbols := func(fileName string) ([]split.Symbol, error) {
+			if fileName != "c.java" {
+				return nil, errors.New("language not supported")
+			}
+			return []split.Symbol{{Name: "b", Kind: "method", Line: 2}}, nil
+		}
+		index, err := EmbedRepo(ctx, repoName, revision, []string{"a.go", "c.java"}, client, splitOptions, readFile, getFileSymbols)
+		require.NoError(t, err)
+		require.Equal(t, []embeddings.RepoEmbeddingRowMetadata{
+			// Files without symbols are split by line counts.
+			{FileName: "a.go", StartLine: 0, EndLine: 1},
+			{FileName: "a.go", StartLine: 1, EndLine: 3},
+			{FileName: "c.java", StartLine: 0, EndLine: 1},
+			{FileName: "c.java", StartLine: 2, EndLine: 3, SymbolName: "b", SymbolKind: "method"},
+			{FileName: "c.java", StartLine: 3, EndLine: 5, SymbolName: "b", SymbolKind: "method"},
+		}, index.CodeIndex.RowMetadata)
+	})
+}
+
+func TestUpdateRepoEmbeddingIndex(t *testing.T) {
+	ctx := context.Background()
+	client := NewMockEmbeddingsClient()
+	splitOptions := split.SplitOptions{ChunkTokensThreshold: 8}
+	mockFiles := map[string][]byte{
+		// 2 embedding chunks (based on split options above)
+		"a.go": mockFile(
+			strings.Repeat("a", 32),
+			"",
+			strings.Repeat("b", 32),
+		),
+		// 2 embedding chunks
+		"b.md": mockFile(
+			"# "+strings.Repeat("a", 32),
+			"",
+			"## "+strings.Repeat("b", 32),
+		),
+		// 3 embedding chunks
+		"c.java": mockFile(
+			strings.Repeat("a", 32),
+			"",
+			strings.Repeat("b", 32),
+			"",
+			strings.Repeat("c", 32),
+		),
+	}
+	readFile := func(fileName string) ([]byte, error) {
+		content, ok := mockFiles[fileName]
+		if !ok {
+			return nil, errors.Newf("file %s not found", fileName)
+		}
+		return content, nil
+	}
+	rows := func(fileNames ...string) []embeddings.RepoEmbeddingRowMetadata {
+		metadata := make([]embeddings.RepoEmbeddingRowMetadata, 0, len(fileNames))
+		for _, fileName := range fileNames {
+			metadata = append(metadata, embeddings.RepoEmbeddingRowMetadata{FileName: fileName})
+		}
+		return metadata
+	}
+	fileNames := func(index embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata]) []string {
+		names := make([]string, 0, len(index.RowMetadata))
+		for _, metadata := range index.RowMetadata {
+			names = append(names, metadata.FileName)
+		}
+		return names
+	}
+
+	previous := &embeddings.RepoEmbeddingIndex{
+		RepoName:           api.RepoName("repo/name"),
+		Revision:           api.CommitID("deadbeef"),
+		EmbeddingsProvider: "mock",
+		EmbeddingsModel:    "mock-model",
+		CodeIndex: embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata]{
+			Embeddings:      []float32{1, 1, 1, 2, 2, 2, 3, 3, 3},
+			ColumnDimension: 3,
+			RowMetadata:     rows("a.go", "d.go", "e.go"),
+		},
+		TextIndex: embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata]{
+			Embeddings:      []float32{4, 4, 4},
+			ColumnDimension: 3,
+			RowMetadata:     rows("f.md"),
+		},
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		index, err := UpdateRepoEmbeddingIndex(ctx, previous, "cafebabe", FileChanges{}, client, splitOptions, readFile, nil)
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("cafebabe"), index.Revision)
+		require.Equal(t, previous.CodeIndex, index.CodeIndex)
+		require.Equal(t, previous.TextIndex, index.TextIndex)
+	})
+
+	t.Run("added, modified and deleted files", func(t *testing.T) {
+		changes := FileChanges{
+			Added:    []string{"b.md", "c.java"},
+			Modified: []string{"a.go"},
+			Deleted:  []string{"d.go", "f.md"},
+		}
+		index, err := UpdateRepoEmbeddingIndex(ctx, previous, "cafebabe", changes, client, splitOptions, readFile, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"e.go", "c.java", "c.java", "c.java", "a.go", "a.go"}, fileNames(index.CodeIndex))
+		require.Equal(t, []float32{3, 3, 3}, index.CodeIndex.Embeddings[:3])
+		require.Len(t, index.CodeIndex.Embeddings, 18)
+		require.Equal(t, []string{"b.md", "b.md"}, fileNames(index.TextIndex))
+		require.Len(t, index.TextIndex.Embeddings, 6)
+
+		// The previous index is left untouched.
+		require.Equal(t, []string{"a.go", "d.go", "e.go"}, fileNames(previous.CodeIndex))
+	})
+
+	t.Run("changed dimensions", func(t *testing.T) {
+		stale := *previous
+		stale.CodeIndex.ColumnDimension = 4
+		_, err := UpdateRepoEmbeddingIndex(ctx, &stale, "cafebabe", FileChanges{}, client, splitOptions, readFile, nil)
+		require.ErrorIs(t, err, ErrModelChanged)
+	})
+
+	t.Run("changed model", func(t *testing.T) {
+		stale := *previous
+		stale.EmbeddingsModel = "other-model"
+		_, err := UpdateRepoEmbeddingIndex(ctx, &stale, "cafebabe", FileChanges{}, client, splitOptions, readFile, nil)
+		require.ErrorIs(t, err, ErrModelChanged)
+	})
+
+	t.Run("changed provider", func(t *testing.T) {
+		stale := *previous
+		stale.EmbeddingsProvider = ""
+		_, err := UpdateRepoEmbeddingIndex(ctx, &stale, "cafebabe", FileChanges{}, client, splitOptions, readFile, nil)
+		require.ErrorIs(t, err, ErrModelChanged)
+	})
+
+	t.Run("full index", func(t *testing.T) {
+		full := *previous
+		full.TextIndex = embeddings.EmbeddingIndex[embeddings.RepoEmbeddingRowMetadata]{
+			Embeddings:      make([]float32, (MAX_TEXT_EMBEDDING_VECTORS+1)*3),
+			ColumnDimension: 3,
+			RowMetadata:     make([]embeddings.RepoEmbeddingRowMetadata, MAX_TEXT_EMBEDDING_VECTORS+1),
+		}
+		changes := FileChanges{Added: []string{"b.md", "c.java"}}
+		index, err := UpdateRepoEmbeddingIndex(ctx, &full, "cafebabe", changes, client, splitOptions, readFile, nil)
+		require.NoError(t, err)
+
+		require.Len(t, index.TextIndex.RowMetadata, MAX_TEXT_EMBEDDING_VECTORS+1)
+		require.Equal(t, []string{"a.go", "d.go", "e.go", "c.java", "c.java", "c.java"}, fileNames(index.CodeIndex))
+	})
 }
 
 func NewMockEmbeddingsClient() EmbeddingsClient {
@@ -112,6 +263,10 @@ func (c *mockEmbeddingsClient) GetDimensions() (int, error) {
 	return 3, nil
 }
 
+func (c *mockEmbeddingsClient) GetModel() (string, string, error) {
+	return "mock", "mock-model", nil
+}
+
 func (c *mockEmbeddingsClient) GetEmbeddingsWithRetries(texts []string, maxRetries int) ([]float32, error) {
 	dimensions, err := c.GetDimensions()
 	if err != nil {
@@ -0,0 +1,65 @@
+package embed
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// NewFakeEmbeddingsProvider returns a provider that embeds texts without an
+// embeddings model, which makes it usable in tests.
+//
+// Embeddings are deterministic: every word of a text is hashed to one of the
+// dimensions, and the resulting vector is normalized. Texts that share words
+// have similar embeddings, so similarity search returns meaningful results.
+func NewFakeEmbeddingsProvider(dimensions int) EmbeddingsProvider {
+	return &fakeEmbeddingsProvider{dimensions: dimensions}
+}
+
+type fakeEmbeddingsProvider struct {
+	dimensions int
+}
+
+func (p *fakeEmbeddingsProvider) GetEmbeddings(texts []string) ([]float32, error) {
+	embeddings := make([]float32, 0, len(texts)*p.dimensions)
+	for _, text := range texts {
+		embeddings = append(embeddings, p.embed(text)...)
+	}
+	return embeddings, nil
+}
+
+func (p *fakeEmbeddingsProvider) embed(text string) []float32 {
+	embedding := make([]float32, p.dimensions)
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		sum := h.Sum64()
+
+		// The lowest bit determines the sign, so that unrelated words cancel
+		// each other out rather than all pointing in the same direction.
+		value := float32(1)
+		if sum&1 == 1 {
+			value = -1
+		}
+		embedding[(sum>>1)%uint64(p.dimensions)] += value
+	}
+
+	var norm float64
+	for _, value := range embedding {
+		norm += float64(value * value)
+	}
+	if norm == 0 {
+		// Texts without words all have the same embedding.
+		embedding[0] = 1
+		return embedding
+	}
+	for i := range embedding {
+		embedding[i] /= float32(math.Sqrt(norm))
+	}
+	return embedding
+}
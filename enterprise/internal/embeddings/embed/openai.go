@@ -0,0 +1,93 @@
+package embed
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type EmbeddingAPIRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type EmbeddingAPIResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// NewOpenAIEmbeddingsProvider returns a provider for the OpenAI embeddings API
+// at the given URL. Since many self-hosted embeddings servers implement the
+// same API, the URL can point to any of them. The access token is optional.
+func NewOpenAIEmbeddingsProvider(doer httpcli.Doer, url string, model string, accessToken string) EmbeddingsProvider {
+	return &openAIEmbeddingsProvider{doer: doer, url: url, model: model, accessToken: accessToken}
+}
+
+type openAIEmbeddingsProvider struct {
+	doer        httpcli.Doer
+	url         string
+	model       string
+	accessToken string
+}
+
+func (p *openAIEmbeddingsProvider) GetEmbeddings(texts []string) ([]float32, error) {
+	// Replace newlines, which can negatively affect performance.
+	augmentedTexts := make([]string, len(texts))
+	for idx, text := range texts {
+		augmentedTexts[idx] = strings.ReplaceAll(text, "\n", " ")
+	}
+
+	request := EmbeddingAPIRequest{Model: p.model, Input: augmentedTexts}
+
+	bodyBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", p.url, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.accessToken)
+	}
+
+	resp, err := p.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, errors.Errorf("embeddings: %s %q: failed with status %d: %s", req.Method, req.URL.String(), resp.StatusCode, string(respBody))
+	}
+
+	var response EmbeddingAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	if len(response.Data) != len(texts) {
+		return nil, errors.Errorf("embeddings: %s %q: expected %d embeddings, got %d", req.Method, req.URL.String(), len(texts), len(response.Data))
+	}
+
+	// Ensure embedding responses are sorted in the original order.
+	sort.Slice(response.Data, func(i, j int) bool {
+		return response.Data[i].Index < response.Data[j].Index
+	})
+
+	var embeddings []float32
+	for _, embedding := range response.Data {
+		embeddings = append(embeddings, embedding.Embedding...)
+	}
+	return embeddings, nil
+}
@@ -0,0 +1,241 @@
+package embeddings
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// HNSWGraph is a hierarchical navigable small world graph over the rows of an
+// embedding index, which allows finding approximate nearest neighbors without
+// comparing the query to every row. See https://arxiv.org/abs/1603.09320.
+//
+// The graph only stores row indexes, so it can be persisted alongside the
+// embeddings it was built from.
+type HNSWGraph struct {
+	// M is the maximum number of neighbors of a row on every level but the
+	// bottom one, which allows up to 2*M neighbors.
+	M int
+	// EntryPoint is the row the search starts from. It is -1 if the graph is
+	// empty.
+	EntryPoint int32
+	// MaxLevel is the top level of the graph.
+	MaxLevel int
+	// Neighbors holds the neighbors of every row on every level the row is
+	// part of, indexed by row and level.
+	Neighbors [][][]int32
+}
+
+type HNSWOptions struct {
+	// M is the maximum number of neighbors of a row. Higher values increase the
+	// recall as well as the size of the graph and the time it takes to build it.
+	M int
+	// EfConstruction is the number of candidates considered when connecting a
+	// row to its neighbors.
+	EfConstruction int
+	// Seed seeds the random assignment of rows to levels.
+	Seed int64
+}
+
+var DefaultHNSWOptions = HNSWOptions{M: 16, EfConstruction: 128, Seed: 1}
+
+// HNSW_EF_SEARCH is the minimum number of candidates considered when searching
+// the graph. Higher values increase the recall but make searches slower.
+const HNSW_EF_SEARCH = 128
+
+// BuildHNSWGraph builds a graph over numRows rows. similarity returns the
+// similarity of two rows, where higher values mean more similar rows.
+func BuildHNSWGraph(numRows int, similarity func(i, j int) float32, options HNSWOptions) *HNSWGraph {
+	graph := &HNSWGraph{
+		M:          options.M,
+		EntryPoint: -1,
+		Neighbors:  make([][][]int32, numRows),
+	}
+
+	prng := rand.New(rand.NewSource(options.Seed))
+	levelMultiplier := 1 / math.Log(float64(options.M))
+	for row := 0; row < numRows; row++ {
+		// Levels are distributed exponentially, so that every level has about
+		// M times fewer rows than the one below.
+		level := int(-math.Log(1-prng.Float64()) * levelMultiplier)
+		graph.insert(row, level, options.EfConstruction, similarity)
+	}
+
+	return graph
+}
+
+func (g *HNSWGraph) maxNeighbors(level int) int {
+	if level == 0 {
+		return 2 * g.M
+	}
+	return g.M
+}
+
+func (g *HNSWGraph) insert(row int, level int, efConstruction int, similarity func(i, j int) float32) {
+	g.Neighbors[row] = make([][]int32, level+1)
+	if g.EntryPoint < 0 {
+		g.EntryPoint = int32(row)
+		g.MaxLevel = level
+		return
+	}
+
+	rowSimilarity := func(other int) float32 { return similarity(row, other) }
+
+	entryPoint := int(g.EntryPoint)
+	for l := g.MaxLevel; l > level; l-- {
+		entryPoint = g.searchLevel([]int{entryPoint}, l, 1, rowSimilarity)[0].index
+	}
+
+	entryPoints := []int{entryPoint}
+	for l := min(level, g.MaxLevel); l >= 0; l-- {
+		candidates := g.searchLevel(entryPoints, l, efConstruction, rowSimilarity)
+
+		neighbors := selectNeighbors(candidates, g.maxNeighbors(l), similarity)
+		g.Neighbors[row][l] = neighbors
+
+		for _, neighbor := range neighbors {
+			g.Neighbors[neighbor][l] = append(g.Neighbors[neighbor][l], int32(row))
+			if len(g.Neighbors[neighbor][l]) > g.maxNeighbors(l) {
+				g.Neighbors[neighbor][l] = g.prune(int(neighbor), g.Neighbors[neighbor][l], g.maxNeighbors(l), similarity)
+			}
+		}
+
+		entryPoints = entryPoints[:0]
+		for _, candidate := range candidates {
+			entryPoints = append(entryPoints, candidate.index)
+		}
+	}
+
+	if level > g.MaxLevel {
+		g.MaxLevel = level
+		g.EntryPoint = int32(row)
+	}
+}
+
+// prune reduces the neighbors of row to the n most similar ones. Unlike
+// selectNeighbors, it doesn't use the heuristic, since it runs for every new
+// neighbor of a row with the maximum number of neighbors.
+func (g *HNSWGraph) prune(row int, neighbors []int32, n int, similarity func(i, j int) float32) []int32 {
+	candidates := make([]nearestNeighbor, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		candidates = append(candidates, nearestNeighbor{int(neighbor), similarity(row, int(neighbor))})
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].similarity > candidates[j].similarity })
+
+	pruned := neighbors[:0]
+	for _, candidate := range candidates[:n] {
+		pruned = append(pruned, int32(candidate.index))
+	}
+	return pruned
+}
+
+// selectNeighbors selects up to n neighbors from the given candidates, which
+// must be sorted by similarity (descending). It uses the heuristic from the
+// paper: a candidate is only selected if it is more similar to the row than to
+// any of the already selected neighbors. This keeps neighbors in different
+// directions and connects clusters, which improves the recall. Remaining slots
+// are filled with the most similar discarded candidates.
+func selectNeighbors(candidates []nearestNeighbor, n int, similarity func(i, j int) float32) []int32 {
+	selected := make([]int32, 0, n)
+	var discarded []int32
+	for _, candidate := range candidates {
+		if len(selected) == n {
+			break
+		}
+
+		diverse := true
+		for _, neighbor := range selected {
+			if similarity(candidate.index, int(neighbor)) > candidate.similarity {
+				diverse = false
+				break
+			}
+		}
+		if diverse {
+			selected = append(selected, int32(candidate.index))
+		} else {
+			discarded = append(discarded, int32(candidate.index))
+		}
+	}
+
+	for _, candidate := range discarded {
+		if len(selected) == n {
+			break
+		}
+		selected = append(selected, candidate)
+	}
+	return selected
+}
+
+// searchLevel finds the ef rows on the given level that are most similar to
+// the query, starting at the given entry points. similarity returns the
+// similarity of the query to a row. It returns the rows sorted by similarity
+// (descending).
+func (g *HNSWGraph) searchLevel(entryPoints []int, level int, ef int, similarity func(row int) float32) []nearestNeighbor {
+	visited := make(map[int]struct{}, ef*g.maxNeighbors(level))
+	// candidates holds the negated similarities, so that the most similar
+	// candidate is popped first.
+	candidates := newNearestNeighborsHeap()
+	results := newNearestNeighborsHeap()
+
+	for _, entryPoint := range entryPoints {
+		if _, ok := visited[entryPoint]; ok {
+			continue
+		}
+		visited[entryPoint] = struct{}{}
+
+		entrySimilarity := similarity(entryPoint)
+		heap.Push(candidates, nearestNeighbor{entryPoint, -entrySimilarity})
+		heap.Push(results, nearestNeighbor{entryPoint, entrySimilarity})
+		if results.Len() > ef {
+			heap.Pop(results)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		candidate := heap.Pop(candidates).(nearestNeighbor)
+		if results.Len() >= ef && -candidate.similarity < results.Peek().similarity {
+			// All remaining candidates are less similar than the results.
+			break
+		}
+
+		for _, neighbor := range g.Neighbors[candidate.index][level] {
+			neighbor := int(neighbor)
+			if _, ok := visited[neighbor]; ok {
+				continue
+			}
+			visited[neighbor] = struct{}{}
+
+			neighborSimilarity := similarity(neighbor)
+			if results.Len() < ef || neighborSimilarity > results.Peek().similarity {
+				heap.Push(candidates, nearestNeighbor{neighbor, -neighborSimilarity})
+				heap.Push(results, nearestNeighbor{neighbor, neighborSimilarity})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	neighbors := results.neighbors
+	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].similarity > neighbors[j].similarity })
+	return neighbors
+}
+
+// search finds the approximate numResults most similar rows to a query.
+// similarity returns the similarity of the query to a row. ef is the number
+// of candidates considered, it is at least numResults. It returns the rows
+// sorted by similarity (descending).
+func (g *HNSWGraph) search(numResults int, ef int, similarity func(row int) float32) []nearestNeighbor {
+	if g.EntryPoint < 0 || numResults == 0 {
+		return nil
+	}
+
+	entryPoint := int(g.EntryPoint)
+	for l := g.MaxLevel; l > 0; l-- {
+		entryPoint = g.searchLevel([]int{entryPoint}, l, 1, similarity)[0].index
+	}
+
+	neighbors := g.searchLevel([]int{entryPoint}, 0, max(ef, numResults), similarity)
+	return neighbors[:min(numResults, len(neighbors))]
+}
@@ -0,0 +1,219 @@
+package embeddings
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getRandomNormalizedEmbeddings(prng *rand.Rand, numRows int, columnDimension int) []float32 {
+	embeddings := make([]float32, numRows*columnDimension)
+	for row := 0; row < numRows; row++ {
+		values := embeddings[row*columnDimension : (row+1)*columnDimension]
+		norm := float32(0)
+		for i := range values {
+			values[i] = float32(prng.NormFloat64())
+			norm += values[i] * values[i]
+		}
+		norm = float32(math.Sqrt(float64(norm)))
+		for i := range values {
+			values[i] /= norm
+		}
+	}
+	return embeddings
+}
+
+// getClusteredEmbeddings returns normalized embeddings that are scattered
+// around the given centers, like the embeddings of real code, which is
+// clustered by topic. Uniformly distributed embeddings are the worst case for
+// approximate nearest neighbor search and don't reflect the recall in practice.
+func getClusteredEmbeddings(prng *rand.Rand, centers []float32, numRows int, columnDimension int) []float32 {
+	numCenters := len(centers) / columnDimension
+	noise := getRandomNormalizedEmbeddings(prng, numRows, columnDimension)
+	embeddings := make([]float32, numRows*columnDimension)
+	for row := 0; row < numRows; row++ {
+		center := prng.Intn(numCenters)
+		values := embeddings[row*columnDimension : (row+1)*columnDimension]
+		norm := float32(0)
+		for i := range values {
+			values[i] = centers[center*columnDimension+i] + noise[row*columnDimension+i]
+			norm += values[i] * values[i]
+		}
+		norm = float32(math.Sqrt(float64(norm)))
+		for i := range values {
+			values[i] /= norm
+		}
+	}
+	return embeddings
+}
+
+func newClusteredEmbeddingIndex(prng *rand.Rand, centers []float32, numRows int, columnDimension int) *EmbeddingIndex[RepoEmbeddingRowMetadata] {
+	index := &EmbeddingIndex[RepoEmbeddingRowMetadata]{
+		Embeddings:      getClusteredEmbeddings(prng, centers, numRows, columnDimension),
+		ColumnDimension: columnDimension,
+		RowMetadata:     make([]RepoEmbeddingRowMetadata, numRows),
+	}
+	for i := range index.RowMetadata {
+		index.RowMetadata[i] = RepoEmbeddingRowMetadata{FileName: fmt.Sprintf("%d", i)}
+	}
+	return index
+}
+
+func getClusteredQueries(prng *rand.Rand, centers []float32, numQueries int, columnDimension int) [][]float32 {
+	embeddings := getClusteredEmbeddings(prng, centers, numQueries, columnDimension)
+	queries := make([][]float32, numQueries)
+	for i := range queries {
+		queries[i] = embeddings[i*columnDimension : (i+1)*columnDimension]
+	}
+	return queries
+}
+
+// recall returns the share of the expected results that are in results.
+func recall(expected []*RepoEmbeddingRowMetadata, results []*RepoEmbeddingRowMetadata) float64 {
+	found := make(map[string]struct{}, len(results))
+	for _, result := range results {
+		found[result.FileName] = struct{}{}
+	}
+	hits := 0
+	for _, result := range expected {
+		if _, ok := found[result.FileName]; ok {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(expected))
+}
+
+// meanRecall compares the results of the quantized index to an exhaustive
+// search of the unquantized index for the given queries.
+func meanRecall(index *EmbeddingIndex[RepoEmbeddingRowMetadata], quantized *QuantizedEmbeddingIndex[RepoEmbeddingRowMetadata], queries [][]float32, numResults int) float64 {
+	sum := 0.0
+	for _, query := range queries {
+		expected := index.SimilaritySearch(query, numResults, WorkerOptions{})
+		results := quantized.SimilaritySearch(query, numResults, WorkerOptions{})
+		sum += recall(expected, results)
+	}
+	return sum / float64(len(queries))
+}
+
+func TestHNSWGraph(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		graph := BuildHNSWGraph(0, func(i, j int) float32 { return 0 }, DefaultHNSWOptions)
+		require.Empty(t, graph.search(10, HNSW_EF_SEARCH, func(row int) float32 { return 0 }))
+	})
+
+	t.Run("single row", func(t *testing.T) {
+		graph := BuildHNSWGraph(1, func(i, j int) float32 { return 0 }, DefaultHNSWOptions)
+		neighbors := graph.search(10, HNSW_EF_SEARCH, func(row int) float32 { return 1 })
+		require.Equal(t, []nearestNeighbor{{0, 1}}, neighbors)
+	})
+
+	t.Run("neighbor lists are bounded", func(t *testing.T) {
+		prng := rand.New(rand.NewSource(0))
+		centers := getRandomNormalizedEmbeddings(prng, 10, 16)
+		index := newClusteredEmbeddingIndex(prng, centers, 1000, 16).Quantize()
+		index.buildGraph(DefaultHNSWOptions, 0)
+
+		for row, levels := range index.Graph.Neighbors {
+			for level, neighbors := range levels {
+				require.LessOrEqual(t, len(neighbors), index.Graph.maxNeighbors(level), "row %d level %d", row, level)
+				require.NotContains(t, neighbors, int32(row))
+			}
+		}
+	})
+}
+
+func TestQuantizedSimilaritySearchRecall(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	numRows, columnDimension, numResults := 5000, 64, 10
+	centers := getRandomNormalizedEmbeddings(prng, 50, columnDimension)
+	index := newClusteredEmbeddingIndex(prng, centers, numRows, columnDimension)
+	queries := getClusteredQueries(prng, centers, 50, columnDimension)
+
+	t.Run("exhaustive", func(t *testing.T) {
+		quantized := index.Quantize()
+		require.GreaterOrEqual(t, meanRecall(index, &quantized, queries, numResults), 0.9)
+	})
+
+	t.Run("hnsw", func(t *testing.T) {
+		quantized := index.Quantize()
+		quantized.buildGraph(DefaultHNSWOptions, 0)
+		require.GreaterOrEqual(t, meanRecall(index, &quantized, queries, numResults), 0.9)
+	})
+}
+
+func TestQuantize(t *testing.T) {
+	require.Equal(t, []int8{0, 127, -127, 64, -64, 127, -127}, Quantize([]float32{0, 1, -1, 0.5, -0.5, 1.01, -1.01}))
+}
+
+func TestQuantizedRepoEmbeddingIndex(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	centers := getRandomNormalizedEmbeddings(prng, 5, 4)
+	index := &RepoEmbeddingIndex{
+		RepoName:  "repo",
+		Revision:  "commit",
+		CodeIndex: *newClusteredEmbeddingIndex(prng, centers, 20, 4),
+		TextIndex: *newClusteredEmbeddingIndex(prng, centers, 0, 4),
+	}
+
+	quantized := index.Quantize()
+	quantized.BuildGraphs(DefaultHNSWOptions)
+
+	require.Equal(t, index.RepoName, quantized.RepoName)
+	require.Equal(t, index.Revision, quantized.Revision)
+	require.Len(t, quantized.CodeIndex.Embeddings, 80)
+	require.Equal(t, index.CodeIndex.RowMetadata, quantized.CodeIndex.RowMetadata)
+	// Small indexes are searched exhaustively.
+	require.Nil(t, quantized.CodeIndex.Graph)
+	require.Nil(t, quantized.TextIndex.Graph)
+
+	results := quantized.CodeIndex.SimilaritySearch(index.CodeIndex.Embeddings[8:12], 1, WorkerOptions{})
+	require.Equal(t, []*RepoEmbeddingRowMetadata{&quantized.CodeIndex.RowMetadata[2]}, results)
+	require.Empty(t, quantized.TextIndex.SimilaritySearch(index.CodeIndex.Embeddings[8:12], 1, WorkerOptions{}))
+
+	scored := quantized.CodeIndex.ScoredSimilaritySearch(index.CodeIndex.Embeddings[8:12], 2, WorkerOptions{})
+	require.Len(t, scored, 2)
+	require.Equal(t, &quantized.CodeIndex.RowMetadata[2], scored[0].Row)
+	// The query is the embedding of the row, up to the quantization error.
+	require.InDelta(t, 1, scored[0].Score, 0.01)
+	require.Greater(t, scored[0].Score, scored[1].Score)
+}
+
+// BenchmarkQuantizedSimilaritySearch compares searching an HNSW graph and
+// exhaustively searching the quantized embeddings to exhaustively searching the
+// unquantized embeddings. The recall is reported relative to the latter.
+func BenchmarkQuantizedSimilaritySearch(b *testing.B) {
+	prng := rand.New(rand.NewSource(0))
+
+	numRows := 20_000
+	numResults := 20
+	columnDimension := 256
+	centers := getRandomNormalizedEmbeddings(prng, 200, columnDimension)
+	index := newClusteredEmbeddingIndex(prng, centers, numRows, columnDimension)
+	queries := getClusteredQueries(prng, centers, 100, columnDimension)
+
+	exhaustive := index.Quantize()
+	hnsw := index.Quantize()
+	hnsw.buildGraph(DefaultHNSWOptions, 0)
+
+	b.ResetTimer()
+
+	b.Run("float32 exhaustive", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_ = index.SimilaritySearch(queries[n%len(queries)], numResults, WorkerOptions{NumWorkers: 1})
+		}
+	})
+
+	for name, quantized := range map[string]*QuantizedEmbeddingIndex[RepoEmbeddingRowMetadata]{"int8 exhaustive": &exhaustive, "int8 hnsw": &hnsw} {
+		quantized := quantized
+		b.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_ = quantized.SimilaritySearch(queries[n%len(queries)], numResults, WorkerOptions{NumWorkers: 1})
+			}
+			b.StopTimer()
+			b.ReportMetric(meanRecall(index, quantized, queries, numResults), "recall")
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -21,3 +22,10 @@ func GetRepoEmbeddingIndexName(repoName api.RepoName) RepoEmbeddingIndexName {
 	hash := md5.Sum([]byte(repoName))
 	return RepoEmbeddingIndexName(fmt.Sprintf(`%s_%s.embeddingindex`, fsSafeRepoName, hex.EncodeToString(hash[:])))
 }
+
+// GetQuantizedRepoEmbeddingIndexName returns the name of the
+// QuantizedRepoEmbeddingIndex that is stored alongside the RepoEmbeddingIndex
+// of a repository.
+func GetQuantizedRepoEmbeddingIndexName(repoName api.RepoName) RepoEmbeddingIndexName {
+	return RepoEmbeddingIndexName(strings.TrimSuffix(string(GetRepoEmbeddingIndexName(repoName)), ".embeddingindex") + ".quantized.embeddingindex")
+}
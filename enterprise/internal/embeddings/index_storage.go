@@ -23,6 +23,10 @@ func DownloadIndex[T any](ctx context.Context, uploadStore uploadstore.Store, ke
 	return &index, nil
 }
 
+// UploadIndex uploads the given index under key, replacing any previous index.
+// The index is encoded completely before the upload starts, and the upload
+// store replaces objects atomically, so readers either see the previous or the
+// new index but never a partially written one.
 func UploadIndex[T any](ctx context.Context, uploadStore uploadstore.Store, key string, index T) error {
 	buffer := bytes.NewBuffer(nil)
 	if err := gob.NewEncoder(buffer).Encode(index); err != nil {
@@ -0,0 +1,155 @@
+package embeddings
+
+import (
+	"math"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// QUANTIZATION_SCALE maps the values of normalized embeddings, which are in
+// [-1, 1], to [-127, 127].
+const QUANTIZATION_SCALE = 127
+
+// HNSW_MIN_ROWS is the minimum number of rows of an index for which an HNSW
+// graph is built. Smaller indexes are searched exhaustively.
+const HNSW_MIN_ROWS = 10_000
+
+// QuantizedEmbeddingIndex is an EmbeddingIndex with embeddings quantized to
+// int8, which takes a quarter of the memory.
+type QuantizedEmbeddingIndex[T any] struct {
+	Embeddings      []int8
+	ColumnDimension int
+	RowMetadata     []T
+	// Graph is used to find approximate nearest neighbors. If it is nil, the
+	// index is searched exhaustively.
+	Graph *HNSWGraph
+}
+
+// QuantizedRepoEmbeddingIndex is the quantized version of a
+// RepoEmbeddingIndex. It is stored alongside the RepoEmbeddingIndex and used
+// to serve searches, while the RepoEmbeddingIndex is used to update the index
+// incrementally.
+type QuantizedRepoEmbeddingIndex struct {
+	RepoName  api.RepoName
+	Revision  api.CommitID
+	CodeIndex QuantizedEmbeddingIndex[RepoEmbeddingRowMetadata]
+	TextIndex QuantizedEmbeddingIndex[RepoEmbeddingRowMetadata]
+}
+
+// Quantize quantizes the values of a normalized embedding to int8.
+func Quantize(values []float32) []int8 {
+	quantized := make([]int8, len(values))
+	for i, value := range values {
+		// Guard against rounding errors in the normalization.
+		value = float32(math.Max(-1, math.Min(1, float64(value))))
+		quantized[i] = int8(math.Round(float64(value * QUANTIZATION_SCALE)))
+	}
+	return quantized
+}
+
+// QuantizedCosineSimilarity is the cosine similarity of two quantized
+// embeddings, scaled by QUANTIZATION_SCALE^2.
+func QuantizedCosineSimilarity(row []int8, query []int8) int32 {
+	similarity := int32(0)
+	for i := 0; i < len(row); i++ {
+		similarity += int32(row[i]) * int32(query[i])
+	}
+	return similarity
+}
+
+// Quantize returns the quantized version of the index, without a graph.
+func (index *EmbeddingIndex[T]) Quantize() QuantizedEmbeddingIndex[T] {
+	return QuantizedEmbeddingIndex[T]{
+		Embeddings:      Quantize(index.Embeddings),
+		ColumnDimension: index.ColumnDimension,
+		RowMetadata:     index.RowMetadata,
+	}
+}
+
+// Quantize returns the quantized version of the index, without graphs.
+func (index *RepoEmbeddingIndex) Quantize() *QuantizedRepoEmbeddingIndex {
+	return &QuantizedRepoEmbeddingIndex{
+		RepoName:  index.RepoName,
+		Revision:  index.Revision,
+		CodeIndex: index.CodeIndex.Quantize(),
+		TextIndex: index.TextIndex.Quantize(),
+	}
+}
+
+// BuildGraphs builds HNSW graphs for the code and text indexes that have at
+// least HNSW_MIN_ROWS rows.
+func (index *QuantizedRepoEmbeddingIndex) BuildGraphs(options HNSWOptions) {
+	index.CodeIndex.buildGraph(options, HNSW_MIN_ROWS)
+	index.TextIndex.buildGraph(options, HNSW_MIN_ROWS)
+}
+
+func (index *QuantizedEmbeddingIndex[T]) buildGraph(options HNSWOptions, minRows int) {
+	numRows := len(index.RowMetadata)
+	if numRows < minRows {
+		index.Graph = nil
+		return
+	}
+	index.Graph = BuildHNSWGraph(numRows, func(i, j int) float32 {
+		return float32(QuantizedCosineSimilarity(index.row(i), index.row(j)))
+	}, options)
+}
+
+func (index *QuantizedEmbeddingIndex[T]) row(i int) []int8 {
+	return index.Embeddings[i*index.ColumnDimension : (i+1)*index.ColumnDimension]
+}
+
+// SimilaritySearch finds the `nResults` most similar rows to a query vector.
+// If the index has a graph, the results are approximate. Otherwise, all rows
+// are compared to the query.
+// IMPORTANT: The query has to be normalized for similarity search to work correctly.
+func (index *QuantizedEmbeddingIndex[T]) SimilaritySearch(query []float32, numResults int, workerOptions WorkerOptions) []*T {
+	neighbors := index.similaritySearch(query, numResults, workerOptions)
+	results := make([]*T, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		results = append(results, &index.RowMetadata[neighbor.index])
+	}
+	return results
+}
+
+// ScoredSimilaritySearchResult is a row found by ScoredSimilaritySearch.
+type ScoredSimilaritySearchResult[T any] struct {
+	Row *T
+	// Score is the cosine similarity of the row and the query, in [-1, 1].
+	// Since indexes are recreated when the embeddings model changes, scores
+	// from different indexes can be compared.
+	Score float32
+}
+
+// ScoredSimilaritySearch is like SimilaritySearch, but also returns the
+// similarity of every row to the query.
+func (index *QuantizedEmbeddingIndex[T]) ScoredSimilaritySearch(query []float32, numResults int, workerOptions WorkerOptions) []ScoredSimilaritySearchResult[T] {
+	neighbors := index.similaritySearch(query, numResults, workerOptions)
+	results := make([]ScoredSimilaritySearchResult[T], 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		results = append(results, ScoredSimilaritySearchResult[T]{
+			Row:   &index.RowMetadata[neighbor.index],
+			Score: neighbor.similarity / (QUANTIZATION_SCALE * QUANTIZATION_SCALE),
+		})
+	}
+	return results
+}
+
+func (index *QuantizedEmbeddingIndex[T]) similaritySearch(query []float32, numResults int, workerOptions WorkerOptions) []nearestNeighbor {
+	if numResults == 0 {
+		return nil
+	}
+
+	numRows := len(index.RowMetadata)
+	// Cannot request more results then there are rows.
+	numResults = min(numRows, numResults)
+
+	quantizedQuery := Quantize(query)
+	similarity := func(row int) float32 {
+		return float32(QuantizedCosineSimilarity(index.row(row), quantizedQuery))
+	}
+
+	if index.Graph != nil {
+		return index.Graph.search(numResults, HNSW_EF_SEARCH, similarity)
+	}
+	return similaritySearch(numRows, numResults, workerOptions, similarity)
+}
@@ -89,6 +89,23 @@ func (index *EmbeddingIndex[T]) SimilaritySearch(query []float32, numResults int
 	numRows := len(index.RowMetadata)
 	// Cannot request more results then there are rows.
 	numResults = min(numRows, numResults)
+
+	neighbors := similaritySearch(numRows, numResults, workerOptions, func(row int) float32 {
+		return CosineSimilarity(index.Embeddings[row*index.ColumnDimension:(row+1)*index.ColumnDimension], query)
+	})
+
+	// Take top neighbors and return them as results.
+	results := make([]*T, numResults)
+	for idx := 0; idx < min(numResults, len(neighbors)); idx++ {
+		results[idx] = &index.RowMetadata[neighbors[idx].index]
+	}
+	return results
+}
+
+// similaritySearch finds the numResults rows with the highest similarity
+// among numRows rows by scanning all of them. The rows are split among the
+// workers. It returns the neighbors sorted by similarity (descending).
+func similaritySearch(numRows int, numResults int, workerOptions WorkerOptions, similarity func(row int) float32) []nearestNeighbor {
 	// We need at least 1 worker.
 	numWorkers := max(1, workerOptions.NumWorkers)
 
@@ -101,12 +118,12 @@ func (index *EmbeddingIndex[T]) SimilaritySearch(query []float32, numResults int
 		for workerIdx := 0; workerIdx < len(rowsPerWorker); workerIdx++ {
 			// Capture the loop variable value so we can use it in the closure below.
 			workerIdx := workerIdx
-			wg.Go(func() { heaps[workerIdx] = index.partialSimilaritySearch(query, numResults, rowsPerWorker[workerIdx]) })
+			wg.Go(func() { heaps[workerIdx] = partialSimilaritySearch(similarity, numResults, rowsPerWorker[workerIdx]) })
 		}
 		wg.Wait()
 	} else {
 		// Run the similarity search directly when we have a single worker to eliminate the concurrency overhead.
-		heaps[0] = index.partialSimilaritySearch(query, numResults, rowsPerWorker[0])
+		heaps[0] = partialSimilaritySearch(similarity, numResults, rowsPerWorker[0])
 	}
 
 	// Collect all heap neighbors from workers into a single array.
@@ -118,16 +135,10 @@ func (index *EmbeddingIndex[T]) SimilaritySearch(query []float32, numResults int
 	}
 	// And re-sort it according to the similarity (descending).
 	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].similarity > neighbors[j].similarity })
-
-	// Take top neighbors and return them as results.
-	results := make([]*T, numResults)
-	for idx := 0; idx < min(numResults, len(neighbors)); idx++ {
-		results[idx] = &index.RowMetadata[neighbors[idx].index]
-	}
-	return results
+	return neighbors
 }
 
-func (index *EmbeddingIndex[T]) partialSimilaritySearch(query []float32, numResults int, partialRows partialRows) *nearestNeighborsHeap {
+func partialSimilaritySearch(similarity func(row int) float32, numResults int, partialRows partialRows) *nearestNeighborsHeap {
 	nRows := partialRows.end - partialRows.start
 	if nRows <= 0 {
 		return nil
@@ -136,23 +147,16 @@ func (index *EmbeddingIndex[T]) partialSimilaritySearch(query []float32, numResu
 
 	nnHeap := newNearestNeighborsHeap()
 	for i := partialRows.start; i < partialRows.start+numResults; i++ {
-		similarity := CosineSimilarity(
-			index.Embeddings[i*index.ColumnDimension:(i+1)*index.ColumnDimension],
-			query,
-		)
-		heap.Push(nnHeap, nearestNeighbor{i, similarity})
+		heap.Push(nnHeap, nearestNeighbor{i, similarity(i)})
 	}
 
 	for i := partialRows.start + numResults; i < partialRows.end; i++ {
-		similarity := CosineSimilarity(
-			index.Embeddings[i*index.ColumnDimension:(i+1)*index.ColumnDimension],
-			query,
-		)
+		rowSimilarity := similarity(i)
 		// Add row if it has greater similarity than the smallest similarity in the heap.
 		// This way we ensure keep a set of highest similarities in the heap.
-		if similarity > nnHeap.Peek().similarity {
+		if rowSimilarity > nnHeap.Peek().similarity {
 			heap.Pop(nnHeap)
-			heap.Push(nnHeap, nearestNeighbor{i, similarity})
+			heap.Push(nnHeap, nearestNeighbor{i, rowSimilarity})
 		}
 	}
 
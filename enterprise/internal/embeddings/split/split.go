@@ -43,6 +43,10 @@ type EmbeddableChunk struct {
 	StartLine int
 	EndLine   int
 	Content   string
+	// SymbolName and SymbolKind are set if the chunk belongs to the
+	// definition of a symbol.
+	SymbolName string
+	SymbolKind string
 }
 
 // SplitIntoEmbeddableChunks splits the given text into embeddable chunks.
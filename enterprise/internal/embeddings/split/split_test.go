@@ -3,6 +3,7 @@ package split
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hexops/autogold/v2"
 )
 
@@ -21,3 +22,47 @@ Line
 	chunks := SplitIntoEmbeddableChunks(content, "", SplitOptions{ChunkTokensThreshold: 4, ChunkEarlySplitTokensThreshold: 1})
 	autogold.ExpectFile(t, chunks)
 }
+
+func TestSplitIntoEmbeddableChunksBySymbols(t *testing.T) {
+	content := `package main
+
+import "fmt"
+
+// Hello prints a greeting.
+// It is very polite.
+func Hello() {
+	fmt.Println("Hello")
+	fmt.Println("World")
+}
+
+type Greeter struct{}
+
+// Greet greets.
+func (g Greeter) Greet() {
+	fmt.Println("Hello")
+
+	fmt.Println("World")
+
+	fmt.Println("!")
+
+	fmt.Println("Goodbye")
+}
+`
+	symbols := []Symbol{
+		{Name: "Greet", Kind: "method", Line: 14},
+		{Name: "Hello", Kind: "function", Line: 6},
+		{Name: "Greeter", Kind: "struct", Line: 11},
+		// Symbols outside of the file are ignored.
+		{Name: "Invalid", Kind: "function", Line: 100},
+	}
+	chunks := SplitIntoEmbeddableChunksBySymbols(content, "main.go", symbols, SplitOptions{ChunkTokensThreshold: 32, ChunkEarlySplitTokensThreshold: 24})
+	autogold.ExpectFile(t, chunks)
+}
+
+func TestSplitIntoEmbeddableChunksBySymbolsWithoutSymbols(t *testing.T) {
+	content := "Line\nLine\n\nLine\n"
+	splitOptions := SplitOptions{ChunkTokensThreshold: 1, ChunkEarlySplitTokensThreshold: 1}
+	if diff := cmp.Diff(SplitIntoEmbeddableChunks(content, "a.txt", splitOptions), SplitIntoEmbeddableChunksBySymbols(content, "a.txt", nil, splitOptions)); diff != "" {
+		t.Fatalf("unexpected chunks (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,138 @@
+package split
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
+)
+
+// Symbol is the definition of a function, method, class or similar symbol in
+// a file, as reported by the symbols service.
+type Symbol struct {
+	Name string
+	Kind string
+	// Line is the 0-based line the symbol is defined on.
+	Line int
+}
+
+var commentLinePrefixes = []string{
+	"//",
+	"#",
+	"/*",
+	"* ",
+	"*/",
+	"--",
+	"@",
+}
+
+// isCommentLine returns true for lines that are part of the comment or the
+// annotations preceding a symbol definition.
+func isCommentLine(line string) bool {
+	trimmedLine := strings.TrimSpace(line)
+	for _, prefix := range commentLinePrefixes {
+		if strings.HasPrefix(trimmedLine, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitIntoEmbeddableChunksBySymbols splits the given text into embeddable
+// chunks at the definitions of the given symbols, so that functions, methods
+// and classes are not split across chunks.
+//
+// Every symbol starts a new chunk, which includes the comment preceding the
+// symbol and ends where the next symbol starts. The chunk carries the name and
+// kind of the symbol. Chunks that exceed the chunk token threshold are split
+// further like in SplitIntoEmbeddableChunks, and all of the resulting chunks
+// carry the symbol. The text before the first symbol is split like in
+// SplitIntoEmbeddableChunks. Without symbols, the text is split like in
+// SplitIntoEmbeddableChunks.
+func SplitIntoEmbeddableChunksBySymbols(text string, fileName string, symbols []Symbol, splitOptions SplitOptions) []EmbeddableChunk {
+	if len(symbols) == 0 || embeddings.EstimateTokens(text) < splitOptions.NoSplitTokensThreshold {
+		return SplitIntoEmbeddableChunks(text, fileName, splitOptions)
+	}
+
+	lines := strings.Split(text, "\n")
+	sections := symbolSections(lines, symbols)
+
+	// Sections are always split, the file as a whole is too large to be
+	// embedded in one chunk.
+	sectionSplitOptions := splitOptions
+	sectionSplitOptions.NoSplitTokensThreshold = 0
+
+	chunks := []EmbeddableChunk{}
+	for _, section := range sections {
+		content := strings.Join(lines[section.startLine:section.endLine], "\n")
+		if len(strings.TrimSpace(content)) == 0 {
+			continue
+		}
+
+		var sectionChunks []EmbeddableChunk
+		if embeddings.EstimateTokens(content) <= splitOptions.ChunkTokensThreshold {
+			sectionChunks = []EmbeddableChunk{{StartLine: 0, EndLine: section.endLine - section.startLine, Content: content}}
+		} else {
+			sectionChunks = SplitIntoEmbeddableChunks(content, fileName, sectionSplitOptions)
+		}
+
+		for _, chunk := range sectionChunks {
+			chunk.FileName = fileName
+			chunk.StartLine += section.startLine
+			chunk.EndLine += section.startLine
+			if section.symbol != nil {
+				chunk.SymbolName = section.symbol.Name
+				chunk.SymbolKind = section.symbol.Kind
+			}
+			chunks = append(chunks, chunk)
+		}
+	}
+	return chunks
+}
+
+type symbolSection struct {
+	startLine int
+	endLine   int
+	// symbol is nil for the section before the first symbol.
+	symbol *Symbol
+}
+
+// symbolSections divides the lines into consecutive sections that start at
+// the definitions of the symbols, including the comments preceding them.
+func symbolSections(lines []string, symbols []Symbol) []symbolSection {
+	sorted := make([]Symbol, 0, len(symbols))
+	for _, symbol := range symbols {
+		if symbol.Line >= 0 && symbol.Line < len(lines) {
+			sorted = append(sorted, symbol)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Line < sorted[j].Line })
+
+	sections := []symbolSection{{startLine: 0}}
+	for i := range sorted {
+		symbol := &sorted[i]
+		previous := &sections[len(sections)-1]
+		if previous.symbol != nil && symbol.Line <= previous.symbol.Line {
+			// Only the first of several symbols defined on the same line
+			// starts a section.
+			continue
+		}
+
+		// The comment preceding the symbol cannot extend into the line
+		// defining the previous symbol.
+		minStartLine := previous.startLine
+		if previous.symbol != nil {
+			minStartLine = previous.symbol.Line + 1
+		}
+		startLine := symbol.Line
+		for startLine > minStartLine && isCommentLine(lines[startLine-1]) {
+			startLine--
+		}
+
+		previous.endLine = startLine
+		sections = append(sections, symbolSection{startLine: startLine, symbol: symbol})
+	}
+	sections[len(sections)-1].endLine = len(lines)
+
+	return sections
+}
@@ -1,6 +1,10 @@
 package embeddings
 
-import "github.com/sourcegraph/sourcegraph/internal/api"
+import (
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
 
 type EmbeddingIndex[T any] struct {
 	Embeddings      []float32
@@ -12,13 +16,22 @@ type RepoEmbeddingRowMetadata struct {
 	FileName  string
 	StartLine int
 	EndLine   int
+	// SymbolName and SymbolKind are set if the row was split at the
+	// definition of a symbol.
+	SymbolName string
+	SymbolKind string
 }
 
 type RepoEmbeddingIndex struct {
-	RepoName  api.RepoName
-	Revision  api.CommitID
-	CodeIndex EmbeddingIndex[RepoEmbeddingRowMetadata]
-	TextIndex EmbeddingIndex[RepoEmbeddingRowMetadata]
+	RepoName api.RepoName
+	Revision api.CommitID
+	// EmbeddingsProvider and EmbeddingsModel identify the model that created
+	// the embeddings. Embeddings of different models cannot be compared, so
+	// the index is recreated when either changes.
+	EmbeddingsProvider string
+	EmbeddingsModel    string
+	CodeIndex          EmbeddingIndex[RepoEmbeddingRowMetadata]
+	TextIndex          EmbeddingIndex[RepoEmbeddingRowMetadata]
 }
 
 type ContextDetectionEmbeddingIndex struct {
@@ -32,8 +45,79 @@ type EmbeddingSearchResults struct {
 }
 
 type EmbeddingSearchResult struct {
-	FileName  string `json:"fileName"`
-	StartLine int    `json:"startLine"`
-	EndLine   int    `json:"endLine"`
-	Content   string `json:"content"`
+	RepoName  api.RepoName `json:"repoName"`
+	Revision  api.CommitID `json:"revision"`
+	FileName  string       `json:"fileName"`
+	StartLine int          `json:"startLine"`
+	EndLine   int          `json:"endLine"`
+	Content   string       `json:"content"`
+	// SymbolName and SymbolKind are set if the result belongs to the
+	// definition of a symbol.
+	SymbolName string `json:"symbolName,omitempty"`
+	SymbolKind string `json:"symbolKind,omitempty"`
+	// Score is the cosine similarity of the result and the query, in [-1, 1].
+	Score float32 `json:"score"`
+}
+
+// MergeEmbeddingSearchResults merges the results of searching several
+// repositories into the codeResultsCount code results and textResultsCount
+// text results with the highest scores.
+//
+// The scores are not normalized per repository: they are the cosine
+// similarities of the rows to the same query embedding, and every index is
+// recreated when the embeddings model changes, so the scores of different
+// repositories are on the same scale. Normalizing them would instead rank the
+// best result of an unrelated repository as high as that of the most relevant
+// one.
+func MergeEmbeddingSearchResults(results []*EmbeddingSearchResults, codeResultsCount int, textResultsCount int) *EmbeddingSearchResults {
+	var codeResults, textResults []EmbeddingSearchResult
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		codeResults = append(codeResults, result.CodeResults...)
+		textResults = append(textResults, result.TextResults...)
+	}
+	return &EmbeddingSearchResults{
+		CodeResults: topEmbeddingSearchResults(codeResults, codeResultsCount),
+		TextResults: topEmbeddingSearchResults(textResults, textResultsCount),
+	}
+}
+
+func topEmbeddingSearchResults(results []EmbeddingSearchResult, count int) []EmbeddingSearchResult {
+	// Results of the same repository are already sorted, so a stable sort
+	// keeps their order if they have the same score.
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > count {
+		results = results[:count]
+	}
+	if results == nil {
+		return []EmbeddingSearchResult{}
+	}
+	return results
+}
+
+// Filter returns a copy of the index that only contains the rows for which
+// keep returns true.
+func (index *EmbeddingIndex[T]) Filter(keep func(T) bool) EmbeddingIndex[T] {
+	filtered := EmbeddingIndex[T]{
+		Embeddings:      make([]float32, 0, len(index.Embeddings)),
+		ColumnDimension: index.ColumnDimension,
+		RowMetadata:     make([]T, 0, len(index.RowMetadata)),
+	}
+	for i, metadata := range index.RowMetadata {
+		if !keep(metadata) {
+			continue
+		}
+		filtered.RowMetadata = append(filtered.RowMetadata, metadata)
+		filtered.Embeddings = append(filtered.Embeddings, index.Embeddings[i*index.ColumnDimension:(i+1)*index.ColumnDimension]...)
+	}
+	return filtered
+}
+
+// Append appends the rows of other, which must have the same column
+// dimension, to the index.
+func (index *EmbeddingIndex[T]) Append(other EmbeddingIndex[T]) {
+	index.Embeddings = append(index.Embeddings, other.Embeddings...)
+	index.RowMetadata = append(index.RowMetadata, other.RowMetadata...)
 }
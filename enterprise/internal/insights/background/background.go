@@ -87,6 +87,7 @@ func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB databas
 		}
 		backfillRunner := pipeline.NewDefaultBackfiller(backfillConfig)
 		config := scheduler.JobMonitorConfig{
+			DB:             mainAppDB,
 			InsightsDB:     insightsDB,
 			InsightStore:   insightsStore,
 			RepoStore:      mainAppDB.Repos(),
@@ -25,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -59,6 +60,7 @@ func makeInProgressWorker(ctx context.Context, config JobMonitorConfig) (*worker
 	handlerConfig := newHandlerConfig()
 
 	task := &inProgressHandler{
+		db:                 config.DB,
 		workerStore:        workerStore,
 		backfillStore:      backfillStore,
 		seriesReadComplete: store.NewInsightStore(db),
@@ -110,6 +112,7 @@ func makeInProgressWorker(ctx context.Context, config JobMonitorConfig) (*worker
 }
 
 type inProgressHandler struct {
+	db                 database.DB
 	workerStore        dbworkerstore.Store[*BaseJob]
 	backfillStore      *BackfillStore
 	seriesReadComplete SeriesReadBackfillComplete
@@ -266,7 +269,11 @@ func (h *inProgressHandler) doExecution(ctx context.Context, execution *backfill
 	}
 
 	if !execution.itr.HasMore() && !execution.itr.HasErrors() {
-		return false, h.finish(ctx, execution)
+		if err := h.finish(ctx, execution); err != nil {
+			return false, err
+		}
+		h.enqueueBackfillWebhook(ctx, execution)
+		return false, nil
 	} else {
 		// in this state we have some errors that will need reprocessing, we will place this job back in queue
 		return true, nil
@@ -308,6 +315,17 @@ func (h *inProgressHandler) finish(ctx context.Context, ex *backfillExecution) (
 	return nil
 }
 
+// enqueueBackfillWebhook enqueues the outbound webhook event for a completed
+// backfill.
+func (h *inProgressHandler) enqueueBackfillWebhook(ctx context.Context, ex *backfillExecution) {
+	scope := ex.series.SeriesID
+	outbound.EnqueueEvent(ctx, ex.logger, h.db, outbound.InsightSeriesBackfill, &scope, outbound.InsightSeriesPayload{
+		SeriesID:    ex.series.SeriesID,
+		Query:       ex.series.Query,
+		CompletedAt: ex.itr.CompletedAt,
+	})
+}
+
 func (h *inProgressHandler) disableBackfill(ctx context.Context, ex *backfillExecution) (err error) {
 	tx, err := h.backfillStore.Transact(ctx)
 	if err != nil {
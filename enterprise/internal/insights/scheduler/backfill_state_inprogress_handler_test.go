@@ -58,6 +58,7 @@ func Test_MovesBackfillFromProcessingToComplete(t *testing.T) {
 	bfs := newBackfillStoreWithClock(insightsDB, clock)
 
 	config := JobMonitorConfig{
+		DB:             newMainAppDB(),
 		InsightsDB:     insightsDB,
 		RepoStore:      repos,
 		InsightStore:   seriesStore,
@@ -135,6 +136,7 @@ func Test_PullsByEstimatedCostAge(t *testing.T) {
 	bfs := newBackfillStoreWithClock(insightsDB, clock)
 
 	config := JobMonitorConfig{
+		DB:             newMainAppDB(),
 		InsightsDB:     insightsDB,
 		RepoStore:      repos,
 		InsightStore:   seriesStore,
@@ -198,6 +200,7 @@ func Test_BackfillWithRetry(t *testing.T) {
 	bfs := newBackfillStoreWithClock(insightsDB, clock)
 
 	config := JobMonitorConfig{
+		DB:             newMainAppDB(),
 		InsightsDB:     insightsDB,
 		RepoStore:      repos,
 		InsightStore:   seriesStore,
@@ -277,6 +280,7 @@ func Test_BackfillWithRetryAndComplete(t *testing.T) {
 	bfs := newBackfillStoreWithClock(insightsDB, clock)
 
 	config := JobMonitorConfig{
+		DB:             newMainAppDB(),
 		InsightsDB:     insightsDB,
 		RepoStore:      repos,
 		InsightStore:   seriesStore,
@@ -360,6 +364,7 @@ func Test_BackfillWithInterrupt(t *testing.T) {
 	bfs := newBackfillStoreWithClock(insightsDB, clock)
 
 	config := JobMonitorConfig{
+		DB:             newMainAppDB(),
 		InsightsDB:     insightsDB,
 		RepoStore:      repos,
 		InsightStore:   seriesStore,
@@ -445,6 +450,7 @@ func Test_BackfillCrossingErrorThreshold(t *testing.T) {
 	bfs := newBackfillStoreWithClock(insightsDB, clock)
 
 	config := JobMonitorConfig{
+		DB:             newMainAppDB(),
 		InsightsDB:     insightsDB,
 		RepoStore:      repos,
 		InsightStore:   seriesStore,
@@ -544,3 +550,9 @@ func Test_calculateErrorThreshold(t *testing.T) {
 		})
 	}
 }
+
+func newMainAppDB() database.DB {
+	db := database.NewMockDB()
+	db.OutboundWebhooksFunc.SetDefaultReturn(database.NewMockOutboundWebhookStore())
+	return db
+}
@@ -105,6 +105,7 @@ type BackgroundJobMonitor struct {
 }
 
 type JobMonitorConfig struct {
+	DB                database.DB
 	InsightsDB        edb.InsightsDB
 	InsightStore      store.Interface
 	RepoStore         database.RepoStore
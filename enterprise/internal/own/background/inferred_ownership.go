@@ -0,0 +1,397 @@
+// Package background infers the ownership of files in repositories from their
+// recent activity, for repositories that don't declare it in CODEOWNERS files.
+package background
+
+import (
+	"context"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ChangesetStore is the part of the batch changes store that is needed to
+// infer ownership from the reviewers of changesets.
+type ChangesetStore interface {
+	ListChangesets(ctx context.Context, opts store.ListChangesetsOpts) (btypes.Changesets, int64, error)
+	ListChangesetEvents(ctx context.Context, opts store.ListChangesetEventsOpts) ([]*btypes.ChangesetEvent, int64, error)
+	GetChangesetSpecByID(ctx context.Context, id int64) (*btypes.ChangesetSpec, error)
+}
+
+// Options configure how ownership is inferred.
+type Options struct {
+	// Window is how far back activity is considered.
+	Window time.Duration
+	// MaxCommits is the maximum number of recent commits per repository
+	// whose authors are considered.
+	MaxCommits int
+	// MaxOwnersPerFile is the maximum number of owners stored per file and
+	// signal. The owners with the highest scores are kept.
+	MaxOwnersPerFile int
+}
+
+// reviewEventKinds are the kinds of changeset events that are attributed to a
+// reviewer of the changeset. Bitbucket Cloud and Azure DevOps events are not
+// included, since they don't identify the reviewer by username.
+var reviewEventKinds = []btypes.ChangesetEventKind{
+	btypes.ChangesetEventKindGitHubReviewed,
+	btypes.ChangesetEventKindGitLabApproved,
+	btypes.ChangesetEventKindBitbucketServerApproved,
+	btypes.ChangesetEventKindBitbucketServerReviewed,
+}
+
+// NewInferredOwnershipIndexer returns a background routine that periodically
+// infers the owners of the files of all cloned repositories from the authors of
+// recent commits and the reviewers of recent changesets, and stores them along
+// with the signal they were inferred from.
+func NewInferredOwnershipIndexer(
+	ctx context.Context,
+	logger log.Logger,
+	db edb.EnterpriseDB,
+	gitserverClient gitserver.Client,
+	changesetStore ChangesetStore,
+	interval time.Duration,
+	opts Options,
+) goroutine.BackgroundRoutine {
+	i := &indexer{
+		logger:         logger,
+		db:             db,
+		gitserver:      gitserverClient,
+		changesetStore: changesetStore,
+		opts:           opts,
+		now:            time.Now,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		"own.inferred-ownership-indexer", "infers ownership of files from recent activity",
+		interval,
+		goroutine.HandlerFunc(i.handle),
+	)
+}
+
+type indexer struct {
+	logger         log.Logger
+	db             edb.EnterpriseDB
+	gitserver      gitserver.Client
+	changesetStore ChangesetStore
+	opts           Options
+	now            func() time.Time
+}
+
+func (i *indexer) handle(ctx context.Context) error {
+	repos, err := i.db.Repos().ListMinimalRepos(ctx, database.ReposListOptions{OnlyCloned: true})
+	if err != nil {
+		return errors.Wrap(err, "listing cloned repositories")
+	}
+
+	// Users are looked up by the emails of commit authors once per run.
+	usernames := make(map[string]string)
+
+	var errs error
+	for _, repo := range repos {
+		if err := i.indexRepo(ctx, repo, usernames); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "inferring ownership of repository %q", repo.Name))
+		}
+	}
+	return errs
+}
+
+func (i *indexer) indexRepo(ctx context.Context, repo types.MinimalRepo, usernames map[string]string) error {
+	windowEnd := i.now().UTC()
+	windowStart := windowEnd.Add(-i.opts.Window)
+
+	contributions, err := i.recentContributions(ctx, repo, windowStart, usernames)
+	if err != nil {
+		return errors.Wrap(err, "listing recent contributions")
+	}
+	reviews, err := i.recentReviews(ctx, repo, windowStart)
+	if err != nil {
+		return errors.Wrap(err, "listing recent reviews")
+	}
+
+	ownershipStore := i.db.InferredOwnership()
+	for signal, activities := range map[owntypes.InferredOwnershipSignal][]activity{
+		owntypes.InferredOwnershipSignalRecentContributor: contributions,
+		owntypes.InferredOwnershipSignalRecentReviewer:    reviews,
+	} {
+		owners := scoreActivities(repo.ID, signal, activities, windowStart, windowEnd, i.opts.MaxOwnersPerFile)
+		if err := ownershipStore.ReplaceInferredOwners(ctx, repo.ID, signal, owners); err != nil {
+			return errors.Wrapf(err, "storing %s owners", signal)
+		}
+	}
+	return nil
+}
+
+// activity is a change of a file by a person, either as author of a commit or
+// as reviewer of a changeset.
+type activity struct {
+	path   string
+	handle string
+	email  string
+	at     time.Time
+}
+
+// recentContributions returns the files changed by the commits of the default
+// branch in the window, attributed to the commit authors.
+func (i *indexer) recentContributions(ctx context.Context, repo types.MinimalRepo, windowStart time.Time, usernames map[string]string) ([]activity, error) {
+	var matches []protocol.CommitMatch
+	_, err := i.gitserver.Search(ctx, &protocol.SearchRequest{
+		Repo:                 repo.Name,
+		Revisions:            []protocol.RevisionSpecifier{{RevSpec: "HEAD"}},
+		Query:                &protocol.CommitAfter{Time: windowStart},
+		IncludeModifiedFiles: true,
+		Limit:                i.opts.MaxCommits,
+	}, func(ms []protocol.CommitMatch) {
+		matches = append(matches, ms...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var activities []activity
+	for _, match := range matches {
+		email := strings.ToLower(match.Author.Email)
+		if email == "" {
+			continue
+		}
+		handle, err := i.usernameForEmail(ctx, email, usernames)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range match.ModifiedFiles {
+			activities = append(activities, activity{path: path, handle: handle, email: email, at: match.Author.Date})
+		}
+	}
+	return activities, nil
+}
+
+// usernameForEmail returns the username of the user with the given verified
+// email, so that owners inferred from commits can be searched for by handle.
+// It returns an empty string if there is no such user.
+func (i *indexer) usernameForEmail(ctx context.Context, email string, usernames map[string]string) (string, error) {
+	if username, ok := usernames[email]; ok {
+		return username, nil
+	}
+	var username string
+	user, err := i.db.Users().GetByVerifiedEmail(ctx, email)
+	if err != nil && !errcode.IsNotFound(err) {
+		return "", err
+	}
+	if user != nil {
+		username = user.Username
+	}
+	usernames[email] = username
+	return username, nil
+}
+
+// recentReviews returns the files changed by the changesets of the repository
+// that were reviewed in the window, attributed to the reviewers.
+func (i *indexer) recentReviews(ctx context.Context, repo types.MinimalRepo, windowStart time.Time) ([]activity, error) {
+	changesets, _, err := i.changesetStore.ListChangesets(ctx, store.ListChangesetsOpts{
+		RepoIDs:         []api.RepoID{repo.ID},
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changesetsByID := make(map[int64]*btypes.Changeset)
+	var ids []int64
+	for _, c := range changesets {
+		if c.ExternalID == "" || c.ExternalUpdatedAt.Before(windowStart) {
+			continue
+		}
+		changesetsByID[c.ID] = c
+		ids = append(ids, c.ID)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	events, _, err := i.changesetStore.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{
+		ChangesetIDs: ids,
+		Kinds:        reviewEventKinds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[int64][]string)
+	var activities []activity
+	for _, event := range events {
+		reviewer := event.ReviewAuthor()
+		at := event.Timestamp()
+		if reviewer == "" || at.Before(windowStart) {
+			continue
+		}
+		changesetPaths, ok := paths[event.ChangesetID]
+		if !ok {
+			changesetPaths, err = i.changesetPaths(ctx, repo, changesetsByID[event.ChangesetID])
+			if err != nil {
+				return nil, err
+			}
+			paths[event.ChangesetID] = changesetPaths
+		}
+		for _, path := range changesetPaths {
+			activities = append(activities, activity{path: path, handle: reviewer, at: at})
+		}
+	}
+	return activities, nil
+}
+
+// changesetPaths returns the paths of the files changed by a changeset. For
+// changesets created by batch changes, these are the files changed by the
+// changeset spec. For other changesets, they are computed from the diff
+// between the base and head commits of the changeset, if both commits exist in
+// the repository. Otherwise, no files are returned.
+func (i *indexer) changesetPaths(ctx context.Context, repo types.MinimalRepo, c *btypes.Changeset) ([]string, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	if c.CurrentSpecID != 0 {
+		spec, err := i.changesetStore.GetChangesetSpecByID(ctx, c.CurrentSpecID)
+		if err != nil {
+			return nil, err
+		}
+		return spec.ChangedPaths()
+	}
+
+	if c.SyncState.BaseRefOid == "" || c.SyncState.HeadRefOid == "" {
+		return nil, nil
+	}
+	iter, err := i.gitserver.Diff(ctx, authz.DefaultSubRepoPermsChecker, gitserver.DiffOptions{
+		Repo: repo.Name,
+		Base: c.SyncState.BaseRefOid,
+		Head: c.SyncState.HeadRefOid,
+	})
+	if err != nil {
+		i.logger.Debug("skipping changeset without diff", log.Int64("changesetID", c.ID), log.Error(err))
+		return nil, nil
+	}
+	defer iter.Close()
+
+	var paths []string
+	for {
+		fileDiff, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			i.logger.Debug("skipping changeset without diff", log.Int64("changesetID", c.ID), log.Error(err))
+			return nil, nil
+		}
+		// Deleted files only have an original name.
+		path := fileDiff.NewName
+		if path == "" || path == "/dev/null" {
+			path = fileDiff.OrigName
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// scoreActivities computes the owners of every file from the activities of a
+// signal in the window ending at windowEnd.
+//
+// Every activity is weighted by its recency: the weight halves every third of
+// the window, so that activity at the end of the window weighs eight times as
+// much as activity at its start. The score of an owner of a file is the share
+// of the owner in the total weight of the activities on the file. Only the
+// maxOwnersPerFile owners with the highest scores are returned per file.
+func scoreActivities(
+	repoID api.RepoID,
+	signal owntypes.InferredOwnershipSignal,
+	activities []activity,
+	windowStart time.Time,
+	windowEnd time.Time,
+	maxOwnersPerFile int,
+) []*owntypes.InferredOwner {
+	type ownerKey struct {
+		handle string
+		email  string
+	}
+
+	halfLife := windowEnd.Sub(windowStart) / 3
+	weights := make(map[string]map[ownerKey]float64)
+	for _, a := range activities {
+		age := windowEnd.Sub(a.at)
+		if age < 0 {
+			age = 0
+		}
+		weight := 1.0
+		if halfLife > 0 {
+			weight = math.Exp2(-float64(age) / float64(halfLife))
+		}
+
+		if weights[a.path] == nil {
+			weights[a.path] = make(map[ownerKey]float64)
+		}
+		weights[a.path][ownerKey{a.handle, a.email}] += weight
+	}
+
+	var owners []*owntypes.InferredOwner
+	for path, ownerWeights := range weights {
+		var total float64
+		for _, weight := range ownerWeights {
+			total += weight
+		}
+
+		pathOwners := make([]*owntypes.InferredOwner, 0, len(ownerWeights))
+		for key, weight := range ownerWeights {
+			pathOwners = append(pathOwners, &owntypes.InferredOwner{
+				RepoID:      repoID,
+				FilePath:    path,
+				Signal:      signal,
+				Handle:      key.handle,
+				Email:       key.email,
+				Score:       weight / total,
+				WindowStart: windowStart,
+				WindowEnd:   windowEnd,
+			})
+		}
+		sortInferredOwners(pathOwners)
+		if maxOwnersPerFile > 0 && len(pathOwners) > maxOwnersPerFile {
+			pathOwners = pathOwners[:maxOwnersPerFile]
+		}
+		owners = append(owners, pathOwners...)
+	}
+
+	sortInferredOwners(owners)
+	return owners
+}
+
+// sortInferredOwners sorts owners by path and descending score.
+func sortInferredOwners(owners []*owntypes.InferredOwner) {
+	sort.Slice(owners, func(i, j int) bool {
+		a, b := owners[i], owners[j]
+		if a.FilePath != b.FilePath {
+			return a.FilePath < b.FilePath
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Handle != b.Handle {
+			return a.Handle < b.Handle
+		}
+		return a.Email < b.Email
+	})
+}
@@ -0,0 +1,242 @@
+package background
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	windowEnd   = time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+	windowStart = windowEnd.Add(-90 * 24 * time.Hour)
+)
+
+type ownerScore struct {
+	Path   string
+	Handle string
+	Email  string
+	Score  float64
+}
+
+func ownerScores(owners []*owntypes.InferredOwner) []ownerScore {
+	scores := make([]ownerScore, 0, len(owners))
+	for _, o := range owners {
+		scores = append(scores, ownerScore{Path: o.FilePath, Handle: o.Handle, Email: o.Email, Score: o.Score})
+	}
+	return scores
+}
+
+func TestScoreActivities(t *testing.T) {
+	signal := owntypes.InferredOwnershipSignalRecentContributor
+
+	t.Run("no activities", func(t *testing.T) {
+		assert.Empty(t, scoreActivities(1, signal, nil, windowStart, windowEnd, 5))
+	})
+
+	t.Run("recent activity weighs more", func(t *testing.T) {
+		owners := scoreActivities(1, signal, []activity{
+			// Two thirds of the window ago weighs a quarter.
+			{path: "main.go", email: "alice@example.com", at: windowEnd.Add(-60 * 24 * time.Hour)},
+			{path: "main.go", handle: "bob", email: "bob@example.com", at: windowEnd},
+			{path: "README.md", email: "alice@example.com", at: windowStart},
+		}, windowStart, windowEnd, 5)
+
+		require.Equal(t, []ownerScore{
+			{Path: "README.md", Email: "alice@example.com", Score: 1},
+			{Path: "main.go", Handle: "bob", Email: "bob@example.com", Score: 0.8},
+			{Path: "main.go", Email: "alice@example.com", Score: 0.2},
+		}, ownerScores(owners))
+		for _, o := range owners {
+			assert.Equal(t, api.RepoID(1), o.RepoID)
+			assert.Equal(t, signal, o.Signal)
+			assert.Equal(t, windowStart, o.WindowStart)
+			assert.Equal(t, windowEnd, o.WindowEnd)
+		}
+	})
+
+	t.Run("activities of an owner add up", func(t *testing.T) {
+		owners := scoreActivities(1, signal, []activity{
+			{path: "main.go", email: "alice@example.com", at: windowEnd},
+			{path: "main.go", email: "alice@example.com", at: windowEnd},
+			{path: "main.go", email: "alice@example.com", at: windowEnd},
+			{path: "main.go", email: "bob@example.com", at: windowEnd},
+		}, windowStart, windowEnd, 5)
+
+		require.Equal(t, []ownerScore{
+			{Path: "main.go", Email: "alice@example.com", Score: 0.75},
+			{Path: "main.go", Email: "bob@example.com", Score: 0.25},
+		}, ownerScores(owners))
+	})
+
+	t.Run("only the top owners of a file are kept", func(t *testing.T) {
+		owners := scoreActivities(1, signal, []activity{
+			{path: "main.go", email: "alice@example.com", at: windowEnd},
+			{path: "main.go", email: "alice@example.com", at: windowEnd},
+			{path: "main.go", email: "bob@example.com", at: windowEnd},
+			{path: "main.go", email: "carol@example.com", at: windowEnd},
+			{path: "main.go", email: "carol@example.com", at: windowEnd},
+		}, windowStart, windowEnd, 2)
+
+		require.Equal(t, []ownerScore{
+			{Path: "main.go", Email: "alice@example.com", Score: 0.4},
+			{Path: "main.go", Email: "carol@example.com", Score: 0.4},
+		}, ownerScores(owners))
+	})
+}
+
+type fakeChangesetStore struct {
+	changesets btypes.Changesets
+	events     []*btypes.ChangesetEvent
+	specs      map[int64]*btypes.ChangesetSpec
+}
+
+func (s *fakeChangesetStore) ListChangesets(_ context.Context, opts store.ListChangesetsOpts) (btypes.Changesets, int64, error) {
+	var changesets btypes.Changesets
+	for _, c := range s.changesets {
+		for _, id := range opts.RepoIDs {
+			if c.RepoID == id {
+				changesets = append(changesets, c)
+			}
+		}
+	}
+	return changesets, 0, nil
+}
+
+func (s *fakeChangesetStore) ListChangesetEvents(_ context.Context, opts store.ListChangesetEventsOpts) ([]*btypes.ChangesetEvent, int64, error) {
+	var events []*btypes.ChangesetEvent
+	for _, e := range s.events {
+		for _, id := range opts.ChangesetIDs {
+			if e.ChangesetID == id {
+				events = append(events, e)
+			}
+		}
+	}
+	return events, 0, nil
+}
+
+func (s *fakeChangesetStore) GetChangesetSpecByID(_ context.Context, id int64) (*btypes.ChangesetSpec, error) {
+	spec, ok := s.specs[id]
+	if !ok {
+		return nil, store.ErrNoResults
+	}
+	return spec, nil
+}
+
+const changesetSpecDiff = `diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1 @@
+-Hello
++Hello, world
+`
+
+func TestInferredOwnershipIndexer(t *testing.T) {
+	ctx := context.Background()
+
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	repoStore := database.NewMockRepoStore()
+	repoStore.ListMinimalReposFunc.SetDefaultReturn([]types.MinimalRepo{repo}, nil)
+
+	userStore := database.NewMockUserStore()
+	userStore.GetByVerifiedEmailFunc.SetDefaultHook(func(_ context.Context, email string) (*types.User, error) {
+		if email == "alice@example.com" {
+			return &types.User{ID: 1, Username: "alice"}, nil
+		}
+		return nil, &errcode.Mock{Message: "user not found", IsNotFound: true}
+	})
+
+	inferredOwnershipStore := edb.NewMockInferredOwnershipStore()
+
+	db := edb.NewMockEnterpriseDB()
+	db.ReposFunc.SetDefaultReturn(repoStore)
+	db.UsersFunc.SetDefaultReturn(userStore)
+	db.InferredOwnershipFunc.SetDefaultReturn(inferredOwnershipStore)
+
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.SearchFunc.SetDefaultHook(func(_ context.Context, req *protocol.SearchRequest, onMatches func([]protocol.CommitMatch)) (bool, error) {
+		assert.Equal(t, &protocol.CommitAfter{Time: windowStart}, req.Query)
+		assert.True(t, req.IncludeModifiedFiles)
+		onMatches([]protocol.CommitMatch{
+			{
+				Author:        protocol.Signature{Email: "Alice@example.com", Date: windowEnd},
+				ModifiedFiles: []string{"main.go", "README.md"},
+			},
+			{
+				Author:        protocol.Signature{Email: "bob@example.com", Date: windowEnd},
+				ModifiedFiles: []string{"main.go"},
+			},
+		})
+		return false, nil
+	})
+
+	changesetStore := &fakeChangesetStore{
+		changesets: btypes.Changesets{
+			{ID: 1, RepoID: 1, ExternalID: "1", ExternalUpdatedAt: windowEnd, CurrentSpecID: 1},
+			// Changesets that were not updated in the window are ignored.
+			{ID: 2, RepoID: 1, ExternalID: "2", ExternalUpdatedAt: windowStart.Add(-time.Hour), CurrentSpecID: 1},
+		},
+		events: []*btypes.ChangesetEvent{
+			{ChangesetID: 1, Kind: btypes.ChangesetEventKindGitHubReviewed, Metadata: &github.PullRequestReview{
+				Author:    github.Actor{Login: "carol"},
+				UpdatedAt: windowEnd,
+			}},
+			{ChangesetID: 2, Kind: btypes.ChangesetEventKindGitHubReviewed, Metadata: &github.PullRequestReview{
+				Author:    github.Actor{Login: "dave"},
+				UpdatedAt: windowEnd,
+			}},
+		},
+		specs: map[int64]*btypes.ChangesetSpec{
+			1: {ID: 1, Diff: []byte(changesetSpecDiff)},
+		},
+	}
+
+	i := &indexer{
+		logger:         logtest.Scoped(t),
+		db:             db,
+		gitserver:      gitserverClient,
+		changesetStore: changesetStore,
+		opts:           Options{Window: 90 * 24 * time.Hour, MaxCommits: 100, MaxOwnersPerFile: 5},
+		now:            func() time.Time { return windowEnd },
+	}
+	require.NoError(t, i.handle(ctx))
+
+	stored := make(map[owntypes.InferredOwnershipSignal][]ownerScore)
+	for _, call := range inferredOwnershipStore.ReplaceInferredOwnersFunc.History() {
+		assert.Equal(t, repo.ID, call.Arg1)
+		stored[call.Arg2] = ownerScores(call.Arg3)
+	}
+	assert.Equal(t, map[owntypes.InferredOwnershipSignal][]ownerScore{
+		owntypes.InferredOwnershipSignalRecentContributor: {
+			{Path: "README.md", Handle: "alice", Email: "alice@example.com", Score: 1},
+			{Path: "main.go", Email: "bob@example.com", Score: 0.5},
+			{Path: "main.go", Handle: "alice", Email: "alice@example.com", Score: 0.5},
+		},
+		owntypes.InferredOwnershipSignalRecentReviewer: {
+			{Path: "README.md", Handle: "carol", Score: 1},
+		},
+	}, stored)
+
+	t.Run("errors of a repository are returned", func(t *testing.T) {
+		gitserverClient.SearchFunc.PushReturn(false, errors.New("gitserver unavailable"))
+		require.ErrorContains(t, i.handle(ctx), "gitserver unavailable")
+	})
+}
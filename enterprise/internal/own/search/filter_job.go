@@ -9,6 +9,8 @@ import (
 	otlog "github.com/opentracing/opentracing-go/log"
 
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -110,6 +112,23 @@ func applyCodeOwnershipFiltering(
 ) ([]result.Match, error) {
 	var errs error
 
+	// Owners inferred from recent activity are fetched for all matched files
+	// of a repository at once.
+	pathsByRepo := make(map[api.RepoID][]string)
+	for _, m := range matches {
+		if mm, ok := m.(*result.FileMatch); ok {
+			pathsByRepo[mm.Repo.ID] = append(pathsByRepo[mm.Repo.ID], mm.File.Path)
+		}
+	}
+	inferredOwnersByRepo := make(map[api.RepoID]map[string][]*owntypes.InferredOwner, len(pathsByRepo))
+	for repoID, paths := range pathsByRepo {
+		inferredOwners, err := rules.GetInferredOwnersFromCacheOrFetch(ctx, repoID, paths)
+		if err != nil {
+			errs = errors.Append(errs, err)
+		}
+		inferredOwnersByRepo[repoID] = inferredOwners
+	}
+
 	filtered := matches[:0]
 
 matchesLoop:
@@ -129,7 +148,13 @@ matchesLoop:
 		var owners []*codeownerspb.Owner
 		// If match.
 		if rule != nil {
-			owners = rule.GetOwner()
+			// Copied so that appending inferred owners leaves the rule as is.
+			owners = append(owners, rule.GetOwner()...)
+		}
+		// Owners inferred from recent activity count like owners from
+		// CODEOWNERS files.
+		for _, inferredOwner := range inferredOwnersByRepo[mm.Repo.ID][mm.File.Path] {
+			owners = append(owners, inferredOwner.Owner())
 		}
 		for _, owner := range includeOwners {
 			if !containsOwner(owners, owner) {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -39,10 +40,11 @@ func TestFeatureFlaggedFileHasOwnerJob(t *testing.T) {
 
 func TestApplyCodeOwnershipFiltering(t *testing.T) {
 	type args struct {
-		includeOwners []string
-		excludeOwners []string
-		matches       []result.Match
-		repoContent   map[string]string
+		includeOwners  []string
+		excludeOwners  []string
+		matches        []result.Match
+		repoContent    map[string]string
+		inferredOwners []*owntypes.InferredOwner
 	}
 	tests := []struct {
 		name string
@@ -285,6 +287,97 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "selects results with inferred owners without a code owners file",
+			args: args{
+				includeOwners: []string{"@alice"},
+				excludeOwners: []string{},
+				matches: []result.Match{
+					&result.FileMatch{
+						File: result.File{
+							Path: "README.md",
+						},
+					},
+					&result.FileMatch{
+						File: result.File{
+							Path: "package.json",
+						},
+					},
+				},
+				inferredOwners: []*owntypes.InferredOwner{
+					{FilePath: "README.md", Signal: owntypes.InferredOwnershipSignalRecentReviewer, Handle: "alice", Score: 1},
+					{FilePath: "package.json", Signal: owntypes.InferredOwnershipSignalRecentContributor, Email: "bob@example.com", Score: 1},
+				},
+			},
+			want: autogold.Expect([]result.Match{
+				&result.FileMatch{
+					File: result.File{
+						Path: "README.md",
+					},
+				},
+			}),
+		},
+		{
+			name: "matches inferred owners alongside code owners",
+			args: args{
+				includeOwners: []string{"@test", "bob@example.com"},
+				excludeOwners: []string{},
+				matches: []result.Match{
+					&result.FileMatch{
+						File: result.File{
+							Path: "README.md",
+						},
+					},
+					&result.FileMatch{
+						File: result.File{
+							Path: "package.json",
+						},
+					},
+				},
+				repoContent: map[string]string{
+					"CODEOWNERS": "* @test\n",
+				},
+				inferredOwners: []*owntypes.InferredOwner{
+					{FilePath: "package.json", Signal: owntypes.InferredOwnershipSignalRecentContributor, Email: "bob@example.com", Score: 1},
+				},
+			},
+			want: autogold.Expect([]result.Match{
+				&result.FileMatch{
+					File: result.File{
+						Path: "package.json",
+					},
+				},
+			}),
+		},
+		{
+			name: "selects results without code owners or inferred owners",
+			args: args{
+				includeOwners: []string{},
+				excludeOwners: []string{""},
+				matches: []result.Match{
+					&result.FileMatch{
+						File: result.File{
+							Path: "README.md",
+						},
+					},
+					&result.FileMatch{
+						File: result.File{
+							Path: "package.json",
+						},
+					},
+				},
+				inferredOwners: []*owntypes.InferredOwner{
+					{FilePath: "README.md", Signal: owntypes.InferredOwnershipSignalRecentContributor, Email: "bob@example.com", Score: 1},
+				},
+			},
+			want: autogold.Expect([]result.Match{
+				&result.FileMatch{
+					File: result.File{
+						Path: "package.json",
+					},
+				},
+			}),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -301,8 +394,21 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 
 			codeownersStore := edb.NewMockCodeownersStore()
 			codeownersStore.GetCodeownersForRepoFunc.SetDefaultReturn(nil, nil)
+			inferredOwnershipStore := edb.NewMockInferredOwnershipStore()
+			inferredOwnershipStore.ListInferredOwnersFunc.SetDefaultHook(func(_ context.Context, opts edb.ListInferredOwnersOpts) ([]*owntypes.InferredOwner, error) {
+				var owners []*owntypes.InferredOwner
+				for _, owner := range tt.args.inferredOwners {
+					for _, path := range opts.Paths {
+						if owner.FilePath == path {
+							owners = append(owners, owner)
+						}
+					}
+				}
+				return owners, nil
+			})
 			db := edb.NewMockEnterpriseDB()
 			db.CodeownersFunc.SetDefaultReturn(codeownersStore)
+			db.InferredOwnershipFunc.SetDefaultReturn(inferredOwnershipStore)
 
 			rules := NewRulesCache(gitserverClient, db)
 
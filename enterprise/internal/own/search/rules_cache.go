@@ -7,6 +7,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -17,17 +18,24 @@ type RulesKey struct {
 	commitID api.CommitID
 }
 
+type inferredOwnersKey struct {
+	repoID api.RepoID
+	path   string
+}
+
 type RulesCache struct {
-	rules      map[RulesKey]*codeowners.Ruleset
-	ownService own.Service
+	rules          map[RulesKey]*codeowners.Ruleset
+	inferredOwners map[inferredOwnersKey][]*owntypes.InferredOwner
+	ownService     own.Service
 
 	mu sync.RWMutex
 }
 
 func NewRulesCache(gs gitserver.Client, db database.DB) RulesCache {
 	return RulesCache{
-		rules:      make(map[RulesKey]*codeowners.Ruleset),
-		ownService: own.NewService(gs, db),
+		rules:          make(map[RulesKey]*codeowners.Ruleset),
+		inferredOwners: make(map[inferredOwnersKey][]*owntypes.InferredOwner),
+		ownService:     own.NewService(gs, db),
 	}
 }
 
@@ -55,3 +63,35 @@ func (c *RulesCache) GetFromCacheOrFetch(ctx context.Context, repoName api.RepoN
 	}
 	return c.rules[key], nil
 }
+
+// GetInferredOwnersFromCacheOrFetch returns the inferred owners of the given files
+// of a repository, keyed by path. The owners of all files that are not cached yet
+// are fetched at once.
+func (c *RulesCache) GetInferredOwnersFromCacheOrFetch(ctx context.Context, repoID api.RepoID, paths []string) (map[string][]*owntypes.InferredOwner, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var missing []string
+	for _, path := range paths {
+		if _, ok := c.inferredOwners[inferredOwnersKey{repoID, path}]; !ok {
+			missing = append(missing, path)
+		}
+	}
+	if len(missing) > 0 {
+		fetched, err := c.ownService.InferredOwnersForPaths(ctx, repoID, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range missing {
+			// Files without inferred owners are cached as well, so that they
+			// are not fetched again.
+			c.inferredOwners[inferredOwnersKey{repoID, path}] = fetched[path]
+		}
+	}
+
+	owners := make(map[string][]*owntypes.InferredOwner, len(paths))
+	for _, path := range paths {
+		owners[path] = c.inferredOwners[inferredOwnersKey{repoID, path}]
+	}
+	return owners, nil
+}
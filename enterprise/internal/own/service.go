@@ -11,6 +11,7 @@ import (
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -21,7 +22,8 @@ import (
 )
 
 // Service gives access to code ownership data.
-// At this point only data from CODEOWNERS file is presented, if available.
+// Ownership is either declared in CODEOWNERS files, or inferred from recent activity
+// in repositories by a background job.
 type Service interface {
 	// RulesetForRepo returns a CODEOWNERS file ruleset from a given repository at given commit ID.
 	// If a CODEOWNERS file has been manually ingested for the repository, it will prioritise returning that file.
@@ -31,6 +33,11 @@ type Service interface {
 	// ResolveOwnersWithType takes a list of codeownerspb.Owner and attempts to retrieve more information about the
 	// owner from the users and teams databases.
 	ResolveOwnersWithType(context.Context, []*codeownerspb.Owner) ([]codeowners.ResolvedOwner, error)
+
+	// InferredOwnersForPaths returns the owners of the given files of a repository that were inferred from
+	// recent activity, keyed by file path. The owners of a file are ordered by signal and descending score.
+	// Files without inferred owners are omitted.
+	InferredOwnersForPaths(context.Context, api.RepoID, []string) (map[string][]*owntypes.InferredOwner, error)
 }
 
 var _ Service = &service{}
@@ -100,6 +107,24 @@ func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, rep
 	return nil, nil
 }
 
+func (s *service) InferredOwnersForPaths(ctx context.Context, repoID api.RepoID, paths []string) (map[string][]*owntypes.InferredOwner, error) {
+	if len(paths) == 0 {
+		return map[string][]*owntypes.InferredOwner{}, nil
+	}
+	owners, err := s.db.InferredOwnership().ListInferredOwners(ctx, edb.ListInferredOwnersOpts{
+		RepoID: repoID,
+		Paths:  paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ownersByPath := make(map[string][]*owntypes.InferredOwner)
+	for _, owner := range owners {
+		ownersByPath[owner.FilePath] = append(ownersByPath[owner.FilePath], owner)
+	}
+	return ownersByPath, nil
+}
+
 func (s *service) ResolveOwnersWithType(ctx context.Context, protoOwners []*codeownerspb.Owner) ([]codeowners.ResolvedOwner, error) {
 	resolved := make([]codeowners.ResolvedOwner, 0, len(protoOwners))
 
@@ -141,6 +141,37 @@ func TestOwnersServesIngestedFile(t *testing.T) {
 	})
 }
 
+func TestInferredOwnersForPaths(t *testing.T) {
+	t.Run("no paths does not query the database", func(t *testing.T) {
+		db := edb.NewMockEnterpriseDB()
+
+		got, err := NewService(gitserver.NewMockClient(), db).InferredOwnersForPaths(context.Background(), 1, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Empty(t, db.InferredOwnershipFunc.History())
+	})
+	t.Run("owners are grouped by path", func(t *testing.T) {
+		alice := &types.InferredOwner{RepoID: 1, FilePath: "main.go", Signal: types.InferredOwnershipSignalRecentContributor, Email: "alice@example.com", Score: 1}
+		bob := &types.InferredOwner{RepoID: 1, FilePath: "README.md", Signal: types.InferredOwnershipSignalRecentContributor, Handle: "bob", Score: 0.75}
+		carol := &types.InferredOwner{RepoID: 1, FilePath: "README.md", Signal: types.InferredOwnershipSignalRecentReviewer, Handle: "carol", Score: 1}
+
+		inferredOwnershipStore := edb.NewMockInferredOwnershipStore()
+		inferredOwnershipStore.ListInferredOwnersFunc.SetDefaultReturn([]*types.InferredOwner{bob, carol, alice}, nil)
+		db := edb.NewMockEnterpriseDB()
+		db.InferredOwnershipFunc.SetDefaultReturn(inferredOwnershipStore)
+
+		got, err := NewService(gitserver.NewMockClient(), db).InferredOwnersForPaths(context.Background(), 1, []string{"README.md", "main.go", "go.mod"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]*types.InferredOwner{
+			"README.md": {bob, carol},
+			"main.go":   {alice},
+		}, got)
+
+		opts := inferredOwnershipStore.ListInferredOwnersFunc.History()[0].Arg1
+		assert.Equal(t, edb.ListInferredOwnersOpts{RepoID: 1, Paths: []string{"README.md", "main.go", "go.mod"}}, opts)
+	})
+}
+
 func TestResolveOwnersWithType(t *testing.T) {
 	t.Run("no owners returns empty", func(t *testing.T) {
 		git := gitserver.NewMockClient()
@@ -15,3 +15,47 @@ type CodeownersFile struct {
 	Contents string
 	Proto    *codeownerspb.File
 }
+
+// InferredOwnershipSignal is the kind of activity ownership of a file was
+// inferred from.
+type InferredOwnershipSignal string
+
+const (
+	// InferredOwnershipSignalRecentContributor is inferred from the authors of
+	// recent commits changing the file.
+	InferredOwnershipSignalRecentContributor InferredOwnershipSignal = "recent-contributor"
+	// InferredOwnershipSignalRecentReviewer is inferred from the reviewers of
+	// recent changesets changing the file.
+	InferredOwnershipSignalRecentReviewer InferredOwnershipSignal = "recent-reviewer"
+)
+
+// InferredOwner is an owner of a file that was inferred from recent activity,
+// as opposed to being declared in a CODEOWNERS file.
+type InferredOwner struct {
+	RepoID   api.RepoID
+	FilePath string
+	Signal   InferredOwnershipSignal
+
+	// Handle and Email identify the owner like in a CODEOWNERS file. At least
+	// one of them is set.
+	Handle string
+	Email  string
+
+	// Score is the share of the recent activity on the file that is
+	// attributed to the owner for the signal, weighted by recency. The
+	// scores of the owners of a file for a signal sum up to 1.
+	Score float64
+
+	// WindowStart and WindowEnd are the bounds of the recency window in
+	// which the activity was considered.
+	WindowStart time.Time
+	WindowEnd   time.Time
+
+	UpdatedAt time.Time
+}
+
+// Owner returns the inferred owner in the representation used for owners
+// declared in CODEOWNERS files.
+func (o *InferredOwner) Owner() *codeownerspb.Owner {
+	return &codeownerspb.Owner{Handle: o.Handle, Email: o.Email}
+}
@@ -254,6 +254,14 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 		return nil, err
 	}
 
+	// The kind and container of symbols are not stored in rockskip_symbols,
+	// so the symbol filters are applied to the parsed symbols.
+	symbolFilter := args.SymbolFilter()
+	isFilterMatch, err := symbolFilter.Matcher()
+	if err != nil {
+		return nil, err
+	}
+
 	paths := goset.NewSet[string]()
 	for rows.Next() {
 		var path string
@@ -287,7 +295,7 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 		lines := strings.Split(string(contents), "\n")
 
 		for _, symbol := range allSymbols {
-			if isMatch(symbol.Name) {
+			if isMatch(symbol.Name) && isFilterMatch(&result.Symbol{Kind: symbol.Kind, Parent: symbol.Parent}) {
 				if symbol.Line < 1 || symbol.Line > len(lines) {
 					log15.Warn("ctags returned an invalid line number", "path", path, "line", symbol.Line, "len(lines)", len(lines), "symbol", symbol.Name)
 					continue
@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/txemail"
 	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -126,6 +127,8 @@ func (h *UserResourceHandler) Create(r *http.Request, attributes scim.ResourceAt
 		return scim.Resource{}, multiErr.Errors()[len(multiErr.Errors())-1]
 	}
 
+	outbound.EnqueueEvent(r.Context(), h.getLogger(), h.db, outbound.UserCreate, nil, outbound.NewUserPayload(user))
+
 	// If there were additional emails provided, now that the user has been created
 	// we can try to add and verify them each in a separate trx so that if it fails we can ignore
 	// the error because they are not required.
@@ -9,6 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -50,6 +51,8 @@ func (h *UserResourceHandler) Delete(r *http.Request, id string) error {
 		return errors.Wrap(err, "delete user")
 	}
 
+	outbound.EnqueueEvent(r.Context(), h.getLogger(), h.db, outbound.UserDelete, nil, outbound.NewUserPayload(&user.User))
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package audit
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sourcegraph/log"
@@ -16,6 +17,7 @@ import (
 // Log creates an INFO log statement that will be a part of the audit log.
 // The audit log records comply with the following design: an actor takes an action on an entity within a context.
 // Refer to Record struct to see details about individual components.
+// If a Store is registered with SetStore, the record is also persisted to it.
 func Log(ctx context.Context, logger log.Logger, record Record) {
 	act := actor.FromContext(ctx)
 
@@ -41,6 +43,16 @@ func Log(ctx context.Context, logger log.Logger, record Record) {
 	loggerFunc := getLoggerFuncWithSeverity(logger, siteConfig)
 	// message string looks like: #{record.Action} (sampling immunity token: #{auditId})
 	loggerFunc(fmt.Sprintf("%s (sampling immunity token: %s)", record.Action, auditId), fields...)
+
+	persist(logger, &Entry{
+		AuditID:      auditId,
+		Timestamp:    time.Now(),
+		Actor:        actorId(act),
+		IP:           ip(client),
+		ForwardedFor: forwardedFor(client),
+		Entity:       record.Entity,
+		Action:       record.Action,
+	}, record.Fields)
 }
 
 func actorId(act *actor.Actor) string {
@@ -101,6 +113,19 @@ func IsEnabled(cfg schema.SiteConfiguration, setting AuditLogSetting) bool {
 	return false
 }
 
+// defaultRetentionDays is the number of days persisted audit log entries are
+// kept if log.auditLog.retentionDays is not set.
+const defaultRetentionDays = 365
+
+// RetentionDays returns the number of days that persisted audit log entries
+// are kept before they are deleted.
+func RetentionDays(cfg schema.SiteConfiguration) int {
+	if auditCfg := getAuditCfg(cfg); auditCfg != nil && auditCfg.RetentionDays > 0 {
+		return auditCfg.RetentionDays
+	}
+	return defaultRetentionDays
+}
+
 // getLoggerFuncWithSeverity returns a specific logger function (logger.Info, logger.Warn, etc.), a the severity is configurable.
 func getLoggerFuncWithSeverity(logger log.Logger, cfg schema.SiteConfiguration) func(string, ...log.Field) {
 	if auditCfg := getAuditCfg(cfg); auditCfg != nil {
@@ -238,3 +238,9 @@ func auditLogMessage(t *testing.T) []logtest.CapturedLog {
 
 	return exportLogs()
 }
+
+func TestRetentionDays(t *testing.T) {
+	assert.Equal(t, 365, RetentionDays(schema.SiteConfiguration{}))
+	assert.Equal(t, 365, RetentionDays(schema.SiteConfiguration{Log: &schema.Log{AuditLog: &schema.AuditLog{}}}))
+	assert.Equal(t, 30, RetentionDays(schema.SiteConfiguration{Log: &schema.Log{AuditLog: &schema.AuditLog{RetentionDays: 30}}}))
+}
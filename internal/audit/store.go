@@ -0,0 +1,354 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Entry is an audit log record as persisted by a Store.
+//
+// Entries form an append-only hash chain: the Hash of an entry covers its
+// contents as well as the Hash of the entry appended before it (PrevHash), so
+// modifying or removing an entry invalidates the chain from that entry on.
+type Entry struct {
+	ID           int64
+	AuditID      string
+	Timestamp    time.Time
+	Actor        string
+	IP           string
+	ForwardedFor string
+	Entity       string
+	Action       string
+	// Fields is a JSON object holding the Fields of the Record.
+	Fields json.RawMessage
+
+	PrevHash string
+	Hash     string
+}
+
+// Store persists audit log entries.
+type Store interface {
+	// Append adds the entry to the end of the audit log. It is responsible for
+	// setting the ID of the entry and for sealing it with Seal, passing the
+	// Hash of the last entry in the audit log.
+	Append(ctx context.Context, entry *Entry) error
+}
+
+// storeBufferSize is the number of entries that can be waiting to be persisted
+// before new entries are dropped.
+const storeBufferSize = 10000
+
+// droppedEntries counts the audit log entries that were logged but could not be
+// persisted to the store.
+var droppedEntries = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "src_audit_log_entries_dropped_total",
+	Help: "Total number of audit log entries that could not be persisted to the database.",
+})
+
+var (
+	storeMu sync.RWMutex
+	writer  *storeWriter
+)
+
+// SetStore registers the store that Log persists audit log entries to, in
+// addition to logging them. Passing nil stops persisting entries.
+//
+// Entries are appended to the store by a single background goroutine, so that
+// Log never waits for the store. Replacing the store waits for the entries
+// queued for the previous store to be persisted, so services pass nil on
+// shutdown to not lose queued entries.
+func SetStore(s Store) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+
+	if writer != nil {
+		writer.stop()
+		writer = nil
+	}
+	if s != nil {
+		writer = newStoreWriter(s)
+	}
+}
+
+// persist queues the record to be written to the registered store, if any.
+// Errors are logged rather than returned, the same as the audit log statement
+// itself.
+func persist(logger log.Logger, entry *Entry, fields []log.Field) {
+	storeMu.RLock()
+	defer storeMu.RUnlock()
+	if writer == nil {
+		return
+	}
+
+	encoded, err := encodeFields(fields)
+	if err != nil {
+		logger.Error("failed to encode audit log fields", log.String("auditId", entry.AuditID), log.Error(err))
+		encoded = json.RawMessage("{}")
+	}
+	entry.Fields = encoded
+
+	if !writer.enqueue(entry) {
+		droppedEntries.Inc()
+		logger.Error("failed to persist audit log entry: too many entries are waiting to be persisted", log.String("auditId", entry.AuditID))
+	}
+}
+
+// storeWriter appends audit log entries to a Store in the background.
+type storeWriter struct {
+	store   Store
+	logger  log.Logger
+	entries chan *Entry
+	done    chan struct{}
+}
+
+func newStoreWriter(s Store) *storeWriter {
+	w := &storeWriter{
+		store:   s,
+		logger:  log.Scoped("auditStoreWriter", "persists audit log entries"),
+		entries: make(chan *Entry, storeBufferSize),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *storeWriter) run() {
+	defer close(w.done)
+
+	for entry := range w.entries {
+		// The entry outlives the request that created it, so we don't use the
+		// context of the request.
+		if err := w.store.Append(context.Background(), entry); err != nil {
+			droppedEntries.Inc()
+			w.logger.Error("failed to persist audit log entry", log.String("auditId", entry.AuditID), log.Error(err))
+			continue
+		}
+		if err := recordChainHead(entry); err != nil {
+			w.logger.Error("failed to record audit log chain head", log.String("auditId", entry.AuditID), log.Error(err))
+		}
+	}
+}
+
+// enqueue queues the entry to be appended to the store without blocking. It
+// returns false if the queue is full.
+func (w *storeWriter) enqueue(entry *Entry) bool {
+	select {
+	case w.entries <- entry:
+		return true
+	default:
+		return false
+	}
+}
+
+// stop waits for the queued entries to be appended to the store and stops the
+// writer. enqueue must not be called afterwards.
+func (w *storeWriter) stop() {
+	close(w.entries)
+	<-w.done
+}
+
+// encodeFields encodes log fields into a JSON object the same way they appear
+// in structured log output.
+func encodeFields(fields []log.Field) (json.RawMessage, error) {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	return json.Marshal(enc.Fields)
+}
+
+// Seal sets the PrevHash of the entry to prevHash, the Hash of the entry
+// appended before it, and computes the Hash of the entry. prevHash is empty for
+// the first entry of the audit log.
+func (e *Entry) Seal(prevHash string) error {
+	// The database stores timestamps with microsecond precision.
+	e.Timestamp = e.Timestamp.UTC().Truncate(time.Microsecond)
+	e.PrevHash = prevHash
+
+	hash, err := e.computeHash()
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+	return nil
+}
+
+func (e *Entry) computeHash() (string, error) {
+	fields, err := canonicalJSON(e.Fields)
+	if err != nil {
+		return "", errors.Wrap(err, "canonicalizing fields")
+	}
+
+	// The hash covers a JSON document with a fixed field order, so that it does
+	// not depend on how the entry was stored.
+	b, err := json.Marshal(struct {
+		PrevHash     string          `json:"prevHash"`
+		AuditID      string          `json:"auditId"`
+		Timestamp    string          `json:"timestamp"`
+		Actor        string          `json:"actor"`
+		IP           string          `json:"ip"`
+		ForwardedFor string          `json:"forwardedFor"`
+		Entity       string          `json:"entity"`
+		Action       string          `json:"action"`
+		Fields       json.RawMessage `json:"fields"`
+	}{
+		PrevHash:     e.PrevHash,
+		AuditID:      e.AuditID,
+		Timestamp:    e.Timestamp.UTC().Format(time.RFC3339Nano),
+		Actor:        e.Actor,
+		IP:           e.IP,
+		ForwardedFor: e.ForwardedFor,
+		Entity:       e.Entity,
+		Action:       e.Action,
+		Fields:       fields,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalJSON re-encodes a JSON document with sorted object keys and without
+// insignificant whitespace, which is how the document reads after a round trip
+// through a jsonb column too.
+func canonicalJSON(raw json.RawMessage) (json.RawMessage, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// ChainHead identifies the newest entry appended to the audit log. It is
+// recorded outside of the Store, so that removing the newest entries or
+// rewriting the audit log in the Store can be detected.
+type ChainHead struct {
+	ID   int64  `json:"id"`
+	Hash string `json:"hash"`
+}
+
+// chainHeadKey is the key that the ChainHead is recorded at in chainHeads.
+const chainHeadKey = "audit_log:chain_head"
+
+// chainHeads is where the ChainHead is recorded. It is a variable so that tests
+// can replace it.
+var chainHeads = redispool.Store
+
+// LoadChainHead returns the recorded ChainHead, or nil if none was recorded.
+func LoadChainHead() (*ChainHead, error) {
+	raw, err := chainHeads.Get(chainHeadKey).Bytes()
+	if err == redis.ErrNil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var head ChainHead
+	if err := json.Unmarshal(raw, &head); err != nil {
+		return nil, errors.Wrap(err, "decoding audit log chain head")
+	}
+	return &head, nil
+}
+
+// recordChainHead records the appended entry as the ChainHead, unless a newer
+// entry was recorded already, e.g. by another service.
+func recordChainHead(entry *Entry) error {
+	head, err := LoadChainHead()
+	if err != nil {
+		return err
+	}
+	if head != nil && head.ID >= entry.ID {
+		return nil
+	}
+
+	raw, err := json.Marshal(ChainHead{ID: entry.ID, Hash: entry.Hash})
+	if err != nil {
+		return err
+	}
+	return chainHeads.Set(chainHeadKey, raw)
+}
+
+// ChainVerifier checks that audit log entries form an unbroken hash chain.
+// Entries must be passed to Verify in the order they were appended, followed
+// by a call to Done.
+//
+// The PrevHash of the first entry is trusted, as older entries may have been
+// removed by the retention policy.
+type ChainVerifier struct {
+	// Head, if set, is the recorded ChainHead. The entries are checked to
+	// still contain it, so that removing the newest entries or rewriting the
+	// audit log is detected.
+	Head *ChainHead
+
+	prevHash string
+	lastID   int64
+	started  bool
+	headSeen bool
+}
+
+// Verify returns an error if the entry was modified, or if it does not follow
+// the entry previously passed to Verify.
+func (v *ChainVerifier) Verify(e *Entry) error {
+	if v.started && e.PrevHash != v.prevHash {
+		return errors.Newf("audit log entry %d does not follow the previous entry: an entry was removed or modified", e.ID)
+	}
+
+	hash, err := e.computeHash()
+	if err != nil {
+		return errors.Wrapf(err, "audit log entry %d", e.ID)
+	}
+	if hash != e.Hash {
+		return errors.Newf("audit log entry %d does not match its hash: the entry was modified", e.ID)
+	}
+
+	if v.Head != nil && !v.headSeen {
+		switch {
+		case e.ID == v.Head.ID && e.Hash != v.Head.Hash:
+			return errors.Newf("audit log entry %d does not match the recorded chain head: the audit log was rewritten", e.ID)
+		case e.ID == v.Head.ID:
+			v.headSeen = true
+		case e.ID > v.Head.ID:
+			return errors.Newf("audit log entry %d, the recorded chain head, is missing: the audit log was rewritten", v.Head.ID)
+		}
+	}
+
+	v.prevHash = e.Hash
+	v.lastID = e.ID
+	v.started = true
+	return nil
+}
+
+// Done returns an error if the entries passed to Verify end before the
+// recorded ChainHead.
+func (v *ChainVerifier) Done() error {
+	if v.Head == nil || v.headSeen {
+		return nil
+	}
+	if !v.started {
+		return errors.Newf("the audit log is empty, but entry %d was appended: the entries were removed", v.Head.ID)
+	}
+	return errors.Newf("the audit log ends at entry %d, but entry %d was appended: the newest entries were removed", v.lastID, v.Head.ID)
+}
@@ -0,0 +1,297 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeStore struct {
+	entries []*Entry
+}
+
+func (s *fakeStore) Append(_ context.Context, entry *Entry) error {
+	prevHash := ""
+	if len(s.entries) > 0 {
+		prevHash = s.entries[len(s.entries)-1].Hash
+	}
+	if err := entry.Seal(prevHash); err != nil {
+		return err
+	}
+	entry.ID = int64(len(s.entries) + 1)
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+// mockChainHeads records the chain head in memory for the duration of the test.
+func mockChainHeads(t *testing.T) {
+	chainHeads = redispool.MemoryKeyValue()
+	t.Cleanup(func() { chainHeads = redispool.Store })
+}
+
+func TestLogPersistsEntries(t *testing.T) {
+	mockChainHeads(t)
+	store := &fakeStore{}
+	SetStore(store)
+	t.Cleanup(func() { SetStore(nil) })
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	ctx = requestclient.WithClient(ctx, &requestclient.Client{IP: "192.168.0.1", ForwardedFor: "10.0.0.1"})
+
+	logger, exportLogs := logtest.Captured(t)
+	Log(ctx, logger, Record{
+		Entity: "test entity",
+		Action: "test audit action",
+		Fields: []log.Field{
+			log.String("additional", "stuff"),
+			log.Object("nested", log.Int("count", 2)),
+		},
+	})
+	Log(ctx, logger, Record{Entity: "test entity", Action: "another action"})
+
+	// Wait for the entries to be persisted.
+	SetStore(nil)
+
+	logs := exportLogs()
+	require.Len(t, logs, 2)
+	require.Len(t, store.entries, 2)
+
+	entry := store.entries[0]
+	assert.Equal(t, logs[0].Fields["audit"].(map[string]any)["auditId"], entry.AuditID)
+	assert.Equal(t, "1", entry.Actor)
+	assert.Equal(t, "192.168.0.1", entry.IP)
+	assert.Equal(t, "10.0.0.1", entry.ForwardedFor)
+	assert.Equal(t, "test entity", entry.Entity)
+	assert.Equal(t, "test audit action", entry.Action)
+	assert.JSONEq(t, `{"additional": "stuff", "nested": {"count": 2}}`, string(entry.Fields))
+	assert.Empty(t, entry.PrevHash)
+	assert.NotEmpty(t, entry.Hash)
+
+	assert.JSONEq(t, `{}`, string(store.entries[1].Fields))
+	assert.Equal(t, entry.Hash, store.entries[1].PrevHash)
+
+	head, err := LoadChainHead()
+	require.NoError(t, err)
+	assert.Equal(t, &ChainHead{ID: 2, Hash: store.entries[1].Hash}, head)
+}
+
+// blockingStore is a Store whose Append blocks until unblock is closed.
+type blockingStore struct {
+	fakeStore
+	unblock chan struct{}
+}
+
+func (s *blockingStore) Append(ctx context.Context, entry *Entry) error {
+	<-s.unblock
+	return s.fakeStore.Append(ctx, entry)
+}
+
+func TestLogDoesNotWaitForStore(t *testing.T) {
+	mockChainHeads(t)
+	store := &blockingStore{unblock: make(chan struct{})}
+	SetStore(store)
+	t.Cleanup(func() { SetStore(nil) })
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	logger, _ := logtest.Captured(t)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Log(ctx, logger, Record{Entity: "test entity", Action: "first action"})
+		Log(ctx, logger, Record{Entity: "test entity", Action: "second action"})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Log waited for the store")
+	}
+
+	close(store.unblock)
+	SetStore(nil)
+
+	require.Len(t, store.entries, 2)
+	assert.Equal(t, "first action", store.entries[0].Action)
+	assert.Equal(t, "second action", store.entries[1].Action)
+}
+
+// failingStore is a Store whose Append always fails.
+type failingStore struct{}
+
+func (failingStore) Append(context.Context, *Entry) error {
+	return errors.New("database is unavailable")
+}
+
+func TestDroppedEntries(t *testing.T) {
+	mockChainHeads(t)
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	t.Run("queue full", func(t *testing.T) {
+		store := &blockingStore{unblock: make(chan struct{})}
+		SetStore(store)
+		t.Cleanup(func() { SetStore(nil) })
+
+		before := testutil.ToFloat64(droppedEntries)
+		logger, exportLogs := logtest.Captured(t)
+		for i := 0; i < storeBufferSize+2; i++ {
+			Log(ctx, logger, Record{Entity: "test entity", Action: "test action"})
+		}
+		close(store.unblock)
+		SetStore(nil)
+
+		dropped := 0
+		for _, l := range exportLogs() {
+			if strings.HasPrefix(l.Message, "failed to persist audit log entry") {
+				dropped++
+			}
+		}
+		require.NotZero(t, dropped)
+		assert.Equal(t, float64(dropped), testutil.ToFloat64(droppedEntries)-before)
+		assert.Len(t, store.entries, storeBufferSize+2-dropped)
+	})
+
+	t.Run("append fails", func(t *testing.T) {
+		SetStore(failingStore{})
+		t.Cleanup(func() { SetStore(nil) })
+
+		before := testutil.ToFloat64(droppedEntries)
+		logger, _ := logtest.Captured(t)
+		Log(ctx, logger, Record{Entity: "test entity", Action: "test action"})
+		SetStore(nil)
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(droppedEntries)-before)
+	})
+}
+
+func TestChainVerifier(t *testing.T) {
+	newChain := func(t *testing.T) []*Entry {
+		store := &fakeStore{}
+		for i, action := range []string{"create", "update", "delete"} {
+			require.NoError(t, store.Append(context.Background(), &Entry{
+				AuditID:   action,
+				Timestamp: time.Date(2023, 3, 1, 12, i, 0, 123456789, time.UTC),
+				Actor:     "1",
+				Entity:    "repo",
+				Action:    action,
+				Fields:    json.RawMessage(`{"name": "github.com/foo/bar", "id": 1}`),
+			}))
+		}
+		return store.entries
+	}
+
+	verifyWithHead := func(entries []*Entry, head *ChainHead) error {
+		v := ChainVerifier{Head: head}
+		for _, e := range entries {
+			if err := v.Verify(e); err != nil {
+				return err
+			}
+		}
+		return v.Done()
+	}
+	verify := func(entries []*Entry) error {
+		return verifyWithHead(entries, nil)
+	}
+	headOf := func(entries []*Entry) *ChainHead {
+		last := entries[len(entries)-1]
+		return &ChainHead{ID: last.ID, Hash: last.Hash}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, verify(newChain(t)))
+	})
+
+	t.Run("fields re-encoded by the database", func(t *testing.T) {
+		entries := newChain(t)
+		entries[1].Fields = json.RawMessage(`{"id":1,"name":"github.com/foo/bar"}`)
+		require.NoError(t, verify(entries))
+	})
+
+	t.Run("oldest entries removed", func(t *testing.T) {
+		require.NoError(t, verify(newChain(t)[1:]))
+	})
+
+	t.Run("modified entry", func(t *testing.T) {
+		entries := newChain(t)
+		entries[1].Actor = "2"
+		require.ErrorContains(t, verify(entries), "entry 2 does not match its hash")
+	})
+
+	t.Run("modified entry with recomputed hash", func(t *testing.T) {
+		entries := newChain(t)
+		entries[1].Fields = json.RawMessage(`{"name": "github.com/foo/baz", "id": 1}`)
+		require.NoError(t, entries[1].Seal(entries[1].PrevHash))
+		require.ErrorContains(t, verify(entries), "entry 3 does not follow the previous entry")
+	})
+
+	t.Run("removed entry", func(t *testing.T) {
+		entries := newChain(t)
+		require.ErrorContains(t, verify([]*Entry{entries[0], entries[2]}), "entry 3 does not follow the previous entry")
+	})
+
+	t.Run("valid with chain head", func(t *testing.T) {
+		entries := newChain(t)
+		require.NoError(t, verifyWithHead(entries, headOf(entries)))
+		require.NoError(t, verifyWithHead(entries[1:], headOf(entries)))
+	})
+
+	t.Run("newest entries removed", func(t *testing.T) {
+		entries := newChain(t)
+		require.NoError(t, verify(entries[:2]))
+		require.ErrorContains(t, verifyWithHead(entries[:2], headOf(entries)), "ends at entry 2, but entry 3 was appended")
+		require.ErrorContains(t, verifyWithHead(nil, headOf(entries)), "empty, but entry 3 was appended")
+	})
+
+	t.Run("rewritten chain", func(t *testing.T) {
+		entries := newChain(t)
+		head := headOf(entries)
+
+		// Resealing every entry keeps the chain itself intact.
+		entries[0].Actor = "2"
+		prevHash := ""
+		for _, e := range entries {
+			require.NoError(t, e.Seal(prevHash))
+			prevHash = e.Hash
+		}
+		require.NoError(t, verify(entries))
+		require.ErrorContains(t, verifyWithHead(entries, head), "entry 3 does not match the recorded chain head")
+	})
+
+	t.Run("rewritten chain with new IDs", func(t *testing.T) {
+		entries := newChain(t)
+		head := headOf(entries)
+
+		entries = append(entries, &Entry{ID: 4, Timestamp: time.Now(), Actor: "1", Entity: "repo", Action: "create"})
+		require.NoError(t, entries[3].Seal(entries[2].Hash))
+		require.ErrorContains(t, verifyWithHead([]*Entry{entries[3]}, head), "entry 3, the recorded chain head, is missing")
+	})
+}
+
+func TestRecordChainHead(t *testing.T) {
+	mockChainHeads(t)
+
+	head, err := LoadChainHead()
+	require.NoError(t, err)
+	assert.Nil(t, head)
+
+	require.NoError(t, recordChainHead(&Entry{ID: 2, Hash: "b"}))
+	// An older entry appended by another service doesn't replace the head.
+	require.NoError(t, recordChainHead(&Entry{ID: 1, Hash: "a"}))
+
+	head, err = LoadChainHead()
+	require.NoError(t, err)
+	assert.Equal(t, &ChainHead{ID: 2, Hash: "b"}, head)
+}
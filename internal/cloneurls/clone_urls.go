@@ -143,6 +143,9 @@ func getRepoNameFromService(ctx context.Context, cloneURL string, svc *types.Ext
 	case *schema.OtherExternalServiceConnection:
 		rs = reposource.Other{OtherExternalServiceConnection: c}
 		host = c.Url
+	case *schema.MercurialConnection:
+		rs = reposource.Mercurial{MercurialConnection: c}
+		host = c.Url
 	default:
 		return "", errors.Errorf("unexpected connection type: %T", cfg)
 	}
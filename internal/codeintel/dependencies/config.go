@@ -0,0 +1,23 @@
+package dependencies
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+type config struct {
+	env.BaseConfig
+
+	RepoDependenciesInventoryInterval  time.Duration
+	RepoDependenciesInventoryBatchSize int
+	RepoDependenciesInventoryMaxAge    time.Duration
+}
+
+var ConfigInst = &config{}
+
+func (c *config) Load() {
+	c.RepoDependenciesInventoryInterval = c.GetInterval("CODEINTEL_REPO_DEPENDENCIES_INVENTORY_INTERVAL", "1m", "How frequently to update the dependency inventory of repositories.")
+	c.RepoDependenciesInventoryBatchSize = c.GetInt("CODEINTEL_REPO_DEPENDENCIES_INVENTORY_BATCH_SIZE", "100", "How many repositories to update the dependency inventory of at once.")
+	c.RepoDependenciesInventoryMaxAge = c.GetInterval("CODEINTEL_REPO_DEPENDENCIES_INVENTORY_MAX_AGE", "24h", "How long the dependency inventory of an unchanged repository is kept before it is recomputed.")
+}
@@ -43,3 +43,20 @@ func PackageFiltersJob(
 		background.NewPackagesFilterApplicator(obsctx, db),
 	}
 }
+
+func RepoDependenciesInventoryJob(
+	observationCtx *observation.Context,
+	db database.DB,
+	gitserverClient background.GitserverClient,
+) goroutine.CombinedRoutine {
+	return []goroutine.BackgroundRoutine{
+		background.NewRepoDependenciesInventory(
+			observationCtx,
+			dependenciesstore.New(scopedContext("store", observationCtx), db),
+			gitserverClient,
+			ConfigInst.RepoDependenciesInventoryInterval,
+			ConfigInst.RepoDependenciesInventoryBatchSize,
+			ConfigInst.RepoDependenciesInventoryMaxAge,
+		),
+	}
+}
@@ -19,6 +19,8 @@ type GitserverClient interface {
 	LsFiles(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, pathspecs ...gitdomain.Pathspec) ([]string, error)
 	ArchiveReader(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, options gitserver.ArchiveOptions) (io.ReadCloser, error)
 	RequestRepoUpdate(context.Context, api.RepoName, time.Duration) (*protocol.RepoUpdateResponse, error)
+	ResolveRevision(ctx context.Context, repo api.RepoName, spec string, opt gitserver.ResolveRevisionOptions) (api.CommitID, error)
+	ReadFile(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string) ([]byte, error)
 }
 
 type ExternalServiceStore interface {
@@ -27,6 +29,12 @@ type ExternalServiceStore interface {
 	GetByID(ctx context.Context, id int64) (*types.ExternalService, error)
 }
 
+type RepoDependenciesStore interface {
+	ListReposWithStaleDependencies(ctx context.Context, limit int, maxAge time.Duration) ([]types.MinimalRepo, error)
+	ListUploadPackageReferences(ctx context.Context, repoID api.RepoID) ([]shared.RepoDependency, error)
+	UpdateRepoDependencies(ctx context.Context, repoID api.RepoID, deps []shared.RepoDependency) error
+}
+
 type AutoIndexingService interface {
 	QueueIndexesForPackage(ctx context.Context, pkg shared.MinimialVersionedPackageRepo, assumeSynced bool) (err error)
 }
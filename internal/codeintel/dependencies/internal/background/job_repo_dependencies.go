@@ -0,0 +1,181 @@
+package background
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/internal/manifests"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type repoDependenciesInventoryJob struct {
+	store      RepoDependenciesStore
+	gitClient  GitserverClient
+	batchSize  int
+	maxAge     time.Duration
+	logger     log.Logger
+	operations *operations
+}
+
+func NewRepoDependenciesInventory(
+	observationCtx *observation.Context,
+	store RepoDependenciesStore,
+	gitClient GitserverClient,
+	interval time.Duration,
+	batchSize int,
+	maxAge time.Duration,
+) goroutine.BackgroundRoutine {
+	job := repoDependenciesInventoryJob{
+		store:      store,
+		gitClient:  gitClient,
+		batchSize:  batchSize,
+		maxAge:     maxAge,
+		logger:     observationCtx.Logger.Scoped("repoDependenciesInventory", "builds the per-repository dependency inventory"),
+		operations: newOperations(observationCtx),
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		context.Background(),
+		"codeintel.repo-dependencies-inventory", "indexes the dependencies declared by repositories from their manifests, lockfiles, and uploads",
+		interval,
+		goroutine.HandlerFunc(job.handle),
+	)
+}
+
+func (j *repoDependenciesInventoryJob) handle(ctx context.Context) (err error) {
+	ctx, _, endObservation := j.operations.repoDependenciesInventory.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	repos, err := j.store.ListReposWithStaleDependencies(ctx, j.batchSize, j.maxAge)
+	if err != nil {
+		return errors.Wrap(err, "failed to list repositories with stale dependencies")
+	}
+
+	var errs error
+	for _, repo := range repos {
+		deps, err := j.inventory(ctx, repo)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "failed to inventory dependencies of %s", repo.Name))
+			continue
+		}
+
+		if err := j.store.UpdateRepoDependencies(ctx, repo.ID, deps); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "failed to update dependencies of %s", repo.Name))
+			continue
+		}
+		j.operations.reposInventoried.Inc()
+	}
+
+	return errs
+}
+
+// inventory returns the dependencies declared by the manifests and lockfiles
+// at the tip of the default branch of the given repository, along with the
+// packages referenced by the uploads visible there.
+func (j *repoDependenciesInventoryJob) inventory(ctx context.Context, repo types.MinimalRepo) ([]shared.RepoDependency, error) {
+	deps, err := j.manifestDependencies(ctx, repo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := j.store.ListUploadPackageReferences(ctx, repo.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if dep, ok := normalizeUploadReference(ref); ok {
+			deps = append(deps, dep)
+		}
+	}
+
+	return deps, nil
+}
+
+func (j *repoDependenciesInventoryJob) manifestDependencies(ctx context.Context, repoName api.RepoName) ([]shared.RepoDependency, error) {
+	// We should use an internal actor when doing cross service calls.
+	ctx = actor.WithInternalActor(ctx)
+
+	commit, err := j.gitClient.ResolveRevision(ctx, repoName, "HEAD", gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+	if err != nil {
+		if errcode.IsNotFound(err) || errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			// Empty repositories have no manifests.
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The suffix pathspecs also match e.g. dev-requirements.txt, which are
+	// filtered out below.
+	basenames := manifests.Basenames()
+	pathspecs := make([]gitdomain.Pathspec, 0, len(basenames))
+	for _, basename := range basenames {
+		pathspecs = append(pathspecs, gitdomain.PathspecSuffix(basename))
+	}
+
+	paths, err := j.gitClient.LsFiles(ctx, nil, repoName, commit, pathspecs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []shared.RepoDependency
+	for _, path := range paths {
+		if !manifests.IsManifest(path) {
+			continue
+		}
+
+		content, err := j.gitClient.ReadFile(ctx, nil, repoName, commit, path)
+		if err != nil {
+			return nil, err
+		}
+
+		fileDeps, err := manifests.Parse(path, content)
+		if err != nil {
+			// A malformed manifest should not prevent us from indexing the
+			// rest of the repository.
+			j.logger.Warn("failed to parse manifest", log.String("repo", string(repoName)), log.String("path", path), log.Error(err))
+			continue
+		}
+		deps = append(deps, fileDeps...)
+	}
+
+	return manifests.PreferLockfiles(deps), nil
+}
+
+// normalizeUploadReference converts a package referenced by an upload into the
+// scheme and name used for the same package by the manifest parsers, so that
+// both can be matched by the same repo:depends.on() predicate.
+func normalizeUploadReference(ref shared.RepoDependency) (shared.RepoDependency, bool) {
+	switch ref.Scheme {
+	case "gomod":
+		ref.Scheme = shared.GoPackagesScheme
+		ref.Name = strings.TrimPrefix(ref.Name, "https://")
+	case shared.JVMPackagesScheme:
+		ref.Name = strings.ReplaceAll(strings.TrimPrefix(ref.Name, "maven/"), "/", ":")
+	case shared.NpmPackagesScheme, "scip-typescript":
+		ref.Scheme = shared.NpmPackagesScheme
+	case shared.PythonPackagesScheme, "scip-python":
+		ref.Scheme = shared.PythonPackagesScheme
+		ref.Name = manifests.NormalizePythonName(ref.Name)
+	case shared.RustPackagesScheme, shared.RubyPackagesScheme:
+	default:
+		return shared.RepoDependency{}, false
+	}
+	if ref.Name == "" {
+		return shared.RepoDependency{}, false
+	}
+
+	ref.Semver = manifests.Coerce(ref.Version)
+	return ref, true
+}
@@ -0,0 +1,117 @@
+package background
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestRepoDependenciesInventory(t *testing.T) {
+	files := map[string]string{
+		"web/package.json":                            `{"dependencies": {"react": "^18.0.0"}}`,
+		"web/package-lock.json":                       `{"lockfileVersion": 3, "packages": {"node_modules/react": {"version": "18.2.0"}}}`,
+		"web/node_modules/react/package.json":         `{"dependencies": {"loose-envify": "^1.1.0"}}`,
+		"requirements.txt":                            "Django==4.1.7\n",
+		"dev-requirements.txt":                        "pytest==7.2.2\n",
+		"broken/package.json":                         `{"dependencies": [}`,
+		"services/api/go.mod":                         "module example.com/api\n\nrequire golang.org/x/mod v0.8.0\n",
+		"services/api/vendor/golang.org/x/mod/go.mod": "module golang.org/x/mod\n",
+	}
+
+	gitserverClient := NewMockGitserverClient()
+	gitserverClient.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, _ string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if repo == "github.com/test/empty" {
+			return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: "HEAD"}
+		}
+		return "deadbeef", nil
+	})
+	gitserverClient.LsFilesFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, _ api.CommitID, _ ...gitdomain.Pathspec) ([]string, error) {
+		paths := make([]string, 0, len(files))
+		for path := range files {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		return paths, nil
+	})
+	gitserverClient.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, _ api.CommitID, name string) ([]byte, error) {
+		content, ok := files[name]
+		if !ok {
+			return nil, errors.Newf("unexpected read of %s", name)
+		}
+		return []byte(content), nil
+	})
+
+	store := NewMockRepoDependenciesStore()
+	store.ListReposWithStaleDependenciesFunc.SetDefaultReturn([]types.MinimalRepo{
+		{ID: 1, Name: "github.com/test/repo"},
+		{ID: 2, Name: "github.com/test/empty"},
+	}, nil)
+	store.ListUploadPackageReferencesFunc.SetDefaultHook(func(_ context.Context, repoID api.RepoID) ([]shared.RepoDependency, error) {
+		if repoID != 1 {
+			return nil, nil
+		}
+		return []shared.RepoDependency{
+			{Source: shared.RepoDependencySourceUpload, Path: "services/api/", Scheme: "gomod", Name: "https://golang.org/x/mod", Version: "v0.8.0"},
+			{Source: shared.RepoDependencySourceUpload, Path: "", Scheme: "scip-python", Name: "Zope.Interface", Version: "5.5.2"},
+			{Source: shared.RepoDependencySourceUpload, Path: "", Scheme: "unknown", Name: "unknown", Version: "1.0.0"},
+		}, nil
+	})
+
+	job := repoDependenciesInventoryJob{
+		store:      store,
+		gitClient:  gitserverClient,
+		batchSize:  10,
+		logger:     logtest.Scoped(t),
+		operations: newOperations(&observation.TestContext),
+	}
+	if err := job.handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	history := store.UpdateRepoDependenciesFunc.History()
+	if len(history) != 2 {
+		t.Fatalf("unexpected number of calls to UpdateRepoDependencies (want=%d, got=%d)", 2, len(history))
+	}
+
+	type entry struct {
+		Source, Path, Scheme, Name, Version, Semver string
+	}
+	var got []entry
+	for _, d := range history[0].Arg2 {
+		e := entry{d.Source, d.Path, d.Scheme, d.Name, d.Version, ""}
+		if d.Semver != nil {
+			e.Semver = d.Semver.String()
+		}
+		got = append(got, e)
+	}
+	want := []entry{
+		{"manifest", "requirements.txt", "python", "django", "4.1.7", "4.1.7"},
+		{"manifest", "services/api/go.mod", "go", "golang.org/x/mod", "v0.8.0", "0.8.0"},
+		{"manifest", "web/package-lock.json", "npm", "react", "18.2.0", "18.2.0"},
+		{"upload", "services/api/", "go", "golang.org/x/mod", "v0.8.0", "0.8.0"},
+		{"upload", "", "python", "zope-interface", "5.5.2", "5.5.2"},
+	}
+	if history[0].Arg1 != 1 {
+		t.Errorf("unexpected repository (want=%d, got=%d)", 1, history[0].Arg1)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+
+	// Empty repositories are marked as synced without any dependencies.
+	if history[1].Arg1 != 2 || len(history[1].Arg2) != 0 {
+		t.Errorf("unexpected update for empty repository: %v %v", history[1].Arg1, history[1].Arg2)
+	}
+}
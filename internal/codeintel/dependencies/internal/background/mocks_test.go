@@ -761,9 +761,15 @@ type MockGitserverClient struct {
 	// LsFilesFunc is an instance of a mock function object controlling the
 	// behavior of the method LsFiles.
 	LsFilesFunc *GitserverClientLsFilesFunc
+	// ReadFileFunc is an instance of a mock function object controlling the
+	// behavior of the method ReadFile.
+	ReadFileFunc *GitserverClientReadFileFunc
 	// RequestRepoUpdateFunc is an instance of a mock function object
 	// controlling the behavior of the method RequestRepoUpdate.
 	RequestRepoUpdateFunc *GitserverClientRequestRepoUpdateFunc
+	// ResolveRevisionFunc is an instance of a mock function object
+	// controlling the behavior of the method ResolveRevision.
+	ResolveRevisionFunc *GitserverClientResolveRevisionFunc
 }
 
 // NewMockGitserverClient creates a new mock of the GitserverClient
@@ -781,11 +787,21 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		ReadFileFunc: &GitserverClientReadFileFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) (r0 []byte, r1 error) {
+				return
+			},
+		},
 		RequestRepoUpdateFunc: &GitserverClientRequestRepoUpdateFunc{
 			defaultHook: func(context.Context, api.RepoName, time.Duration) (r0 *protocol.RepoUpdateResponse, r1 error) {
 				return
 			},
 		},
+		ResolveRevisionFunc: &GitserverClientResolveRevisionFunc{
+			defaultHook: func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -803,11 +819,21 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.LsFiles")
 			},
 		},
+		ReadFileFunc: &GitserverClientReadFileFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error) {
+				panic("unexpected invocation of MockGitserverClient.ReadFile")
+			},
+		},
 		RequestRepoUpdateFunc: &GitserverClientRequestRepoUpdateFunc{
 			defaultHook: func(context.Context, api.RepoName, time.Duration) (*protocol.RepoUpdateResponse, error) {
 				panic("unexpected invocation of MockGitserverClient.RequestRepoUpdate")
 			},
 		},
+		ResolveRevisionFunc: &GitserverClientResolveRevisionFunc{
+			defaultHook: func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+				panic("unexpected invocation of MockGitserverClient.ResolveRevision")
+			},
+		},
 	}
 }
 
@@ -822,9 +848,15 @@ func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
 		LsFilesFunc: &GitserverClientLsFilesFunc{
 			defaultHook: i.LsFiles,
 		},
+		ReadFileFunc: &GitserverClientReadFileFunc{
+			defaultHook: i.ReadFile,
+		},
 		RequestRepoUpdateFunc: &GitserverClientRequestRepoUpdateFunc{
 			defaultHook: i.RequestRepoUpdate,
 		},
+		ResolveRevisionFunc: &GitserverClientResolveRevisionFunc{
+			defaultHook: i.ResolveRevision,
+		},
 	}
 }
 
@@ -1067,6 +1099,123 @@ func (c GitserverClientLsFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientReadFileFunc describes the behavior when the ReadFile
+// method of the parent MockGitserverClient instance is invoked.
+type GitserverClientReadFileFunc struct {
+	defaultHook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error)
+	hooks       []func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error)
+	history     []GitserverClientReadFileFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadFile delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverClient) ReadFile(v0 context.Context, v1 authz.SubRepoPermissionChecker, v2 api.RepoName, v3 api.CommitID, v4 string) ([]byte, error) {
+	r0, r1 := m.ReadFileFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ReadFileFunc.appendCall(GitserverClientReadFileFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadFile method of
+// the parent MockGitserverClient instance is invoked and the hook queue is
+// empty.
+func (f *GitserverClientReadFileFunc) SetDefaultHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadFile method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientReadFileFunc) PushHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientReadFileFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientReadFileFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientReadFileFunc) nextHook() func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientReadFileFunc) appendCall(r0 GitserverClientReadFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientReadFileFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientReadFileFunc) History() []GitserverClientReadFileFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientReadFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientReadFileFuncCall is an object that describes an invocation
+// of method ReadFile on an instance of MockGitserverClient.
+type GitserverClientReadFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 authz.SubRepoPermissionChecker
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientReadFileFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientReadFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientRequestRepoUpdateFunc describes the behavior when the
 // RequestRepoUpdate method of the parent MockGitserverClient instance is
 // invoked.
@@ -1180,3 +1329,541 @@ func (c GitserverClientRequestRepoUpdateFuncCall) Args() []interface{} {
 func (c GitserverClientRequestRepoUpdateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// GitserverClientResolveRevisionFunc describes the behavior when the
+// ResolveRevision method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientResolveRevisionFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error)
+	history     []GitserverClientResolveRevisionFuncCall
+	mutex       sync.Mutex
+}
+
+// ResolveRevision delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) ResolveRevision(v0 context.Context, v1 api.RepoName, v2 string, v3 gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2, v3)
+	m.ResolveRevisionFunc.appendCall(GitserverClientResolveRevisionFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ResolveRevision
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResolveRevision method of the parent MockGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverClientResolveRevisionFunc) PushHook(hook func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientResolveRevisionFunc) nextHook() func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientResolveRevisionFunc) appendCall(r0 GitserverClientResolveRevisionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientResolveRevisionFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientResolveRevisionFunc) History() []GitserverClientResolveRevisionFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientResolveRevisionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientResolveRevisionFuncCall is an object that describes an
+// invocation of method ResolveRevision on an instance of
+// MockGitserverClient.
+type GitserverClientResolveRevisionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 gitserver.ResolveRevisionOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientResolveRevisionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientResolveRevisionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockRepoDependenciesStore is a mock implementation of the
+// RepoDependenciesStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/internal/background)
+// used for unit testing.
+type MockRepoDependenciesStore struct {
+	// ListReposWithStaleDependenciesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListReposWithStaleDependencies.
+	ListReposWithStaleDependenciesFunc *RepoDependenciesStoreListReposWithStaleDependenciesFunc
+	// ListUploadPackageReferencesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListUploadPackageReferences.
+	ListUploadPackageReferencesFunc *RepoDependenciesStoreListUploadPackageReferencesFunc
+	// UpdateRepoDependenciesFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateRepoDependencies.
+	UpdateRepoDependenciesFunc *RepoDependenciesStoreUpdateRepoDependenciesFunc
+}
+
+// NewMockRepoDependenciesStore creates a new mock of the
+// RepoDependenciesStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockRepoDependenciesStore() *MockRepoDependenciesStore {
+	return &MockRepoDependenciesStore{
+		ListReposWithStaleDependenciesFunc: &RepoDependenciesStoreListReposWithStaleDependenciesFunc{
+			defaultHook: func(context.Context, int, time.Duration) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		ListUploadPackageReferencesFunc: &RepoDependenciesStoreListUploadPackageReferencesFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 []shared.RepoDependency, r1 error) {
+				return
+			},
+		},
+		UpdateRepoDependenciesFunc: &RepoDependenciesStoreUpdateRepoDependenciesFunc{
+			defaultHook: func(context.Context, api.RepoID, []shared.RepoDependency) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoDependenciesStore creates a new mock of the
+// RepoDependenciesStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRepoDependenciesStore() *MockRepoDependenciesStore {
+	return &MockRepoDependenciesStore{
+		ListReposWithStaleDependenciesFunc: &RepoDependenciesStoreListReposWithStaleDependenciesFunc{
+			defaultHook: func(context.Context, int, time.Duration) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoDependenciesStore.ListReposWithStaleDependencies")
+			},
+		},
+		ListUploadPackageReferencesFunc: &RepoDependenciesStoreListUploadPackageReferencesFunc{
+			defaultHook: func(context.Context, api.RepoID) ([]shared.RepoDependency, error) {
+				panic("unexpected invocation of MockRepoDependenciesStore.ListUploadPackageReferences")
+			},
+		},
+		UpdateRepoDependenciesFunc: &RepoDependenciesStoreUpdateRepoDependenciesFunc{
+			defaultHook: func(context.Context, api.RepoID, []shared.RepoDependency) error {
+				panic("unexpected invocation of MockRepoDependenciesStore.UpdateRepoDependencies")
+			},
+		},
+	}
+}
+
+// NewMockRepoDependenciesStoreFrom creates a new mock of the
+// MockRepoDependenciesStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoDependenciesStoreFrom(i RepoDependenciesStore) *MockRepoDependenciesStore {
+	return &MockRepoDependenciesStore{
+		ListReposWithStaleDependenciesFunc: &RepoDependenciesStoreListReposWithStaleDependenciesFunc{
+			defaultHook: i.ListReposWithStaleDependencies,
+		},
+		ListUploadPackageReferencesFunc: &RepoDependenciesStoreListUploadPackageReferencesFunc{
+			defaultHook: i.ListUploadPackageReferences,
+		},
+		UpdateRepoDependenciesFunc: &RepoDependenciesStoreUpdateRepoDependenciesFunc{
+			defaultHook: i.UpdateRepoDependencies,
+		},
+	}
+}
+
+// RepoDependenciesStoreListReposWithStaleDependenciesFunc describes the
+// behavior when the ListReposWithStaleDependencies method of the parent
+// MockRepoDependenciesStore instance is invoked.
+type RepoDependenciesStoreListReposWithStaleDependenciesFunc struct {
+	defaultHook func(context.Context, int, time.Duration) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, int, time.Duration) ([]types.MinimalRepo, error)
+	history     []RepoDependenciesStoreListReposWithStaleDependenciesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListReposWithStaleDependencies delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoDependenciesStore) ListReposWithStaleDependencies(v0 context.Context, v1 int, v2 time.Duration) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListReposWithStaleDependenciesFunc.nextHook()(v0, v1, v2)
+	m.ListReposWithStaleDependenciesFunc.appendCall(RepoDependenciesStoreListReposWithStaleDependenciesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListReposWithStaleDependencies method of the parent
+// MockRepoDependenciesStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) SetDefaultHook(hook func(context.Context, int, time.Duration) ([]types.MinimalRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListReposWithStaleDependencies method of the parent
+// MockRepoDependenciesStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) PushHook(hook func(context.Context, int, time.Duration) ([]types.MinimalRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, time.Duration) ([]types.MinimalRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, int, time.Duration) ([]types.MinimalRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) nextHook() func(context.Context, int, time.Duration) ([]types.MinimalRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) appendCall(r0 RepoDependenciesStoreListReposWithStaleDependenciesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoDependenciesStoreListReposWithStaleDependenciesFuncCall objects
+// describing the invocations of this function.
+func (f *RepoDependenciesStoreListReposWithStaleDependenciesFunc) History() []RepoDependenciesStoreListReposWithStaleDependenciesFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoDependenciesStoreListReposWithStaleDependenciesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoDependenciesStoreListReposWithStaleDependenciesFuncCall is an object
+// that describes an invocation of method ListReposWithStaleDependencies on
+// an instance of MockRepoDependenciesStore.
+type RepoDependenciesStoreListReposWithStaleDependenciesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Duration
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.MinimalRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoDependenciesStoreListReposWithStaleDependenciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoDependenciesStoreListReposWithStaleDependenciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoDependenciesStoreListUploadPackageReferencesFunc describes the
+// behavior when the ListUploadPackageReferences method of the parent
+// MockRepoDependenciesStore instance is invoked.
+type RepoDependenciesStoreListUploadPackageReferencesFunc struct {
+	defaultHook func(context.Context, api.RepoID) ([]shared.RepoDependency, error)
+	hooks       []func(context.Context, api.RepoID) ([]shared.RepoDependency, error)
+	history     []RepoDependenciesStoreListUploadPackageReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListUploadPackageReferences delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoDependenciesStore) ListUploadPackageReferences(v0 context.Context, v1 api.RepoID) ([]shared.RepoDependency, error) {
+	r0, r1 := m.ListUploadPackageReferencesFunc.nextHook()(v0, v1)
+	m.ListUploadPackageReferencesFunc.appendCall(RepoDependenciesStoreListUploadPackageReferencesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListUploadPackageReferences method of the parent
+// MockRepoDependenciesStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) SetDefaultHook(hook func(context.Context, api.RepoID) ([]shared.RepoDependency, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListUploadPackageReferences method of the parent
+// MockRepoDependenciesStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) PushHook(hook func(context.Context, api.RepoID) ([]shared.RepoDependency, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) SetDefaultReturn(r0 []shared.RepoDependency, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) ([]shared.RepoDependency, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) PushReturn(r0 []shared.RepoDependency, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) ([]shared.RepoDependency, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) nextHook() func(context.Context, api.RepoID) ([]shared.RepoDependency, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) appendCall(r0 RepoDependenciesStoreListUploadPackageReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoDependenciesStoreListUploadPackageReferencesFuncCall objects
+// describing the invocations of this function.
+func (f *RepoDependenciesStoreListUploadPackageReferencesFunc) History() []RepoDependenciesStoreListUploadPackageReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoDependenciesStoreListUploadPackageReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoDependenciesStoreListUploadPackageReferencesFuncCall is an object
+// that describes an invocation of method ListUploadPackageReferences on an
+// instance of MockRepoDependenciesStore.
+type RepoDependenciesStoreListUploadPackageReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RepoDependency
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoDependenciesStoreListUploadPackageReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoDependenciesStoreListUploadPackageReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoDependenciesStoreUpdateRepoDependenciesFunc describes the behavior
+// when the UpdateRepoDependencies method of the parent
+// MockRepoDependenciesStore instance is invoked.
+type RepoDependenciesStoreUpdateRepoDependenciesFunc struct {
+	defaultHook func(context.Context, api.RepoID, []shared.RepoDependency) error
+	hooks       []func(context.Context, api.RepoID, []shared.RepoDependency) error
+	history     []RepoDependenciesStoreUpdateRepoDependenciesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateRepoDependencies delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoDependenciesStore) UpdateRepoDependencies(v0 context.Context, v1 api.RepoID, v2 []shared.RepoDependency) error {
+	r0 := m.UpdateRepoDependenciesFunc.nextHook()(v0, v1, v2)
+	m.UpdateRepoDependenciesFunc.appendCall(RepoDependenciesStoreUpdateRepoDependenciesFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateRepoDependencies method of the parent MockRepoDependenciesStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []shared.RepoDependency) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateRepoDependencies method of the parent MockRepoDependenciesStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) PushHook(hook func(context.Context, api.RepoID, []shared.RepoDependency) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, []shared.RepoDependency) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, []shared.RepoDependency) error {
+		return r0
+	})
+}
+
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) nextHook() func(context.Context, api.RepoID, []shared.RepoDependency) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) appendCall(r0 RepoDependenciesStoreUpdateRepoDependenciesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoDependenciesStoreUpdateRepoDependenciesFuncCall objects describing
+// the invocations of this function.
+func (f *RepoDependenciesStoreUpdateRepoDependenciesFunc) History() []RepoDependenciesStoreUpdateRepoDependenciesFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoDependenciesStoreUpdateRepoDependenciesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoDependenciesStoreUpdateRepoDependenciesFuncCall is an object that
+// describes an invocation of method UpdateRepoDependencies on an instance
+// of MockRepoDependenciesStore.
+type RepoDependenciesStoreUpdateRepoDependenciesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []shared.RepoDependency
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoDependenciesStoreUpdateRepoDependenciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoDependenciesStoreUpdateRepoDependenciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
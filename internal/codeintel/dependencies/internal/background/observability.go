@@ -11,11 +11,13 @@ import (
 )
 
 type operations struct {
-	handleCrateSyncer        *observation.Operation
-	packagesFilterApplicator *observation.Operation
+	handleCrateSyncer         *observation.Operation
+	packagesFilterApplicator  *observation.Operation
+	repoDependenciesInventory *observation.Operation
 
-	packagesUpdated prometheus.Counter
-	versionsUpdated prometheus.Counter
+	packagesUpdated  prometheus.Counter
+	versionsUpdated  prometheus.Counter
+	reposInventoried prometheus.Counter
 }
 
 var (
@@ -62,8 +64,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		handleCrateSyncer:        op("HandleCrateSyncer"),
-		packagesFilterApplicator: op("HandlePackagesFilterApplicator"),
+		handleCrateSyncer:         op("HandleCrateSyncer"),
+		packagesFilterApplicator:  op("HandlePackagesFilterApplicator"),
+		repoDependenciesInventory: op("HandleRepoDependenciesInventory"),
 
 		packagesUpdated: counter(
 			"src_codeintel_background_filtered_packages_updated",
@@ -73,5 +76,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 			"src_codeintel_background_filtered_package_versions_updated",
 			"The number of package repo versions who's blocked status was updated",
 		),
+		reposInventoried: counter(
+			"src_codeintel_background_repo_dependencies_inventoried",
+			"The number of repositories whose dependency inventory was updated",
+		),
 	}
 }
@@ -0,0 +1,60 @@
+package manifests
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+// parseCargoLock parses a Cargo.lock. The format is generated by cargo and
+// consists of a flat list of [[package]] tables with string values, so we
+// read it line by line rather than with a full TOML parser. Packages without
+// a source are members of the workspace itself and are skipped.
+func parseCargoLock(content []byte) ([]dependency, error) {
+	var (
+		deps      []dependency
+		inPackage bool
+		pkg       map[string]string
+	)
+	flush := func() {
+		if inPackage && pkg["name"] != "" && pkg["source"] != "" {
+			deps = append(deps, dependency{
+				scheme:  shared.RustPackagesScheme,
+				name:    pkg["name"],
+				version: pkg["version"],
+				semver:  Coerce(pkg["version"]),
+			})
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			flush()
+			inPackage = line == "[[package]]"
+			pkg = map[string]string{}
+			continue
+		}
+		if !inPackage {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		if s, err := strconv.Unquote(strings.TrimSpace(value)); err == nil {
+			pkg[strings.TrimSpace(key)] = s
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+
+	return deps, nil
+}
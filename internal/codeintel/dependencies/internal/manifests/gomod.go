@@ -0,0 +1,27 @@
+package manifests
+
+import (
+	"golang.org/x/mod/modfile"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+// parseGoMod parses a go.mod. Since Go 1.17, go.mod lists all modules that
+// provide packages to the main module, so it acts as a lockfile.
+func parseGoMod(content []byte) ([]dependency, error) {
+	f, err := modfile.ParseLax("go.mod", content, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make([]dependency, 0, len(f.Require))
+	for _, r := range f.Require {
+		deps = append(deps, dependency{
+			scheme:  shared.GoPackagesScheme,
+			name:    r.Mod.Path,
+			version: r.Mod.Version,
+			semver:  Coerce(r.Mod.Version),
+		})
+	}
+	return deps, nil
+}
@@ -0,0 +1,219 @@
+// Package manifests extracts the dependencies of a repository from the package
+// manifests and lockfiles in its tree.
+package manifests
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// dependency is a dependency declared in a single manifest or lockfile.
+type dependency struct {
+	scheme  string
+	name    string
+	version string
+	semver  *semver.Version
+}
+
+type parser struct {
+	parse func(content []byte) ([]dependency, error)
+	// lockfile parsers yield resolved versions. They take precedence over
+	// the manifest of the same scheme in the same directory.
+	lockfile bool
+}
+
+var parsers = map[string]parser{
+	"package.json":      {parse: parseNpmManifest},
+	"package-lock.json": {parse: parseNpmLockfile, lockfile: true},
+	"yarn.lock":         {parse: parseYarnLockfile, lockfile: true},
+	"go.mod":            {parse: parseGoMod, lockfile: true},
+	"pom.xml":           {parse: parseMavenPOM},
+	"requirements.txt":  {parse: parsePythonRequirements},
+	"Cargo.lock":        {parse: parseCargoLock, lockfile: true},
+}
+
+// Basenames returns the file names of all supported manifests and lockfiles.
+func Basenames() []string {
+	names := make([]string, 0, len(parsers))
+	for name := range parsers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// vendoredDirs are directories that contain copies of dependencies. Manifests
+// in there describe the dependencies of dependencies.
+var vendoredDirs = []string{"node_modules", "vendor", "third_party", ".git"}
+
+// IsManifest returns true if the file at the given path is a supported manifest
+// or lockfile that describes the dependencies of the repository itself.
+func IsManifest(filepath string) bool {
+	if _, ok := parsers[path.Base(filepath)]; !ok {
+		return false
+	}
+	for _, dir := range strings.Split(path.Dir(filepath), "/") {
+		for _, vendored := range vendoredDirs {
+			if dir == vendored {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Parse returns the dependencies declared by the manifest or lockfile at the
+// given path.
+func Parse(filepath string, content []byte) ([]shared.RepoDependency, error) {
+	p, ok := parsers[path.Base(filepath)]
+	if !ok {
+		return nil, errors.Newf("unsupported manifest %q", filepath)
+	}
+
+	deps, err := p.parse(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q", filepath)
+	}
+
+	repoDeps := make([]shared.RepoDependency, 0, len(deps))
+	for _, d := range deps {
+		repoDeps = append(repoDeps, shared.RepoDependency{
+			Source:  shared.RepoDependencySourceManifest,
+			Path:    filepath,
+			Scheme:  d.scheme,
+			Name:    d.name,
+			Version: d.version,
+			Semver:  d.semver,
+		})
+	}
+	return repoDeps, nil
+}
+
+// PreferLockfiles drops the dependencies declared by manifests that live next
+// to a lockfile of the same scheme, such as a package.json next to a
+// package-lock.json: the lockfile has the versions that are actually used.
+func PreferLockfiles(deps []shared.RepoDependency) []shared.RepoDependency {
+	type dirScheme struct{ dir, scheme string }
+	locked := map[dirScheme]struct{}{}
+	for _, d := range deps {
+		if p, ok := parsers[path.Base(d.Path)]; ok && p.lockfile {
+			locked[dirScheme{path.Dir(d.Path), d.Scheme}] = struct{}{}
+		}
+	}
+
+	filtered := deps[:0]
+	for _, d := range deps {
+		if p, ok := parsers[path.Base(d.Path)]; ok && !p.lockfile {
+			if _, ok := locked[dirScheme{path.Dir(d.Path), d.Scheme}]; ok {
+				continue
+			}
+		}
+		filtered = append(filtered, d)
+	}
+	return filtered
+}
+
+// npmRangeLowerBound returns the lowest version admitted by an npm-style
+// version range, or nil if the range cannot be parsed or has no lower bound.
+func npmRangeLowerBound(spec string) *semver.Version {
+	var lowest *semver.Version
+	for _, alternative := range strings.Split(spec, "||") {
+		lower := npmComparatorsLowerBound(strings.TrimSpace(alternative))
+		if lower == nil {
+			return nil
+		}
+		if lowest == nil || lower.LessThan(lowest) {
+			lowest = lower
+		}
+	}
+	return lowest
+}
+
+var (
+	npmHyphenRangePattern = regexp.MustCompile(`^(\S+)\s+-\s+\S+$`)
+	npmOperatorPattern    = regexp.MustCompile(`(\^|~>?|[<>]=?|=)\s+`)
+	npmComparatorPattern  = regexp.MustCompile(`^(\^|~>?|[<>]=?|=)?v?([0-9xX*]+(?:\.[0-9xX*]+){0,2})(-[0-9A-Za-z.-]+)?$`)
+)
+
+// npmComparatorsLowerBound returns the lower bound of a hyphen range or of a
+// space-separated set of comparators that must all be satisfied.
+func npmComparatorsLowerBound(comparators string) *semver.Version {
+	if m := npmHyphenRangePattern.FindStringSubmatch(comparators); m != nil {
+		comparators = m[1]
+	}
+
+	var lower *semver.Version
+	for _, comparator := range strings.Fields(npmOperatorPattern.ReplaceAllString(comparators, "$1")) {
+		m := npmComparatorPattern.FindStringSubmatch(comparator)
+		if m == nil {
+			return nil
+		}
+		if m[1] == "<" || m[1] == "<=" {
+			continue
+		}
+
+		// The lowest version matching a wildcard, e.g. 1.0.0 for 1.x.
+		var components []string
+		for _, c := range strings.Split(m[2], ".") {
+			if c == "x" || c == "X" || c == "*" {
+				break
+			}
+			components = append(components, c)
+		}
+		if len(components) == 0 {
+			continue
+		}
+		version := strings.Join(components, ".")
+		if len(components) == 3 {
+			version += m[3]
+		}
+
+		v := Coerce(version)
+		if v == nil {
+			return nil
+		}
+		if lower == nil || lower.LessThan(v) {
+			lower = v
+		}
+	}
+	return lower
+}
+
+var leadingVersionPattern = regexp.MustCompile(`^v?(\d+(?:\.\d+){0,2})`)
+
+// leadingVersion interprets the numeric prefix of ecosystems' version schemes
+// that are only loosely related to semver, such as Maven's "31.1-jre" or
+// Python's "2.0rc1", as a release version.
+func leadingVersion(version string) *semver.Version {
+	m := leadingVersionPattern.FindStringSubmatch(strings.TrimSpace(version))
+	if m == nil {
+		return nil
+	}
+	return Coerce(m[1])
+}
+
+// Coerce interprets a version such as "1.2.3", "v1.2" or "=1.2.3-rc.1" as a
+// semantic version, filling in missing components with zeros. Build metadata
+// is dropped. It returns nil if the version cannot be interpreted, including
+// for wildcards such as "1.x".
+func Coerce(version string) *semver.Version {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "=")
+	if i := strings.IndexByte(version, '+'); i >= 0 {
+		version = version[:i]
+	}
+	if version == "" {
+		return nil
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return nil
+	}
+	return v
+}
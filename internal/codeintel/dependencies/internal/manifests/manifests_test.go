@@ -0,0 +1,346 @@
+package manifests
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+// entry is a shorter form of shared.RepoDependency for test expectations.
+type entry struct {
+	Scheme  string
+	Name    string
+	Version string
+	Semver  string
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		path    string
+		content string
+		want    []entry
+	}{
+		{
+			path: "web/package.json",
+			content: `{
+				"name": "web",
+				"dependencies": {"react": "^18.2.0", "left-pad": "git+https://github.com/left-pad/left-pad.git"},
+				"devDependencies": {"@types/node": "~18.11 || >=20"},
+				"peerDependencies": {"react-dom": "*"}
+			}`,
+			want: []entry{
+				{"npm", "left-pad", "git+https://github.com/left-pad/left-pad.git", ""},
+				{"npm", "react", "^18.2.0", "18.2.0"},
+				{"npm", "@types/node", "~18.11 || >=20", "18.11.0"},
+				{"npm", "react-dom", "*", ""},
+			},
+		},
+		{
+			path: "yarn.lock",
+			content: `# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@types/node@^18.0.0", "@types/node@~18.11":
+  version "18.11.9"
+  resolved "https://registry.yarnpkg.com/@types/node/-/node-18.11.9.tgz"
+
+react@^18.2.0:
+  version "18.2.0"
+  dependencies:
+    loose-envify "^1.1.0"
+`,
+			want: []entry{
+				{"npm", "@types/node", "18.11.9", "18.11.9"},
+				{"npm", "react", "18.2.0", "18.2.0"},
+			},
+		},
+		{
+			path: "web/yarn.lock",
+			content: `__metadata:
+  version: 6
+
+"react@npm:^18.0.0, react@npm:^18.2.0":
+  version: 18.2.0
+  resolution: "react@npm:18.2.0"
+
+"web@workspace:.":
+  version: 0.0.0-use.local
+  resolution: "web@workspace:."
+`,
+			want: []entry{
+				{"npm", "react", "18.2.0", "18.2.0"},
+			},
+		},
+		{
+			path: "package-lock.json",
+			content: `{
+				"lockfileVersion": 3,
+				"packages": {
+					"": {"name": "web", "version": "1.0.0"},
+					"node_modules/react": {"version": "18.2.0"},
+					"node_modules/@types/node": {"version": "18.11.9"},
+					"node_modules/a/node_modules/b": {"version": "1.0.0-rc.1"},
+					"node_modules/workspace": {"resolved": "packages/workspace", "link": true}
+				}
+			}`,
+			want: []entry{
+				{"npm", "@types/node", "18.11.9", "18.11.9"},
+				{"npm", "b", "1.0.0-rc.1", "1.0.0-rc.1"},
+				{"npm", "react", "18.2.0", "18.2.0"},
+			},
+		},
+		{
+			path: "package-lock.json",
+			content: `{
+				"lockfileVersion": 1,
+				"dependencies": {
+					"a": {"version": "1.0.0", "dependencies": {"b": {"version": "2.0.0"}}},
+					"c": {"version": "3.0.0"}
+				}
+			}`,
+			want: []entry{
+				{"npm", "a", "1.0.0", "1.0.0"},
+				{"npm", "b", "2.0.0", "2.0.0"},
+				{"npm", "c", "3.0.0", "3.0.0"},
+			},
+		},
+		{
+			path: "go.mod",
+			content: `module github.com/sourcegraph/example
+
+go 1.19
+
+require (
+	github.com/grafana/regexp v0.0.0-20221122212121-6b5c0a4cb7fd
+	golang.org/x/mod v0.8.0 // indirect
+)
+
+require github.com/google/go-cmp v0.5.9
+`,
+			want: []entry{
+				{"go", "github.com/grafana/regexp", "v0.0.0-20221122212121-6b5c0a4cb7fd", "0.0.0-20221122212121-6b5c0a4cb7fd"},
+				{"go", "golang.org/x/mod", "v0.8.0", "0.8.0"},
+				{"go", "github.com/google/go-cmp", "v0.5.9", "0.5.9"},
+			},
+		},
+		{
+			path: "service/pom.xml",
+			content: `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <parent><version>2.1.0</version></parent>
+  <properties>
+    <guava.version>31.1-jre</guava.version>
+  </properties>
+  <dependencies>
+    <dependency>
+      <groupId>com.google.guava</groupId>
+      <artifactId>guava</artifactId>
+      <version>${guava.version}</version>
+    </dependency>
+    <dependency>
+      <groupId>com.example</groupId>
+      <artifactId>sibling</artifactId>
+      <version>${project.version}</version>
+    </dependency>
+    <dependency>
+      <groupId>junit</groupId>
+      <artifactId>junit</artifactId>
+    </dependency>
+    <dependency>
+      <groupId>org.slf4j</groupId>
+      <artifactId>slf4j-api</artifactId>
+      <version>${slf4j.version}</version>
+    </dependency>
+  </dependencies>
+  <dependencyManagement>
+    <dependencies>
+      <dependency>
+        <groupId>junit</groupId>
+        <artifactId>junit</artifactId>
+        <version>4.13.2</version>
+      </dependency>
+    </dependencies>
+  </dependencyManagement>
+</project>`,
+			want: []entry{
+				{"semanticdb", "com.google.guava:guava", "31.1-jre", "31.1.0"},
+				{"semanticdb", "com.example:sibling", "2.1.0", "2.1.0"},
+				{"semanticdb", "junit:junit", "", ""},
+				{"semanticdb", "org.slf4j:slf4j-api", "${slf4j.version}", ""},
+				{"semanticdb", "junit:junit", "4.13.2", "4.13.2"},
+			},
+		},
+		{
+			path: "requirements.txt",
+			content: `# Pinned
+Django==4.1.7
+requests[security] == 2.28.2  # inline comment
+zope.interface==5.5.2 ; python_version >= "3.7"
+numpy>=1.21,<2
+typing_extensions
+-r dev-requirements.txt
+--index-url https://pypi.example.com/simple
+git+https://github.com/psf/black.git
+local-pkg @ file:///opt/local-pkg
+`,
+			want: []entry{
+				{"python", "django", "4.1.7", "4.1.7"},
+				{"python", "requests", "2.28.2", "2.28.2"},
+				{"python", "zope-interface", "5.5.2", "5.5.2"},
+				{"python", "numpy", ">=1.21,<2", "1.21.0"},
+				{"python", "typing-extensions", "", ""},
+			},
+		},
+		{
+			path: "Cargo.lock",
+			content: `# This file is automatically @generated by Cargo.
+# It is not intended for manual editing.
+version = 3
+
+[[package]]
+name = "example"
+version = "0.1.0"
+dependencies = [
+ "serde",
+]
+
+[[package]]
+name = "serde"
+version = "1.0.152"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "bb7d1f0d3021d347a83e556fc4683dea2ea09d87bccdf88ff5c12545d89d5efb"
+
+[[package]]
+name = "tokio"
+version = "1.26.0"
+source = "git+https://github.com/tokio-rs/tokio#abcdef"
+`,
+			want: []entry{
+				{"rust-analyzer", "serde", "1.0.152", "1.0.152"},
+				{"rust-analyzer", "tokio", "1.26.0", "1.26.0"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			deps, err := Parse(tc.path, []byte(tc.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []entry
+			for _, d := range deps {
+				if d.Source != shared.RepoDependencySourceManifest || d.Path != tc.path {
+					t.Errorf("unexpected source or path of %+v", d)
+				}
+				e := entry{Scheme: d.Scheme, Name: d.Name, Version: d.Version}
+				if d.Semver != nil {
+					e.Semver = d.Semver.String()
+				}
+				got = append(got, e)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for path, content := range map[string]string{
+		"package.json":      `{"dependencies": [}`,
+		"package-lock.json": `not json`,
+		"go.mod":            "module\nrequire (",
+		"pom.xml":           `<project><dependencies>`,
+		"Gemfile.lock":      ``,
+	} {
+		if _, err := Parse(path, []byte(content)); err == nil {
+			t.Errorf("expected parsing %s to fail", path)
+		}
+	}
+}
+
+func TestIsManifest(t *testing.T) {
+	for path, want := range map[string]bool{
+		"package.json":                        true,
+		"web/package-lock.json":               true,
+		"web/yarn.lock":                       true,
+		"services/api/go.mod":                 true,
+		"Cargo.lock":                          true,
+		"requirements.txt":                    true,
+		"dev-requirements.txt":                false,
+		"Cargo.toml":                          false,
+		"web/node_modules/react/package.json": false,
+		"vendor/github.com/a/b/go.mod":        false,
+	} {
+		if got := IsManifest(path); got != want {
+			t.Errorf("IsManifest(%q): want %t, got %t", path, want, got)
+		}
+	}
+}
+
+func TestNpmRangeLowerBound(t *testing.T) {
+	for spec, want := range map[string]string{
+		"18.2.0":              "18.2.0",
+		"=v18.2.0":            "18.2.0",
+		"^18.2.0":             "18.2.0",
+		"~18.11":              "18.11.0",
+		"~> 1.2":              "1.2.0",
+		">= 1.2.3 < 2":        "1.2.3",
+		">1.0.0 >=1.5.0":      "1.5.0",
+		"1.x":                 "1.0.0",
+		"1.2.x || ^1.0.0":     "1.0.0",
+		"~18.11 || >=20":      "18.11.0",
+		"1.2.3 - 2.0.0":       "1.2.3",
+		"^2.0.0-beta.1":       "2.0.0-beta.1",
+		"*":                   "",
+		"":                    "",
+		"<2.0.0":              "",
+		"^1.0.0 || <0.5.0":    "",
+		"latest":              "",
+		"github:facebook/jsx": "",
+	} {
+		got := ""
+		if v := npmRangeLowerBound(spec); v != nil {
+			got = v.String()
+		}
+		if got != want {
+			t.Errorf("npmRangeLowerBound(%q): want %q, got %q", spec, want, got)
+		}
+	}
+}
+
+func TestPreferLockfiles(t *testing.T) {
+	v := semver.MustParse("18.2.0")
+	deps := []shared.RepoDependency{
+		{Path: "package.json", Scheme: "npm", Name: "react", Version: "^18.0.0"},
+		{Path: "package-lock.json", Scheme: "npm", Name: "react", Version: "18.2.0", Semver: v},
+		{Path: "legacy/package.json", Scheme: "npm", Name: "react", Version: "^16.0.0"},
+		{Path: "go.mod", Scheme: "go", Name: "golang.org/x/mod", Version: "v0.8.0"},
+		{Path: "requirements.txt", Scheme: "python", Name: "django", Version: "4.1.7"},
+	}
+
+	want := []shared.RepoDependency{
+		{Path: "package-lock.json", Scheme: "npm", Name: "react", Version: "18.2.0", Semver: v},
+		{Path: "legacy/package.json", Scheme: "npm", Name: "react", Version: "^16.0.0"},
+		{Path: "go.mod", Scheme: "go", Name: "golang.org/x/mod", Version: "v0.8.0"},
+		{Path: "requirements.txt", Scheme: "python", Name: "django", Version: "4.1.7"},
+	}
+	if diff := cmp.Diff(want, PreferLockfiles(deps), semverComparer); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+}
+
+// semverComparer compares versions by value. The Equal method of
+// *semver.Version does not handle nil versions.
+var semverComparer = cmp.Comparer(func(a, b *semver.Version) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(b)
+})
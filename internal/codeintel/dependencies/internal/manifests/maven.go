@@ -0,0 +1,83 @@
+package manifests
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+type mavenPOM struct {
+	Version string `xml:"version"`
+	Parent  struct {
+		Version string `xml:"version"`
+	} `xml:"parent"`
+	Properties struct {
+		Entries []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	} `xml:"properties"`
+	Dependencies         []mavenDependency `xml:"dependencies>dependency"`
+	DependencyManagement []mavenDependency `xml:"dependencyManagement>dependencies>dependency"`
+}
+
+type mavenDependency struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+}
+
+var mavenPropertyPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// parseMavenPOM parses a pom.xml. Properties defined in the POM itself are
+// substituted, but inherited properties and versions managed by a parent POM
+// are not resolved, so such dependencies have no usable version.
+func parseMavenPOM(content []byte) ([]dependency, error) {
+	var pom mavenPOM
+	if err := xml.Unmarshal(content, &pom); err != nil {
+		return nil, err
+	}
+
+	properties := map[string]string{}
+	for _, e := range pom.Properties.Entries {
+		properties[e.XMLName.Local] = strings.TrimSpace(e.Value)
+	}
+	projectVersion := pom.Version
+	if projectVersion == "" {
+		projectVersion = pom.Parent.Version
+	}
+	properties["project.version"] = projectVersion
+	properties["version"] = projectVersion
+
+	resolve := func(s string) string {
+		return mavenPropertyPattern.ReplaceAllStringFunc(strings.TrimSpace(s), func(ref string) string {
+			if v, ok := properties[ref[2:len(ref)-1]]; ok {
+				return v
+			}
+			return ref
+		})
+	}
+
+	var deps []dependency
+	for _, d := range append(pom.Dependencies, pom.DependencyManagement...) {
+		groupID, artifactID := resolve(d.GroupID), resolve(d.ArtifactID)
+		if groupID == "" || artifactID == "" {
+			continue
+		}
+
+		version := resolve(d.Version)
+		dep := dependency{
+			scheme:  shared.JVMPackagesScheme,
+			name:    groupID + ":" + artifactID,
+			version: version,
+		}
+		if !strings.Contains(version, "${") {
+			dep.semver = leadingVersion(version)
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
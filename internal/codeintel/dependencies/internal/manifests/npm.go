@@ -0,0 +1,173 @@
+package manifests
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+type npmManifest struct {
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+// parseNpmManifest parses a package.json. The declared versions are ranges, so
+// the lowest version of each range is used for matching.
+func parseNpmManifest(content []byte) ([]dependency, error) {
+	var manifest npmManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+
+	var deps []dependency
+	for _, m := range []map[string]string{
+		manifest.Dependencies,
+		manifest.DevDependencies,
+		manifest.PeerDependencies,
+		manifest.OptionalDependencies,
+	} {
+		for _, name := range sortedKeys(m) {
+			spec := m[name]
+			deps = append(deps, dependency{
+				scheme:  shared.NpmPackagesScheme,
+				name:    name,
+				version: spec,
+				semver:  npmRangeLowerBound(spec),
+			})
+		}
+	}
+	return deps, nil
+}
+
+type npmLockfile struct {
+	// Packages is used by lockfileVersion 2 and 3 and maps paths below
+	// node_modules to packages.
+	Packages map[string]struct {
+		Version string `json:"version"`
+		Link    bool   `json:"link"`
+	} `json:"packages"`
+	// Dependencies is used by lockfileVersion 1 and is a tree of packages.
+	Dependencies map[string]npmLockfileDependency `json:"dependencies"`
+}
+
+type npmLockfileDependency struct {
+	Version      string                           `json:"version"`
+	Dependencies map[string]npmLockfileDependency `json:"dependencies"`
+}
+
+// parseNpmLockfile parses a package-lock.json, including transitive
+// dependencies.
+func parseNpmLockfile(content []byte) ([]dependency, error) {
+	var lockfile npmLockfile
+	if err := json.Unmarshal(content, &lockfile); err != nil {
+		return nil, err
+	}
+
+	var deps []dependency
+	add := func(name, version string) {
+		deps = append(deps, dependency{
+			scheme:  shared.NpmPackagesScheme,
+			name:    name,
+			version: version,
+			semver:  Coerce(version),
+		})
+	}
+
+	if len(lockfile.Packages) > 0 {
+		for _, p := range sortedKeys(lockfile.Packages) {
+			pkg := lockfile.Packages[p]
+			i := strings.LastIndex(p, "node_modules/")
+			if i < 0 || pkg.Link {
+				// The root package, and workspace packages that are
+				// linked instead of installed.
+				continue
+			}
+			add(p[i+len("node_modules/"):], pkg.Version)
+		}
+		return deps, nil
+	}
+
+	var walk func(map[string]npmLockfileDependency)
+	walk = func(tree map[string]npmLockfileDependency) {
+		for _, name := range sortedKeys(tree) {
+			add(name, tree[name].Version)
+			walk(tree[name].Dependencies)
+		}
+	}
+	walk(lockfile.Dependencies)
+	return deps, nil
+}
+
+// parseYarnLockfile parses a yarn.lock of Yarn 1 or of Yarn 2 and later,
+// including transitive dependencies. Each entry lists the ranges it resolves,
+// e.g. `"react@^18.0.0", "react@^18.2.0":`, followed by the indented fields
+// of the resolved package, of which only the version is used.
+func parseYarnLockfile(content []byte) ([]dependency, error) {
+	var deps []dependency
+	name := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if !strings.HasPrefix(line, " ") {
+			// The first range of an entry, e.g. "@types/node@^18.0.0" or
+			// "react@npm:^18.2.0". The range of the name of scoped
+			// packages starts after the second @.
+			spec, _, _ := strings.Cut(strings.TrimSuffix(line, ":"), ",")
+			spec = strings.Trim(strings.TrimSpace(spec), `"`)
+			name = ""
+			if i := strings.LastIndex(spec, "@"); i > 0 && !isYarnLocalProtocol(spec[i+1:]) {
+				name = spec[:i]
+			}
+			continue
+		}
+
+		field := strings.TrimSpace(line)
+		if name == "" || !strings.HasPrefix(field, "version") {
+			continue
+		}
+		version := strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(field, "version"), ":"))
+		if unquoted, err := strconv.Unquote(version); err == nil {
+			version = unquoted
+		}
+		deps = append(deps, dependency{
+			scheme:  shared.NpmPackagesScheme,
+			name:    name,
+			version: version,
+			semver:  Coerce(version),
+		})
+		name = ""
+	}
+	return deps, scanner.Err()
+}
+
+// isYarnLocalProtocol returns true for the ranges of packages of the
+// repository itself, such as workspaces, which are not dependencies.
+func isYarnLocalProtocol(spec string) bool {
+	for _, protocol := range []string{"workspace:", "link:", "portal:", "file:"} {
+		if strings.HasPrefix(spec, protocol) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
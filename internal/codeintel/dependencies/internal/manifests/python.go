@@ -0,0 +1,84 @@
+package manifests
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+var (
+	pythonRequirementPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*\])?\s*(.*)$`)
+	pythonNameSeparators     = regexp.MustCompile(`[-_.]+`)
+)
+
+// NormalizePythonName returns the normalized form of a Python package name as
+// defined by PEP 503, under which names are compared.
+func NormalizePythonName(name string) string {
+	return strings.ToLower(pythonNameSeparators.ReplaceAllString(name, "-"))
+}
+
+// parsePythonRequirements parses a pip requirements file. Options, includes of
+// other requirements files and requirements given as URLs or paths are
+// skipped.
+func parsePythonRequirements(content []byte) ([]dependency, error) {
+	var deps []dependency
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		// Environment markers, e.g. `; python_version < "3.8"`.
+		if i := strings.Index(line, ";"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "-") || strings.Contains(line, "://") {
+			continue
+		}
+
+		m := pythonRequirementPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		name, specifiers := NormalizePythonName(m[1]), strings.ReplaceAll(m[2], " ", "")
+		if strings.HasPrefix(specifiers, "@") {
+			// A direct reference, e.g. `pkg @ file:///...`.
+			continue
+		}
+
+		dep := dependency{
+			scheme:  shared.PythonPackagesScheme,
+			name:    name,
+			version: specifiers,
+		}
+		if v := strings.TrimPrefix(specifiers, "=="); v != specifiers && !strings.ContainsAny(v, ",*") {
+			// A pinned version, which is the common case for requirements files.
+			dep.version = v
+			dep.semver = leadingVersion(v)
+		} else {
+			dep.semver = pythonLowerBound(specifiers)
+		}
+		deps = append(deps, dep)
+	}
+	return deps, scanner.Err()
+}
+
+// pythonLowerBound returns the lowest version admitted by a comma-separated
+// list of PEP 440 version specifiers, if any specifier sets one.
+func pythonLowerBound(specifiers string) *semver.Version {
+	for _, specifier := range strings.Split(specifiers, ",") {
+		for _, op := range []string{"===", "==", ">=", "~="} {
+			if strings.HasPrefix(specifier, op) {
+				return leadingVersion(specifier[len(op):])
+			}
+		}
+	}
+	return nil
+}
@@ -20,6 +20,11 @@ type operations struct {
 
 	shouldRefilterPackageRepoRefs *observation.Operation
 	updateAllBlockedStatuses      *observation.Operation
+
+	listReposWithStaleDependencies *observation.Operation
+	listUploadPackageReferences    *observation.Operation
+	updateRepoDependencies         *observation.Operation
+	listRepoDependencies           *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -55,5 +60,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 		shouldRefilterPackageRepoRefs: op("ShouldRefilterPackageRepoRefs"),
 		updateAllBlockedStatuses:      op("UpdateAllBlockedStatuses"),
+
+		listReposWithStaleDependencies: op("ListReposWithStaleDependencies"),
+		listUploadPackageReferences:    op("ListUploadPackageReferences"),
+		updateRepoDependencies:         op("UpdateRepoDependencies"),
+		listRepoDependencies:           op("ListRepoDependencies"),
 	}
 }
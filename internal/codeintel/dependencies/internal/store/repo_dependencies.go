@@ -0,0 +1,167 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ListReposWithStaleDependencies returns cloned repositories whose entries in
+// the dependency inventory need to be recomputed, least recently synced first.
+// This is the case if the repository or the set of uploads visible at the tip
+// of its default branch changed since the last sync, or if the last sync is
+// older than maxAge.
+func (s *store) ListReposWithStaleDependencies(ctx context.Context, limit int, maxAge time.Duration) (_ []types.MinimalRepo, err error) {
+	ctx, _, endObservation := s.operations.listReposWithStaleDependencies.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.NewSliceScanner(func(s dbutil.Scanner) (r types.MinimalRepo, err error) {
+		err = s.Scan(&r.ID, &r.Name)
+		return r, err
+	})(s.db.Query(ctx, sqlf.Sprintf(listReposWithStaleDependenciesQuery, maxAge.Seconds(), limit)))
+}
+
+const listReposWithStaleDependenciesQuery = `
+SELECT r.id, r.name
+FROM repo r
+JOIN gitserver_repos gr ON gr.repo_id = r.id
+LEFT JOIN codeintel_repo_dependency_syncs s ON s.repo_id = r.id
+WHERE
+	r.deleted_at IS NULL AND
+	r.blocked IS NULL AND
+	gr.clone_status = 'cloned' AND
+	(
+		s.synced_at IS NULL OR
+		s.synced_at < gr.last_changed OR
+		s.synced_at < NOW() - (%s * '1 second'::interval) OR
+		EXISTS (
+			SELECT 1
+			FROM lsif_uploads_visible_at_tip vt
+			JOIN lsif_uploads u ON u.id = vt.upload_id
+			WHERE
+				vt.repository_id = r.id AND
+				vt.is_default_branch AND
+				u.finished_at > s.synced_at
+		)
+	)
+ORDER BY s.synced_at NULLS FIRST, r.id
+LIMIT %s
+`
+
+// ListUploadPackageReferences returns the packages referenced by the uploads
+// that are visible at the tip of the default branch of the given repository.
+// The schemes and names are returned as written by the indexers.
+func (s *store) ListUploadPackageReferences(ctx context.Context, repoID api.RepoID) (_ []shared.RepoDependency, err error) {
+	ctx, _, endObservation := s.operations.listUploadPackageReferences.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repoID", int(repoID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.NewSliceScanner(func(s dbutil.Scanner) (d shared.RepoDependency, err error) {
+		d.Source = shared.RepoDependencySourceUpload
+		err = s.Scan(&d.Path, &d.Scheme, &d.Name, &d.Version)
+		return d, err
+	})(s.db.Query(ctx, sqlf.Sprintf(listUploadPackageReferencesQuery, repoID)))
+}
+
+const listUploadPackageReferencesQuery = `
+SELECT DISTINCT u.root, r.scheme, r.name, COALESCE(r.version, '')
+FROM lsif_uploads_visible_at_tip vt
+JOIN lsif_uploads u ON u.id = vt.upload_id
+JOIN lsif_references r ON r.dump_id = u.id
+WHERE vt.repository_id = %s AND vt.is_default_branch
+ORDER BY u.root, r.scheme, r.name, COALESCE(r.version, '')
+`
+
+// UpdateRepoDependencies replaces the entries of the given repository in the
+// dependency inventory and marks the repository as synced.
+func (s *store) UpdateRepoDependencies(ctx context.Context, repoID api.RepoID, deps []shared.RepoDependency) (err error) {
+	ctx, _, endObservation := s.operations.updateRepoDependencies.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repoID", int(repoID)),
+		log.Int("numDependencies", len(deps)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		if err := tx.Exec(ctx, sqlf.Sprintf(deleteRepoDependenciesQuery, repoID)); err != nil {
+			return errors.Wrap(err, "failed to delete repo dependencies")
+		}
+
+		type key struct{ source, path, scheme, name, version string }
+		seen := make(map[key]struct{}, len(deps))
+
+		if err := batch.WithInserter(
+			ctx,
+			tx.Handle(),
+			"codeintel_repo_dependencies",
+			batch.MaxNumPostgresParameters,
+			[]string{"repo_id", "source", "path", "scheme", "name", "version", "version_major", "version_minor", "version_patch", "version_prerelease"},
+			func(inserter *batch.Inserter) error {
+				for _, d := range deps {
+					k := key{d.Source, d.Path, d.Scheme, d.Name, d.Version}
+					if _, ok := seen[k]; ok {
+						continue
+					}
+					seen[k] = struct{}{}
+
+					var major, minor, patch, prerelease any
+					if d.Semver != nil {
+						major, minor, patch = d.Semver.Major(), d.Semver.Minor(), d.Semver.Patch()
+						if d.Semver.Prerelease() != "" {
+							prerelease = d.Semver.Prerelease()
+						}
+					}
+					if err := inserter.Insert(ctx, repoID, d.Source, d.Path, d.Scheme, d.Name, d.Version, major, minor, patch, prerelease); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		); err != nil {
+			return errors.Wrap(err, "failed to insert repo dependencies")
+		}
+
+		return tx.Exec(ctx, sqlf.Sprintf(markRepoDependenciesSyncedQuery, repoID))
+	})
+}
+
+const deleteRepoDependenciesQuery = `
+DELETE FROM codeintel_repo_dependencies WHERE repo_id = %s
+`
+
+const markRepoDependenciesSyncedQuery = `
+INSERT INTO codeintel_repo_dependency_syncs (repo_id, synced_at)
+VALUES (%s, NOW())
+ON CONFLICT (repo_id) DO UPDATE SET synced_at = EXCLUDED.synced_at
+`
+
+// ListRepoDependencies returns the entries of the given repository in the
+// dependency inventory.
+func (s *store) ListRepoDependencies(ctx context.Context, repoID api.RepoID) (_ []shared.RepoDependency, err error) {
+	ctx, _, endObservation := s.operations.listRepoDependencies.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repoID", int(repoID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.NewSliceScanner(scanRepoDependency)(s.db.Query(ctx, sqlf.Sprintf(listRepoDependenciesQuery, repoID)))
+}
+
+const listRepoDependenciesQuery = `
+SELECT source, path, scheme, name, version, version_major, version_minor, version_patch, version_prerelease
+FROM codeintel_repo_dependencies
+WHERE repo_id = %s
+ORDER BY source, path, scheme, name, version
+`
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRepoDependencies(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	for _, q := range []*sqlf.Query{
+		sqlf.Sprintf(`INSERT INTO repo (id, name) VALUES (1, 'r1'), (2, 'r2'), (3, 'r3')`),
+		sqlf.Sprintf(`UPDATE gitserver_repos SET clone_status = 'cloned', last_changed = NOW() - '1 hour'::interval WHERE repo_id IN (1, 2)`),
+	} {
+		if err := store.db.Exec(ctx, q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Repositories that were never synced are stale, uncloned ones are skipped.
+	repos, err := store.ListReposWithStaleDependencies(ctx, 10, time.Hour*24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]types.MinimalRepo{{ID: 1, Name: "r1"}, {ID: 2, Name: "r2"}}, repos); diff != "" {
+		t.Fatalf("unexpected repositories (-want +got):\n%s", diff)
+	}
+
+	v := semver.MustParse("18.2.0-rc.1")
+	deps := []shared.RepoDependency{
+		{Source: shared.RepoDependencySourceManifest, Path: "package.json", Scheme: "npm", Name: "react", Version: "18.2.0-rc.1", Semver: v},
+		{Source: shared.RepoDependencySourceManifest, Path: "package.json", Scheme: "npm", Name: "react", Version: "18.2.0-rc.1", Semver: v},
+		{Source: shared.RepoDependencySourceUpload, Path: "", Scheme: "go", Name: "golang.org/x/mod", Version: ""},
+	}
+	if err := store.UpdateRepoDependencies(ctx, 1, deps); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := store.ListRepoDependencies(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]shared.RepoDependency{deps[0], deps[2]}, got, semverComparer); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+
+	repos, err = store.ListReposWithStaleDependencies(ctx, 10, time.Hour*24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]types.MinimalRepo{{ID: 2, Name: "r2"}}, repos); diff != "" {
+		t.Fatalf("unexpected repositories (-want +got):\n%s", diff)
+	}
+
+	// Updating replaces the previous inventory.
+	if err := store.UpdateRepoDependencies(ctx, 1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.ListRepoDependencies(ctx, 1); err != nil {
+		t.Fatal(err)
+	} else if len(got) != 0 {
+		t.Errorf("unexpected dependencies: %v", got)
+	}
+}
+
+// semverComparer compares versions by value. The Equal method of
+// *semver.Version does not handle nil versions.
+var semverComparer = cmp.Comparer(func(a, b *semver.Version) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(b)
+})
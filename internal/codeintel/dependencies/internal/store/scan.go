@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/lib/pq"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
@@ -99,3 +101,26 @@ func scanPackageFilter(s dbutil.Scanner) (shared.PackageRepoFilter, error) {
 
 	return filter, nil
 }
+
+func scanRepoDependency(s dbutil.Scanner) (shared.RepoDependency, error) {
+	var (
+		dep                 shared.RepoDependency
+		major, minor, patch sql.NullInt32
+		prerelease          sql.NullString
+	)
+	if err := s.Scan(&dep.Source, &dep.Path, &dep.Scheme, &dep.Name, &dep.Version, &major, &minor, &patch, &prerelease); err != nil {
+		return dep, err
+	}
+	if major.Valid {
+		version := fmt.Sprintf("%d.%d.%d", major.Int32, minor.Int32, patch.Int32)
+		if prerelease.Valid {
+			version += "-" + prerelease.String
+		}
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			return dep, err
+		}
+		dep.Semver = v
+	}
+	return dep, nil
+}
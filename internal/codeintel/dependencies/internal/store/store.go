@@ -13,6 +13,7 @@ import (
 	"github.com/opentracing/opentracing-go/log"
 	"golang.org/x/exp/slices"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
 	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -20,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/batch"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -39,6 +41,11 @@ type Store interface {
 
 	ShouldRefilterPackageRepoRefs(ctx context.Context) (exists bool, err error)
 	UpdateAllBlockedStatuses(ctx context.Context, pkgs []shared.PackageRepoReference, startTime time.Time) (pkgsUpdated, versionsUpdated int, err error)
+
+	ListReposWithStaleDependencies(ctx context.Context, limit int, maxAge time.Duration) ([]types.MinimalRepo, error)
+	ListUploadPackageReferences(ctx context.Context, repoID api.RepoID) ([]shared.RepoDependency, error)
+	UpdateRepoDependencies(ctx context.Context, repoID api.RepoID, deps []shared.RepoDependency) error
+	ListRepoDependencies(ctx context.Context, repoID api.RepoID) ([]shared.RepoDependency, error)
 }
 
 // store manages the database tables for package dependencies.
@@ -3,6 +3,8 @@ package shared
 import (
 	"time"
 
+	"github.com/Masterminds/semver"
+
 	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 )
 
@@ -69,3 +71,24 @@ type PackageRepoFilter struct {
 	DeletedAt *time.Time
 	UpdatedAt time.Time
 }
+
+// RepoDependency is an entry of the per-repository dependency inventory, which
+// backs the repo:depends.on() search predicate.
+type RepoDependency struct {
+	// Source is either RepoDependencySourceManifest or RepoDependencySourceUpload.
+	Source string
+	// Path is the manifest or lockfile path, or the root of the upload.
+	Path    string
+	Scheme  string
+	Name    string
+	Version string
+	// Semver is the lowest semantic version that Version stands for, which is
+	// Version itself for lockfiles. It is nil if Version cannot be interpreted
+	// as a semantic version, in which case it only matches unversioned queries.
+	Semver *semver.Version
+}
+
+const (
+	RepoDependencySourceManifest = "manifest"
+	RepoDependencySourceUpload   = "upload"
+)
@@ -29,6 +29,10 @@ type SentinelServiceResolver interface {
 	VulnerabilityMatches(ctx context.Context, args GetVulnerabilityMatchesArgs) (VulnerabilityMatchConnectionResolver, error)
 	VulnerabilityByID(ctx context.Context, id graphql.ID) (_ VulnerabilityResolver, err error)
 	VulnerabilityMatchByID(ctx context.Context, id graphql.ID) (_ VulnerabilityMatchResolver, err error)
+	VulnerabilitySuppressionRules(ctx context.Context, args GetVulnerabilitySuppressionRulesArgs) (VulnerabilitySuppressionRuleConnectionResolver, error)
+	TriageVulnerabilityMatch(ctx context.Context, args TriageVulnerabilityMatchArgs) (VulnerabilityMatchResolver, error)
+	CreateVulnerabilitySuppressionRule(ctx context.Context, args CreateVulnerabilitySuppressionRuleArgs) (VulnerabilitySuppressionRuleResolver, error)
+	DeleteVulnerabilitySuppressionRule(ctx context.Context, args DeleteVulnerabilitySuppressionRuleArgs) (*EmptyResponse, error)
 }
 
 type GetVulnerabilitiesArgs struct {
@@ -37,8 +41,33 @@ type GetVulnerabilitiesArgs struct {
 }
 
 type GetVulnerabilityMatchesArgs struct {
-	First *int32
-	After *string
+	First  *int32
+	After  *string
+	States *[]string
+}
+
+type GetVulnerabilitySuppressionRulesArgs struct {
+	Repository *graphql.ID
+	First      *int32
+	After      *string
+}
+
+type TriageVulnerabilityMatchArgs struct {
+	ID            graphql.ID
+	State         string
+	Justification *string
+}
+
+type CreateVulnerabilitySuppressionRuleArgs struct {
+	Repository    graphql.ID
+	Vulnerability string
+	PackageName   *string
+	State         string
+	Justification string
+}
+
+type DeleteVulnerabilitySuppressionRuleArgs struct {
+	ID graphql.ID
 }
 
 type VulnerabilityConnectionResolver interface {
@@ -53,6 +82,12 @@ type VulnerabilityMatchConnectionResolver interface {
 	PageInfo() PageInfo
 }
 
+type VulnerabilitySuppressionRuleConnectionResolver interface {
+	Nodes() []VulnerabilitySuppressionRuleResolver
+	TotalCount() *int32
+	PageInfo() PageInfo
+}
+
 type VulnerabilityResolver interface {
 	ID() graphql.ID
 	SourceID() string
@@ -93,6 +128,20 @@ type VulnerabilityMatchResolver interface {
 	Vulnerability(ctx context.Context) (VulnerabilityResolver, error)
 	AffectedPackage(ctx context.Context) (VulnerabilityAffectedPackageResolver, error)
 	PreciseIndex(ctx context.Context) (PreciseIndexResolver, error)
+	State() string
+	Justification() *string
+	TriagedAt() *gqlutil.DateTime
+	SuppressedByRule() bool
+}
+
+type VulnerabilitySuppressionRuleResolver interface {
+	ID() graphql.ID
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	Vulnerability() string
+	PackageName() *string
+	State() string
+	Justification() string
+	CreatedAt() gqlutil.DateTime
 }
 
 type CodeNavServiceResolver interface {
@@ -0,0 +1,49 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMercurialCloneURLToRepoName(t *testing.T) {
+	tests := []struct {
+		conn schema.MercurialConnection
+		urls []urlToRepoNameErr
+	}{
+		{
+			conn: schema.MercurialConnection{
+				Url:   "https://hg.example.org",
+				Repos: []string{"projects/foo"},
+			},
+			urls: []urlToRepoNameErr{
+				{"https://hg.example.org/projects/foo", "hg.example.org/projects/foo", nil},
+				{"https://hg.example.org/projects/foo/", "hg.example.org/projects/foo", nil},
+				{"https://hg.example.com/projects/foo", "", nil},
+			},
+		},
+		{
+			conn: schema.MercurialConnection{
+				Url:                   "ssh://hg@hg.example.org/repos",
+				RepositoryPathPattern: "hg/{repo}",
+				Repos:                 []string{"foo"},
+			},
+			urls: []urlToRepoNameErr{
+				{"ssh://hg@hg.example.org/repos/foo", "hg/foo", nil},
+				{"ssh://hg@hg.example.org/other/foo", "", nil},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, u := range test.urls {
+			repoName, err := Mercurial{&test.conn}.CloneURLToRepoName(u.cloneURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.repoName != string(repoName) {
+				t.Errorf("expected %q but got %q for clone URL %q (connection: %+v)", u.repoName, repoName, u.cloneURL, test.conn)
+			}
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// AuditLogStore provides access to the `audit_logs` table, which persists the
+// records of the audit log as a hash chain.
+type AuditLogStore interface {
+	basestore.ShareableStore
+	With(basestore.ShareableStore) AuditLogStore
+
+	// Append adds the given entry to the end of the audit log, chaining it to
+	// the last entry. It sets the ID, PrevHash and Hash of the entry.
+	Append(ctx context.Context, entry *audit.Entry) error
+	// List returns the entries matching the given options.
+	List(ctx context.Context, opts AuditLogListOpts) ([]*audit.Entry, error)
+	// Count counts the entries matching the filters of the given options,
+	// ignoring Cursor and Limit.
+	Count(ctx context.Context, opts AuditLogListOpts) (int, error)
+	// Verify walks the whole audit log and checks that no entry was modified or
+	// removed, except for the oldest entries removed by DeleteOlderThan. If
+	// head is not nil, the audit log must still contain it.
+	Verify(ctx context.Context, head *audit.ChainHead) (*AuditLogVerification, error)
+	// DeleteOlderThan removes the oldest entries of the audit log, up to and
+	// including the last entry created before the given time. The newest entry
+	// is never removed.
+	DeleteOlderThan(ctx context.Context, before time.Time) error
+}
+
+// AuditLogListOpts provide the options when listing audit log entries.
+type AuditLogListOpts struct {
+	// Actor filters the entries by the UID of the actor.
+	Actor string
+	// Entity filters the entries by the audited entity.
+	Entity string
+	// Action filters the entries by the audited action.
+	Action string
+	// Since, if set, only includes entries created at or after this time.
+	Since time.Time
+	// Until, if set, only includes entries created before this time.
+	Until time.Time
+
+	// Ascending lists the oldest entries first. By default, the newest entries
+	// are listed first.
+	Ascending bool
+	// Cursor, if set, only includes entries that are listed after the entry
+	// with this ID.
+	Cursor int64
+	// Limit is the maximum number of entries to return. Zero means no limit.
+	Limit int
+}
+
+func (opts AuditLogListOpts) sqlConds() *sqlf.Query {
+	preds := []*sqlf.Query{}
+
+	if opts.Actor != "" {
+		preds = append(preds, sqlf.Sprintf("actor = %s", opts.Actor))
+	}
+	if opts.Entity != "" {
+		preds = append(preds, sqlf.Sprintf("entity = %s", opts.Entity))
+	}
+	if opts.Action != "" {
+		preds = append(preds, sqlf.Sprintf("action = %s", opts.Action))
+	}
+	if !opts.Since.IsZero() {
+		preds = append(preds, sqlf.Sprintf("created_at >= %s", opts.Since))
+	}
+	if !opts.Until.IsZero() {
+		preds = append(preds, sqlf.Sprintf("created_at < %s", opts.Until))
+	}
+
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+
+	return sqlf.Join(preds, "\n AND ")
+}
+
+func (opts AuditLogListOpts) cursorCond() *sqlf.Query {
+	switch {
+	case opts.Cursor == 0:
+		return sqlf.Sprintf("TRUE")
+	case opts.Ascending:
+		return sqlf.Sprintf("id > %s", opts.Cursor)
+	default:
+		return sqlf.Sprintf("id < %s", opts.Cursor)
+	}
+}
+
+func (opts AuditLogListOpts) orderSQL() *sqlf.Query {
+	if opts.Ascending {
+		return sqlf.Sprintf("ORDER BY id ASC")
+	}
+	return sqlf.Sprintf("ORDER BY id DESC")
+}
+
+func (opts AuditLogListOpts) limitSQL() *sqlf.Query {
+	if opts.Limit == 0 {
+		return &sqlf.Query{}
+	}
+	return (&LimitOffset{Limit: opts.Limit}).SQL()
+}
+
+// AuditLogVerification is the result of verifying the hash chain of the audit
+// log.
+type AuditLogVerification struct {
+	// Checked is the number of entries that were verified before the first
+	// broken entry, if any.
+	Checked int
+	// BrokenEntry is the first entry that breaks the hash chain. It is nil if
+	// the audit log is intact, or if only its newest entries were removed.
+	BrokenEntry *audit.Entry
+	// Reason describes how the hash chain is broken. It is empty if the audit
+	// log is intact.
+	Reason string
+}
+
+// Valid returns true if the hash chain of the audit log is intact.
+func (v *AuditLogVerification) Valid() bool {
+	return v.Reason == ""
+}
+
+// auditLogVerifyBatchSize is the number of entries Verify reads at once.
+const auditLogVerifyBatchSize = 1000
+
+type auditLogStore struct {
+	*basestore.Store
+}
+
+// AuditLogsWith instantiates and returns a new AuditLogStore using the other store handle.
+func AuditLogsWith(other basestore.ShareableStore) AuditLogStore {
+	return &auditLogStore{
+		Store: basestore.NewWithHandle(other.Handle()),
+	}
+}
+
+func (s *auditLogStore) With(other basestore.ShareableStore) AuditLogStore {
+	return &auditLogStore{
+		Store: s.Store.With(other),
+	}
+}
+
+func (s *auditLogStore) Append(ctx context.Context, entry *audit.Entry) error {
+	return s.WithTransact(ctx, func(tx *basestore.Store) error {
+		// Appends are serialized so that every entry is chained to the entry
+		// that was committed right before it. Reads are not blocked.
+		if err := tx.Exec(ctx, sqlf.Sprintf("LOCK TABLE audit_logs IN SHARE ROW EXCLUSIVE MODE")); err != nil {
+			return err
+		}
+
+		prevHash, _, err := basestore.ScanFirstString(tx.Query(ctx, sqlf.Sprintf(auditLogLastHashQueryFmtstr)))
+		if err != nil {
+			return err
+		}
+
+		if err := entry.Seal(prevHash); err != nil {
+			return err
+		}
+
+		fields := entry.Fields
+		if len(fields) == 0 {
+			fields = []byte("{}")
+		}
+
+		q := sqlf.Sprintf(
+			auditLogAppendQueryFmtstr,
+			entry.AuditID,
+			entry.Timestamp,
+			entry.Actor,
+			entry.IP,
+			entry.ForwardedFor,
+			entry.Entity,
+			entry.Action,
+			string(fields),
+			entry.PrevHash,
+			entry.Hash,
+		)
+		id, _, err := basestore.ScanFirstInt64(tx.Query(ctx, q))
+		if err != nil {
+			return err
+		}
+		entry.ID = id
+		return nil
+	})
+}
+
+const auditLogLastHashQueryFmtstr = `
+SELECT hash FROM audit_logs ORDER BY id DESC LIMIT 1
+`
+
+const auditLogAppendQueryFmtstr = `
+INSERT INTO audit_logs (
+	audit_id,
+	created_at,
+	actor,
+	ip,
+	forwarded_for,
+	entity,
+	action,
+	fields,
+	prev_hash,
+	hash
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING id
+`
+
+func (s *auditLogStore) List(ctx context.Context, opts AuditLogListOpts) (_ []*audit.Entry, err error) {
+	q := sqlf.Sprintf(
+		auditLogListQueryFmtstr,
+		sqlf.Join(auditLogColumns, ", "),
+		opts.sqlConds(),
+		opts.cursorCond(),
+		opts.orderSQL(),
+		opts.limitSQL(),
+	)
+
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var entries []*audit.Entry
+	for rows.Next() {
+		var entry audit.Entry
+		if err := scanAuditLogEntry(&entry, rows); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+const auditLogListQueryFmtstr = `
+SELECT %s
+FROM audit_logs
+WHERE (%s) AND %s
+%s
+%s  -- LIMIT clause
+`
+
+func (s *auditLogStore) Count(ctx context.Context, opts AuditLogListOpts) (int, error) {
+	q := sqlf.Sprintf(auditLogCountQueryFmtstr, opts.sqlConds())
+
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, q))
+	return count, err
+}
+
+const auditLogCountQueryFmtstr = `
+SELECT COUNT(*)
+FROM audit_logs
+WHERE %s
+`
+
+func (s *auditLogStore) Verify(ctx context.Context, head *audit.ChainHead) (*AuditLogVerification, error) {
+	var (
+		verifier     = audit.ChainVerifier{Head: head}
+		verification AuditLogVerification
+		cursor       int64
+	)
+	for {
+		entries, err := s.List(ctx, AuditLogListOpts{
+			Ascending: true,
+			Cursor:    cursor,
+			Limit:     auditLogVerifyBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if err := verifier.Verify(entry); err != nil {
+				verification.BrokenEntry = entry
+				verification.Reason = err.Error()
+				return &verification, nil
+			}
+			verification.Checked++
+		}
+
+		if len(entries) < auditLogVerifyBatchSize {
+			if err := verifier.Done(); err != nil {
+				verification.Reason = err.Error()
+			}
+			return &verification, nil
+		}
+		cursor = entries[len(entries)-1].ID
+	}
+}
+
+func (s *auditLogStore) DeleteOlderThan(ctx context.Context, before time.Time) error {
+	return s.WithTransact(ctx, func(tx *basestore.Store) error {
+		// The audit_logs_prevent_delete trigger rejects deletions outside of
+		// transactions that set this.
+		if err := tx.Exec(ctx, sqlf.Sprintf("SET LOCAL audit_logs.retention = 'on'")); err != nil {
+			return err
+		}
+		return tx.Exec(ctx, sqlf.Sprintf(auditLogDeleteOlderThanQueryFmtstr, before))
+	})
+}
+
+// Entries are created with the clock of the service that recorded them, so
+// created_at is not strictly ordered. Deleting by ID keeps the remaining
+// entries a contiguous part of the hash chain. The newest entry is kept so
+// that the head of the chain can still be verified.
+const auditLogDeleteOlderThanQueryFmtstr = `
+DELETE FROM audit_logs
+WHERE
+	id <= (SELECT MAX(id) FROM audit_logs WHERE created_at < %s) AND
+	id < (SELECT MAX(id) FROM audit_logs)
+`
+
+// auditLogColumns are the columns that must be selected by audit_logs queries
+// in order to use scanAuditLogEntry().
+var auditLogColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("audit_id"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("actor"),
+	sqlf.Sprintf("ip"),
+	sqlf.Sprintf("forwarded_for"),
+	sqlf.Sprintf("entity"),
+	sqlf.Sprintf("action"),
+	sqlf.Sprintf("fields"),
+	sqlf.Sprintf("prev_hash"),
+	sqlf.Sprintf("hash"),
+}
+
+// scanAuditLogEntry scans an audit.Entry from the given scanner into the given
+// entry.
+func scanAuditLogEntry(entry *audit.Entry, s dbutil.Scanner) error {
+	return s.Scan(
+		&entry.ID,
+		&entry.AuditID,
+		&entry.Timestamp,
+		&entry.Actor,
+		&entry.IP,
+		&entry.ForwardedFor,
+		&entry.Entity,
+		&entry.Action,
+		&entry.Fields,
+		&entry.PrevHash,
+		&entry.Hash,
+	)
+}
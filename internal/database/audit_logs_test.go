@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestAuditLogs_AppendListCount(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	store := db.AuditLogs()
+
+	start := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*audit.Entry{
+		{AuditID: "1", Timestamp: start, Actor: "1", Entity: "gitserver", Action: "access", Fields: json.RawMessage(`{"repo": "github.com/foo/bar"}`)},
+		{AuditID: "2", Timestamp: start.Add(time.Hour), Actor: "2", Entity: "gitserver", Action: "access"},
+		{AuditID: "3", Timestamp: start.Add(2 * time.Hour), Actor: "1", Entity: "security events", Action: "SignInSucceeded"},
+	}
+	for _, e := range entries {
+		require.NoError(t, store.Append(ctx, e))
+		require.NotZero(t, e.ID)
+	}
+	assert.Empty(t, entries[0].PrevHash)
+	assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+
+	ids := func(entries []*audit.Entry) []string {
+		var ids []string
+		for _, e := range entries {
+			ids = append(ids, e.AuditID)
+		}
+		return ids
+	}
+
+	for _, tc := range []struct {
+		name string
+		opts AuditLogListOpts
+		want []string
+	}{
+		{name: "all", opts: AuditLogListOpts{}, want: []string{"3", "2", "1"}},
+		{name: "ascending", opts: AuditLogListOpts{Ascending: true}, want: []string{"1", "2", "3"}},
+		{name: "actor", opts: AuditLogListOpts{Actor: "1"}, want: []string{"3", "1"}},
+		{name: "entity and action", opts: AuditLogListOpts{Entity: "gitserver", Action: "access"}, want: []string{"2", "1"}},
+		{name: "time range", opts: AuditLogListOpts{Since: start.Add(time.Hour), Until: start.Add(2 * time.Hour)}, want: []string{"2"}},
+		{name: "first page", opts: AuditLogListOpts{Limit: 2}, want: []string{"3", "2"}},
+		{name: "second page", opts: AuditLogListOpts{Limit: 2, Cursor: entries[1].ID}, want: []string{"1"}},
+		{name: "second page ascending", opts: AuditLogListOpts{Limit: 2, Cursor: entries[1].ID, Ascending: true}, want: []string{"3"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := store.List(ctx, tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ids(have))
+
+			count, err := store.Count(ctx, AuditLogListOpts{Actor: tc.opts.Actor, Entity: tc.opts.Entity, Action: tc.opts.Action, Since: tc.opts.Since, Until: tc.opts.Until})
+			require.NoError(t, err)
+			if tc.opts.Limit == 0 {
+				assert.Equal(t, len(tc.want), count)
+			}
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		have, err := store.List(ctx, AuditLogListOpts{Limit: 1, Ascending: true})
+		require.NoError(t, err)
+		require.Len(t, have, 1)
+		assert.Equal(t, entries[0].Timestamp, have[0].Timestamp.UTC())
+		assert.JSONEq(t, string(entries[0].Fields), string(have[0].Fields))
+		assert.Equal(t, entries[0].Hash, have[0].Hash)
+	})
+}
+
+func TestAuditLogs_Verify(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	store := db.AuditLogs()
+
+	start := time.Now().Add(-48 * time.Hour)
+	var entries []*audit.Entry
+	for i := 0; i < 5; i++ {
+		e := &audit.Entry{
+			AuditID:   string(rune('a' + i)),
+			Timestamp: start.Add(time.Duration(i) * time.Hour),
+			Actor:     "1",
+			Entity:    "test",
+			Action:    "test",
+			Fields:    json.RawMessage(`{"b": 1, "a": {"c": [1, 2]}}`),
+		}
+		require.NoError(t, store.Append(ctx, e))
+		entries = append(entries, e)
+	}
+
+	verification, err := store.Verify(ctx, nil)
+	require.NoError(t, err)
+	assert.True(t, verification.Valid())
+	assert.Equal(t, 5, verification.Checked)
+
+	t.Run("entries cannot be updated", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "UPDATE audit_logs SET actor = '2' WHERE id = $1", entries[1].ID)
+		require.ErrorContains(t, err, "audit log entries cannot be modified")
+	})
+
+	t.Run("entries can only be deleted by the retention policy", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "DELETE FROM audit_logs WHERE id = $1", entries[1].ID)
+		require.ErrorContains(t, err, "audit log entries can only be removed by the retention policy")
+		_, err = db.ExecContext(ctx, "TRUNCATE audit_logs")
+		require.ErrorContains(t, err, "audit log entries can only be removed by the retention policy")
+	})
+
+	t.Run("retention keeps a valid chain", func(t *testing.T) {
+		require.NoError(t, store.DeleteOlderThan(ctx, entries[2].Timestamp))
+
+		count, err := store.Count(ctx, AuditLogListOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		verification, err := store.Verify(ctx, nil)
+		require.NoError(t, err)
+		assert.True(t, verification.Valid())
+		assert.Equal(t, 3, verification.Checked)
+	})
+
+	head := &audit.ChainHead{ID: entries[4].ID, Hash: entries[4].Hash}
+
+	t.Run("chain head", func(t *testing.T) {
+		verification, err := store.Verify(ctx, head)
+		require.NoError(t, err)
+		assert.True(t, verification.Valid())
+
+		verification, err = store.Verify(ctx, &audit.ChainHead{ID: entries[4].ID, Hash: entries[3].Hash})
+		require.NoError(t, err)
+		assert.False(t, verification.Valid())
+		assert.Equal(t, entries[4].ID, verification.BrokenEntry.ID)
+		assert.Contains(t, verification.Reason, "does not match the recorded chain head")
+	})
+
+	t.Run("removed entry", func(t *testing.T) {
+		// Disable the trigger the way someone with direct database access could.
+		_, err := db.ExecContext(ctx, "ALTER TABLE audit_logs DISABLE TRIGGER audit_logs_prevent_delete")
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, "DELETE FROM audit_logs WHERE id = $1", entries[3].ID)
+		require.NoError(t, err)
+
+		verification, err := store.Verify(ctx, nil)
+		require.NoError(t, err)
+		assert.False(t, verification.Valid())
+		assert.Equal(t, entries[4].ID, verification.BrokenEntry.ID)
+		assert.Equal(t, 1, verification.Checked)
+	})
+
+	t.Run("newest entries removed", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "DELETE FROM audit_logs WHERE id = $1", entries[4].ID)
+		require.NoError(t, err)
+
+		// Without the chain head, the remaining entries look intact.
+		verification, err := store.Verify(ctx, nil)
+		require.NoError(t, err)
+		assert.True(t, verification.Valid())
+
+		verification, err = store.Verify(ctx, head)
+		require.NoError(t, err)
+		assert.False(t, verification.Valid())
+		assert.Nil(t, verification.BrokenEntry)
+		assert.Contains(t, verification.Reason, "the newest entries were removed")
+	})
+
+	t.Run("modified entry", func(t *testing.T) {
+		// Disable the trigger the way someone with direct database access could.
+		_, err := db.ExecContext(ctx, "ALTER TABLE audit_logs DISABLE TRIGGER audit_logs_prevent_update")
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, "UPDATE audit_logs SET actor = '2' WHERE id = $1", entries[2].ID)
+		require.NoError(t, err)
+
+		verification, err := store.Verify(ctx, nil)
+		require.NoError(t, err)
+		assert.False(t, verification.Valid())
+		assert.Equal(t, entries[2].ID, verification.BrokenEntry.ID)
+		assert.Contains(t, verification.Reason, "does not match its hash")
+	})
+}
+
+func TestAuditLogs_DeleteOlderThanKeepsNewestEntry(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	store := db.AuditLogs()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, store.Append(ctx, &audit.Entry{
+			AuditID:   string(rune('a' + i)),
+			Timestamp: time.Now().Add(-time.Duration(3-i) * time.Hour),
+			Actor:     "1",
+			Entity:    "test",
+			Action:    "test",
+		}))
+	}
+
+	require.NoError(t, store.DeleteOlderThan(ctx, time.Now()))
+
+	entries, err := store.List(ctx, AuditLogListOpts{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "c", entries[0].AuditID)
+}
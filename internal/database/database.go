@@ -22,6 +22,7 @@ type DB interface {
 
 	AccessRequests() AccessRequestStore
 	AccessTokens() AccessTokenStore
+	AuditLogs() AuditLogStore
 	Authz() AuthzStore
 	BitbucketProjectPermissions() BitbucketProjectPermissionsStore
 	Conf() ConfStore
@@ -128,6 +129,10 @@ func (d *db) BitbucketProjectPermissions() BitbucketProjectPermissionsStore {
 	return BitbucketProjectPermissionsStoreWith(d.Store)
 }
 
+func (d *db) AuditLogs() AuditLogStore {
+	return AuditLogsWith(d.Store)
+}
+
 func (d *db) Authz() AuthzStore {
 	return AuthzWith(d.Store)
 }
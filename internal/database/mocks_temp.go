@@ -15,6 +15,7 @@ import (
 	uuid "github.com/google/uuid"
 	sqlf "github.com/keegancsmith/sqlf"
 	api "github.com/sourcegraph/sourcegraph/internal/api"
+	audit "github.com/sourcegraph/sourcegraph/internal/audit"
 	conf "github.com/sourcegraph/sourcegraph/internal/conf"
 	basestore "github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	encryption "github.com/sourcegraph/sourcegraph/internal/encryption"
@@ -2811,6 +2812,876 @@ func (c AccessTokenStoreWithTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockAuditLogStore is a mock implementation of the AuditLogStore interface
+// (from the package github.com/sourcegraph/sourcegraph/internal/database)
+// used for unit testing.
+type MockAuditLogStore struct {
+	// AppendFunc is an instance of a mock function object controlling the
+	// behavior of the method Append.
+	AppendFunc *AuditLogStoreAppendFunc
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *AuditLogStoreCountFunc
+	// DeleteOlderThanFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteOlderThan.
+	DeleteOlderThanFunc *AuditLogStoreDeleteOlderThanFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *AuditLogStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *AuditLogStoreListFunc
+	// VerifyFunc is an instance of a mock function object controlling the
+	// behavior of the method Verify.
+	VerifyFunc *AuditLogStoreVerifyFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *AuditLogStoreWithFunc
+}
+
+// NewMockAuditLogStore creates a new mock of the AuditLogStore interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockAuditLogStore() *MockAuditLogStore {
+	return &MockAuditLogStore{
+		AppendFunc: &AuditLogStoreAppendFunc{
+			defaultHook: func(context.Context, *audit.Entry) (r0 error) {
+				return
+			},
+		},
+		CountFunc: &AuditLogStoreCountFunc{
+			defaultHook: func(context.Context, AuditLogListOpts) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteOlderThanFunc: &AuditLogStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Time) (r0 error) {
+				return
+			},
+		},
+		HandleFunc: &AuditLogStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &AuditLogStoreListFunc{
+			defaultHook: func(context.Context, AuditLogListOpts) (r0 []*audit.Entry, r1 error) {
+				return
+			},
+		},
+		VerifyFunc: &AuditLogStoreVerifyFunc{
+			defaultHook: func(context.Context, *audit.ChainHead) (r0 *AuditLogVerification, r1 error) {
+				return
+			},
+		},
+		WithFunc: &AuditLogStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 AuditLogStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockAuditLogStore creates a new mock of the AuditLogStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockAuditLogStore() *MockAuditLogStore {
+	return &MockAuditLogStore{
+		AppendFunc: &AuditLogStoreAppendFunc{
+			defaultHook: func(context.Context, *audit.Entry) error {
+				panic("unexpected invocation of MockAuditLogStore.Append")
+			},
+		},
+		CountFunc: &AuditLogStoreCountFunc{
+			defaultHook: func(context.Context, AuditLogListOpts) (int, error) {
+				panic("unexpected invocation of MockAuditLogStore.Count")
+			},
+		},
+		DeleteOlderThanFunc: &AuditLogStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Time) error {
+				panic("unexpected invocation of MockAuditLogStore.DeleteOlderThan")
+			},
+		},
+		HandleFunc: &AuditLogStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockAuditLogStore.Handle")
+			},
+		},
+		ListFunc: &AuditLogStoreListFunc{
+			defaultHook: func(context.Context, AuditLogListOpts) ([]*audit.Entry, error) {
+				panic("unexpected invocation of MockAuditLogStore.List")
+			},
+		},
+		VerifyFunc: &AuditLogStoreVerifyFunc{
+			defaultHook: func(context.Context, *audit.ChainHead) (*AuditLogVerification, error) {
+				panic("unexpected invocation of MockAuditLogStore.Verify")
+			},
+		},
+		WithFunc: &AuditLogStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) AuditLogStore {
+				panic("unexpected invocation of MockAuditLogStore.With")
+			},
+		},
+	}
+}
+
+// NewMockAuditLogStoreFrom creates a new mock of the MockAuditLogStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockAuditLogStoreFrom(i AuditLogStore) *MockAuditLogStore {
+	return &MockAuditLogStore{
+		AppendFunc: &AuditLogStoreAppendFunc{
+			defaultHook: i.Append,
+		},
+		CountFunc: &AuditLogStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		DeleteOlderThanFunc: &AuditLogStoreDeleteOlderThanFunc{
+			defaultHook: i.DeleteOlderThan,
+		},
+		HandleFunc: &AuditLogStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &AuditLogStoreListFunc{
+			defaultHook: i.List,
+		},
+		VerifyFunc: &AuditLogStoreVerifyFunc{
+			defaultHook: i.Verify,
+		},
+		WithFunc: &AuditLogStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// AuditLogStoreAppendFunc describes the behavior when the Append method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreAppendFunc struct {
+	defaultHook func(context.Context, *audit.Entry) error
+	hooks       []func(context.Context, *audit.Entry) error
+	history     []AuditLogStoreAppendFuncCall
+	mutex       sync.Mutex
+}
+
+// Append delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Append(v0 context.Context, v1 *audit.Entry) error {
+	r0 := m.AppendFunc.nextHook()(v0, v1)
+	m.AppendFunc.appendCall(AuditLogStoreAppendFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Append method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreAppendFunc) SetDefaultHook(hook func(context.Context, *audit.Entry) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Append method of the parent MockAuditLogStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreAppendFunc) PushHook(hook func(context.Context, *audit.Entry) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreAppendFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *audit.Entry) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreAppendFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *audit.Entry) error {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreAppendFunc) nextHook() func(context.Context, *audit.Entry) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreAppendFunc) appendCall(r0 AuditLogStoreAppendFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreAppendFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreAppendFunc) History() []AuditLogStoreAppendFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreAppendFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreAppendFuncCall is an object that describes an invocation of
+// method Append on an instance of MockAuditLogStore.
+type AuditLogStoreAppendFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *audit.Entry
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreAppendFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreAppendFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AuditLogStoreCountFunc describes the behavior when the Count method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreCountFunc struct {
+	defaultHook func(context.Context, AuditLogListOpts) (int, error)
+	hooks       []func(context.Context, AuditLogListOpts) (int, error)
+	history     []AuditLogStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Count(v0 context.Context, v1 AuditLogListOpts) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(AuditLogStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreCountFunc) SetDefaultHook(hook func(context.Context, AuditLogListOpts) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockAuditLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreCountFunc) PushHook(hook func(context.Context, AuditLogListOpts) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, AuditLogListOpts) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, AuditLogListOpts) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *AuditLogStoreCountFunc) nextHook() func(context.Context, AuditLogListOpts) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreCountFunc) appendCall(r0 AuditLogStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreCountFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreCountFunc) History() []AuditLogStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockAuditLogStore.
+type AuditLogStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 AuditLogListOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AuditLogStoreDeleteOlderThanFunc describes the behavior when the
+// DeleteOlderThan method of the parent MockAuditLogStore instance is
+// invoked.
+type AuditLogStoreDeleteOlderThanFunc struct {
+	defaultHook func(context.Context, time.Time) error
+	hooks       []func(context.Context, time.Time) error
+	history     []AuditLogStoreDeleteOlderThanFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteOlderThan delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAuditLogStore) DeleteOlderThan(v0 context.Context, v1 time.Time) error {
+	r0 := m.DeleteOlderThanFunc.nextHook()(v0, v1)
+	m.DeleteOlderThanFunc.appendCall(AuditLogStoreDeleteOlderThanFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteOlderThan
+// method of the parent MockAuditLogStore instance is invoked and the hook
+// queue is empty.
+func (f *AuditLogStoreDeleteOlderThanFunc) SetDefaultHook(hook func(context.Context, time.Time) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteOlderThan method of the parent MockAuditLogStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AuditLogStoreDeleteOlderThanFunc) PushHook(hook func(context.Context, time.Time) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreDeleteOlderThanFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreDeleteOlderThanFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreDeleteOlderThanFunc) nextHook() func(context.Context, time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreDeleteOlderThanFunc) appendCall(r0 AuditLogStoreDeleteOlderThanFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreDeleteOlderThanFuncCall
+// objects describing the invocations of this function.
+func (f *AuditLogStoreDeleteOlderThanFunc) History() []AuditLogStoreDeleteOlderThanFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreDeleteOlderThanFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreDeleteOlderThanFuncCall is an object that describes an
+// invocation of method DeleteOlderThan on an instance of MockAuditLogStore.
+type AuditLogStoreDeleteOlderThanFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreDeleteOlderThanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreDeleteOlderThanFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AuditLogStoreHandleFunc describes the behavior when the Handle method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []AuditLogStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(AuditLogStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockAuditLogStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreHandleFunc) appendCall(r0 AuditLogStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreHandleFunc) History() []AuditLogStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockAuditLogStore.
+type AuditLogStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AuditLogStoreListFunc describes the behavior when the List method of the
+// parent MockAuditLogStore instance is invoked.
+type AuditLogStoreListFunc struct {
+	defaultHook func(context.Context, AuditLogListOpts) ([]*audit.Entry, error)
+	hooks       []func(context.Context, AuditLogListOpts) ([]*audit.Entry, error)
+	history     []AuditLogStoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) List(v0 context.Context, v1 AuditLogListOpts) ([]*audit.Entry, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(AuditLogStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreListFunc) SetDefaultHook(hook func(context.Context, AuditLogListOpts) ([]*audit.Entry, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockAuditLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreListFunc) PushHook(hook func(context.Context, AuditLogListOpts) ([]*audit.Entry, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreListFunc) SetDefaultReturn(r0 []*audit.Entry, r1 error) {
+	f.SetDefaultHook(func(context.Context, AuditLogListOpts) ([]*audit.Entry, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreListFunc) PushReturn(r0 []*audit.Entry, r1 error) {
+	f.PushHook(func(context.Context, AuditLogListOpts) ([]*audit.Entry, error) {
+		return r0, r1
+	})
+}
+
+func (f *AuditLogStoreListFunc) nextHook() func(context.Context, AuditLogListOpts) ([]*audit.Entry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreListFunc) appendCall(r0 AuditLogStoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreListFunc) History() []AuditLogStoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreListFuncCall is an object that describes an invocation of
+// method List on an instance of MockAuditLogStore.
+type AuditLogStoreListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 AuditLogListOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*audit.Entry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AuditLogStoreVerifyFunc describes the behavior when the Verify method of
+// the parent MockAuditLogStore instance is invoked.
+type AuditLogStoreVerifyFunc struct {
+	defaultHook func(context.Context, *audit.ChainHead) (*AuditLogVerification, error)
+	hooks       []func(context.Context, *audit.ChainHead) (*AuditLogVerification, error)
+	history     []AuditLogStoreVerifyFuncCall
+	mutex       sync.Mutex
+}
+
+// Verify delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) Verify(v0 context.Context, v1 *audit.ChainHead) (*AuditLogVerification, error) {
+	r0, r1 := m.VerifyFunc.nextHook()(v0, v1)
+	m.VerifyFunc.appendCall(AuditLogStoreVerifyFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Verify method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreVerifyFunc) SetDefaultHook(hook func(context.Context, *audit.ChainHead) (*AuditLogVerification, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Verify method of the parent MockAuditLogStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreVerifyFunc) PushHook(hook func(context.Context, *audit.ChainHead) (*AuditLogVerification, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreVerifyFunc) SetDefaultReturn(r0 *AuditLogVerification, r1 error) {
+	f.SetDefaultHook(func(context.Context, *audit.ChainHead) (*AuditLogVerification, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreVerifyFunc) PushReturn(r0 *AuditLogVerification, r1 error) {
+	f.PushHook(func(context.Context, *audit.ChainHead) (*AuditLogVerification, error) {
+		return r0, r1
+	})
+}
+
+func (f *AuditLogStoreVerifyFunc) nextHook() func(context.Context, *audit.ChainHead) (*AuditLogVerification, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreVerifyFunc) appendCall(r0 AuditLogStoreVerifyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreVerifyFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreVerifyFunc) History() []AuditLogStoreVerifyFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreVerifyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreVerifyFuncCall is an object that describes an invocation of
+// method Verify on an instance of MockAuditLogStore.
+type AuditLogStoreVerifyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *audit.ChainHead
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *AuditLogVerification
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreVerifyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreVerifyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AuditLogStoreWithFunc describes the behavior when the With method of the
+// parent MockAuditLogStore instance is invoked.
+type AuditLogStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) AuditLogStore
+	hooks       []func(basestore.ShareableStore) AuditLogStore
+	history     []AuditLogStoreWithFuncCall
+	mutex       sync.Mutex
+}
+
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAuditLogStore) With(v0 basestore.ShareableStore) AuditLogStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(AuditLogStoreWithFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockAuditLogStore instance is invoked and the hook queue is empty.
+func (f *AuditLogStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) AuditLogStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// With method of the parent MockAuditLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AuditLogStoreWithFunc) PushHook(hook func(basestore.ShareableStore) AuditLogStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AuditLogStoreWithFunc) SetDefaultReturn(r0 AuditLogStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) AuditLogStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AuditLogStoreWithFunc) PushReturn(r0 AuditLogStore) {
+	f.PushHook(func(basestore.ShareableStore) AuditLogStore {
+		return r0
+	})
+}
+
+func (f *AuditLogStoreWithFunc) nextHook() func(basestore.ShareableStore) AuditLogStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AuditLogStoreWithFunc) appendCall(r0 AuditLogStoreWithFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AuditLogStoreWithFuncCall objects
+// describing the invocations of this function.
+func (f *AuditLogStoreWithFunc) History() []AuditLogStoreWithFuncCall {
+	f.mutex.Lock()
+	history := make([]AuditLogStoreWithFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AuditLogStoreWithFuncCall is an object that describes an invocation of
+// method With on an instance of MockAuditLogStore.
+type AuditLogStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 AuditLogStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AuditLogStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AuditLogStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockAuthzStore is a mock implementation of the AuthzStore interface (from
 // the package github.com/sourcegraph/sourcegraph/internal/database) used
 // for unit testing.
@@ -4887,6 +5758,9 @@ type MockDB struct {
 	// AccessTokensFunc is an instance of a mock function object controlling
 	// the behavior of the method AccessTokens.
 	AccessTokensFunc *DBAccessTokensFunc
+	// AuditLogsFunc is an instance of a mock function object controlling
+	// the behavior of the method AuditLogs.
+	AuditLogsFunc *DBAuditLogsFunc
 	// AuthzFunc is an instance of a mock function object controlling the
 	// behavior of the method Authz.
 	AuthzFunc *DBAuthzFunc
@@ -5048,6 +5922,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		AuditLogsFunc: &DBAuditLogsFunc{
+			defaultHook: func() (r0 AuditLogStore) {
+				return
+			},
+		},
 		AuthzFunc: &DBAuthzFunc{
 			defaultHook: func() (r0 AuthzStore) {
 				return
@@ -5305,6 +6184,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.AccessTokens")
 			},
 		},
+		AuditLogsFunc: &DBAuditLogsFunc{
+			defaultHook: func() AuditLogStore {
+				panic("unexpected invocation of MockDB.AuditLogs")
+			},
+		},
 		AuthzFunc: &DBAuthzFunc{
 			defaultHook: func() AuthzStore {
 				panic("unexpected invocation of MockDB.Authz")
@@ -5558,6 +6442,9 @@ func NewMockDBFrom(i DB) *MockDB {
 		AccessTokensFunc: &DBAccessTokensFunc{
 			defaultHook: i.AccessTokens,
 		},
+		AuditLogsFunc: &DBAuditLogsFunc{
+			defaultHook: i.AuditLogs,
+		},
 		AuthzFunc: &DBAuthzFunc{
 			defaultHook: i.Authz,
 		},
@@ -5902,6 +6789,104 @@ func (c DBAccessTokensFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBAuditLogsFunc describes the behavior when the AuditLogs method of the
+// parent MockDB instance is invoked.
+type DBAuditLogsFunc struct {
+	defaultHook func() AuditLogStore
+	hooks       []func() AuditLogStore
+	history     []DBAuditLogsFuncCall
+	mutex       sync.Mutex
+}
+
+// AuditLogs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDB) AuditLogs() AuditLogStore {
+	r0 := m.AuditLogsFunc.nextHook()()
+	m.AuditLogsFunc.appendCall(DBAuditLogsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AuditLogs method of
+// the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBAuditLogsFunc) SetDefaultHook(hook func() AuditLogStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AuditLogs method of the parent MockDB instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *DBAuditLogsFunc) PushHook(hook func() AuditLogStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBAuditLogsFunc) SetDefaultReturn(r0 AuditLogStore) {
+	f.SetDefaultHook(func() AuditLogStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBAuditLogsFunc) PushReturn(r0 AuditLogStore) {
+	f.PushHook(func() AuditLogStore {
+		return r0
+	})
+}
+
+func (f *DBAuditLogsFunc) nextHook() func() AuditLogStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBAuditLogsFunc) appendCall(r0 DBAuditLogsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBAuditLogsFuncCall objects describing the
+// invocations of this function.
+func (f *DBAuditLogsFunc) History() []DBAuditLogsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBAuditLogsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBAuditLogsFuncCall is an object that describes an invocation of method
+// AuditLogs on an instance of MockDB.
+type DBAuditLogsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 AuditLogStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBAuditLogsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBAuditLogsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBAuthzFunc describes the behavior when the Authz method of the parent
 // MockDB instance is invoked.
 type DBAuthzFunc struct {
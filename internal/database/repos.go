@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/grafana/regexp"
 	regexpsyntax "github.com/grafana/regexp/syntax"
 	"github.com/keegancsmith/sqlf"
@@ -564,6 +565,8 @@ func scanRepo(logger log.Logger, rows *sql.Rows, r *types.Repo) (err error) {
 		r.Metadata = new(pagure.Project)
 	case extsvc.TypeOther:
 		r.Metadata = new(extsvc.OtherRepoMetadata)
+	case extsvc.TypeMercurial:
+		r.Metadata = new(extsvc.MercurialRepoMetadata)
 	case extsvc.TypeJVMPackages:
 		r.Metadata = new(reposource.MavenMetadata)
 	case extsvc.TypeNpmPackages:
@@ -617,6 +620,10 @@ type ReposListOptions struct {
 	// A set of filters to select only repos with the given set of topics
 	TopicFilters []RepoTopicFilter
 
+	// A set of filters to select only repos that depend on the given packages,
+	// according to the codeintel_repo_dependencies inventory.
+	DependencyFilters []RepoDependencyFilter
+
 	// CaseSensitivePatterns determines if IncludePatterns and ExcludePattern are treated
 	// with case sensitivity or not.
 	CaseSensitivePatterns bool
@@ -769,6 +776,66 @@ type RepoTopicFilter struct {
 	Negated bool
 }
 
+type RepoDependencyFilter struct {
+	// Scheme and Name identify the package, e.g. "npm" and "@types/node".
+	Scheme string
+	Name   string
+	// Constraint restricts the matching versions of the package. If nil,
+	// every version matches, including versions that could not be parsed.
+	Constraint *semver.Constraints
+	// If negated is true, this filter will select only repos
+	// that do _not_ depend on the package
+	Negated bool
+}
+
+// dependencyVersionCond returns the condition on the version columns of
+// codeintel_repo_dependencies (aliased as rd) for the version constraint of
+// the given filter. Constraints cannot be evaluated by Postgres, so the
+// distinct versions of the package are checked against the constraint first.
+func (s *repoStore) dependencyVersionCond(ctx context.Context, filter RepoDependencyFilter) (_ *sqlf.Query, err error) {
+	if filter.Constraint == nil {
+		return sqlf.Sprintf("TRUE"), nil
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(listDependencyVersionsQuery, filter.Scheme, filter.Name))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var matching []*sqlf.Query
+	for rows.Next() {
+		var major, minor, patch int
+		var prerelease string
+		if err := rows.Scan(&major, &minor, &patch, &prerelease); err != nil {
+			return nil, err
+		}
+
+		version := fmt.Sprintf("%d.%d.%d", major, minor, patch)
+		if prerelease != "" {
+			version += "-" + prerelease
+		}
+		v, err := semver.NewVersion(version)
+		if err != nil || !filter.Constraint.Check(v) {
+			continue
+		}
+		matching = append(matching, sqlf.Sprintf("(%s::integer, %s::integer, %s::integer, %s::text)", major, minor, patch, prerelease))
+	}
+	if len(matching) == 0 {
+		return sqlf.Sprintf("FALSE"), nil
+	}
+	return sqlf.Sprintf(
+		"(rd.version_major, rd.version_minor, rd.version_patch, COALESCE(rd.version_prerelease, '')) IN (%s)",
+		sqlf.Join(matching, ","),
+	), nil
+}
+
+const listDependencyVersionsQuery = `
+SELECT DISTINCT version_major, version_minor, version_patch, COALESCE(version_prerelease, '')
+FROM codeintel_repo_dependencies
+WHERE scheme = %s AND name = %s AND version_major IS NOT NULL
+`
+
 type RepoListOrderBy []RepoListSort
 
 func (r RepoListOrderBy) SQL() *sqlf.Query {
@@ -1200,6 +1267,22 @@ func (s *repoStore) listSQL(ctx context.Context, tr *trace.Trace, opt ReposListO
 		where = append(where, sqlf.Join(ands, "AND"))
 	}
 
+	if len(opt.DependencyFilters) > 0 {
+		var ands []*sqlf.Query
+		for _, filter := range opt.DependencyFilters {
+			cond := "EXISTS (SELECT 1 FROM codeintel_repo_dependencies rd WHERE rd.repo_id = repo.id AND rd.scheme = %s AND rd.name = %s AND %s)"
+			if filter.Negated {
+				cond = "NOT " + cond
+			}
+			versionCond, err := s.dependencyVersionCond(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			ands = append(ands, sqlf.Sprintf(cond, filter.Scheme, filter.Name, versionCond))
+		}
+		where = append(where, sqlf.Join(ands, "AND"))
+	}
+
 	baseConds := sqlf.Sprintf("TRUE")
 	if !opt.IncludeDeleted {
 		baseConds = sqlf.Sprintf("repo.deleted_at IS NULL")
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -1373,6 +1374,104 @@ func TestRepos_List_topics(t *testing.T) {
 	}
 }
 
+func TestRepos_List_dependencies(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := actor.WithInternalActor(context.Background())
+	confGet := func() *conf.Unified { return &conf.Unified{} }
+
+	services := typestest.MakeExternalServices()
+	githubService := services[0]
+	if err := db.ExternalServices().Create(ctx, confGet, githubService); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := func(id int) func(r *types.Repo) {
+		return func(r *types.Repo) {
+			r.ExternalRepo.ID = strconv.Itoa(id)
+			r.Name = api.RepoName(strconv.Itoa(id))
+		}
+	}
+
+	r1 := typestest.MakeGithubRepo().With(ids(1))
+	r2 := typestest.MakeGithubRepo().With(ids(2))
+	r3 := typestest.MakeGithubRepo().With(ids(3))
+	r4 := typestest.MakeGithubRepo().With(ids(4))
+	if err := db.Repos().Create(ctx, r1, r2, r3, r4); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dep := range []struct {
+		repo       *types.Repo
+		scheme     string
+		name       string
+		version    string
+		prerelease any
+	}{
+		{r1, "npm", "react", "18.2.0", nil},
+		{r2, "npm", "react", "17.0.2", nil},
+		{r3, "npm", "react", "19.0.0", "rc.1"},
+		{r3, "go", "golang.org/x/mod", "0.8.0", nil},
+		{r4, "npm", "react", "", nil},
+	} {
+		var major, minor, patch any
+		if dep.version != "" {
+			v, err := semver.NewVersion(dep.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			major, minor, patch = v.Major(), v.Minor(), v.Patch()
+		}
+		if _, err := db.ExecContext(ctx, `
+			INSERT INTO codeintel_repo_dependencies (repo_id, source, path, scheme, name, version, version_major, version_minor, version_patch, version_prerelease)
+			VALUES ($1, 'manifest', 'package.json', $2, $3, $4, $5, $6, $7, $8)
+		`, dep.repo.ID, dep.scheme, dep.name, dep.version, major, minor, patch, dep.prerelease); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	filter := func(scheme, name, versions string, negated bool) RepoDependencyFilter {
+		f := RepoDependencyFilter{Scheme: scheme, Name: name, Negated: negated}
+		if versions != "" {
+			c, err := semver.NewConstraint(versions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.Constraint = c
+		}
+		return f
+	}
+
+	tests := []struct {
+		name string
+		opt  ReposListOptions
+		want []*types.Repo
+	}{
+		{"any version", ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("npm", "react", "", false)}}, []*types.Repo{r1, r2, r3, r4}},
+		{"caret range", ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("npm", "react", "^18", false)}}, []*types.Repo{r1}},
+		{"union", ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("npm", "react", "^17 || ^18", false)}}, []*types.Repo{r1, r2}},
+		{"release range excludes prerelease", ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("npm", "react", ">=18", false)}}, []*types.Repo{r1}},
+		{"prerelease", ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("npm", "react", "19.0.0-rc.1", false)}}, []*types.Repo{r3}},
+		{"negated", ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("npm", "react", "^18", true)}}, []*types.Repo{r2, r3, r4}},
+		{
+			"go and not react 17",
+			ReposListOptions{DependencyFilters: []RepoDependencyFilter{filter("go", "golang.org/x/mod", "", false), filter("npm", "react", "17.x", true)}},
+			[]*types.Repo{r3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repos, err := db.Repos().List(ctx, test.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			require.Equal(t, test.want, repos)
+		})
+	}
+}
+
 func TestRepos_ListMinimalRepos(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,211 @@
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ChangeStatus is the status of a Gerrit change.
+type ChangeStatus string
+
+const (
+	ChangeStatusNew       ChangeStatus = "NEW"
+	ChangeStatusMerged    ChangeStatus = "MERGED"
+	ChangeStatusAbandoned ChangeStatus = "ABANDONED"
+)
+
+// Labels that Gerrit configures by default and that are used to derive the
+// review and check state of a change.
+const (
+	LabelCodeReview = "Code-Review"
+	LabelVerified   = "Verified"
+)
+
+// Change is a Gerrit change, as returned by the ChangeInfo entity of the REST
+// API.
+type Change struct {
+	ID              string               `json:"id"`
+	Project         string               `json:"project"`
+	Branch          string               `json:"branch"`
+	Topic           string               `json:"topic,omitempty"`
+	ChangeID        string               `json:"change_id"`
+	Subject         string               `json:"subject"`
+	Status          ChangeStatus         `json:"status"`
+	Created         Timestamp            `json:"created"`
+	Updated         Timestamp            `json:"updated"`
+	Insertions      int                  `json:"insertions"`
+	Deletions       int                  `json:"deletions"`
+	Number          int                  `json:"_number"`
+	WorkInProgress  bool                 `json:"work_in_progress,omitempty"`
+	Owner           Account              `json:"owner"`
+	Labels          map[string]LabelInfo `json:"labels,omitempty"`
+	CurrentRevision string               `json:"current_revision,omitempty"`
+	Revisions       map[string]Revision  `json:"revisions,omitempty"`
+}
+
+// LabelInfo is the summary of the votes on a label of a change. Only the
+// fields that are set when requesting the LABELS option are included.
+type LabelInfo struct {
+	Approved    *Account `json:"approved,omitempty"`
+	Rejected    *Account `json:"rejected,omitempty"`
+	Recommended *Account `json:"recommended,omitempty"`
+	Disliked    *Account `json:"disliked,omitempty"`
+	Blocking    bool     `json:"blocking,omitempty"`
+	Optional    bool     `json:"optional,omitempty"`
+}
+
+// Revision is a patch set of a change.
+type Revision struct {
+	Number int     `json:"_number"`
+	Ref    string  `json:"ref"`
+	Commit *Commit `json:"commit,omitempty"`
+}
+
+// Commit is the commit of a patch set.
+type Commit struct {
+	Subject string         `json:"subject"`
+	Message string         `json:"message"`
+	Parents []CommitParent `json:"parents"`
+}
+
+// CommitParent is a parent of a patch set commit.
+type CommitParent struct {
+	Commit  string `json:"commit"`
+	Subject string `json:"subject"`
+}
+
+// timestampLayout is the layout of timestamps in the Gerrit REST API, which
+// are always in UTC.
+const timestampLayout = "2006-01-02 15:04:05.000000000"
+
+// Timestamp is a timestamp as returned by the Gerrit REST API.
+type Timestamp struct {
+	time.Time
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.UTC().Format(timestampLayout))
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.Parse(timestampLayout, s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// GetChange retrieves the change with the given ID, including its labels, its
+// current patch set and the commit of the current patch set.
+func (c *Client) GetChange(ctx context.Context, changeID string) (*Change, error) {
+	query := make(url.Values)
+	for _, o := range []string{"LABELS", "DETAILED_ACCOUNTS", "CURRENT_REVISION", "CURRENT_COMMIT"} {
+		query.Add("o", o)
+	}
+
+	u := url.URL{Path: "a/changes/" + changeID, RawQuery: query.Encode()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var change Change
+	if _, err := c.do(ctx, req, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// AbandonChange abandons the change with the given ID.
+func (c *Client) AbandonChange(ctx context.Context, changeID string) (*Change, error) {
+	var change Change
+	if err := c.postChange(ctx, changeID, "abandon", nil, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// RestoreChange restores the abandoned change with the given ID.
+func (c *Client) RestoreChange(ctx context.Context, changeID string) (*Change, error) {
+	var change Change
+	if err := c.postChange(ctx, changeID, "restore", nil, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// SubmitChange submits the change with the given ID, which merges it into its
+// destination branch. A change that is not submittable yields an error with
+// HTTP status 409.
+func (c *Client) SubmitChange(ctx context.Context, changeID string) (*Change, error) {
+	var change Change
+	if err := c.postChange(ctx, changeID, "submit", nil, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// MoveChange moves the change with the given ID to another destination branch.
+func (c *Client) MoveChange(ctx context.Context, changeID, branch string) (*Change, error) {
+	input := struct {
+		DestinationBranch string `json:"destination_branch"`
+	}{DestinationBranch: branch}
+
+	var change Change
+	if err := c.postChange(ctx, changeID, "move", input, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// SetWorkInProgress marks the change with the given ID as work in progress.
+func (c *Client) SetWorkInProgress(ctx context.Context, changeID string) error {
+	return c.postChange(ctx, changeID, "wip", nil, nil)
+}
+
+// SetReadyForReview marks the change with the given ID as ready for review.
+func (c *Client) SetReadyForReview(ctx context.Context, changeID string) error {
+	return c.postChange(ctx, changeID, "ready", nil, nil)
+}
+
+// WriteReviewComment posts a change message on the current patch set of the
+// change with the given ID, without voting on any label.
+func (c *Client) WriteReviewComment(ctx context.Context, changeID, message string) error {
+	input := struct {
+		Message string `json:"message"`
+	}{Message: message}
+
+	return c.postChange(ctx, changeID, "revisions/current/review", input, &struct{}{})
+}
+
+func (c *Client) postChange(ctx context.Context, changeID, action string, input, result any) error {
+	var body bytes.Buffer
+	if input != nil {
+		if err := json.NewEncoder(&body).Encode(input); err != nil {
+			return errors.Wrap(err, "encoding request body")
+		}
+	}
+
+	u := url.URL{Path: "a/changes/" + changeID + "/" + action}
+	req, err := http.NewRequest("POST", u.String(), &body)
+	if err != nil {
+		return err
+	}
+	if input != nil {
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
+
+	_, err = c.do(ctx, req, result)
+	return err
+}
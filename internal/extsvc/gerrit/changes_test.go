@@ -0,0 +1,152 @@
+package gerrit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+const testChange = `)]}'
+{
+  "id": "android%2Fframeworks~main~I8473b95934b5732ac55d26311a706c9c2bde9940",
+  "project": "android/frameworks",
+  "branch": "main",
+  "topic": "batch-update-sdk",
+  "change_id": "I8473b95934b5732ac55d26311a706c9c2bde9940",
+  "subject": "Update SDK",
+  "status": "NEW",
+  "created": "2023-03-20 10:11:12.000000000",
+  "updated": "2023-03-21 08:00:00.123000000",
+  "insertions": 3,
+  "deletions": 1,
+  "_number": 4247,
+  "owner": {"_account_id": 1000096, "name": "Alice", "email": "alice@example.com", "username": "alice"},
+  "labels": {
+    "Code-Review": {"approved": {"_account_id": 1000097}},
+    "Verified": {"rejected": {"_account_id": 1000098}}
+  },
+  "current_revision": "184ebe53805e102605d11f6b143486d15c23a09c",
+  "revisions": {
+    "184ebe53805e102605d11f6b143486d15c23a09c": {
+      "_number": 2,
+      "ref": "refs/changes/47/4247/2",
+      "commit": {
+        "subject": "Update SDK",
+        "message": "Update SDK\n\nChange-Id: I8473b95934b5732ac55d26311a706c9c2bde9940\n",
+        "parents": [{"commit": "1eee2c9d8f352483781e772f35dc586a69ff5646", "subject": "Base"}]
+      }
+    }
+  }
+}`
+
+func newTestServerClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+
+	c, err := NewClient("gerrit", u, &AccountCredentials{Username: "user", Password: "pass"}, srv.Client())
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_GetChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/a/changes/I8473b95934b5732ac55d26311a706c9c2bde9940", r.URL.Path)
+			assert.Equal(t, []string{"LABELS", "DETAILED_ACCOUNTS", "CURRENT_REVISION", "CURRENT_COMMIT"}, r.URL.Query()["o"])
+			_, _ = io.WriteString(w, testChange)
+		})
+
+		change, err := c.GetChange(ctx, "I8473b95934b5732ac55d26311a706c9c2bde9940")
+		require.NoError(t, err)
+
+		assert.Equal(t, ChangeStatusNew, change.Status)
+		assert.Equal(t, 4247, change.Number)
+		assert.Equal(t, time.Date(2023, 3, 21, 8, 0, 0, 123000000, time.UTC), change.Updated.Time)
+		assert.NotNil(t, change.Labels[LabelCodeReview].Approved)
+		assert.NotNil(t, change.Labels[LabelVerified].Rejected)
+		assert.Equal(t, "refs/changes/47/4247/2", change.Revisions[change.CurrentRevision].Ref)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Not found: I0", http.StatusNotFound)
+		})
+
+		_, err := c.GetChange(ctx, "I0")
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func TestClient_SubmitChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("submitted", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "/a/changes/I8473b95934b5732ac55d26311a706c9c2bde9940/submit", r.URL.Path)
+			_, _ = io.WriteString(w, testChange)
+		})
+
+		change, err := c.SubmitChange(ctx, "I8473b95934b5732ac55d26311a706c9c2bde9940")
+		require.NoError(t, err)
+		assert.Equal(t, "I8473b95934b5732ac55d26311a706c9c2bde9940", change.ChangeID)
+	})
+
+	t.Run("not submittable", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "change is new", http.StatusConflict)
+		})
+
+		_, err := c.SubmitChange(ctx, "I8473b95934b5732ac55d26311a706c9c2bde9940")
+		assert.True(t, errcode.IsHTTPErrorCode(err, http.StatusConflict))
+	})
+}
+
+func TestClient_SetWorkInProgress(t *testing.T) {
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/a/changes/I8473b95934b5732ac55d26311a706c9c2bde9940/wip", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	require.NoError(t, c.SetWorkInProgress(context.Background(), "I8473b95934b5732ac55d26311a706c9c2bde9940"))
+}
+
+func TestClient_WriteReviewComment(t *testing.T) {
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/a/changes/I8473b95934b5732ac55d26311a706c9c2bde9940/revisions/current/review", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"message": "LGTM"}`, string(body))
+		_, _ = io.WriteString(w, ")]}'\n{}")
+	})
+
+	require.NoError(t, c.WriteReviewComment(context.Background(), "I8473b95934b5732ac55d26311a706c9c2bde9940", "LGTM"))
+}
+
+func TestTimestamp_Roundtrip(t *testing.T) {
+	var ts Timestamp
+	require.NoError(t, ts.UnmarshalJSON([]byte(`"2023-03-20 10:11:12.500000000"`)))
+	assert.Equal(t, time.Date(2023, 3, 20, 10, 11, 12, 500000000, time.UTC), ts.Time)
+
+	data, err := ts.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"2023-03-20 10:11:12.500000000"`, string(data))
+}
@@ -53,6 +53,11 @@ func NewClient(urn string, url *url.URL, creds *AccountCredentials, httpClient h
 	}, nil
 }
 
+// Authenticator returns the authenticator used to authenticate HTTP requests.
+func (c *Client) Authenticator() auth.Authenticator {
+	return c.auther
+}
+
 func (c *Client) WithAuthenticator(a auth.Authenticator) *Client {
 	return &Client{
 		httpClient: c.httpClient,
@@ -227,6 +232,12 @@ func (c *Client) do(ctx context.Context, req *http.Request, result any) (*http.R
 		}
 	}
 
+	// Some endpoints, such as marking a change as work in progress, don't
+	// return a body that we'd need to decode.
+	if result == nil {
+		return resp, nil
+	}
+
 	// The first 4 characters of the Gerrit API responses need to be stripped, see: https://gerrit-review.googlesource.com/Documentation/rest-api.html#output .
 	if len(bs) < 4 {
 		return nil, &httpError{
@@ -295,3 +306,7 @@ func (e *httpError) Unauthorized() bool {
 func (e *httpError) NotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
+
+func (e *httpError) HTTPStatusCode() int {
+	return e.StatusCode
+}
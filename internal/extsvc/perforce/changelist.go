@@ -0,0 +1,116 @@
+package perforce
+
+import (
+	"bufio"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ChangelistState is the state of a Perforce changelist.
+type ChangelistState string
+
+const (
+	// ChangelistStatePending is a pending changelist without shelved files.
+	ChangelistStatePending ChangelistState = "pending"
+	// ChangelistStateShelved is a pending changelist with shelved files.
+	ChangelistStateShelved ChangelistState = "shelved"
+	// ChangelistStateSubmitted is a changelist that has been submitted to the
+	// depot.
+	ChangelistStateSubmitted ChangelistState = "submitted"
+)
+
+// Changelist is a Perforce changelist, as described by `p4 describe`.
+type Changelist struct {
+	ID           string          `json:"id"`
+	CreationDate time.Time       `json:"creationDate"`
+	State        ChangelistState `json:"state"`
+	Author       string          `json:"author"`
+	Workspace    string          `json:"workspace"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+}
+
+// changelistHeaderPattern matches the first line of the output of `p4
+// describe`, e.g.
+//
+//	Change 1234 by alice@workspace on 2023/03/20 10:11:12 *pending*
+var changelistHeaderPattern = lazyregexp.New(`^Change (\d+) by ([^@\s]+)@(\S+) on (\d{4}/\d{2}/\d{2}(?: \d{2}:\d{2}:\d{2})?)(?: \*(\w+)\*)?`)
+
+// changelistUnknownPattern matches the output of `p4 describe` for a changelist
+// that does not exist.
+var changelistUnknownPattern = lazyregexp.New(`^Change \d+ unknown`)
+
+// ErrChangelistNotFound is returned by ParseChangelistDescription if the
+// described changelist does not exist.
+var ErrChangelistNotFound = errors.New("changelist not found")
+
+// ParseChangelistDescription parses the output of `p4 describe -s -S` for a
+// single changelist.
+func ParseChangelistDescription(out string) (*Changelist, error) {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	if !scanner.Scan() {
+		return nil, errors.New("empty changelist description")
+	}
+
+	header := strings.TrimSpace(scanner.Text())
+	if changelistUnknownPattern.MatchString(header) {
+		return nil, ErrChangelistNotFound
+	}
+	match := changelistHeaderPattern.FindStringSubmatch(header)
+	if match == nil {
+		return nil, errors.Newf("unexpected changelist description header %q", header)
+	}
+
+	layout := "2006/01/02 15:04:05"
+	if !strings.Contains(match[4], ":") {
+		layout = "2006/01/02"
+	}
+	creationDate, err := time.Parse(layout, match[4])
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing changelist date")
+	}
+
+	cl := &Changelist{
+		ID:           match[1],
+		Author:       match[2],
+		Workspace:    match[3],
+		CreationDate: creationDate,
+		State:        ChangelistStateSubmitted,
+	}
+
+	// The description is indented with a tab and ends at the first line that
+	// isn't, which starts the list of files.
+	var description []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "\t") {
+			description = append(description, strings.TrimPrefix(line, "\t"))
+			continue
+		}
+		if line == "" {
+			description = append(description, "")
+			continue
+		}
+		if strings.HasPrefix(line, "Shelved files") {
+			if match[5] == string(ChangelistStatePending) {
+				cl.State = ChangelistStateShelved
+			}
+		}
+		break
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if match[5] == string(ChangelistStatePending) && cl.State != ChangelistStateShelved {
+		cl.State = ChangelistStatePending
+	}
+
+	cl.Description = strings.TrimSpace(strings.Join(description, "\n"))
+	cl.Title, _, _ = strings.Cut(cl.Description, "\n")
+
+	return cl, nil
+}
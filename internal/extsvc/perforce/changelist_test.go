@@ -0,0 +1,88 @@
+package perforce
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseChangelistDescription(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		out     string
+		want    *Changelist
+		wantErr error
+	}{
+		{
+			name: "shelved",
+			out: "Change 1235 by alice@sourcegraph-batches-abc on 2023/03/20 10:11:12 *pending*\n" +
+				"\n" +
+				"\tUpdate assets\n" +
+				"\t\n" +
+				"\tShelved by Sourcegraph.\n" +
+				"\n" +
+				"Shelved files ...\n" +
+				"\n" +
+				"... //depot/game/a.txt#3 edit\n",
+			want: &Changelist{
+				ID:           "1235",
+				CreationDate: time.Date(2023, 3, 20, 10, 11, 12, 0, time.UTC),
+				State:        ChangelistStateShelved,
+				Author:       "alice",
+				Workspace:    "sourcegraph-batches-abc",
+				Title:        "Update assets",
+				Description:  "Update assets\n\nShelved by Sourcegraph.",
+			},
+		},
+		{
+			name: "pending",
+			out: "Change 1236 by bob@ws on 2023/03/20 *pending*\n" +
+				"\n" +
+				"\tWork in progress\n",
+			want: &Changelist{
+				ID:           "1236",
+				CreationDate: time.Date(2023, 3, 20, 0, 0, 0, 0, time.UTC),
+				State:        ChangelistStatePending,
+				Author:       "bob",
+				Workspace:    "ws",
+				Title:        "Work in progress",
+				Description:  "Work in progress",
+			},
+		},
+		{
+			name: "submitted",
+			out: "Change 1240 by alice@ws on 2023/03/21 08:00:00\n" +
+				"\n" +
+				"\tUpdate assets\n" +
+				"\n" +
+				"Affected files ...\n" +
+				"\n" +
+				"... //depot/game/a.txt#4 edit\n",
+			want: &Changelist{
+				ID:           "1240",
+				CreationDate: time.Date(2023, 3, 21, 8, 0, 0, 0, time.UTC),
+				State:        ChangelistStateSubmitted,
+				Author:       "alice",
+				Workspace:    "ws",
+				Title:        "Update assets",
+				Description:  "Update assets",
+			},
+		},
+		{
+			name:    "unknown",
+			out:     "Change 99 unknown.\n",
+			wantErr: ErrChangelistNotFound,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := ParseChangelistDescription(tc.out)
+			if err != tc.wantErr {
+				t.Fatalf("unexpected error. want=%v, have=%v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("unexpected changelist (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
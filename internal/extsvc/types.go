@@ -138,6 +138,7 @@ const (
 	KindRubyPackages    = "RUBYPACKAGES"
 	KindNpmPackages     = "NPMPACKAGES"
 	KindPagure          = "PAGURE"
+	KindMercurial       = "MERCURIAL"
 	KindAzureDevOps     = "AZUREDEVOPS"
 	KindSCIM            = "SCIM"
 	KindOther           = "OTHER"
@@ -185,6 +186,10 @@ const (
 	// TypePagure is the (api.ExternalRepoSpec).ServiceType value for Pagure projects.
 	TypePagure = "pagure"
 
+	// TypeMercurial is the (api.ExternalRepoSpec).ServiceType value for Mercurial (hg)
+	// repositories. The ServiceID value is the base URL of the Mercurial server.
+	TypeMercurial = "mercurial"
+
 	// TypeAzureDevOps is the (api.ExternalRepoSpec).ServiceType value for ADO projects.
 	TypeAzureDevOps = "azuredevops"
 
@@ -243,6 +248,8 @@ func KindToType(kind string) string {
 		return TypeGoModules
 	case KindPagure:
 		return TypePagure
+	case KindMercurial:
+		return TypeMercurial
 	case KindAzureDevOps:
 		return TypeAzureDevOps
 	case KindOther:
@@ -288,6 +295,8 @@ func TypeToKind(t string) string {
 		return KindGoPackages
 	case TypePagure:
 		return KindPagure
+	case TypeMercurial:
+		return KindMercurial
 	case TypeAzureDevOps:
 		return KindAzureDevOps
 	case TypeOther:
@@ -345,6 +354,8 @@ func ParseServiceType(s string) (string, bool) {
 		return TypeRubyPackages, true
 	case TypePagure:
 		return TypePagure, true
+	case TypeMercurial:
+		return TypeMercurial, true
 	case TypeAzureDevOps:
 		return TypeAzureDevOps, true
 	case TypeOther:
@@ -388,6 +399,8 @@ func ParseServiceKind(s string) (string, bool) {
 		return KindRubyPackages, true
 	case KindPagure:
 		return KindPagure, true
+	case KindMercurial:
+		return KindMercurial, true
 	case KindAzureDevOps:
 		return KindAzureDevOps, true
 	case KindOther:
@@ -481,6 +494,8 @@ func getConfigPrototype(kind string) (any, error) {
 		return &schema.JVMPackagesConnection{}, nil
 	case KindPagure:
 		return &schema.PagureConnection{}, nil
+	case KindMercurial:
+		return &schema.MercurialConnection{}, nil
 	case KindNpmPackages:
 		return &schema.NpmPackagesConnection{}, nil
 	case KindPythonPackages:
@@ -735,6 +750,12 @@ type OtherRepoMetadata struct {
 	AbsFilePath string
 }
 
+type MercurialRepoMetadata struct {
+	// Path is the repository path as configured in the connection's repos
+	// list. Resolving it against the connection URL gives you the clone url.
+	Path string
+}
+
 func UniqueEncryptableCodeHostIdentifier(ctx context.Context, kind string, config *EncryptableConfig) (string, error) {
 	cfg, err := ParseEncryptableConfig(ctx, kind, config)
 	if err != nil {
@@ -784,6 +805,8 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		rawURL = c.Url
 	case *schema.OtherExternalServiceConnection:
 		rawURL = c.Url
+	case *schema.MercurialConnection:
+		rawURL = c.Url
 	case *schema.GitoliteConnection:
 		rawURL = c.Host
 	case *schema.AWSCodeCommitConnection:
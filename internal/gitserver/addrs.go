@@ -89,6 +89,12 @@ type GitserverConns struct {
 
 func (g *GitserverConns) ConnForRepo(userAgent string, repo api.RepoName) (*grpc.ClientConn, error) {
 	addr := g.AddrForRepo(userAgent, repo)
+	return g.ConnForAddr(addr)
+}
+
+// ConnForAddr returns the gRPC connection for the gitserver instance at the
+// given address.
+func (g *GitserverConns) ConnForAddr(addr string) (*grpc.ClientConn, error) {
 	ce, ok := g.grpcConns[addr]
 	if !ok {
 		return nil, errors.Newf("no gRPC connection found for address %q", addr)
@@ -226,7 +226,7 @@ type Client interface {
 
 	// CreateCommitFromPatch will attempt to create a commit from a patch
 	// If possible, the error returned will be of type protocol.CreateCommitFromPatchError
-	CreateCommitFromPatch(context.Context, protocol.CreateCommitFromPatchRequest) (string, error)
+	CreateCommitFromPatch(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
 
 	// GetDefaultBranch returns the name of the default branch and the commit it's
 	// currently at from the given repository. If short is true, then `main` instead
@@ -438,6 +438,10 @@ func (c *clientImplementor) ConnForRepo(repo api.RepoName) (*grpc.ClientConn, er
 	return c.conns().ConnForRepo(c.userAgent, repo)
 }
 
+func (c *clientImplementor) connForAddr(addr string) (*grpc.ClientConn, error) {
+	return c.conns().ConnForAddr(addr)
+}
+
 // ArchiveOptions contains options for the Archive func.
 type ArchiveOptions struct {
 	Treeish   string               // the tree or commit to produce an archive for
@@ -593,6 +597,33 @@ func (c *RemoteGitCommand) sendExec(ctx context.Context) (_ io.ReadCloser, errRe
 	}
 }
 
+// newEagerStreamReader returns a reader over the data returned by recv. The
+// first call to recv happens eagerly so that errors which occur before any data
+// is produced are returned from newEagerStreamReader itself.
+func newEagerStreamReader(recv func() ([]byte, error)) (io.Reader, error) {
+	first, err := recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	firstRead := false
+	return streamio.NewReader(func() ([]byte, error) {
+		if !firstRead {
+			firstRead = true
+			if err != nil {
+				return nil, err
+			}
+			return first, nil
+		}
+
+		data, err := recv()
+		if status.Code(err) == codes.Canceled {
+			return nil, context.Canceled
+		}
+		return data, err
+	}), nil
+}
+
 type readCloseWrapper struct {
 	r       io.Reader
 	closeFn func()
@@ -601,29 +632,36 @@ type readCloseWrapper struct {
 func (r *readCloseWrapper) Read(p []byte) (int, error) {
 	n, err := r.r.Read(p)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if !ok {
-			return n, err
-		}
+		err = convertExecError(err)
+	}
+	return n, err
+}
 
-		for _, detail := range st.Details() {
-			switch payload := detail.(type) {
-			case *proto.ExecStatusPayload:
-				return n, &CommandStatusError{
-					Message:    st.Message(),
-					Stderr:     payload.Stderr,
-					StatusCode: payload.StatusCode,
-				}
-			case *proto.NotFoundPayload:
-				return n, &gitdomain.RepoNotExistError{
-					Repo:            api.RepoName(payload.Repo),
-					CloneInProgress: payload.CloneInProgress,
-					CloneProgress:   payload.CloneProgress,
-				}
+// convertExecError converts a gRPC status error returned by a streaming exec
+// call into the error types returned by the HTTP client.
+func convertExecError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		switch payload := detail.(type) {
+		case *proto.ExecStatusPayload:
+			return &CommandStatusError{
+				Message:    st.Message(),
+				Stderr:     payload.Stderr,
+				StatusCode: payload.StatusCode,
+			}
+		case *proto.NotFoundPayload:
+			return &gitdomain.RepoNotExistError{
+				Repo:            api.RepoName(payload.Repo),
+				CloneInProgress: payload.CloneInProgress,
+				CloneProgress:   payload.CloneProgress,
 			}
 		}
 	}
-	return n, err
+	return err
 }
 
 func (r *readCloseWrapper) Close() error {
@@ -796,6 +834,35 @@ func (c *clientImplementor) P4Exec(ctx context.Context, host, user, password str
 		P4Passwd: password,
 		Args:     args,
 	}
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo("")
+		if err != nil {
+			return nil, nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		ctx, cancel := context.WithCancel(ctx)
+		stream, err := client.P4Exec(ctx, req.ToProto())
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+
+		// Receive the first message eagerly so that errors such as invalid
+		// credentials are returned from P4Exec rather than on the first read.
+		r, err := newEagerStreamReader(func() ([]byte, error) {
+			msg, err := stream.Recv()
+			return msg.GetData(), err
+		})
+		if err != nil {
+			cancel()
+			return nil, nil, convertExecError(err)
+		}
+
+		return &readCloseWrapper{r: r, closeFn: cancel}, nil, nil
+	}
+
 	resp, err := c.httpPost(ctx, "", "p4-exec", req)
 	if err != nil {
 		return nil, nil, err
@@ -843,33 +910,59 @@ func (c *clientImplementor) BatchLog(ctx context.Context, opts BatchLogOptions,
 			})
 		}()
 
-		uri := "http://" + addr + "/batch-log"
-		repoName := api.RepoName(strings.Join(repoNames, ",")) // only used to label spans
-
 		request := protocol.BatchLogRequest{
 			RepoCommits: repoCommits,
 			Format:      opts.Format,
 		}
 
-		var buf bytes.Buffer
-		if err := json.NewEncoder(&buf).Encode(request); err != nil {
-			return err
-		}
+		var response protocol.BatchLogResponse
+		if internalgrpc.IsGRPCEnabled(ctx) {
+			conn, err := c.connForAddr(addr)
+			if err != nil {
+				return err
+			}
 
-		resp, err := c.do(ctx, repoName, "POST", uri, buf.Bytes())
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
-		logger.AddEvent("POST", attribute.Int("resp.StatusCode", resp.StatusCode))
+			client := proto.NewGitserverServiceClient(conn)
+			stream, err := client.BatchLog(ctx, request.ToProto())
+			if err != nil {
+				return err
+			}
 
-		if resp.StatusCode != http.StatusOK {
-			return errors.Newf("http status %d: %s", resp.StatusCode, readResponseBody(io.LimitReader(resp.Body, 200)))
-		}
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					return convertGitserverError(err)
+				}
 
-		var response protocol.BatchLogResponse
-		if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-			return err
+				chunk := protocol.BatchLogResponseFromProto(msg)
+				response.Results = append(response.Results, chunk.Results...)
+			}
+		} else {
+			uri := "http://" + addr + "/batch-log"
+			repoName := api.RepoName(strings.Join(repoNames, ",")) // only used to label spans
+
+			var buf bytes.Buffer
+			if err := json.NewEncoder(&buf).Encode(request); err != nil {
+				return err
+			}
+
+			resp, err := c.do(ctx, repoName, "POST", uri, buf.Bytes())
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			logger.AddEvent("POST", attribute.Int("resp.StatusCode", resp.StatusCode))
+
+			if resp.StatusCode != http.StatusOK {
+				return errors.Newf("http status %d: %s", resp.StatusCode, readResponseBody(io.LimitReader(resp.Body, 200)))
+			}
+
+			if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+				return err
+			}
 		}
 		logger.AddEvent("read response", attribute.Int("numResults", len(response.Results)))
 
@@ -984,6 +1077,23 @@ func (c *clientImplementor) RequestRepoUpdate(ctx context.Context, repo api.Repo
 		Repo:  repo,
 		Since: since,
 	}
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		resp, err := client.RepoUpdate(ctx, req.ToProto())
+		if err != nil {
+			return nil, convertGitserverError(err)
+		}
+
+		info := protocol.RepoUpdateResponseFromProto(resp)
+		return &info, nil
+	}
+
 	resp, err := c.httpPost(ctx, repo, "repo-update", req)
 	if err != nil {
 		return nil, err
@@ -1007,6 +1117,22 @@ func (c *clientImplementor) RequestRepoClone(ctx context.Context, repo api.RepoN
 	req := &protocol.RepoCloneRequest{
 		Repo: repo,
 	}
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		resp, err := client.RepoClone(ctx, &proto.RepoCloneRequest{Repo: string(repo)})
+		if err != nil {
+			return nil, convertGitserverError(err)
+		}
+
+		return &protocol.RepoCloneResponse{Error: resp.GetError()}, nil
+	}
+
 	resp, err := c.httpPost(ctx, repo, "repo-clone", req)
 	if err != nil {
 		return nil, err
@@ -1033,21 +1159,37 @@ func (c *clientImplementor) IsRepoCloneable(ctx context.Context, repo api.RepoNa
 		return MockIsRepoCloneable(repo)
 	}
 
-	req := &protocol.IsRepoCloneableRequest{
-		Repo: repo,
-	}
-	r, err := c.httpPost(ctx, repo, "is-repo-cloneable", req)
-	if err != nil {
-		return err
-	}
-	defer r.Body.Close()
-	if r.StatusCode != http.StatusOK {
-		return errors.Errorf("gitserver error (status code %d): %s", r.StatusCode, readResponseBody(r.Body))
-	}
-
 	var resp protocol.IsRepoCloneableResponse
-	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return err
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo(repo)
+		if err != nil {
+			return err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		r, err := client.IsRepoCloneable(ctx, &proto.IsRepoCloneableRequest{Repo: string(repo)})
+		if err != nil {
+			return convertGitserverError(err)
+		}
+
+		resp = protocol.IsRepoCloneableResponseFromProto(r)
+	} else {
+		req := &protocol.IsRepoCloneableRequest{
+			Repo: repo,
+		}
+		r, err := c.httpPost(ctx, repo, "is-repo-cloneable", req)
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+		if r.StatusCode != http.StatusOK {
+			return errors.Errorf("gitserver error (status code %d): %s", r.StatusCode, readResponseBody(r.Body))
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+			return err
+		}
 	}
 
 	if resp.Cloneable {
@@ -1106,14 +1248,43 @@ func (c *clientImplementor) RepoCloneProgress(ctx context.Context, repos ...api.
 	}
 
 	type op struct {
-		req *protocol.RepoCloneProgressRequest
-		res *protocol.RepoCloneProgressResponse
-		err error
+		addr string
+		req  *protocol.RepoCloneProgressRequest
+		res  *protocol.RepoCloneProgressResponse
+		err  error
 	}
 
 	ch := make(chan op, len(shards))
-	for _, req := range shards {
+	for addr, req := range shards {
 		go func(o op) {
+			if internalgrpc.IsGRPCEnabled(ctx) {
+				var conn *grpc.ClientConn
+				conn, o.err = c.connForAddr(o.addr)
+				if o.err != nil {
+					ch <- o
+					return
+				}
+				client := proto.NewGitserverServiceClient(conn)
+
+				repos := make([]string, 0, len(o.req.Repos))
+				for _, repo := range o.req.Repos {
+					repos = append(repos, string(repo))
+				}
+
+				var resp *proto.RepoCloneProgressResponse
+				resp, o.err = client.RepoCloneProgress(ctx, &proto.RepoCloneProgressRequest{Repos: repos})
+				if o.err != nil {
+					o.err = convertGitserverError(o.err)
+					ch <- o
+					return
+				}
+
+				res := protocol.RepoCloneProgressResponseFromProto(resp)
+				o.res = &res
+				ch <- o
+				return
+			}
+
 			var resp *http.Response
 			resp, o.err = c.httpPost(ctx, o.req.Repos[0], "repo-clone-progress", o.req)
 			if o.err != nil {
@@ -1135,7 +1306,7 @@ func (c *clientImplementor) RepoCloneProgress(ctx context.Context, repos ...api.
 			o.res = new(protocol.RepoCloneProgressResponse)
 			o.err = json.NewDecoder(resp.Body).Decode(o.res)
 			ch <- o
-		}(op{req: req})
+		}(op{addr: addr, req: req})
 	}
 
 	var err error
@@ -1174,6 +1345,22 @@ func (c *clientImplementor) ReposStats(ctx context.Context) (map[string]*protoco
 }
 
 func (c *clientImplementor) doReposStats(ctx context.Context, addr string) (*protocol.ReposStats, error) {
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.connForAddr(addr)
+		if err != nil {
+			return nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		resp, err := client.ReposStats(ctx, &proto.ReposStatsRequest{})
+		if err != nil {
+			return nil, convertGitserverError(err)
+		}
+
+		stats := protocol.ReposStatsFromProto(resp)
+		return &stats, nil
+	}
+
 	resp, err := c.do(ctx, "", "GET", fmt.Sprintf("http://%s/repos-stats", addr), nil)
 	if err != nil {
 		return nil, err
@@ -1197,6 +1384,20 @@ func (c *clientImplementor) Remove(ctx context.Context, repo api.RepoName) error
 }
 
 func (c *clientImplementor) RemoveFrom(ctx context.Context, repo api.RepoName, from string) error {
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.connForAddr(from)
+		if err != nil {
+			return err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		_, err = client.RepoDelete(ctx, &proto.RepoDeleteRequest{Repo: string(repo)})
+		if err != nil {
+			return convertGitserverError(err)
+		}
+		return nil
+	}
+
 	b, err := json.Marshal(&protocol.RepoDeleteRequest{
 		Repo: repo,
 	})
@@ -1285,21 +1486,47 @@ func (c *clientImplementor) do(ctx context.Context, repo api.RepoName, method, u
 	return c.httpClient.Do(req)
 }
 
-func (c *clientImplementor) CreateCommitFromPatch(ctx context.Context, req protocol.CreateCommitFromPatchRequest) (string, error) {
+func (c *clientImplementor) CreateCommitFromPatch(ctx context.Context, req protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo(req.Repo)
+		if err != nil {
+			return nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		resp, err := client.CreateCommitFromPatchBinary(ctx, req.ToProto())
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				for _, detail := range st.Details() {
+					if payload, ok := detail.(*proto.CreateCommitFromPatchError); ok {
+						return nil, protocol.CreateCommitFromPatchErrorFromProto(payload)
+					}
+				}
+			}
+			return nil, convertGitserverError(err)
+		}
+
+		return &protocol.CreateCommitFromPatchResponse{
+			Rev:          resp.GetRev(),
+			ChangelistID: resp.GetChangelistId(),
+		}, nil
+	}
+
 	resp, err := c.httpPost(ctx, req.Repo, "create-commit-from-patch-binary", req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to read response body")
+		return nil, errors.Wrap(err, "failed to read response body")
 	}
 	var res protocol.CreateCommitFromPatchResponse
 	if err := json.Unmarshal(body, &res); err != nil {
 		c.logger.Warn("decoding gitserver create-commit-from-patch response", sglog.Error(err))
-		return "", &url.Error{
+		return nil, &url.Error{
 			URL: resp.Request.URL.String(),
 			Op:  "CreateCommitFromPatch",
 			Err: errors.Errorf("CreateCommitFromPatch: http status %d, %s", resp.StatusCode, string(body)),
@@ -1307,9 +1534,9 @@ func (c *clientImplementor) CreateCommitFromPatch(ctx context.Context, req proto
 	}
 
 	if res.Error != nil {
-		return res.Rev, res.Error
+		return &res, res.Error
 	}
-	return res.Rev, nil
+	return &res, nil
 }
 
 func (c *clientImplementor) GetObject(ctx context.Context, repo api.RepoName, objectName string) (*gitdomain.GitObject, error) {
@@ -1317,6 +1544,25 @@ func (c *clientImplementor) GetObject(ctx context.Context, repo api.RepoName, ob
 		return ClientMocks.GetObject(repo, objectName)
 	}
 
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		resp, err := client.GetObject(ctx, &proto.GetObjectRequest{
+			Repo:       string(repo),
+			ObjectName: objectName,
+		})
+		if err != nil {
+			return nil, convertGitserverError(err)
+		}
+
+		res := protocol.GetObjectResponseFromProto(resp)
+		return &res.Object, nil
+	}
+
 	req := protocol.GetObjectRequest{
 		Repo:       repo,
 		ObjectName: objectName,
@@ -35,6 +35,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -2399,6 +2401,52 @@ func (c *clientImplementor) ArchiveReader(
 		return nil, err
 	}
 
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		conn, err := c.ConnForRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+		client := proto.NewGitserverServiceClient(conn)
+
+		pathspecs := make([]string, 0, len(options.Pathspecs))
+		for _, pathspec := range options.Pathspecs {
+			pathspecs = append(pathspecs, string(pathspec))
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		stream, err := client.Archive(ctx, &proto.ArchiveRequest{
+			Repo:      string(repo),
+			Treeish:   options.Treeish,
+			Format:    string(options.Format),
+			Pathspecs: pathspecs,
+		})
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		// Receive the first message eagerly so that a missing repository is
+		// reported from ArchiveReader, like it is over HTTP.
+		r, err := newEagerStreamReader(func() ([]byte, error) {
+			msg, err := stream.Recv()
+			return msg.GetData(), err
+		})
+		if err != nil {
+			cancel()
+			err = convertExecError(err)
+			if gitdomain.IsRepoNotExist(err) {
+				return nil, &badRequestError{error: err}
+			}
+			return nil, err
+		}
+
+		return &archiveReader{
+			base: &readCloseWrapper{r: r, closeFn: cancel},
+			repo: repo,
+			spec: options.Treeish,
+		}, nil
+	}
+
 	u := c.archiveURL(repo, options)
 
 	resp, err := c.do(ctx, repo, "POST", u.String(), nil)
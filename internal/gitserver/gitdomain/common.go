@@ -10,6 +10,7 @@ import (
 	"github.com/gobwas/glob"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -63,6 +64,49 @@ type GitObject struct {
 	Type ObjectType
 }
 
+func (o *GitObject) ToProto() *proto.GitObject {
+	var t proto.GitObject_ObjectType
+	switch o.Type {
+	case ObjectTypeCommit:
+		t = proto.GitObject_OBJECT_TYPE_COMMIT
+	case ObjectTypeTag:
+		t = proto.GitObject_OBJECT_TYPE_TAG
+	case ObjectTypeTree:
+		t = proto.GitObject_OBJECT_TYPE_TREE
+	case ObjectTypeBlob:
+		t = proto.GitObject_OBJECT_TYPE_BLOB
+	default:
+		t = proto.GitObject_OBJECT_TYPE_UNSPECIFIED
+	}
+
+	return &proto.GitObject{
+		Id:   o.ID[:],
+		Type: t,
+	}
+}
+
+func (o *GitObject) FromProto(p *proto.GitObject) {
+	var id OID
+	copy(id[:], p.GetId())
+
+	var t ObjectType
+	switch p.GetType() {
+	case proto.GitObject_OBJECT_TYPE_COMMIT:
+		t = ObjectTypeCommit
+	case proto.GitObject_OBJECT_TYPE_TAG:
+		t = ObjectTypeTag
+	case proto.GitObject_OBJECT_TYPE_TREE:
+		t = ObjectTypeTree
+	case proto.GitObject_OBJECT_TYPE_BLOB:
+		t = ObjectTypeBlob
+	}
+
+	*o = GitObject{
+		ID:   id,
+		Type: t,
+	}
+}
+
 // IsAbsoluteRevision checks if the revision is a git OID SHA string.
 //
 // Note: This doesn't mean the SHA exists in a repository, nor does it mean it
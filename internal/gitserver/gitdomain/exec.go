@@ -36,11 +36,11 @@ var (
 		"shortlog":     {"-s", "-n", "-e", "--no-merges", "--after", "--before"},
 		"cat-file":     {},
 		"lfs":          {},
-		"apply":        {"--cached", "-p0"},
+		"apply":        {"--cached", "-p0", "--3way"},
 
 		// Commands used by Batch Changes when publishing changesets.
 		"init":       {},
-		"reset":      {"-q"},
+		"reset":      {"-q", "--soft"},
 		"commit":     {"-m"},
 		"push":       {"--force"},
 		"update-ref": {},
@@ -88,6 +88,63 @@ func TestClient_GRPCRouting(t *testing.T) {
 	}
 }
 
+// mockCloneableGitserver implements IsRepoCloneable and RepoDelete over gRPC.
+type mockCloneableGitserver struct {
+	deleted []string
+	proto.UnimplementedGitserverServiceServer
+}
+
+func (m *mockCloneableGitserver) IsRepoCloneable(_ context.Context, req *proto.IsRepoCloneableRequest) (*proto.IsRepoCloneableResponse, error) {
+	if req.GetRepo() == "github.com/sourcegraph/cloneable" {
+		return &proto.IsRepoCloneableResponse{Cloneable: true}, nil
+	}
+	return &proto.IsRepoCloneableResponse{Cloned: true, Reason: "repository not found"}, nil
+}
+
+func (m *mockCloneableGitserver) RepoDelete(_ context.Context, req *proto.RepoDeleteRequest) (*proto.RepoDeleteResponse, error) {
+	m.deleted = append(m.deleted, req.GetRepo())
+	return &proto.RepoDeleteResponse{}, nil
+}
+
+func (m *mockCloneableGitserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusInternalServerError)
+}
+
+func TestClient_GRPCMethods(t *testing.T) {
+	gs := grpc.NewServer()
+	m := &mockCloneableGitserver{}
+	proto.RegisterGitserverServiceServer(gs, m)
+	srv := httptest.NewServer(internalgrpc.MultiplexHandlers(gs, m))
+	t.Cleanup(srv.Close)
+
+	u, _ := url.Parse(srv.URL)
+
+	conf.Mock(&conf.Unified{
+		ServiceConnectionConfig: conftypes.ServiceConnections{
+			GitServers: []string{u.Host},
+		},
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{
+				EnableGRPC: true,
+			},
+		},
+	})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	client := NewClient()
+	ctx := context.Background()
+
+	require.NoError(t, client.IsRepoCloneable(ctx, "github.com/sourcegraph/cloneable"))
+
+	err := client.IsRepoCloneable(ctx, "github.com/sourcegraph/missing")
+	var notCloneable *RepoNotCloneableErr
+	require.ErrorAs(t, err, &notCloneable)
+	require.True(t, notCloneable.NotFound())
+
+	require.NoError(t, client.Remove(ctx, "github.com/sourcegraph/deleted"))
+	require.Equal(t, []string{"github.com/sourcegraph/deleted"}, m.deleted)
+}
+
 func TestClient_AddrForRepo_UsesConfToRead_PinnedRepos(t *testing.T) {
 	client := NewClient()
 
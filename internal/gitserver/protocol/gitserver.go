@@ -6,6 +6,7 @@ import (
 
 	"github.com/opentracing/opentracing-go/log"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -283,10 +284,66 @@ func (req BatchLogRequest) SpanAttributes() []attribute.KeyValue {
 	}
 }
 
+func (req *BatchLogRequest) ToProto() *proto.BatchLogRequest {
+	repoCommits := make([]*proto.RepoCommit, 0, len(req.RepoCommits))
+	for _, rc := range req.RepoCommits {
+		repoCommits = append(repoCommits, repoCommitToProto(rc))
+	}
+	return &proto.BatchLogRequest{
+		RepoCommits: repoCommits,
+		Format:      req.Format,
+	}
+}
+
+func BatchLogRequestFromProto(p *proto.BatchLogRequest) BatchLogRequest {
+	repoCommits := make([]api.RepoCommit, 0, len(p.GetRepoCommits()))
+	for _, rc := range p.GetRepoCommits() {
+		repoCommits = append(repoCommits, repoCommitFromProto(rc))
+	}
+	return BatchLogRequest{
+		RepoCommits: repoCommits,
+		Format:      p.GetFormat(),
+	}
+}
+
+func repoCommitToProto(rc api.RepoCommit) *proto.RepoCommit {
+	return &proto.RepoCommit{
+		Repo:   string(rc.Repo),
+		Commit: string(rc.CommitID),
+	}
+}
+
+func repoCommitFromProto(p *proto.RepoCommit) api.RepoCommit {
+	return api.RepoCommit{
+		Repo:     api.RepoName(p.GetRepo()),
+		CommitID: api.CommitID(p.GetCommit()),
+	}
+}
+
 type BatchLogResponse struct {
 	Results []BatchLogResult `json:"results"`
 }
 
+func (bl *BatchLogResponse) ToProto() *proto.BatchLogResponse {
+	results := make([]*proto.BatchLogResult, 0, len(bl.Results))
+	for _, r := range bl.Results {
+		results = append(results, r.ToProto())
+	}
+	return &proto.BatchLogResponse{
+		Results: results,
+	}
+}
+
+func BatchLogResponseFromProto(p *proto.BatchLogResponse) BatchLogResponse {
+	results := make([]BatchLogResult, 0, len(p.GetResults()))
+	for _, r := range p.GetResults() {
+		results = append(results, BatchLogResultFromProto(r))
+	}
+	return BatchLogResponse{
+		Results: results,
+	}
+}
+
 // BatchLogResult associates a repository and commit pair from the input of a BatchLog
 // request with the result of the associated git log command.
 type BatchLogResult struct {
@@ -295,6 +352,25 @@ type BatchLogResult struct {
 	CommandError  string         `json:"error,omitempty"`
 }
 
+func (r *BatchLogResult) ToProto() *proto.BatchLogResult {
+	result := &proto.BatchLogResult{
+		RepoCommit:    repoCommitToProto(r.RepoCommit),
+		CommandOutput: r.CommandOutput,
+	}
+	if r.CommandError != "" {
+		result.CommandError = &r.CommandError
+	}
+	return result
+}
+
+func BatchLogResultFromProto(p *proto.BatchLogResult) BatchLogResult {
+	return BatchLogResult{
+		RepoCommit:    repoCommitFromProto(p.GetRepoCommit()),
+		CommandOutput: p.GetCommandOutput(),
+		CommandError:  p.GetCommandError(),
+	}
+}
+
 // P4ExecRequest is a request to execute a p4 command with given arguments.
 //
 // Note that this request is deserialized by both gitserver and the frontend's
@@ -307,6 +383,24 @@ type P4ExecRequest struct {
 	Args     []string `json:"args"`
 }
 
+func (r *P4ExecRequest) ToProto() *proto.P4ExecRequest {
+	return &proto.P4ExecRequest{
+		P4Port:   r.P4Port,
+		P4User:   r.P4User,
+		P4Passwd: r.P4Passwd,
+		Args:     r.Args,
+	}
+}
+
+func P4ExecRequestFromProto(p *proto.P4ExecRequest) P4ExecRequest {
+	return P4ExecRequest{
+		P4Port:   p.GetP4Port(),
+		P4User:   p.GetP4User(),
+		P4Passwd: p.GetP4Passwd(),
+		Args:     p.GetArgs(),
+	}
+}
+
 // RepoUpdateRequest is a request to update the contents of a given repo, or clone it if it doesn't exist.
 type RepoUpdateRequest struct {
 	Repo  api.RepoName  `json:"repo"`  // identifying URL for repo
@@ -318,6 +412,22 @@ type RepoUpdateRequest struct {
 	CloneFromShard string `json:"cloneFromShard"`
 }
 
+func (r *RepoUpdateRequest) ToProto() *proto.RepoUpdateRequest {
+	return &proto.RepoUpdateRequest{
+		Repo:           string(r.Repo),
+		Since:          durationpb.New(r.Since),
+		CloneFromShard: r.CloneFromShard,
+	}
+}
+
+func RepoUpdateRequestFromProto(p *proto.RepoUpdateRequest) RepoUpdateRequest {
+	return RepoUpdateRequest{
+		Repo:           api.RepoName(p.GetRepo()),
+		Since:          p.GetSince().AsDuration(),
+		CloneFromShard: p.GetCloneFromShard(),
+	}
+}
+
 // RepoUpdateResponse returns meta information of the repo enqueued for update.
 type RepoUpdateResponse struct {
 	LastFetched *time.Time `json:",omitempty"`
@@ -327,6 +437,38 @@ type RepoUpdateResponse struct {
 	Error string `json:",omitempty"`
 }
 
+func (r *RepoUpdateResponse) ToProto() *proto.RepoUpdateResponse {
+	var lastFetched, lastChanged *timestamppb.Timestamp
+	if r.LastFetched != nil {
+		lastFetched = timestamppb.New(*r.LastFetched)
+	}
+	if r.LastChanged != nil {
+		lastChanged = timestamppb.New(*r.LastChanged)
+	}
+	return &proto.RepoUpdateResponse{
+		LastFetched: lastFetched,
+		LastChanged: lastChanged,
+		Error:       r.Error,
+	}
+}
+
+func RepoUpdateResponseFromProto(p *proto.RepoUpdateResponse) RepoUpdateResponse {
+	var lastFetched, lastChanged *time.Time
+	if p.GetLastFetched() != nil {
+		t := p.GetLastFetched().AsTime()
+		lastFetched = &t
+	}
+	if p.GetLastChanged() != nil {
+		t := p.GetLastChanged().AsTime()
+		lastChanged = &t
+	}
+	return RepoUpdateResponse{
+		LastFetched: lastFetched,
+		LastChanged: lastChanged,
+		Error:       p.GetError(),
+	}
+}
+
 // RepoCloneRequest is a request to clone a repository asynchronously.
 type RepoCloneRequest struct {
 	Repo api.RepoName `json:"repo"`
@@ -357,6 +499,22 @@ type IsRepoCloneableResponse struct {
 	Reason    string // if not cloneable, the reason why not
 }
 
+func (i *IsRepoCloneableResponse) ToProto() *proto.IsRepoCloneableResponse {
+	return &proto.IsRepoCloneableResponse{
+		Cloneable: i.Cloneable,
+		Cloned:    i.Cloned,
+		Reason:    i.Reason,
+	}
+}
+
+func IsRepoCloneableResponseFromProto(p *proto.IsRepoCloneableResponse) IsRepoCloneableResponse {
+	return IsRepoCloneableResponse{
+		Cloneable: p.GetCloneable(),
+		Cloned:    p.GetCloned(),
+		Reason:    p.GetReason(),
+	}
+}
+
 // RepoDeleteRequest is a request to delete a repository clone on gitserver
 type RepoDeleteRequest struct {
 	// Repo is the repository to delete.
@@ -374,6 +532,28 @@ type ReposStats struct {
 	GitDirBytes int64
 }
 
+func (rs *ReposStats) ToProto() *proto.ReposStatsResponse {
+	var updatedAt *timestamppb.Timestamp
+	if !rs.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(rs.UpdatedAt)
+	}
+	return &proto.ReposStatsResponse{
+		UpdatedAt:   updatedAt,
+		GitDirBytes: rs.GitDirBytes,
+	}
+}
+
+func ReposStatsFromProto(p *proto.ReposStatsResponse) ReposStats {
+	var updatedAt time.Time
+	if p.GetUpdatedAt() != nil {
+		updatedAt = p.GetUpdatedAt().AsTime()
+	}
+	return ReposStats{
+		UpdatedAt:   updatedAt,
+		GitDirBytes: p.GetGitDirBytes(),
+	}
+}
+
 // RepoCloneProgressRequest is a request for information about the clone progress of multiple
 // repositories on gitserver.
 type RepoCloneProgressRequest struct {
@@ -387,12 +567,48 @@ type RepoCloneProgress struct {
 	Cloned          bool   // whether the repository has been cloned successfully
 }
 
+func (r *RepoCloneProgress) ToProto() *proto.RepoCloneProgress {
+	return &proto.RepoCloneProgress{
+		CloneInProgress: r.CloneInProgress,
+		CloneProgress:   r.CloneProgress,
+		Cloned:          r.Cloned,
+	}
+}
+
+func RepoCloneProgressFromProto(p *proto.RepoCloneProgress) *RepoCloneProgress {
+	return &RepoCloneProgress{
+		CloneInProgress: p.GetCloneInProgress(),
+		CloneProgress:   p.GetCloneProgress(),
+		Cloned:          p.GetCloned(),
+	}
+}
+
 // RepoCloneProgressResponse is the response to a repository clone progress request
 // for multiple repositories at the same time.
 type RepoCloneProgressResponse struct {
 	Results map[api.RepoName]*RepoCloneProgress
 }
 
+func (r *RepoCloneProgressResponse) ToProto() *proto.RepoCloneProgressResponse {
+	results := make(map[string]*proto.RepoCloneProgress, len(r.Results))
+	for repo, progress := range r.Results {
+		results[string(repo)] = progress.ToProto()
+	}
+	return &proto.RepoCloneProgressResponse{
+		Results: results,
+	}
+}
+
+func RepoCloneProgressResponseFromProto(p *proto.RepoCloneProgressResponse) RepoCloneProgressResponse {
+	results := make(map[api.RepoName]*RepoCloneProgress, len(p.GetResults()))
+	for repo, progress := range p.GetResults() {
+		results[api.RepoName(repo)] = RepoCloneProgressFromProto(progress)
+	}
+	return RepoCloneProgressResponse{
+		Results: results,
+	}
+}
+
 // CreateCommitFromPatchRequest is the request information needed for creating
 // the simulated staging area git object for a repo.
 type CreateCommitFromPatchRequest struct {
@@ -414,6 +630,67 @@ type CreateCommitFromPatchRequest struct {
 	// GitApplyArgs are the arguments that will be passed to `git apply` along
 	// with `--cached`.
 	GitApplyArgs []string
+	// PushRef is the ref on the remote that the commit will be pushed to. If
+	// empty, TargetRef is used. Code hosts such as Gerrit expect pushes to a
+	// magic ref like `refs/for/main` instead of the branch itself.
+	PushRef string
+	// StackParentCommit is the head commit of the changeset this commit is
+	// stacked on. If set, Patch is expected to contain the changes of the
+	// parent as well (it is still applied to BaseCommit), and only the changes
+	// between StackParentCommit and the patched BaseCommit are committed.
+	StackParentCommit api.CommitID
+	// StackOntoCommit is the commit that the stacked commit is created on. It
+	// defaults to StackParentCommit. Setting it to another commit, such as the
+	// tip of the base branch after the parent has been merged, rebases the
+	// changes onto that commit.
+	StackOntoCommit api.CommitID
+	// ChangelistID is the ID of the pending changelist that the changes are
+	// shelved in when pushing to a Perforce depot. If empty, a new changelist
+	// is created.
+	ChangelistID string
+}
+
+func (c *CreateCommitFromPatchRequest) ToProto() *proto.CreateCommitFromPatchBinaryRequest {
+	var push *proto.PushConfig
+	if c.Push != nil {
+		push = c.Push.ToProto()
+	}
+	return &proto.CreateCommitFromPatchBinaryRequest{
+		Repo:              string(c.Repo),
+		BaseCommit:        string(c.BaseCommit),
+		Patch:             c.Patch,
+		TargetRef:         c.TargetRef,
+		UniqueRef:         c.UniqueRef,
+		CommitInfo:        c.CommitInfo.ToProto(),
+		Push:              push,
+		GitApplyArgs:      c.GitApplyArgs,
+		PushRef:           c.PushRef,
+		StackParentCommit: string(c.StackParentCommit),
+		StackOntoCommit:   string(c.StackOntoCommit),
+		ChangelistId:      c.ChangelistID,
+	}
+}
+
+func CreateCommitFromPatchRequestFromProto(p *proto.CreateCommitFromPatchBinaryRequest) CreateCommitFromPatchRequest {
+	var push *PushConfig
+	if p.GetPush() != nil {
+		pc := PushConfigFromProto(p.GetPush())
+		push = &pc
+	}
+	return CreateCommitFromPatchRequest{
+		Repo:              api.RepoName(p.GetRepo()),
+		BaseCommit:        api.CommitID(p.GetBaseCommit()),
+		Patch:             p.GetPatch(),
+		TargetRef:         p.GetTargetRef(),
+		UniqueRef:         p.GetUniqueRef(),
+		CommitInfo:        PatchCommitInfoFromProto(p.GetCommitInfo()),
+		Push:              push,
+		GitApplyArgs:      p.GetGitApplyArgs(),
+		PushRef:           p.GetPushRef(),
+		StackParentCommit: api.CommitID(p.GetStackParentCommit()),
+		StackOntoCommit:   api.CommitID(p.GetStackOntoCommit()),
+		ChangelistID:      p.GetChangelistId(),
+	}
 }
 
 // PatchCommitInfo will be used for commit information when creating a commit from a patch
@@ -426,6 +703,28 @@ type PatchCommitInfo struct {
 	Date           time.Time
 }
 
+func (p *PatchCommitInfo) ToProto() *proto.PatchCommitInfo {
+	return &proto.PatchCommitInfo{
+		Message:        p.Message,
+		AuthorName:     p.AuthorName,
+		AuthorEmail:    p.AuthorEmail,
+		CommitterName:  p.CommitterName,
+		CommitterEmail: p.CommitterEmail,
+		Date:           timestamppb.New(p.Date),
+	}
+}
+
+func PatchCommitInfoFromProto(p *proto.PatchCommitInfo) PatchCommitInfo {
+	return PatchCommitInfo{
+		Message:        p.GetMessage(),
+		AuthorName:     p.GetAuthorName(),
+		AuthorEmail:    p.GetAuthorEmail(),
+		CommitterName:  p.GetCommitterName(),
+		CommitterEmail: p.GetCommitterEmail(),
+		Date:           p.GetDate().AsTime(),
+	}
+}
+
 // PushConfig provides the configuration required to push one or more commits to
 // a code host.
 type PushConfig struct {
@@ -444,12 +743,32 @@ type PushConfig struct {
 	Passphrase string
 }
 
+func (p *PushConfig) ToProto() *proto.PushConfig {
+	return &proto.PushConfig{
+		RemoteUrl:  p.RemoteURL,
+		PrivateKey: p.PrivateKey,
+		Passphrase: p.Passphrase,
+	}
+}
+
+func PushConfigFromProto(p *proto.PushConfig) PushConfig {
+	return PushConfig{
+		RemoteURL:  p.GetRemoteUrl(),
+		PrivateKey: p.GetPrivateKey(),
+		Passphrase: p.GetPassphrase(),
+	}
+}
+
 // CreateCommitFromPatchResponse is the response type returned after creating
 // a commit from a patch
 type CreateCommitFromPatchResponse struct {
 	// Rev is the tag that the staging object can be found at
 	Rev string
 
+	// ChangelistID is the ID of the changelist the changes were shelved in, if
+	// the commit was pushed to a Perforce depot.
+	ChangelistID string
+
 	// Error is populated only on error
 	Error *CreateCommitFromPatchError
 }
@@ -480,6 +799,24 @@ type CreateCommitFromPatchError struct {
 	CombinedOutput string
 }
 
+func (e *CreateCommitFromPatchError) ToProto() *proto.CreateCommitFromPatchError {
+	return &proto.CreateCommitFromPatchError{
+		RepositoryName: e.RepositoryName,
+		InternalError:  e.InternalError,
+		Command:        e.Command,
+		CombinedOutput: e.CombinedOutput,
+	}
+}
+
+func CreateCommitFromPatchErrorFromProto(p *proto.CreateCommitFromPatchError) *CreateCommitFromPatchError {
+	return &CreateCommitFromPatchError{
+		RepositoryName: p.GetRepositoryName(),
+		InternalError:  p.GetInternalError(),
+		Command:        p.GetCommand(),
+		CombinedOutput: p.GetCombinedOutput(),
+	}
+}
+
 // Error returns a detailed error conforming to the error interface
 func (e *CreateCommitFromPatchError) Error() string {
 	return e.InternalError
@@ -493,3 +830,17 @@ type GetObjectRequest struct {
 type GetObjectResponse struct {
 	Object gitdomain.GitObject
 }
+
+func (r *GetObjectResponse) ToProto() *proto.GetObjectResponse {
+	return &proto.GetObjectResponse{
+		Object: r.Object.ToProto(),
+	}
+}
+
+func GetObjectResponseFromProto(p *proto.GetObjectResponse) GetObjectResponse {
+	var obj gitdomain.GitObject
+	obj.FromProto(p.GetObject())
+	return GetObjectResponse{
+		Object: obj,
+	}
+}
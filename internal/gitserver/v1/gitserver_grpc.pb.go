@@ -19,8 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GitserverService_Exec_FullMethodName   = "/gitserver.v1.GitserverService/Exec"
-	GitserverService_Search_FullMethodName = "/gitserver.v1.GitserverService/Search"
+	GitserverService_Exec_FullMethodName                        = "/gitserver.v1.GitserverService/Exec"
+	GitserverService_Search_FullMethodName                      = "/gitserver.v1.GitserverService/Search"
+	GitserverService_Archive_FullMethodName                     = "/gitserver.v1.GitserverService/Archive"
+	GitserverService_BatchLog_FullMethodName                    = "/gitserver.v1.GitserverService/BatchLog"
+	GitserverService_P4Exec_FullMethodName                      = "/gitserver.v1.GitserverService/P4Exec"
+	GitserverService_IsRepoCloneable_FullMethodName             = "/gitserver.v1.GitserverService/IsRepoCloneable"
+	GitserverService_ReposStats_FullMethodName                  = "/gitserver.v1.GitserverService/ReposStats"
+	GitserverService_RepoCloneProgress_FullMethodName           = "/gitserver.v1.GitserverService/RepoCloneProgress"
+	GitserverService_RepoDelete_FullMethodName                  = "/gitserver.v1.GitserverService/RepoDelete"
+	GitserverService_RepoUpdate_FullMethodName                  = "/gitserver.v1.GitserverService/RepoUpdate"
+	GitserverService_RepoClone_FullMethodName                   = "/gitserver.v1.GitserverService/RepoClone"
+	GitserverService_CreateCommitFromPatchBinary_FullMethodName = "/gitserver.v1.GitserverService/CreateCommitFromPatchBinary"
+	GitserverService_GetObject_FullMethodName                   = "/gitserver.v1.GitserverService/GetObject"
 )
 
 // GitserverServiceClient is the client API for GitserverService service.
@@ -29,6 +40,17 @@ const (
 type GitserverServiceClient interface {
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (GitserverService_SearchClient, error)
+	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (GitserverService_ArchiveClient, error)
+	BatchLog(ctx context.Context, in *BatchLogRequest, opts ...grpc.CallOption) (GitserverService_BatchLogClient, error)
+	P4Exec(ctx context.Context, in *P4ExecRequest, opts ...grpc.CallOption) (GitserverService_P4ExecClient, error)
+	IsRepoCloneable(ctx context.Context, in *IsRepoCloneableRequest, opts ...grpc.CallOption) (*IsRepoCloneableResponse, error)
+	ReposStats(ctx context.Context, in *ReposStatsRequest, opts ...grpc.CallOption) (*ReposStatsResponse, error)
+	RepoCloneProgress(ctx context.Context, in *RepoCloneProgressRequest, opts ...grpc.CallOption) (*RepoCloneProgressResponse, error)
+	RepoDelete(ctx context.Context, in *RepoDeleteRequest, opts ...grpc.CallOption) (*RepoDeleteResponse, error)
+	RepoUpdate(ctx context.Context, in *RepoUpdateRequest, opts ...grpc.CallOption) (*RepoUpdateResponse, error)
+	RepoClone(ctx context.Context, in *RepoCloneRequest, opts ...grpc.CallOption) (*RepoCloneResponse, error)
+	CreateCommitFromPatchBinary(ctx context.Context, in *CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*CreateCommitFromPatchBinaryResponse, error)
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 }
 
 type gitserverServiceClient struct {
@@ -103,12 +125,191 @@ func (x *gitserverServiceSearchClient) Recv() (*SearchResponse, error) {
 	return m, nil
 }
 
+func (c *gitserverServiceClient) Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (GitserverService_ArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[2], GitserverService_Archive_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceArchiveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ArchiveClient interface {
+	Recv() (*ArchiveResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceArchiveClient) Recv() (*ArchiveResponse, error) {
+	m := new(ArchiveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) BatchLog(ctx context.Context, in *BatchLogRequest, opts ...grpc.CallOption) (GitserverService_BatchLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[3], GitserverService_BatchLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceBatchLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_BatchLogClient interface {
+	Recv() (*BatchLogResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceBatchLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceBatchLogClient) Recv() (*BatchLogResponse, error) {
+	m := new(BatchLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) P4Exec(ctx context.Context, in *P4ExecRequest, opts ...grpc.CallOption) (GitserverService_P4ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[4], GitserverService_P4Exec_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceP4ExecClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_P4ExecClient interface {
+	Recv() (*P4ExecResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceP4ExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceP4ExecClient) Recv() (*P4ExecResponse, error) {
+	m := new(P4ExecResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) IsRepoCloneable(ctx context.Context, in *IsRepoCloneableRequest, opts ...grpc.CallOption) (*IsRepoCloneableResponse, error) {
+	out := new(IsRepoCloneableResponse)
+	err := c.cc.Invoke(ctx, GitserverService_IsRepoCloneable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) ReposStats(ctx context.Context, in *ReposStatsRequest, opts ...grpc.CallOption) (*ReposStatsResponse, error) {
+	out := new(ReposStatsResponse)
+	err := c.cc.Invoke(ctx, GitserverService_ReposStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RepoCloneProgress(ctx context.Context, in *RepoCloneProgressRequest, opts ...grpc.CallOption) (*RepoCloneProgressResponse, error) {
+	out := new(RepoCloneProgressResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RepoCloneProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RepoDelete(ctx context.Context, in *RepoDeleteRequest, opts ...grpc.CallOption) (*RepoDeleteResponse, error) {
+	out := new(RepoDeleteResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RepoDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RepoUpdate(ctx context.Context, in *RepoUpdateRequest, opts ...grpc.CallOption) (*RepoUpdateResponse, error) {
+	out := new(RepoUpdateResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RepoUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RepoClone(ctx context.Context, in *RepoCloneRequest, opts ...grpc.CallOption) (*RepoCloneResponse, error) {
+	out := new(RepoCloneResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RepoClone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CreateCommitFromPatchBinary(ctx context.Context, in *CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*CreateCommitFromPatchBinaryResponse, error) {
+	out := new(CreateCommitFromPatchBinaryResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CreateCommitFromPatchBinary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error) {
+	out := new(GetObjectResponse)
+	err := c.cc.Invoke(ctx, GitserverService_GetObject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GitserverServiceServer is the server API for GitserverService service.
 // All implementations must embed UnimplementedGitserverServiceServer
 // for forward compatibility
 type GitserverServiceServer interface {
 	Exec(*ExecRequest, GitserverService_ExecServer) error
 	Search(*SearchRequest, GitserverService_SearchServer) error
+	Archive(*ArchiveRequest, GitserverService_ArchiveServer) error
+	BatchLog(*BatchLogRequest, GitserverService_BatchLogServer) error
+	P4Exec(*P4ExecRequest, GitserverService_P4ExecServer) error
+	IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error)
+	ReposStats(context.Context, *ReposStatsRequest) (*ReposStatsResponse, error)
+	RepoCloneProgress(context.Context, *RepoCloneProgressRequest) (*RepoCloneProgressResponse, error)
+	RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error)
+	RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error)
+	RepoClone(context.Context, *RepoCloneRequest) (*RepoCloneResponse, error)
+	CreateCommitFromPatchBinary(context.Context, *CreateCommitFromPatchBinaryRequest) (*CreateCommitFromPatchBinaryResponse, error)
+	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
 	mustEmbedUnimplementedGitserverServiceServer()
 }
 
@@ -122,6 +323,39 @@ func (UnimplementedGitserverServiceServer) Exec(*ExecRequest, GitserverService_E
 func (UnimplementedGitserverServiceServer) Search(*SearchRequest, GitserverService_SearchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedGitserverServiceServer) Archive(*ArchiveRequest, GitserverService_ArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Archive not implemented")
+}
+func (UnimplementedGitserverServiceServer) BatchLog(*BatchLogRequest, GitserverService_BatchLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchLog not implemented")
+}
+func (UnimplementedGitserverServiceServer) P4Exec(*P4ExecRequest, GitserverService_P4ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method P4Exec not implemented")
+}
+func (UnimplementedGitserverServiceServer) IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsRepoCloneable not implemented")
+}
+func (UnimplementedGitserverServiceServer) ReposStats(context.Context, *ReposStatsRequest) (*ReposStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReposStats not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoCloneProgress(context.Context, *RepoCloneProgressRequest) (*RepoCloneProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoCloneProgress not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoDelete not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoUpdate not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoClone(context.Context, *RepoCloneRequest) (*RepoCloneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoClone not implemented")
+}
+func (UnimplementedGitserverServiceServer) CreateCommitFromPatchBinary(context.Context, *CreateCommitFromPatchBinaryRequest) (*CreateCommitFromPatchBinaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommitFromPatchBinary not implemented")
+}
+func (UnimplementedGitserverServiceServer) GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
 func (UnimplementedGitserverServiceServer) mustEmbedUnimplementedGitserverServiceServer() {}
 
 // UnsafeGitserverServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -177,13 +411,253 @@ func (x *gitserverServiceSearchServer) Send(m *SearchResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GitserverService_Archive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Archive(m, &gitserverServiceArchiveServer{stream})
+}
+
+type GitserverService_ArchiveServer interface {
+	Send(*ArchiveResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceArchiveServer) Send(m *ArchiveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_BatchLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).BatchLog(m, &gitserverServiceBatchLogServer{stream})
+}
+
+type GitserverService_BatchLogServer interface {
+	Send(*BatchLogResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceBatchLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceBatchLogServer) Send(m *BatchLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_P4Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(P4ExecRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).P4Exec(m, &gitserverServiceP4ExecServer{stream})
+}
+
+type GitserverService_P4ExecServer interface {
+	Send(*P4ExecResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceP4ExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceP4ExecServer) Send(m *P4ExecResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_IsRepoCloneable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsRepoCloneableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).IsRepoCloneable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_IsRepoCloneable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).IsRepoCloneable(ctx, req.(*IsRepoCloneableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_ReposStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReposStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).ReposStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_ReposStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).ReposStats(ctx, req.(*ReposStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoCloneProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoCloneProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoCloneProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoCloneProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoCloneProgress(ctx, req.(*RepoCloneProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoDelete(ctx, req.(*RepoDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoUpdate(ctx, req.(*RepoUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoCloneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoClone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoClone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoClone(ctx, req.(*RepoCloneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_CreateCommitFromPatchBinary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommitFromPatchBinaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).CreateCommitFromPatchBinary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_CreateCommitFromPatchBinary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).CreateCommitFromPatchBinary(ctx, req.(*CreateCommitFromPatchBinaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_GetObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).GetObject(ctx, req.(*GetObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GitserverService_ServiceDesc is the grpc.ServiceDesc for GitserverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var GitserverService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "gitserver.v1.GitserverService",
 	HandlerType: (*GitserverServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsRepoCloneable",
+			Handler:    _GitserverService_IsRepoCloneable_Handler,
+		},
+		{
+			MethodName: "ReposStats",
+			Handler:    _GitserverService_ReposStats_Handler,
+		},
+		{
+			MethodName: "RepoCloneProgress",
+			Handler:    _GitserverService_RepoCloneProgress_Handler,
+		},
+		{
+			MethodName: "RepoDelete",
+			Handler:    _GitserverService_RepoDelete_Handler,
+		},
+		{
+			MethodName: "RepoUpdate",
+			Handler:    _GitserverService_RepoUpdate_Handler,
+		},
+		{
+			MethodName: "RepoClone",
+			Handler:    _GitserverService_RepoClone_Handler,
+		},
+		{
+			MethodName: "CreateCommitFromPatchBinary",
+			Handler:    _GitserverService_CreateCommitFromPatchBinary_Handler,
+		},
+		{
+			MethodName: "GetObject",
+			Handler:    _GitserverService_GetObject_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Exec",
@@ -195,6 +669,21 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GitserverService_Search_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Archive",
+			Handler:       _GitserverService_Archive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchLog",
+			Handler:       _GitserverService_BatchLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "P4Exec",
+			Handler:       _GitserverService_P4Exec_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gitserver.proto",
 }
@@ -0,0 +1,27 @@
+package rbac
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// Permissions guarding features that are available to every user by default. A
+// site administrator can revoke them from the USER role to restrict the feature
+// to selected roles.
+var (
+	CodeInsightsWritePermission   = fmt.Sprintf("%s#WRITE", types.CodeInsightsNamespace)
+	CodeMonitorsWritePermission   = fmt.Sprintf("%s#WRITE", types.CodeMonitorsNamespace)
+	SearchContextsWritePermission = fmt.Sprintf("%s#WRITE", types.SearchContextsNamespace)
+	NotebooksWritePermission      = fmt.Sprintf("%s#WRITE", types.NotebooksNamespace)
+)
+
+// Permissions guarding administrative features. Site administrators always have
+// access to them, and they can be assigned to other roles to delegate the
+// administration of a feature without granting site-admin.
+var (
+	CodeIntelWritePermission        = fmt.Sprintf("%s#WRITE", types.CodeIntelNamespace)
+	ExecutorSecretsWritePermission  = fmt.Sprintf("%s#WRITE", types.ExecutorSecretsNamespace)
+	OutboundWebhooksReadPermission  = fmt.Sprintf("%s#READ", types.OutboundWebhooksNamespace)
+	OutboundWebhooksWritePermission = fmt.Sprintf("%s#WRITE", types.OutboundWebhooksNamespace)
+)
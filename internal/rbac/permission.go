@@ -67,3 +67,14 @@ func CheckCurrentUserHasPermission(ctx context.Context, db database.DB, permissi
 
 	return nil
 }
+
+// CheckCurrentUserIsSiteAdminOrHasPermission returns an error if the current user is neither a
+// site administrator nor has the permission assigned to them. It is used to guard administrative
+// features, so that site administrators keep access to them even if the permission has been
+// revoked from their role, while other users can be granted access through a role.
+func CheckCurrentUserIsSiteAdminOrHasPermission(ctx context.Context, db database.DB, permission string) error {
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != auth.ErrMustBeSiteAdmin {
+		return err
+	}
+	return CheckCurrentUserHasPermission(ctx, db, permission)
+}
@@ -99,3 +99,85 @@ func TestCheckCurrentUserHasPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckCurrentUserIsSiteAdminOrHasPermission(t *testing.T) {
+	ctx := context.Background()
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	admin, err := db.Users().Create(ctx, database.NewUser{Username: "admin"})
+	require.NoError(t, err)
+	require.NoError(t, db.Users().SetIsSiteAdmin(ctx, admin.ID, true))
+
+	u1, err := db.Users().Create(ctx, database.NewUser{Username: "username-1"})
+	require.NoError(t, err)
+
+	u2, err := db.Users().Create(ctx, database.NewUser{Username: "username-2"})
+	require.NoError(t, err)
+
+	p, err := db.Permissions().Create(ctx, database.CreatePermissionOpts{
+		Namespace: types.OutboundWebhooksNamespace,
+		Action:    "WRITE",
+	})
+	require.NoError(t, err)
+
+	r, err := db.Roles().Create(ctx, "WEBHOOK-ADMIN", false)
+	require.NoError(t, err)
+
+	err = db.RolePermissions().Assign(ctx, database.AssignRolePermissionOpts{
+		RoleID:       r.ID,
+		PermissionID: p.ID,
+	})
+	require.NoError(t, err)
+
+	err = db.UserRoles().Assign(ctx, database.AssignUserRoleOpts{
+		UserID: u2.ID,
+		RoleID: r.ID,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		context context.Context
+
+		expectedErr error
+	}{
+		{
+			name:        "internal actor",
+			context:     actor.WithInternalActor(ctx),
+			expectedErr: nil,
+		},
+		{
+			name:        "non-existent actor",
+			context:     actor.WithActor(ctx, &actor.Actor{UID: 9389}),
+			expectedErr: auth.ErrNotAuthenticated,
+		},
+		{
+			name:        "site admin without permission",
+			context:     actor.WithActor(ctx, &actor.Actor{UID: admin.ID}),
+			expectedErr: nil,
+		},
+		{
+			name:        "unauthorized user",
+			context:     actor.WithActor(ctx, &actor.Actor{UID: u1.ID}),
+			expectedErr: &ErrNotAuthorized{Permission: p.DisplayName()},
+		},
+		{
+			name:        "authorized user",
+			context:     actor.WithActor(ctx, &actor.Actor{UID: u2.ID}),
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckCurrentUserIsSiteAdminOrHasPermission(tc.context, db, p.DisplayName())
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
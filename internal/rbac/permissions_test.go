@@ -124,3 +124,35 @@ func TestComparePermissions(t *testing.T) {
 }
 
 func sortDeletePermissionOptSlice(a, b database.DeletePermissionOpts) bool { return a.ID < b.ID }
+
+func TestSchemaSystemRolesFor(t *testing.T) {
+	schema := Schema{
+		Namespaces: []Namespace{
+			{Name: "TEST-NAMESPACE", Actions: []string{"READ", "WRITE"}},
+		},
+		ExcludeFromUserRole: []string{"TEST-NAMESPACE#WRITE"},
+	}
+
+	require.Equal(t,
+		[]types.SystemRole{types.SiteAdministratorSystemRole, types.UserSystemRole},
+		schema.SystemRolesFor(&types.Permission{Namespace: "TEST-NAMESPACE", Action: "READ"}),
+	)
+	require.Equal(t,
+		[]types.SystemRole{types.SiteAdministratorSystemRole},
+		schema.SystemRolesFor(&types.Permission{Namespace: "TEST-NAMESPACE", Action: "WRITE"}),
+	)
+}
+
+func TestRBACSchema(t *testing.T) {
+	defined := make(map[string]struct{})
+	for _, n := range RBACSchema.Namespaces {
+		require.True(t, n.Name.Valid(), "namespace %s is not valid", n.Name)
+		for _, a := range n.Actions {
+			defined[(&types.Permission{Namespace: n.Name, Action: a}).DisplayName()] = struct{}{}
+		}
+	}
+
+	for _, displayName := range RBACSchema.ExcludeFromUserRole {
+		require.Contains(t, defined, displayName)
+	}
+}
@@ -6,6 +6,9 @@ import "github.com/sourcegraph/sourcegraph/internal/types"
 // the RBAC system.
 type Schema struct {
 	Namespaces []Namespace `json:"namespaces"`
+	// ExcludeFromUserRole contains the display names of permissions that are not assigned to the
+	// USER system role when they are created, because they guard administrative features.
+	ExcludeFromUserRole []string `json:"excludeFromUserRole" yaml:"excludeFromUserRole"`
 }
 
 // Namespace represents a feature to be guarded by RBAC. (example: Batch Changes, Code Insights e.t.c)
@@ -13,3 +16,13 @@ type Namespace struct {
 	Name    types.PermissionNamespace `json:"name"`
 	Actions []string                  `json:"actions"`
 }
+
+// SystemRolesFor returns the system roles a newly created permission is assigned to.
+func (s Schema) SystemRolesFor(p *types.Permission) []types.SystemRole {
+	for _, displayName := range s.ExcludeFromUserRole {
+		if displayName == p.DisplayName() {
+			return []types.SystemRole{types.SiteAdministratorSystemRole}
+		}
+	}
+	return []types.SystemRole{types.SiteAdministratorSystemRole, types.UserSystemRole}
+}
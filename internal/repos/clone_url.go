@@ -97,6 +97,10 @@ func cloneURL(parsed any, logger log.Logger, kind string, repo *types.Repo) (str
 		if r, ok := repo.Metadata.(*extsvc.OtherRepoMetadata); ok {
 			return otherCloneURL(repo, r), nil
 		}
+	case *schema.MercurialConnection:
+		if r, ok := repo.Metadata.(*extsvc.MercurialRepoMetadata); ok {
+			return mercurialCloneURL(t, r)
+		}
 	case *schema.GoModulesConnection:
 		return string(repo.Name), nil
 	case *schema.PythonPackagesConnection:
@@ -329,3 +333,17 @@ func phabricatorCloneURL(logger log.Logger, repo *phabricator.Repo, _ *schema.Ph
 func otherCloneURL(repo *types.Repo, m *extsvc.OtherRepoMetadata) string {
 	return repo.ExternalRepo.ServiceID + m.RelativePath
 }
+
+// mercurialCloneURL resolves the repository path against the configured URL,
+// which is where any credentials for the Mercurial server live.
+func mercurialCloneURL(c *schema.MercurialConnection, m *extsvc.MercurialRepoMetadata) (string, error) {
+	base, err := url.Parse(c.Url)
+	if err != nil {
+		return "", err
+	}
+	u, err := extsvc.NormalizeBaseURL(base).Parse(m.Path)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,141 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A MercurialSource yields repositories from a single Mercurial connection
+// configured in Sourcegraph via the external services configuration. Like
+// OtherSource, it does not talk to the code host: the repositories are taken
+// verbatim from the repos list and converted to git by gitserver.
+type MercurialSource struct {
+	svc     *types.ExternalService
+	conn    *schema.MercurialConnection
+	base    *url.URL
+	exclude excludeFunc
+}
+
+// NewMercurialSource returns a new MercurialSource from the given external service.
+func NewMercurialSource(ctx context.Context, svc *types.ExternalService) (*MercurialSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.MercurialConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	base, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+	// Repository paths are resolved relative to the base URL, so it needs a
+	// trailing slash to not drop its last path segment.
+	base = extsvc.NormalizeBaseURL(base)
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Pattern(r.Pattern)
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MercurialSource{
+		svc:     svc,
+		conn:    &c,
+		base:    base,
+		exclude: exclude,
+	}, nil
+}
+
+// CheckConnection at this point assumes availability and relies on errors returned
+// from the subsequent calls. This is going to be expanded as part of issue #44683
+// to actually only return true if the source can serve requests.
+func (s *MercurialSource) CheckConnection(ctx context.Context) error {
+	return nil
+}
+
+// ListRepos returns all Mercurial repositories listed in the connection.
+func (s *MercurialSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	urn := s.svc.URN()
+	for _, p := range s.conn.Repos {
+		r, err := s.makeRepo(urn, p)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			return
+		}
+		if s.exclude(string(r.Name)) {
+			continue
+		}
+
+		results <- SourceResult{Source: s, Repo: r}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *MercurialSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *MercurialSource) makeRepo(urn, repoPath string) (*types.Repo, error) {
+	cloneURL, err := s.base.Parse(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	repoSource := reposource.Mercurial{MercurialConnection: s.conn}
+	name, err := repoSource.CloneURLToRepoName(cloneURL.String())
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, errors.Errorf("mercurial repository %q is not hosted at %q", repoPath, redactedBaseURL(s.base))
+	}
+	uri, err := repoSource.CloneURLToRepoURI(cloneURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// The external ID must not change when credentials in the URL are
+	// rotated, so we strip them from both the ID and the service ID.
+	id := *cloneURL
+	id.User = nil
+
+	return &types.Repo{
+		Name: name,
+		URI:  uri,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          id.String(),
+			ServiceType: extsvc.TypeMercurial,
+			ServiceID:   redactedBaseURL(s.base),
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL.String(),
+			},
+		},
+		Metadata: &extsvc.MercurialRepoMetadata{
+			Path: repoPath,
+		},
+	}, nil
+}
+
+func redactedBaseURL(u *url.URL) string {
+	base := *u
+	base.User = nil
+	return base.String()
+}
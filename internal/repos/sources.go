@@ -80,6 +80,8 @@ func NewSource(ctx context.Context, logger log.Logger, db database.DB, svc *type
 		return NewRustPackagesSource(ctx, svc, cf)
 	case extsvc.KindRubyPackages:
 		return NewRubyPackagesSource(ctx, svc, cf)
+	case extsvc.KindMercurial:
+		return NewMercurialSource(ctx, svc)
 	case extsvc.KindOther:
 		return NewOtherSource(ctx, svc, cf, logger.Scoped("OtherSource", ""))
 	default:
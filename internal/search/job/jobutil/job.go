@@ -348,8 +348,9 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 			// Create Symbol Search jobs over repo set.
 			if !skipRepoSubsetSearch {
 				symbolSearchJob := &searcher.SymbolSearchJob{
-					PatternInfo: patternInfo,
-					Limit:       maxResults,
+					PatternInfo:  patternInfo,
+					SymbolFilter: toSymbolFilter(f.ToBasic()),
+					Limit:        maxResults,
 				}
 
 				addJob(&repoPagerJob{
@@ -645,6 +646,19 @@ func toTextPatternInfo(b query.Basic, resultTypes result.Types, p search.Protoco
 	}
 }
 
+// toSymbolFilter returns the symbol.kind: and symbol.container: filters of b.
+func toSymbolFilter(b query.Basic) search.SymbolFilter {
+	includeKinds, excludeKinds := b.IncludeExcludeValues(query.FieldSymbolKind)
+	includeContainers, excludeContainers := b.IncludeExcludeValues(query.FieldSymbolContainer)
+	return search.SymbolFilter{
+		IncludeKinds:      includeKinds,
+		ExcludeKinds:      excludeKinds,
+		IncludeContainers: includeContainers,
+		ExcludeContainer:  query.UnionRegExps(excludeContainers),
+		IsCaseSensitive:   b.IsCaseSensitive(),
+	}
+}
+
 // computeResultTypes returns result types based three inputs: `type:...` in the query,
 // the `pattern`, and top-level `searchType` (coming from a GQL value).
 func computeResultTypes(b query.Basic, searchType query.SearchType) result.Types {
@@ -730,6 +744,7 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		UseIndex:            b.Index(),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
+		DependsOn:           b.RepoDependsOn(),
 	}
 }
 
@@ -786,6 +801,7 @@ func (b *jobBuilder) newZoektGlobalSearch(typ search.IndexedRequestType) (job.Jo
 		return &zoekt.GlobalSymbolSearchJob{
 			GlobalZoektQuery: globalZoektQuery,
 			ZoektArgs:        zoektArgs,
+			SymbolFilter:     toSymbolFilter(b.query),
 			RepoOpts:         b.repoOptions,
 		}, nil
 	case search.TextRequest:
@@ -811,6 +827,7 @@ func (b *jobBuilder) newZoektSearch(typ search.IndexedRequestType) (job.Job, err
 			Query:          zoektQuery,
 			FileMatchLimit: b.fileMatchLimit,
 			Select:         b.selector,
+			SymbolFilter:   toSymbolFilter(b.query),
 			Features:       *b.features,
 		}, nil
 	case search.TextRequest:
@@ -1004,6 +1021,12 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// Zoekt does not know about repo dependencies, so we depend on the
+	// database to handle this filter.
+	if len(op.DependsOn) > 0 {
+		return false
+	}
+
 	// If a search context is specified, we do not know ahead of time whether
 	// the repos in the context are indexed and we need to go through the repo
 	// resolution process.
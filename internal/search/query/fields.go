@@ -27,6 +27,10 @@ const (
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
 
+	// For symbol search only:
+	FieldSymbolKind      = "symbol.kind"
+	FieldSymbolContainer = "symbol.container"
+
 	// Temporary experimental fields:
 	FieldIndex     = "index"
 	FieldCount     = "count" // Searches that specify `count:` will fetch at least that number of results, or the full result set
@@ -71,6 +75,8 @@ var allFields = map[string]struct{}{
 	FieldRev:                empty,
 	"revision":              empty,
 	FieldSelect:             empty,
+	FieldSymbolKind:         empty,
+	FieldSymbolContainer:    empty,
 }
 
 var aliases = map[string]string{
@@ -289,6 +289,10 @@ func TestScanField(t *testing.T) {
 	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("-repo"))
 	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("--repo:"))
 	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test(":foo"))
+	autogold.Expect(`{"Field":"symbol.kind","Negated":true,"Advance":13}`).Equal(t, test("-symbol.kind:method"))
+	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("symbol.:"))
+	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("symbol..kind:"))
+	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test(".kind:"))
 }
 
 func parseAndOrGrammar(in string) ([]Node, error) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Masterminds/semver"
 	"github.com/grafana/regexp"
 	"github.com/grafana/regexp/syntax"
 
@@ -39,6 +40,7 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has":                   func() Predicate { return &RepoHasKVPPredicate{} },
 		"has.key":               func() Predicate { return &RepoHasKeyPredicate{} },
 		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"depends.on":            func() Predicate { return &RepoDependsOnPredicate{} },
 
 		// Deprecated predicates
 		"contains": func() Predicate { return &RepoContainsPredicate{} },
@@ -407,6 +409,101 @@ func (p *RepoHasTopicPredicate) Unmarshal(params string, negated bool) (err erro
 func (p *RepoHasTopicPredicate) Field() string { return FieldRepo }
 func (p *RepoHasTopicPredicate) Name() string  { return "has.topic" }
 
+// RepoDependsOnPredicate represents the `repo:depends.on(npm/react@^18)`
+// predicate, which matches repositories that depend on a package, optionally
+// restricted to the versions in an npm-style version range.
+type RepoDependsOnPredicate struct {
+	// Scheme is the package scheme as stored in the dependency inventory,
+	// e.g. "npm" or "semanticdb".
+	Scheme  string
+	Package string
+	// Version is the version range as given in the query, empty for any
+	// version. Constraint is its parsed form, nil for any version.
+	Version    string
+	Constraint *semver.Constraints
+	Negated    bool
+}
+
+// dependencySchemes maps the ecosystem names accepted by repo:depends.on() to
+// the package schemes of internal/codeintel/dependencies/shared.
+var dependencySchemes = map[string]string{
+	"npm":    "npm",
+	"go":     "go",
+	"gomod":  "go",
+	"maven":  "semanticdb",
+	"jvm":    "semanticdb",
+	"python": "python",
+	"pip":    "python",
+	"pypi":   "python",
+	"rust":   "rust-analyzer",
+	"cargo":  "rust-analyzer",
+	"crates": "rust-analyzer",
+	"ruby":   "scip-ruby",
+	"gem":    "scip-ruby",
+}
+
+var pythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+func (p *RepoDependsOnPredicate) Unmarshal(params string, negated bool) error {
+	scheme, pkg, ok := strings.Cut(strings.TrimSpace(params), "/")
+	if !ok || pkg == "" {
+		return errors.Errorf("expected a package of the form ecosystem/name[@version], e.g. npm/react@^18, got %q", params)
+	}
+	if p.Scheme, ok = dependencySchemes[strings.ToLower(scheme)]; !ok {
+		return errors.Errorf("unsupported package ecosystem %q", scheme)
+	}
+
+	// The version is separated by the last "@", which must not be the
+	// leading "@" of a scoped npm package like @types/node.
+	p.Package = pkg
+	if i := strings.LastIndexByte(pkg, '@'); i > 0 {
+		p.Package, p.Version = pkg[:i], strings.TrimSpace(pkg[i+1:])
+	}
+	if p.Package == "" {
+		return errors.Errorf("package name must be non-empty in %q", params)
+	}
+	if p.Scheme == "python" {
+		// Python package names are case-insensitive and treat runs of
+		// "-", "_" and "." as equal (PEP 503). The inventory stores
+		// them normalized.
+		p.Package = strings.ToLower(pythonNameSeparators.ReplaceAllString(p.Package, "-"))
+	}
+
+	if p.Version != "" {
+		c, err := parseVersionConstraint(p.Version)
+		if err != nil {
+			return errors.Wrapf(err, "invalid version range %q", p.Version)
+		}
+		p.Constraint = c
+	}
+	p.Negated = negated
+	return nil
+}
+
+func (p *RepoDependsOnPredicate) Field() string { return FieldRepo }
+func (p *RepoDependsOnPredicate) Name() string  { return "depends.on" }
+
+var (
+	hyphenRangePattern        = regexp.MustCompile(`^\S+\s+-\s+\S+$`)
+	comparatorOperatorPattern = regexp.MustCompile(`([<>=~^!]+)\s+`)
+)
+
+// parseVersionConstraint parses a version range into semver constraints.
+// Besides the comma-separated comparators of the semver package, it accepts
+// comparators separated by whitespace as in npm, e.g. ">=18.0.0 <19.0.0".
+func parseVersionConstraint(version string) (*semver.Constraints, error) {
+	ors := strings.Split(version, "||")
+	for i, or := range ors {
+		or = strings.TrimSpace(or)
+		if !hyphenRangePattern.MatchString(or) {
+			or = comparatorOperatorPattern.ReplaceAllString(or, "$1")
+			or = strings.Join(strings.Fields(strings.ReplaceAll(or, ",", " ")), ",")
+		}
+		ors[i] = or
+	}
+	return semver.NewConstraint(strings.Join(ors, " || "))
+}
+
 // RepoContainsPredicate represents the `repo:contains(file:a content:b)` predicate.
 // DEPRECATED: this syntax is deprecated in favor of `repo:contains.file`.
 type RepoContainsPredicate struct {
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/Masterminds/semver"
 	"github.com/stretchr/testify/require"
 )
 
@@ -145,6 +146,86 @@ func TestRepoHasTopicPredicate(t *testing.T) {
 	})
 }
 
+func TestRepoDependsOnPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			params  string
+			scheme  string
+			pkg     string
+			version string
+		}
+
+		valid := []test{
+			{`npm/react`, "npm", "react", ""},
+			{`npm/react@^18`, "npm", "react", "^18"},
+			{`npm/@types/node@>=18.0.0 <19`, "npm", "@types/node", ">=18.0.0 <19"},
+			{`npm/@types/node`, "npm", "@types/node", ""},
+			{`go/github.com/sourcegraph/log@v0.0.0-20230203201409-49ea5a9ad8cf`, "go", "github.com/sourcegraph/log", "v0.0.0-20230203201409-49ea5a9ad8cf"},
+			{`gomod/golang.org/x/exp`, "go", "golang.org/x/exp", ""},
+			{`maven/junit:junit@4.x`, "semanticdb", "junit:junit", "4.x"},
+			{`Cargo/serde@1.0 || 2.0`, "rust-analyzer", "serde", "1.0 || 2.0"},
+			{`pip/Zope.Interface@5`, "python", "zope-interface", "5"},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.params, func(t *testing.T) {
+				var p RepoDependsOnPredicate
+				require.NoError(t, p.Unmarshal(tc.params, true))
+				require.Equal(t, tc.scheme, p.Scheme)
+				require.Equal(t, tc.pkg, p.Package)
+				require.Equal(t, tc.version, p.Version)
+				require.Equal(t, tc.version != "", p.Constraint != nil)
+				require.True(t, p.Negated)
+			})
+		}
+
+		invalid := []string{
+			``,
+			`react`,
+			`npm/`,
+			`npm/react@1.2.3.4`,
+			`cobol/payroll`,
+			`npm/react@not-a-range`,
+		}
+
+		for _, params := range invalid {
+			t.Run(params, func(t *testing.T) {
+				var p RepoDependsOnPredicate
+				require.Error(t, p.Unmarshal(params, false))
+			})
+		}
+	})
+
+	t.Run("Constraint", func(t *testing.T) {
+		type test struct {
+			version string
+			match   []string
+			noMatch []string
+		}
+
+		tests := []test{
+			{`^18`, []string{"18.0.0", "18.2.0"}, []string{"17.0.2", "19.0.0", "18.3.0-rc.1"}},
+			{`>=18.0.0 <19.0.0`, []string{"18.2.0"}, []string{"17.0.2", "19.0.0"}},
+			{`>= 18, < 19.0.0`, []string{"18.2.0"}, []string{"19.0.0"}},
+			{`17.x || >=19.0.0-0`, []string{"17.0.2", "19.0.0-rc.1"}, []string{"18.2.0"}},
+			{`1.2 - 1.4`, []string{"1.2.0", "1.4.0"}, []string{"1.5.0"}},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.version, func(t *testing.T) {
+				var p RepoDependsOnPredicate
+				require.NoError(t, p.Unmarshal("npm/react@"+tc.version, false))
+				for _, v := range tc.match {
+					require.True(t, p.Constraint.Check(semver.MustParse(v)), v)
+				}
+				for _, v := range tc.noMatch {
+					require.False(t, p.Constraint.Check(semver.MustParse(v)), v)
+				}
+			})
+		}
+	})
+}
+
 func TestRepoHasKVPPredicate(t *testing.T) {
 	t.Run("Unmarshal", func(t *testing.T) {
 		type test struct {
@@ -474,6 +474,13 @@ func (p Parameters) RepoHasTopics() (res []RepoHasTopicPredicate) {
 	return res
 }
 
+func (p Parameters) RepoDependsOn() (res []RepoDependsOnPredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *RepoDependsOnPredicate) {
+		res = append(res, *pred)
+	})
+	return res
+}
+
 func (p Parameters) FileHasOwner() (include, exclude []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileHasOwnerPredicate) {
 		if pred.Negated {
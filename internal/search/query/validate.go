@@ -222,6 +222,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isValidSymbolKind := func() error {
+		if _, err := filter.SelectPathFromString(filter.Symbol + "." + strings.ToLower(value)); err != nil {
+			return errors.Errorf("invalid value %q for field %q: not a symbol kind", value, field)
+		}
+		return nil
+	}
+
 	isValidGitDate := func() error {
 		_, err := ParseGitDate(value, time.Now)
 		return err
@@ -298,6 +305,12 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldSymbolKind:
+		return satisfies(isValidSymbolKind)
+	case
+		FieldSymbolContainer:
+		return satisfies(isValidRegexp)
 	default:
 		return isUnrecognizedField()
 	}
@@ -358,6 +371,24 @@ func validateCommitParameters(nodes []Node) error {
 	return nil
 }
 
+// Queries containing symbol parameters without type:symbol are not valid.
+func validateSymbolParameters(nodes []Node) error {
+	var seenSymbolParam string
+	var typeSymbolExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldSymbolKind || field == FieldSymbolContainer {
+			seenSymbolParam = field
+		}
+		if field == FieldType && value == "symbol" {
+			typeSymbolExists = true
+		}
+	})
+	if seenSymbolParam != "" && !typeSymbolExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:symbol in the query`, seenSymbolParam)
+	}
+	return nil
+}
+
 func validateTypeStructural(nodes []Node) error {
 	seenStructural := false
 	seenType := false
@@ -498,6 +529,7 @@ func validate(nodes []Node) error {
 		validateRepoRevPair,
 		validateRepoHasFile,
 		validateCommitParameters,
+		validateSymbolParameters,
 		validateTypeStructural,
 		validateRefGlobs,
 	)
@@ -111,6 +111,18 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input: "repo:foo symbol.kind:function",
+			want:  `your query contains the field 'symbol.kind', which requires type:symbol in the query`,
+		},
+		{
+			input: "type:symbol symbol.kind:timelime",
+			want:  `invalid value "timelime" for field "symbol.kind": not a symbol kind`,
+		},
+		{
+			input: "type:symbol symbol.container:[a",
+			want:  "error parsing regexp: missing closing ]: `[a`",
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
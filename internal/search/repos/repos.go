@@ -153,6 +153,16 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (_ Resolv
 		})
 	}
 
+	dependencyFilters := make([]database.RepoDependencyFilter, 0, len(op.DependsOn))
+	for _, filter := range op.DependsOn {
+		dependencyFilters = append(dependencyFilters, database.RepoDependencyFilter{
+			Scheme:     filter.Scheme,
+			Name:       filter.Package,
+			Constraint: filter.Constraint,
+			Negated:    filter.Negated,
+		})
+	}
+
 	options := database.ReposListOptions{
 		IncludePatterns:       includePatterns,
 		ExcludePattern:        query.UnionRegExps(excludePatterns),
@@ -160,6 +170,7 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (_ Resolv
 		CaseSensitivePatterns: op.CaseSensitiveRepoFilters,
 		KVPFilters:            kvpFilters,
 		TopicFilters:          topicFilters,
+		DependencyFilters:     dependencyFilters,
 		Cursors:               op.Cursors,
 		// List N+1 repos so we can see if there are repos omitted due to our repo limit.
 		LimitOffset:  &database.LimitOffset{Limit: limit + 1},
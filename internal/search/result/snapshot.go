@@ -0,0 +1,197 @@
+package result
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// SnapshotEntry identifies a single result of a search in a snapshot of its
+// result set. For file content matches there is one entry per matched line,
+// other matches are represented by a single entry without a line.
+type SnapshotEntry struct {
+	Repo api.RepoName `json:"repo"`
+	Path string       `json:"path,omitempty"`
+
+	// Line is the 0-based line number of the match, or -1 if the match is
+	// not associated with a line.
+	Line int `json:"line"`
+
+	// Hash identifies the content of the match independently of its
+	// location, so that matches can be recognized after they moved. For
+	// content matches it is computed from the matched line without leading
+	// and trailing whitespace.
+	Hash string `json:"hash"`
+}
+
+// SnapshotEntries is a set of snapshot entries ordered by location.
+type SnapshotEntries []SnapshotEntry
+
+func (s SnapshotEntries) Len() int      { return len(s) }
+func (s SnapshotEntries) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s SnapshotEntries) Less(i, j int) bool {
+	a, b := s[i], s[j]
+	if a.Repo != b.Repo {
+		return a.Repo < b.Repo
+	}
+	if a.Path != b.Path {
+		return a.Path < b.Path
+	}
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Hash < b.Hash
+}
+
+// NewSnapshotEntries returns the snapshot entries representing the given match.
+func NewSnapshotEntries(match Match) SnapshotEntries {
+	fm, ok := match.(*FileMatch)
+	if !ok {
+		key := match.Key()
+		return SnapshotEntries{{
+			Repo: key.Repo,
+			Path: key.Path,
+			Line: -1,
+			Hash: snapshotHash(string(key.Commit), key.OwnerMetadata),
+		}}
+	}
+
+	var entries SnapshotEntries
+	newEntry := func(line int, content ...string) {
+		entries = append(entries, SnapshotEntry{
+			Repo: fm.Repo.Name,
+			Path: fm.Path,
+			Line: line,
+			Hash: snapshotHash(content...),
+		})
+	}
+
+	for _, cm := range fm.ChunkMatches {
+		lines := strings.Split(cm.Content, "\n")
+		seen := map[int]struct{}{}
+		for _, r := range cm.Ranges {
+			line := r.Start.Line
+			if _, ok := seen[line]; ok {
+				continue
+			}
+			seen[line] = struct{}{}
+
+			var content string
+			if i := line - cm.ContentStart.Line; i >= 0 && i < len(lines) {
+				content = strings.TrimSpace(lines[i])
+			}
+			newEntry(line, content)
+		}
+	}
+	for _, sm := range fm.Symbols {
+		newEntry(sm.Symbol.Line, sm.Symbol.Kind, sm.Symbol.Name)
+	}
+	if len(entries) == 0 {
+		// Path matches are identified by the file.
+		newEntry(-1, fm.Path)
+	}
+
+	return entries
+}
+
+func snapshotHash(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// SnapshotMove is an entry that moved between two snapshots.
+type SnapshotMove struct {
+	From SnapshotEntry `json:"from"`
+	To   SnapshotEntry `json:"to"`
+}
+
+// SnapshotDiff is the difference between two snapshots of a result set.
+type SnapshotDiff struct {
+	Added     SnapshotEntries
+	Removed   SnapshotEntries
+	Moved     []SnapshotMove
+	Unchanged int
+}
+
+// DiffSnapshots compares the entries of two snapshots of a result set, which
+// must be sorted. Entries that only exist in next are added, entries that only
+// exist in prev are removed. An added and a removed entry with the same
+// content in the same repository are reported as a move instead, preferring
+// moves within the same file.
+func DiffSnapshots(prev, next SnapshotEntries) SnapshotDiff {
+	var diff SnapshotDiff
+
+	remaining := make(map[SnapshotEntry]int, len(prev))
+	for _, e := range prev {
+		remaining[e]++
+	}
+	var added SnapshotEntries
+	for _, e := range next {
+		if remaining[e] > 0 {
+			remaining[e]--
+			diff.Unchanged++
+			continue
+		}
+		added = append(added, e)
+	}
+	var removed SnapshotEntries
+	for _, e := range prev {
+		if remaining[e] > 0 {
+			remaining[e]--
+			removed = append(removed, e)
+		}
+	}
+
+	type moveKey struct {
+		repo       api.RepoName
+		path, hash string
+	}
+	movedFrom := make([]bool, len(removed))
+	movedTo := make([]bool, len(added))
+	pair := func(key func(SnapshotEntry) moveKey) {
+		candidates := map[moveKey][]int{}
+		for i, e := range removed {
+			if !movedFrom[i] {
+				k := key(e)
+				candidates[k] = append(candidates[k], i)
+			}
+		}
+		for j, e := range added {
+			if movedTo[j] {
+				continue
+			}
+			k := key(e)
+			if c := candidates[k]; len(c) > 0 {
+				i := c[0]
+				candidates[k] = c[1:]
+				movedFrom[i], movedTo[j] = true, true
+				diff.Moved = append(diff.Moved, SnapshotMove{From: removed[i], To: e})
+			}
+		}
+	}
+	pair(func(e SnapshotEntry) moveKey { return moveKey{e.Repo, e.Path, e.Hash} })
+	pair(func(e SnapshotEntry) moveKey { return moveKey{e.Repo, "", e.Hash} })
+
+	for j, e := range added {
+		if !movedTo[j] {
+			diff.Added = append(diff.Added, e)
+		}
+	}
+	for i, e := range removed {
+		if !movedFrom[i] {
+			diff.Removed = append(diff.Removed, e)
+		}
+	}
+	sort.Slice(diff.Moved, func(i, j int) bool {
+		return SnapshotEntries{diff.Moved[i].To, diff.Moved[j].To}.Less(0, 1)
+	})
+
+	return diff
+}
@@ -0,0 +1,81 @@
+package result
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestNewSnapshotEntries(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "r"}
+
+	fm := &FileMatch{
+		File: File{Repo: repo, Path: "a.go"},
+		ChunkMatches: ChunkMatches{{
+			Content:      "\tfoo()\n\tbar(foo)",
+			ContentStart: Location{Offset: 10, Line: 3},
+			Ranges: Ranges{
+				{Start: Location{11, 3, 1}, End: Location{14, 3, 4}},
+				{Start: Location{18, 4, 1}, End: Location{21, 4, 4}},
+				{Start: Location{22, 4, 5}, End: Location{25, 4, 8}},
+			},
+		}},
+	}
+	entries := NewSnapshotEntries(fm)
+	require.Equal(t, SnapshotEntries{
+		{Repo: "r", Path: "a.go", Line: 3, Hash: snapshotHash("foo()")},
+		{Repo: "r", Path: "a.go", Line: 4, Hash: snapshotHash("bar(foo)")},
+	}, entries)
+
+	// Indentation does not change the hash.
+	fm.ChunkMatches[0].Content = "foo()\n\tbar(foo)"
+	require.Equal(t, entries, NewSnapshotEntries(fm))
+
+	pathMatch := &FileMatch{File: File{Repo: repo, Path: "a.go"}}
+	require.Equal(t, SnapshotEntries{{Repo: "r", Path: "a.go", Line: -1, Hash: snapshotHash("a.go")}}, NewSnapshotEntries(pathMatch))
+
+	repoMatch := &RepoMatch{Name: "r", ID: 1}
+	require.Equal(t, SnapshotEntries{{Repo: "r", Line: -1, Hash: snapshotHash("", "")}}, NewSnapshotEntries(repoMatch))
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	entry := func(path string, line int, hash string) SnapshotEntry {
+		return SnapshotEntry{Repo: "r", Path: path, Line: line, Hash: hash}
+	}
+	sorted := func(entries ...SnapshotEntry) SnapshotEntries {
+		sort.Sort(SnapshotEntries(entries))
+		return entries
+	}
+
+	prev := sorted(
+		entry("a.go", 1, "x"),
+		entry("a.go", 5, "y"),
+		entry("b.go", 1, "z"),
+		entry("c.go", 1, "w"),
+	)
+	next := sorted(
+		entry("a.go", 1, "x"), // unchanged
+		entry("a.go", 7, "y"), // moved within a.go
+		entry("d.go", 1, "z"), // moved from b.go
+		entry("d.go", 2, "v"), // added
+	)
+
+	require.Equal(t, SnapshotDiff{
+		Added:   SnapshotEntries{entry("d.go", 2, "v")},
+		Removed: SnapshotEntries{entry("c.go", 1, "w")},
+		Moved: []SnapshotMove{
+			{From: entry("a.go", 5, "y"), To: entry("a.go", 7, "y")},
+			{From: entry("b.go", 1, "z"), To: entry("d.go", 1, "z")},
+		},
+		Unchanged: 1,
+	}, DiffSnapshots(prev, next))
+
+	// Duplicated entries are matched one by one.
+	require.Equal(t, SnapshotDiff{
+		Added:     SnapshotEntries{entry("a.go", 1, "x")},
+		Unchanged: 1,
+	}, DiffSnapshots(sorted(entry("a.go", 1, "x")), sorted(entry("a.go", 1, "x"), entry("a.go", 1, "x"))))
+}
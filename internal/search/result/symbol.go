@@ -3,6 +3,7 @@ package result
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -225,6 +226,22 @@ var toSelectKind = map[string]string{
 	"annotation":      "type-parameter",
 }
 
+// SymbolKindsForSelectKind returns the internal symbol kinds (cf. ctagsKind)
+// that correspond to the given symbol selector kind in select.go, in sorted
+// order. The selector kind itself is always included, so that kinds which the
+// parsers report verbatim (like "enum") also match.
+func SymbolKindsForSelectKind(selectKind string) []string {
+	selectKind = strings.ToLower(selectKind)
+	kinds := []string{selectKind}
+	for kind, k := range toSelectKind {
+		if k == selectKind && kind != selectKind {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
 func pick(symbols []*SymbolMatch, satisfy func(*SymbolMatch) bool) []*SymbolMatch {
 	var result []*SymbolMatch
 	for _, symbol := range symbols {
@@ -53,3 +53,9 @@ func TestSymbolURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSymbolKindsForSelectKind(t *testing.T) {
+	require.Equal(t, []string{"method", "methodspec"}, SymbolKindsForSelectKind("method"))
+	require.Equal(t, []string{"enum"}, SymbolKindsForSelectKind("enum"))
+	require.Equal(t, []string{"enum member", "enum-member", "enumconstant"}, SymbolKindsForSelectKind("Enum-Member"))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -122,6 +123,10 @@ func ValidateSearchContextWriteAccessForCurrentUser(ctx context.Context, db data
 		return errors.New("current user not found")
 	}
 
+	if err := rbac.CheckCurrentUserHasPermission(ctx, db, rbac.SearchContextsWritePermission); err != nil {
+		return err
+	}
+
 	// Site-admins have write access to all public search contexts
 	if user.SiteAdmin && public {
 		return nil
@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -195,6 +196,7 @@ func TestResolvingSearchContextRepoNames(t *testing.T) {
 	internalCtx := actor.WithInternalActor(context.Background())
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignSearchContextsWritePermissionToUserRole(t, db)
 	u := db.Users()
 	r := db.Repos()
 
@@ -235,6 +237,7 @@ func TestSearchContextWriteAccessValidation(t *testing.T) {
 	internalCtx := actor.WithInternalActor(context.Background())
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignSearchContextsWritePermissionToUserRole(t, db)
 	u := db.Users()
 
 	org, err := db.Orgs().Create(internalCtx, "myorg", nil)
@@ -348,6 +351,24 @@ func TestSearchContextWriteAccessValidation(t *testing.T) {
 	}
 }
 
+func TestSearchContextWriteAccessValidationWithoutPermission(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	internalCtx := actor.WithInternalActor(context.Background())
+	logger := logtest.Scoped(t)
+	// The SEARCH_CONTEXTS#WRITE permission is not assigned to any role.
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	user, err := db.Users().Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
+	require.NoError(t, err)
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: user.ID})
+	err = ValidateSearchContextWriteAccessForCurrentUser(ctx, db, user.ID, 0, false)
+	require.ErrorContains(t, err, (&rbac.ErrNotAuthorized{Permission: rbac.SearchContextsWritePermission}).Error())
+}
+
 func TestCreatingSearchContexts(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -356,6 +377,7 @@ func TestCreatingSearchContexts(t *testing.T) {
 	internalCtx := actor.WithInternalActor(context.Background())
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignSearchContextsWritePermissionToUserRole(t, db)
 	u := db.Users()
 
 	user1, err := u.Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
@@ -496,6 +518,7 @@ func TestUpdatingSearchContexts(t *testing.T) {
 	internalCtx := actor.WithInternalActor(context.Background())
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignSearchContextsWritePermissionToUserRole(t, db)
 	u := db.Users()
 
 	user1, err := u.Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
@@ -581,6 +604,7 @@ func TestDeletingAutoDefinedSearchContext(t *testing.T) {
 	internalCtx := actor.WithInternalActor(context.Background())
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	assignSearchContextsWritePermissionToUserRole(t, db)
 	u := db.Users()
 
 	user1, err := u.Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
@@ -721,3 +745,21 @@ func TestParseRepoOpts(t *testing.T) {
 		})
 	}
 }
+
+// assignSearchContextsWritePermissionToUserRole mirrors the default RBAC setup,
+// where every user may create and edit search contexts.
+func assignSearchContextsWritePermissionToUserRole(t *testing.T, db database.DB) {
+	t.Helper()
+
+	ctx := context.Background()
+	p, err := db.Permissions().Create(ctx, database.CreatePermissionOpts{
+		Namespace: types.SearchContextsNamespace,
+		Action:    "WRITE",
+	})
+	require.NoError(t, err)
+	err = db.RolePermissions().BulkAssignPermissionsToSystemRoles(ctx, database.BulkAssignPermissionsToSystemRolesOpts{
+		Roles:        []types.SystemRole{types.SiteAdministratorSystemRole, types.UserSystemRole},
+		PermissionID: p.ID,
+	})
+	require.NoError(t, err)
+}
@@ -23,9 +23,10 @@ import (
 )
 
 type SymbolSearchJob struct {
-	PatternInfo *search.TextPatternInfo
-	Repos       []*search.RepositoryRevisions // the set of repositories to search with searcher.
-	Limit       int
+	PatternInfo  *search.TextPatternInfo
+	SymbolFilter search.SymbolFilter
+	Repos        []*search.RepositoryRevisions // the set of repositories to search with searcher.
+	Limit        int
 }
 
 // Run calls the searcher service to search symbols.
@@ -49,7 +50,7 @@ func (s *SymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClients, s
 		}
 
 		p.Go(func(ctx context.Context) error {
-			matches, err := searchInRepo(ctx, repoRevs, s.PatternInfo, s.Limit)
+			matches, err := searchInRepo(ctx, repoRevs, s.PatternInfo, s.SymbolFilter, s.Limit)
 			status, limitHit, err := search.HandleRepoSearchResult(repoRevs.Repo.ID, repoRevs.Revs, len(matches) > s.Limit, false, err)
 			stream.Send(streaming.SearchEvent{
 				Results: matches,
@@ -79,6 +80,11 @@ func (s *SymbolSearchJob) Fields(v job.Verbosity) (res []log.Field) {
 	case job.VerbosityBasic:
 		res = append(res,
 			trace.Scoped("patternInfo", s.PatternInfo.Fields()...),
+		)
+		if !s.SymbolFilter.IsEmpty() {
+			res = append(res, trace.Scoped("symbolFilter", s.SymbolFilter.Fields()...))
+		}
+		res = append(res,
 			log.Int("numRepos", len(s.Repos)),
 			log.Int("limit", s.Limit),
 		)
@@ -89,7 +95,7 @@ func (s *SymbolSearchJob) Fields(v job.Verbosity) (res []log.Field) {
 func (s *SymbolSearchJob) Children() []job.Describer       { return nil }
 func (s *SymbolSearchJob) MapChildren(job.MapFunc) job.Job { return s }
 
-func searchInRepo(ctx context.Context, repoRevs *search.RepositoryRevisions, patternInfo *search.TextPatternInfo, limit int) (res []result.Match, err error) {
+func searchInRepo(ctx context.Context, repoRevs *search.RepositoryRevisions, patternInfo *search.TextPatternInfo, symbolFilter search.SymbolFilter, limit int) (res []result.Match, err error) {
 	span, ctx := ot.StartSpanFromContext(ctx, "Search symbols in repo") //nolint:staticcheck // OT is deprecated
 	defer func() {
 		if err != nil {
@@ -120,6 +126,12 @@ func searchInRepo(ctx context.Context, repoRevs *search.RepositoryRevisions, pat
 		IsRegExp:        patternInfo.IsRegExp,
 		IncludePatterns: patternInfo.IncludePatterns,
 		ExcludePattern:  patternInfo.ExcludePattern,
+
+		IncludeKinds:      symbolFilter.IncludeKinds,
+		ExcludeKinds:      symbolFilter.ExcludeKinds,
+		IncludeContainers: symbolFilter.IncludeContainers,
+		ExcludeContainer:  symbolFilter.ExcludeContainer,
+
 		// Ask for limit + 1 so we can detect whether there are more results than the limit.
 		First: limit + 1,
 	})
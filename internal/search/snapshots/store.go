@@ -0,0 +1,149 @@
+// Package snapshots persists snapshots of search result sets, so that later
+// runs of the same query can be diffed against them.
+package snapshots
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// MaxAge is how long snapshots are kept before they are deleted.
+	MaxAge = 30 * 24 * time.Hour
+
+	// MaxPerUser is the number of snapshots kept for each user. Storing a
+	// new snapshot deletes the oldest snapshots of the user beyond it.
+	MaxPerUser = 100
+)
+
+// Snapshot is a stored snapshot of the result set of a search query.
+type Snapshot struct {
+	ID     int32
+	UserID int32
+	Query  string
+
+	// Entries are the sorted entries of the result set.
+	Entries result.SnapshotEntries
+
+	// LimitHit is true if the search hit a limit, in which case the snapshot
+	// does not contain the complete result set.
+	LimitHit bool
+
+	CreatedAt time.Time
+}
+
+// SnapshotNotFoundErr is returned when a snapshot cannot be found.
+type SnapshotNotFoundErr struct {
+	id int32
+}
+
+func (err SnapshotNotFoundErr) Error() string {
+	return fmt.Sprintf("search result snapshot not found: id=%d", err.id)
+}
+
+func (SnapshotNotFoundErr) NotFound() bool {
+	return true
+}
+
+// Store provides access to the `search_result_snapshots` table.
+type Store interface {
+	// Create stores a new snapshot of the result set of query, returning it
+	// with its ID and creation time populated. Only the MaxPerUser most
+	// recent snapshots of the user are kept.
+	Create(ctx context.Context, userID int32, query string, entries result.SnapshotEntries, limitHit bool) (*Snapshot, error)
+
+	// GetByID returns the snapshot with the given ID. If it does not exist, a
+	// SnapshotNotFoundErr is returned.
+	GetByID(ctx context.Context, id int32) (*Snapshot, error)
+
+	// DeleteOlderThan deletes the snapshots created before t.
+	DeleteOlderThan(ctx context.Context, t time.Time) error
+}
+
+type store struct {
+	*basestore.Store
+}
+
+// NewStore returns a new Store backed by db.
+func NewStore(db database.DB) Store {
+	return &store{Store: basestore.NewWithHandle(db.Handle())}
+}
+
+const createSnapshotFmtstr = `
+WITH inserted AS (
+	INSERT INTO search_result_snapshots (user_id, query, entries, limit_hit)
+	VALUES (%s, %s, %s, %s)
+	RETURNING id, user_id, query, entries, limit_hit, created_at
+),
+-- The inserted snapshot is not visible to the rest of the statement, so
+-- keep one less of the existing snapshots.
+evicted AS (
+	DELETE FROM search_result_snapshots
+	WHERE id IN (
+		SELECT id
+		FROM search_result_snapshots
+		WHERE user_id = %s
+		ORDER BY created_at DESC, id DESC
+		OFFSET %s
+	)
+)
+SELECT id, user_id, query, entries, limit_hit, created_at FROM inserted
+`
+
+func (s *store) Create(ctx context.Context, userID int32, query string, entries result.SnapshotEntries, limitHit bool) (*Snapshot, error) {
+	if entries == nil {
+		entries = result.SnapshotEntries{}
+	}
+	raw, err := json.Marshal(entries)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling snapshot entries")
+	}
+
+	return scanSnapshot(s.QueryRow(ctx, sqlf.Sprintf(createSnapshotFmtstr, userID, query, raw, limitHit, userID, MaxPerUser-1)))
+}
+
+const getSnapshotByIDFmtstr = `
+SELECT id, user_id, query, entries, limit_hit, created_at
+FROM search_result_snapshots
+WHERE id = %s
+`
+
+func (s *store) GetByID(ctx context.Context, id int32) (*Snapshot, error) {
+	snapshot, err := scanSnapshot(s.QueryRow(ctx, sqlf.Sprintf(getSnapshotByIDFmtstr, id)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, SnapshotNotFoundErr{id: id}
+	}
+	return snapshot, err
+}
+
+const deleteSnapshotsOlderThanFmtstr = `
+DELETE FROM search_result_snapshots WHERE created_at < %s
+`
+
+func (s *store) DeleteOlderThan(ctx context.Context, t time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteSnapshotsOlderThanFmtstr, t))
+}
+
+func scanSnapshot(sc interface{ Scan(...any) error }) (*Snapshot, error) {
+	var (
+		s   Snapshot
+		raw []byte
+	)
+	if err := sc.Scan(&s.ID, &s.UserID, &s.Query, &raw, &s.LimitHit, &s.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &s.Entries); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling snapshot entries")
+	}
+	return &s, nil
+}
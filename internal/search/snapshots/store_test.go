@@ -0,0 +1,100 @@
+package snapshots
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	user, err := db.Users().Create(ctx, database.NewUser{Username: "u", Password: "p"})
+	require.NoError(t, err)
+
+	s := NewStore(db)
+	entries := result.SnapshotEntries{
+		{Repo: "r", Path: "a.go", Line: 3, Hash: "abc"},
+		{Repo: "r", Line: -1, Hash: "def"},
+	}
+
+	created, err := s.Create(ctx, user.ID, "foo type:file", entries, true)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, user.ID, created.UserID)
+	require.Equal(t, entries, created.Entries)
+	require.True(t, created.LimitHit)
+
+	got, err := s.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created, got)
+
+	empty, err := s.Create(ctx, user.ID, "nothing", nil, false)
+	require.NoError(t, err)
+	require.Equal(t, result.SnapshotEntries{}, empty.Entries)
+
+	_, err = s.GetByID(ctx, created.ID+1000)
+	require.True(t, errcode.IsNotFound(err))
+}
+
+func TestStoreRetention(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	u1, err := db.Users().Create(ctx, database.NewUser{Username: "u1", Password: "p"})
+	require.NoError(t, err)
+	u2, err := db.Users().Create(ctx, database.NewUser{Username: "u2", Password: "p"})
+	require.NoError(t, err)
+
+	s := NewStore(db)
+
+	other, err := s.Create(ctx, u2.ID, "foo", nil, false)
+	require.NoError(t, err)
+
+	// Only the most recent snapshots of each user are kept.
+	var created []*Snapshot
+	for i := 0; i < MaxPerUser+2; i++ {
+		snapshot, err := s.Create(ctx, u1.ID, "foo", nil, false)
+		require.NoError(t, err)
+		created = append(created, snapshot)
+	}
+	for i, snapshot := range created {
+		_, err := s.GetByID(ctx, snapshot.ID)
+		if i < 2 {
+			require.True(t, errcode.IsNotFound(err), "snapshot %d was not evicted", i)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	_, err = s.GetByID(ctx, other.ID)
+	require.NoError(t, err)
+
+	// Expired snapshots are deleted.
+	_, err = db.ExecContext(ctx, "UPDATE search_result_snapshots SET created_at = NOW() - INTERVAL '31 days' WHERE id = $1", other.ID)
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteOlderThan(ctx, time.Now().Add(-MaxAge)))
+
+	_, err = s.GetByID(ctx, other.ID)
+	require.True(t, errcode.IsNotFound(err))
+	_, err = s.GetByID(ctx, created[len(created)-1].ID)
+	require.NoError(t, err)
+}
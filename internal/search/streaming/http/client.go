@@ -39,6 +39,8 @@ type FrontendStreamDecoder struct {
 	OnFilters  func([]*EventFilter)
 	OnAlert    func(*EventAlert)
 	OnError    func(*EventError)
+	OnSnapshot func(*EventSnapshot)
+	OnDiff     func(*EventSnapshotDiff)
 	OnUnknown  func(event, data []byte)
 }
 
@@ -98,6 +100,24 @@ func (rr FrontendStreamDecoder) ReadAll(r io.Reader) error {
 				return errors.Errorf("failed to decode error payload: %w", err)
 			}
 			rr.OnError(&d)
+		} else if bytes.Equal(event, []byte("snapshot")) {
+			if rr.OnSnapshot == nil {
+				continue
+			}
+			var d EventSnapshot
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode snapshot payload: %w", err)
+			}
+			rr.OnSnapshot(&d)
+		} else if bytes.Equal(event, []byte("diff")) {
+			if rr.OnDiff == nil {
+				continue
+			}
+			var d EventSnapshotDiff
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode diff payload: %w", err)
+			}
+			rr.OnDiff(&d)
 		} else if bytes.Equal(event, []byte("done")) {
 			// Always the last event
 			break
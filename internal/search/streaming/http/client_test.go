@@ -66,6 +66,24 @@ func TestFrontendClient(t *testing.T) {
 		Value: &EventError{
 			Message: "error",
 		},
+	}, {
+		Name: "snapshot",
+		Value: &EventSnapshot{
+			ID:    1,
+			Count: 2,
+		},
+	}, {
+		Name: "diff",
+		Value: &EventSnapshotDiff{
+			Since:   1,
+			Added:   []EventSnapshotEntry{{Repository: "r", Path: "a.go", Line: 3, Hash: "abc"}},
+			Removed: []EventSnapshotEntry{},
+			Moved: []EventSnapshotMove{{
+				From: EventSnapshotEntry{Repository: "r", Path: "a.go", Line: 1, Hash: "def"},
+				To:   EventSnapshotEntry{Repository: "r", Path: "b.go", Line: 1, Hash: "def"},
+			}},
+			Unchanged: 4,
+		},
 	}}
 
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -108,6 +126,12 @@ func TestFrontendClient(t *testing.T) {
 		OnError: func(d *EventError) {
 			got = append(got, Event{Name: "error", Value: d})
 		},
+		OnSnapshot: func(d *EventSnapshot) {
+			got = append(got, Event{Name: "snapshot", Value: d})
+		},
+		OnDiff: func(d *EventSnapshotDiff) {
+			got = append(got, Event{Name: "diff", Value: d})
+		},
 		OnUnknown: func(event, data []byte) {
 			t.Fatalf("got unexpected event: %s %s", event, data)
 		},
@@ -231,6 +231,43 @@ type Annotation struct {
 	Value string `json:"value"`
 }
 
+// EventSnapshot is sent when a snapshot of the result set was stored. Its ID
+// can be passed as the diff parameter of a later search to compare against it.
+type EventSnapshot struct {
+	ID int32 `json:"id"`
+	// Count is the number of entries in the snapshot.
+	Count    int  `json:"count"`
+	LimitHit bool `json:"limitHit"`
+}
+
+// EventSnapshotEntry identifies a single result in a snapshot. Line is -1
+// for results that are not associated with a line.
+type EventSnapshotEntry struct {
+	Repository string `json:"repository"`
+	Path       string `json:"path,omitempty"`
+	Line       int    `json:"line"`
+	Hash       string `json:"hash"`
+}
+
+type EventSnapshotMove struct {
+	From EventSnapshotEntry `json:"from"`
+	To   EventSnapshotEntry `json:"to"`
+}
+
+// EventSnapshotDiff is the difference between the result set of a search and
+// a prior snapshot.
+type EventSnapshotDiff struct {
+	// Since is the ID of the snapshot the result set is compared against.
+	Since     int32                `json:"since"`
+	Added     []EventSnapshotEntry `json:"added"`
+	Removed   []EventSnapshotEntry `json:"removed"`
+	Moved     []EventSnapshotMove  `json:"moved"`
+	Unchanged int                  `json:"unchanged"`
+	// LimitHit is true if either result set is incomplete, in which case
+	// added and removed may contain results that did not change.
+	LimitHit bool `json:"limitHit"`
+}
+
 // EventError emulates a JavaScript error with a message property
 // as is returned when the search encounters an error.
 type EventError struct {
@@ -1,6 +1,7 @@
 package streaming
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -118,6 +119,44 @@ func (d *dedupingStream) Send(event SearchEvent) {
 	d.parent.Send(event)
 }
 
+// NewSnapshottingStream records a snapshot of the results sent on the stream,
+// which can be compared to the snapshot of a later execution of the same
+// search with result.DiffSnapshots.
+func NewSnapshottingStream(s Sender) *snapshottingStream {
+	return &snapshottingStream{
+		parent: s,
+	}
+}
+
+type snapshottingStream struct {
+	parent Sender
+
+	mu      sync.Mutex
+	entries result.SnapshotEntries
+}
+
+func (s *snapshottingStream) Send(event SearchEvent) {
+	// Record the entries before passing the event on, since the parent may
+	// truncate the matches.
+	s.mu.Lock()
+	for _, match := range event.Results {
+		s.entries = append(s.entries, result.NewSnapshotEntries(match)...)
+	}
+	s.mu.Unlock()
+	s.parent.Send(event)
+}
+
+// Snapshot returns the sorted entries of the results sent so far.
+func (s *snapshottingStream) Snapshot() result.SnapshotEntries {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make(result.SnapshotEntries, len(s.entries))
+	copy(entries, s.entries)
+	sort.Sort(entries)
+	return entries
+}
+
 // NewBatchingStream returns a stream that batches results sent to it, holding
 // delaying their forwarding by a max time of maxDelay, then sending the batched
 // event to the parent stream. The first event is passed through without delay.
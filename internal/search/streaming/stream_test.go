@@ -1,6 +1,7 @@
 package streaming
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -148,3 +149,31 @@ func TestDedupingStream(t *testing.T) {
 
 	require.Equal(t, 1, len(sent))
 }
+
+func TestSnapshottingStream(t *testing.T) {
+	s := NewSnapshottingStream(StreamFunc(func(e SearchEvent) {
+		// Parents may truncate the matches they are sent.
+		e.Results.Limit(1)
+	}))
+
+	for _, path := range []string{"b", "a"} {
+		s.Send(SearchEvent{
+			Results: []result.Match{&result.FileMatch{
+				File: result.File{Path: path},
+				ChunkMatches: result.ChunkMatches{{
+					Content: "x\ny",
+					Ranges: result.Ranges{
+						{Start: result.Location{Line: 0}, End: result.Location{Offset: 1, Column: 1}},
+						{Start: result.Location{Offset: 2, Line: 1}, End: result.Location{Offset: 3, Line: 1, Column: 1}},
+					},
+				}},
+			}},
+		})
+	}
+
+	var got []string
+	for _, e := range s.Snapshot() {
+		got = append(got, fmt.Sprintf("%s:%d", e.Path, e.Line))
+	}
+	require.Equal(t, []string{"a:0", "a:1", "b:0", "b:1"}, got)
+}
@@ -111,6 +111,128 @@ type SymbolsParameters struct {
 	//
 	// If Timeout isn't specified, a default timeout of 60 seconds is used.
 	Timeout time.Duration
+
+	// IncludeKinds is a list of symbol kinds (cf. the symbol selector kinds in
+	// select.go) that symbols need to match one of to get included in the
+	// result.
+	IncludeKinds []string
+
+	// ExcludeKinds is a list of symbol kinds that symbols must not match to
+	// get included in the result.
+	ExcludeKinds []string
+
+	// IncludeContainers is a list of regexes that the name of a symbol's
+	// container (its parent) needs to match to get included in the result.
+	// Like IncludePatterns, the patterns are ANDed together.
+	IncludeContainers []string
+
+	// ExcludeContainer is an optional regex that the name of a symbol's
+	// container must not match to get included in the result.
+	ExcludeContainer string
+}
+
+// SymbolFilter returns the symbol kind and container filters of p.
+func (p *SymbolsParameters) SymbolFilter() SymbolFilter {
+	return SymbolFilter{
+		IncludeKinds:      p.IncludeKinds,
+		ExcludeKinds:      p.ExcludeKinds,
+		IncludeContainers: p.IncludeContainers,
+		ExcludeContainer:  p.ExcludeContainer,
+		IsCaseSensitive:   p.IsCaseSensitive,
+	}
+}
+
+// SymbolFilter restricts symbol search results by the kind of the symbols and
+// the name of their container, as specified by the symbol.kind: and
+// symbol.container: filters.
+type SymbolFilter struct {
+	// IncludeKinds and ExcludeKinds are symbol selector kinds in select.go.
+	// A symbol must match one of IncludeKinds (if any) and none of
+	// ExcludeKinds.
+	IncludeKinds []string
+	ExcludeKinds []string
+
+	// IncludeContainers are regexes that a symbol's container must all match,
+	// ExcludeContainer is an optional regex it must not match.
+	IncludeContainers []string
+	ExcludeContainer  string
+
+	IsCaseSensitive bool
+}
+
+func (f *SymbolFilter) IsEmpty() bool {
+	return len(f.IncludeKinds) == 0 && len(f.ExcludeKinds) == 0 && len(f.IncludeContainers) == 0 && f.ExcludeContainer == ""
+}
+
+// Matcher returns a function that reports whether a symbol satisfies the
+// filter.
+func (f *SymbolFilter) Matcher() (func(*result.Symbol) bool, error) {
+	kindSet := func(selectKinds []string) map[string]struct{} {
+		kinds := map[string]struct{}{}
+		for _, selectKind := range selectKinds {
+			for _, kind := range result.SymbolKindsForSelectKind(selectKind) {
+				kinds[kind] = struct{}{}
+			}
+		}
+		return kinds
+	}
+	includeKinds, excludeKinds := kindSet(f.IncludeKinds), kindSet(f.ExcludeKinds)
+
+	compile := func(pattern string) (*regexp.Regexp, error) {
+		if !f.IsCaseSensitive {
+			pattern = "(?i:" + pattern + ")"
+		}
+		return regexp.Compile(pattern)
+	}
+	var includeContainers []*regexp.Regexp
+	for _, pattern := range f.IncludeContainers {
+		re, err := compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		includeContainers = append(includeContainers, re)
+	}
+	var excludeContainer *regexp.Regexp
+	if f.ExcludeContainer != "" {
+		re, err := compile(f.ExcludeContainer)
+		if err != nil {
+			return nil, err
+		}
+		excludeContainer = re
+	}
+
+	return func(s *result.Symbol) bool {
+		kind := strings.ToLower(s.Kind)
+		if _, ok := includeKinds[kind]; len(includeKinds) > 0 && !ok {
+			return false
+		}
+		if _, ok := excludeKinds[kind]; ok {
+			return false
+		}
+		for _, re := range includeContainers {
+			if !re.MatchString(s.Parent) {
+				return false
+			}
+		}
+		return excludeContainer == nil || !excludeContainer.MatchString(s.Parent)
+	}, nil
+}
+
+func (f *SymbolFilter) Fields() []otlog.Field {
+	var res []otlog.Field
+	if len(f.IncludeKinds) > 0 {
+		res = append(res, trace.Strings("includeKinds", f.IncludeKinds))
+	}
+	if len(f.ExcludeKinds) > 0 {
+		res = append(res, trace.Strings("excludeKinds", f.ExcludeKinds))
+	}
+	if len(f.IncludeContainers) > 0 {
+		res = append(res, trace.Strings("includeContainers", f.IncludeContainers))
+	}
+	if f.ExcludeContainer != "" {
+		res = append(res, otlog.String("excludeContainer", f.ExcludeContainer))
+	}
+	return res
 }
 
 type SymbolsResponse struct {
@@ -386,6 +508,7 @@ type RepoOptions struct {
 	HasFileContent []query.RepoHasFileContentArgs
 	HasKVPs        []query.RepoKVPFilter
 	HasTopics      []query.RepoHasTopicPredicate
+	DependsOn      []query.RepoDependsOnPredicate
 
 	// ForkSet indicates whether `fork:` was set explicitly in the query,
 	// or whether the values were set from defaults.
@@ -481,6 +604,21 @@ func (op *RepoOptions) Tags() []otlog.Field {
 			add(trace.Scoped(fmt.Sprintf("hasTopics[%d]", i), nondefault...))
 		}
 	}
+	if len(op.DependsOn) > 0 {
+		for i, arg := range op.DependsOn {
+			nondefault := []otlog.Field{
+				otlog.String("scheme", arg.Scheme),
+				otlog.String("package", arg.Package),
+			}
+			if arg.Version != "" {
+				nondefault = append(nondefault, otlog.String("version", arg.Version))
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, otlog.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("dependsOn[%d]", i), nondefault...))
+		}
+	}
 	if op.ForkSet {
 		add(otlog.Bool("forkSet", op.ForkSet))
 	}
@@ -567,6 +705,18 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	if len(op.DependsOn) > 0 {
+		for i, arg := range op.DependsOn {
+			fmt.Fprintf(&b, "DependsOn[%d].scheme: %s\n", i, arg.Scheme)
+			fmt.Fprintf(&b, "DependsOn[%d].package: %s\n", i, arg.Package)
+			if arg.Version != "" {
+				fmt.Fprintf(&b, "DependsOn[%d].version: %s\n", i, arg.Version)
+			}
+			if arg.Negated {
+				fmt.Fprintf(&b, "DependsOn[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
 
 	if op.CaseSensitiveRepoFilters {
 		fmt.Fprintf(&b, "CaseSensitiveRepoFilters: %t\n", op.CaseSensitiveRepoFilters)
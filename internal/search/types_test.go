@@ -0,0 +1,36 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestSymbolFilterMatcher(t *testing.T) {
+	filter := SymbolFilter{
+		IncludeKinds:      []string{"method", "function"},
+		ExcludeKinds:      []string{"constructor"},
+		IncludeContainers: []string{"^Server$"},
+	}
+	match, err := filter.Matcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		symbol result.Symbol
+		want   bool
+	}{
+		{result.Symbol{Name: "Run", Kind: "method", Parent: "Server"}, true},
+		{result.Symbol{Name: "Run", Kind: "methodSpec", Parent: "server"}, true},
+		{result.Symbol{Name: "Run", Kind: "func", Parent: "Server"}, true},
+		{result.Symbol{Name: "Run", Kind: "method", Parent: "Client"}, false},
+		{result.Symbol{Name: "Server", Kind: "struct", Parent: "Server"}, false},
+		{result.Symbol{Name: "Server", Kind: "constructor", Parent: "Server"}, false},
+	}
+	for _, c := range cases {
+		if got := match(&c.symbol); got != c.want {
+			t.Errorf("unexpected match for %+v (want=%v, got=%v)", c.symbol, c.want, got)
+		}
+	}
+}
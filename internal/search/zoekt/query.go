@@ -28,6 +28,14 @@ func QueryToZoektQuery(b query.Basic, resultTypes result.Types, feat *search.Fea
 		if err != nil {
 			return nil, err
 		}
+	} else if typ == search.SymbolRequest && (b.Exists(query.FieldSymbolKind) || b.Exists(query.FieldSymbolContainer)) {
+		// Symbol filters are applied to the results zoekt returns, so
+		// without a pattern we have to match all symbols.
+		q, err = parseRe(".*", false, true, isCaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		q = &zoekt.Symbol{Expr: q}
 	}
 
 	// Handle file: and -file: filters.
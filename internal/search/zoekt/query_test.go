@@ -1,6 +1,7 @@
 package zoekt
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hexops/autogold/v2"
@@ -121,6 +122,26 @@ func TestQueryToZoektQuery(t *testing.T) {
 	}
 }
 
+func TestQueryToZoektQuery_symbolFilters(t *testing.T) {
+	test := func(input string) string {
+		sourceQuery, _ := query.ParseRegexp(input)
+		b, _ := query.ToBasicQuery(sourceQuery)
+		got, err := QueryToZoektQuery(b, result.TypeSymbol, &search.Features{}, search.SymbolRequest)
+		if err != nil {
+			t.Fatal("QueryToZoektQuery failed:", err)
+		}
+		return got.String()
+	}
+
+	// Without a pattern, symbol filters have to match all symbols.
+	if got := test(`type:symbol symbol.kind:function file:\.go$`); !strings.Contains(got, "sym:") {
+		t.Errorf("expected a symbol query, got %s", got)
+	}
+	if got := test(`type:symbol file:\.go$`); strings.Contains(got, "sym:") {
+		t.Errorf("unexpected symbol query %s", got)
+	}
+}
+
 func Test_toZoektPattern(t *testing.T) {
 	test := func(input string, searchType query.SearchType, typ search.IndexedRequestType) string {
 		p, err := query.Pipeline(query.Init(input, searchType))
@@ -2,6 +2,8 @@ package zoekt
 
 import (
 	"context"
+	"math"
+	"sync"
 	"time"
 
 	"github.com/opentracing/opentracing-go/log"
@@ -11,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
@@ -20,6 +23,7 @@ type SymbolSearchJob struct {
 	Query          zoektquery.Q
 	FileMatchLimit int32
 	Select         filter.SelectPath
+	SymbolFilter   search.SymbolFilter
 	Features       search.Features
 	Since          func(time.Time) time.Duration `json:"-"` // since if non-nil will be used instead of time.Since. For tests
 }
@@ -41,10 +45,16 @@ func (z *SymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClients, s
 		since = z.Since
 	}
 
+	fileMatchLimit := symbolSearchFileMatchLimit(z.SymbolFilter, z.FileMatchLimit)
+	stream, err = newSymbolFilterStream(stream, z.SymbolFilter, z.FileMatchLimit, fileMatchLimit)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	err = zoektSearch(ctx, z.Repos, z.Query, nil, search.SymbolRequest, clients.Zoekt, z.FileMatchLimit, z.Select, z.Features, since, stream)
+	err = zoektSearch(ctx, z.Repos, z.Query, nil, search.SymbolRequest, clients.Zoekt, fileMatchLimit, z.Select, z.Features, since, stream)
 	if err != nil {
 		tr.SetAttributes(attribute.String("error", err.Error()))
 		// Only record error if we haven't timed out.
@@ -79,6 +89,9 @@ func (z *SymbolSearchJob) Fields(v job.Verbosity) (res []log.Field) {
 		res = append(res,
 			trace.Stringer("query", z.Query),
 		)
+		if !z.SymbolFilter.IsEmpty() {
+			res = append(res, trace.Scoped("symbolFilter", z.SymbolFilter.Fields()...))
+		}
 	}
 	return res
 }
@@ -89,6 +102,7 @@ func (z *SymbolSearchJob) MapChildren(job.MapFunc) job.Job { return z }
 type GlobalSymbolSearchJob struct {
 	GlobalZoektQuery *GlobalZoektQuery
 	ZoektArgs        *search.ZoektParameters
+	SymbolFilter     search.SymbolFilter
 	RepoOpts         search.RepoOptions
 }
 
@@ -100,8 +114,15 @@ func (s *GlobalSymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClie
 	s.GlobalZoektQuery.ApplyPrivateFilter(userPrivateRepos)
 	s.ZoektArgs.Query = s.GlobalZoektQuery.Generate()
 
+	args := *s.ZoektArgs
+	args.FileMatchLimit = symbolSearchFileMatchLimit(s.SymbolFilter, s.ZoektArgs.FileMatchLimit)
+	stream, err = newSymbolFilterStream(stream, s.SymbolFilter, s.ZoektArgs.FileMatchLimit, args.FileMatchLimit)
+	if err != nil {
+		return nil, err
+	}
+
 	// always search for symbols in indexed repositories when searching the repo universe.
-	err = DoZoektSearchGlobal(ctx, clients.Zoekt, s.ZoektArgs, nil, stream)
+	err = DoZoektSearchGlobal(ctx, clients.Zoekt, &args, nil, stream)
 	if err != nil {
 		tr.SetAttributes(attribute.String("error", err.Error()))
 		// Only record error if we haven't timed out.
@@ -133,9 +154,100 @@ func (s *GlobalSymbolSearchJob) Fields(v job.Verbosity) (res []log.Field) {
 			log.String("type", string(s.ZoektArgs.Typ)),
 			trace.Scoped("repoOpts", s.RepoOpts.Tags()...),
 		)
+		if !s.SymbolFilter.IsEmpty() {
+			res = append(res, trace.Scoped("symbolFilter", s.SymbolFilter.Fields()...))
+		}
 	}
 	return res
 }
 
 func (s *GlobalSymbolSearchJob) Children() []job.Describer       { return nil }
 func (s *GlobalSymbolSearchJob) MapChildren(job.MapFunc) job.Job { return s }
+
+// symbolFilterOverFetch is the factor by which the file match limit sent to
+// Zoekt is raised when a symbol filter is set.
+const symbolFilterOverFetch = 10
+
+// symbolSearchFileMatchLimit returns the file match limit to send to Zoekt for
+// a symbol search.
+//
+// Unlike the symbols service, which translates the kind and container filters
+// into its SQLite query, Zoekt's query language can only match symbol names:
+// the symbol query is already part of the Zoekt query, but kinds and
+// containers are not indexed in a way we can query. Those filters are applied
+// to the results by newSymbolFilterStream instead, so we ask Zoekt for more
+// files than we return to make up for the files that get dropped.
+func symbolSearchFileMatchLimit(symbolFilter search.SymbolFilter, fileMatchLimit int32) int32 {
+	if symbolFilter.IsEmpty() {
+		return fileMatchLimit
+	}
+	if fileMatchLimit > math.MaxInt32/symbolFilterOverFetch {
+		return math.MaxInt32
+	}
+	return fileMatchLimit * symbolFilterOverFetch
+}
+
+// newSymbolFilterStream returns a stream that drops the symbols which do not
+// satisfy the given filter, and the file matches left without symbols. It is
+// the fallback for the filters Zoekt cannot express, see
+// symbolSearchFileMatchLimit.
+//
+// Zoekt is asked for up to zoektFileMatchLimit files, of which at most
+// fileMatchLimit are sent on once filtered. The limit is reported as hit when
+// more files than fileMatchLimit satisfy the filter, or when Zoekt reached
+// zoektFileMatchLimit after files were dropped, since it stops without
+// reporting the files it skipped.
+func newSymbolFilterStream(stream streaming.Sender, symbolFilter search.SymbolFilter, fileMatchLimit, zoektFileMatchLimit int32) (streaming.Sender, error) {
+	if symbolFilter.IsEmpty() {
+		return stream, nil
+	}
+
+	match, err := symbolFilter.Matcher()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu                      sync.Mutex
+		received, dropped, sent int64
+	)
+	return streaming.StreamFunc(func(event streaming.SearchEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		filtered := event.Results[:0]
+		for _, m := range event.Results {
+			fm, ok := m.(*result.FileMatch)
+			if !ok {
+				filtered = append(filtered, m)
+				continue
+			}
+			received++
+
+			symbols := fm.Symbols[:0]
+			for _, sm := range fm.Symbols {
+				if match(&sm.Symbol) {
+					symbols = append(symbols, sm)
+				}
+			}
+			if len(symbols) == 0 {
+				dropped++
+				continue
+			}
+			if sent >= int64(fileMatchLimit) {
+				event.Stats.IsLimitHit = true
+				continue
+			}
+			fm.Symbols = symbols
+			filtered = append(filtered, fm)
+			sent++
+		}
+
+		if received >= int64(zoektFileMatchLimit) && dropped > 0 {
+			event.Stats.IsLimitHit = true
+		}
+
+		event.Results = filtered
+		stream.Send(event)
+	}), nil
+}
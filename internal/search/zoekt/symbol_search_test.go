@@ -0,0 +1,177 @@
+package zoekt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/zoekt"
+	zoektquery "github.com/sourcegraph/zoekt/query"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	searchbackend "github.com/sourcegraph/sourcegraph/internal/search/backend"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+func TestSymbolFilterStream(t *testing.T) {
+	agg := streaming.NewAggregatingStream()
+	stream, err := newSymbolFilterStream(agg, search.SymbolFilter{IncludeKinds: []string{"function"}}, 10, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	symbolMatch := func(name, kind string) *result.SymbolMatch {
+		return &result.SymbolMatch{Symbol: result.Symbol{Name: name, Kind: kind}}
+	}
+	stream.Send(streaming.SearchEvent{
+		Results: result.Matches{
+			&result.FileMatch{
+				File:    result.File{Path: "a.go"},
+				Symbols: []*result.SymbolMatch{symbolMatch("main", "func"), symbolMatch("x", "variable")},
+			},
+			&result.FileMatch{
+				File:    result.File{Path: "b.go"},
+				Symbols: []*result.SymbolMatch{symbolMatch("T", "struct")},
+			},
+		},
+	})
+
+	if len(agg.Results) != 1 {
+		t.Fatalf("unexpected number of results (want=%d, got=%d)", 1, len(agg.Results))
+	}
+	fm := agg.Results[0].(*result.FileMatch)
+	if fm.Path != "a.go" || len(fm.Symbols) != 1 || fm.Symbols[0].Symbol.Name != "main" {
+		t.Errorf("unexpected result: %+v", fm)
+	}
+	if agg.Stats.IsLimitHit {
+		t.Errorf("unexpected limit hit below the file match limit")
+	}
+}
+
+func TestSymbolFilterStreamLimitHit(t *testing.T) {
+	agg := streaming.NewAggregatingStream()
+	stream, err := newSymbolFilterStream(agg, search.SymbolFilter{IncludeKinds: []string{"function"}}, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileMatch := func(path, kind string) *result.FileMatch {
+		return &result.FileMatch{
+			File:    result.File{Path: path},
+			Symbols: []*result.SymbolMatch{{Symbol: result.Symbol{Name: "s", Kind: kind}}},
+		}
+	}
+
+	// Zoekt stops at the file match limit, so once it is reached after files
+	// were dropped there may be more matching files that were never sent.
+	stream.Send(streaming.SearchEvent{Results: result.Matches{fileMatch("a.go", "struct")}})
+	if agg.Stats.IsLimitHit {
+		t.Fatalf("unexpected limit hit below the file match limit")
+	}
+	stream.Send(streaming.SearchEvent{Results: result.Matches{fileMatch("b.go", "func")}})
+	if !agg.Stats.IsLimitHit {
+		t.Errorf("expected limit hit once the file match limit is reached")
+	}
+	if len(agg.Results) != 1 {
+		t.Errorf("unexpected number of results (want=%d, got=%d)", 1, len(agg.Results))
+	}
+}
+
+func TestSymbolFilterStreamFileMatchLimit(t *testing.T) {
+	agg := streaming.NewAggregatingStream()
+	stream, err := newSymbolFilterStream(agg, search.SymbolFilter{IncludeKinds: []string{"function"}}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileMatch := func(path string) *result.FileMatch {
+		return &result.FileMatch{
+			File:    result.File{Path: path},
+			Symbols: []*result.SymbolMatch{{Symbol: result.Symbol{Name: "s", Kind: "func"}}},
+		}
+	}
+
+	// Zoekt is asked for more files than we return, so the file match limit
+	// is enforced once the results are filtered.
+	stream.Send(streaming.SearchEvent{Results: result.Matches{fileMatch("a.go"), fileMatch("b.go")}})
+	if len(agg.Results) != 1 {
+		t.Errorf("unexpected number of results (want=%d, got=%d)", 1, len(agg.Results))
+	}
+	if !agg.Stats.IsLimitHit {
+		t.Errorf("expected limit hit once more files than the file match limit match")
+	}
+}
+
+type recordingStreamer struct {
+	searchbackend.FakeStreamer
+	query zoektquery.Q
+	opts  *zoekt.SearchOptions
+}
+
+func (s *recordingStreamer) StreamSearch(ctx context.Context, q zoektquery.Q, opts *zoekt.SearchOptions, sender zoekt.Sender) error {
+	s.query, s.opts = q, opts
+	return s.FakeStreamer.StreamSearch(ctx, q, opts, sender)
+}
+
+func TestSymbolSearchJobZoektQuery(t *testing.T) {
+	repos, _ := zoektIndexedRepos(
+		map[uint32]*zoekt.MinimalRepoListEntry{
+			1: {Branches: []zoekt.RepositoryBranch{{Name: "HEAD", Version: "deadbeef"}}},
+		},
+		[]*search.RepositoryRevisions{{Repo: types.MinimalRepo{ID: api.RepoID(1), Name: "test/repo"}, Revs: []string{""}}},
+		nil,
+	)
+	symbolQuery := &zoektquery.Symbol{Expr: &zoektquery.Substring{Pattern: "foo", Content: true}}
+
+	cases := []struct {
+		name              string
+		symbolFilter      search.SymbolFilter
+		wantMaxDocDisplay int
+	}{{
+		name:              "no symbol filter",
+		wantMaxDocDisplay: 10,
+	}, {
+		name:              "kind filter",
+		symbolFilter:      search.SymbolFilter{IncludeKinds: []string{"function"}},
+		wantMaxDocDisplay: 10 * symbolFilterOverFetch,
+	}, {
+		name:              "container filter",
+		symbolFilter:      search.SymbolFilter{IncludeContainers: []string{"Foo"}},
+		wantMaxDocDisplay: 10 * symbolFilterOverFetch,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			streamer := &recordingStreamer{}
+			j := &SymbolSearchJob{
+				Repos:          repos,
+				Query:          symbolQuery,
+				FileMatchLimit: 10,
+				SymbolFilter:   tc.symbolFilter,
+			}
+			if _, err := j.Run(context.Background(), job.RuntimeClients{Zoekt: streamer}, streaming.NewAggregatingStream()); err != nil {
+				t.Fatal(err)
+			}
+
+			// Zoekt cannot match the kind or container of symbols, so the
+			// query only matches symbol names and the filters are applied to
+			// the results.
+			wantQuery := zoektquery.NewAnd(
+				&zoektquery.BranchesRepos{List: []zoektquery.BranchRepos{{Branch: "HEAD", Repos: roaring.BitmapOf(1)}}},
+				symbolQuery,
+			)
+			if diff := cmp.Diff(wantQuery.String(), streamer.query.String()); diff != "" {
+				t.Errorf("query mismatch (-want +got):\n%s", diff)
+			}
+			if streamer.opts.MaxDocDisplayCount != tc.wantMaxDocDisplay {
+				t.Errorf("unexpected MaxDocDisplayCount (want=%d, got=%d)", tc.wantMaxDocDisplay, streamer.opts.MaxDocDisplayCount)
+			}
+		})
+	}
+}
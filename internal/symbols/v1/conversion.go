@@ -22,6 +22,11 @@ func (x *SearchRequest) FromInternal(p *search.SymbolsParameters) {
 
 		First:   int32(p.First),
 		Timeout: durationpb.New(p.Timeout),
+
+		IncludeKinds:      p.IncludeKinds,
+		ExcludeKinds:      p.ExcludeKinds,
+		IncludeContainers: p.IncludeContainers,
+		ExcludeContainer:  p.ExcludeContainer,
 	}
 }
 
@@ -36,6 +41,11 @@ func (x *SearchRequest) ToInternal() search.SymbolsParameters {
 		ExcludePattern:  x.GetExcludePattern(),
 		First:           int(x.GetFirst()),
 		Timeout:         x.GetTimeout().AsDuration(),
+
+		IncludeKinds:      x.GetIncludeKinds(),
+		ExcludeKinds:      x.GetExcludeKinds(),
+		IncludeContainers: x.GetIncludeContainers(),
+		ExcludeContainer:  x.GetExcludeContainer(),
 	}
 }
 
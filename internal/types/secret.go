@@ -106,6 +106,11 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		if err != nil {
 			return "", err
 		}
+	case *schema.MercurialConnection:
+		err = es.redactURL(c.Url, "url")
+		if err != nil {
+			return "", err
+		}
 	default:
 		// return an error; it's safer to fail than to incorrectly return unsafe data.
 		return "", errors.Errorf("Unrecognized ExternalServiceConfig for redaction: kind %+v not implemented", reflect.TypeOf(cfg))
@@ -273,30 +278,14 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 		es.unredactString(c.Credentials, o.Credentials, "credentials")
 	case *schema.OtherExternalServiceConnection:
 		o := oldCfg.(*schema.OtherExternalServiceConnection)
-		err := es.unredactURL(c.Url, o.Url, "url")
-		if err != nil {
-			return err
-		}
-		oldParsed, err := url.Parse(o.Url)
-		if err != nil {
+		if err := es.unredactBaseURL(c.Url, o.Url, "url"); err != nil {
 			return err
 		}
-		newParsed, err := url.Parse(c.Url)
-		if err != nil {
+	case *schema.MercurialConnection:
+		o := oldCfg.(*schema.MercurialConnection)
+		if err := es.unredactBaseURL(c.Url, o.Url, "url"); err != nil {
 			return err
 		}
-		// compare URLs and see if password changed
-		pwd, ok := newParsed.User.Password()
-
-		// remove UserInfo so we can compare URLs
-		oldParsed.User = nil
-		newParsed.User = nil
-
-		if newParsed.String() != oldParsed.String() {
-			if ok && pwd == RedactedSecret {
-				return errCodeHostIdentityChanged{"url", "password"}
-			}
-		}
 
 	default:
 		// return an error; it's safer to fail than to incorrectly return unsafe data.
@@ -411,6 +400,36 @@ func (es *edits) unredactURL(new, old string, path ...any) error {
 	return nil
 }
 
+// unredactBaseURL unredacts a single URL carrying credentials in its userinfo,
+// refusing to do so when the rest of the URL changed since the secret was set.
+func (es *edits) unredactBaseURL(new, old string, path ...any) error {
+	err := es.unredactURL(new, old, path...)
+	if err != nil {
+		return err
+	}
+	oldParsed, err := url.Parse(old)
+	if err != nil {
+		return err
+	}
+	newParsed, err := url.Parse(new)
+	if err != nil {
+		return err
+	}
+	// compare URLs and see if password changed
+	pwd, ok := newParsed.User.Password()
+
+	// remove UserInfo so we can compare URLs
+	oldParsed.User = nil
+	newParsed.User = nil
+
+	if newParsed.String() != oldParsed.String() {
+		if ok && pwd == RedactedSecret {
+			return errCodeHostIdentityChanged{"url", "password"}
+		}
+	}
+	return nil
+}
+
 type edit struct {
 	path  jsonx.Path
 	value any
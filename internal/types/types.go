@@ -865,15 +865,38 @@ func (n PermissionNamespace) String() string {
 // Valid checks if a namespace is valid and supported by the Sourcegraph RBAC system.
 func (n PermissionNamespace) Valid() bool {
 	switch n {
-	case BatchChangesNamespace:
+	case BatchChangesNamespace,
+		CodeInsightsNamespace,
+		CodeMonitorsNamespace,
+		SearchContextsNamespace,
+		NotebooksNamespace,
+		CodeIntelNamespace,
+		ExecutorSecretsNamespace,
+		OutboundWebhooksNamespace:
 		return true
 	default:
 		return false
 	}
 }
 
-// BatchChangesNamespace represents the Batch Changes namespace.
-const BatchChangesNamespace PermissionNamespace = "BATCH_CHANGES"
+const (
+	// BatchChangesNamespace represents the Batch Changes namespace.
+	BatchChangesNamespace PermissionNamespace = "BATCH_CHANGES"
+	// CodeInsightsNamespace represents the Code Insights namespace.
+	CodeInsightsNamespace PermissionNamespace = "CODE_INSIGHTS"
+	// CodeMonitorsNamespace represents the Code Monitors namespace.
+	CodeMonitorsNamespace PermissionNamespace = "CODE_MONITORS"
+	// SearchContextsNamespace represents the Search Contexts namespace.
+	SearchContextsNamespace PermissionNamespace = "SEARCH_CONTEXTS"
+	// NotebooksNamespace represents the Notebooks namespace.
+	NotebooksNamespace PermissionNamespace = "NOTEBOOKS"
+	// CodeIntelNamespace represents the namespace for managing precise code intelligence uploads and indexes.
+	CodeIntelNamespace PermissionNamespace = "CODEINTEL"
+	// ExecutorSecretsNamespace represents the namespace for managing global executor secrets.
+	ExecutorSecretsNamespace PermissionNamespace = "EXECUTOR_SECRETS"
+	// OutboundWebhooksNamespace represents the Outbound Webhooks namespace.
+	OutboundWebhooksNamespace PermissionNamespace = "OUTBOUND_WEBHOOKS"
+)
 
 type Permission struct {
 	ID        int32
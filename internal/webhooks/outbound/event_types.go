@@ -5,6 +5,23 @@ import "sync"
 type EventType struct {
 	Key         string
 	Description string
+
+	// Scope, if set, describes the scope that events of this type are
+	// enqueued with. A webhook registered for the event type with a scope only
+	// receives the events with the same scope, while a webhook registered
+	// without a scope receives all events of the type.
+	Scope string
+
+	// Payload documents the fields of the JSON payload sent with events of
+	// this type.
+	Payload []PayloadField
+}
+
+// PayloadField documents a field of an outbound webhook event payload.
+type PayloadField struct {
+	Name        string
+	Type        string
+	Description string
 }
 
 type eventTypes struct {
@@ -0,0 +1,263 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	RepoAdd               = "repo:add"
+	RepoRemove            = "repo:remove"
+	RepoClone             = "repo:clone"
+	RepoCloneError        = "repo:clone_error"
+	UserCreate            = "user:create"
+	UserDelete            = "user:delete"
+	UserPromote           = "user:promote"
+	CodeMonitorTrigger    = "code_monitor:trigger"
+	InsightSeriesBackfill = "insight_series:backfill"
+	SCIPUploadComplete    = "scip_upload:complete"
+	SCIPUploadError       = "scip_upload:error"
+)
+
+// RepoPayload is the payload of the repo events.
+type RepoPayload struct {
+	ID    graphql.ID `json:"id"`
+	Name  string     `json:"name"`
+	Error string     `json:"error,omitempty"`
+}
+
+func NewRepoPayload(id api.RepoID, name api.RepoName) RepoPayload {
+	return RepoPayload{
+		ID:   relay.MarshalID("Repository", id),
+		Name: string(name),
+	}
+}
+
+// UserPayload is the payload of the user events.
+type UserPayload struct {
+	ID       graphql.ID `json:"id"`
+	Username string     `json:"username"`
+}
+
+func NewUserPayload(user *types.User) UserPayload {
+	return UserPayload{
+		ID:       relay.MarshalID("User", user.ID),
+		Username: user.Username,
+	}
+}
+
+// CodeMonitorPayload is the payload of the code monitor events.
+type CodeMonitorPayload struct {
+	ID          graphql.ID `json:"id"`
+	Description string     `json:"description"`
+	Owner       graphql.ID `json:"owner_user_id"`
+	Query       string     `json:"query"`
+	ResultCount int        `json:"result_count"`
+	URL         string     `json:"url"`
+}
+
+// InsightSeriesPayload is the payload of the code insight series events.
+type InsightSeriesPayload struct {
+	SeriesID    string    `json:"series_id"`
+	Query       string    `json:"query"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// SCIPUploadPayload is the payload of the SCIP upload events.
+type SCIPUploadPayload struct {
+	ID             graphql.ID `json:"id"`
+	RepositoryID   graphql.ID `json:"repository_id"`
+	RepositoryName string     `json:"repository_name"`
+	Commit         string     `json:"commit"`
+	Root           string     `json:"root"`
+	Indexer        string     `json:"indexer"`
+	Error          string     `json:"error,omitempty"`
+}
+
+var (
+	repoPayloadFields = []PayloadField{
+		{Name: "id", Type: "string", Description: "the GraphQL ID of the repository"},
+		{Name: "name", Type: "string", Description: "the name of the repository, such as github.com/sourcegraph/sourcegraph"},
+	}
+	userPayloadFields = []PayloadField{
+		{Name: "id", Type: "string", Description: "the GraphQL ID of the user"},
+		{Name: "username", Type: "string", Description: "the username of the user"},
+	}
+	scipUploadPayloadFields = []PayloadField{
+		{Name: "id", Type: "string", Description: "the GraphQL ID of the precise index"},
+		{Name: "repository_id", Type: "string", Description: "the GraphQL ID of the repository"},
+		{Name: "repository_name", Type: "string", Description: "the name of the repository"},
+		{Name: "commit", Type: "string", Description: "the commit the upload is for"},
+		{Name: "root", Type: "string", Description: "the directory of the repository the upload is for"},
+		{Name: "indexer", Type: "string", Description: "the name of the indexer that produced the upload"},
+	}
+)
+
+func init() {
+	RegisterEventType(EventType{
+		Key:         RepoAdd,
+		Description: "sent when a repository is added from a code host",
+		Scope:       "the name of the repository",
+		Payload:     repoPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         RepoRemove,
+		Description: "sent when a repository is removed because it is no longer synced from a code host",
+		Scope:       "the name of the repository",
+		Payload:     repoPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         RepoClone,
+		Description: "sent when a repository is cloned",
+		Scope:       "the name of the repository",
+		Payload:     repoPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         RepoCloneError,
+		Description: "sent when an attempt to clone a repository fails",
+		Scope:       "the name of the repository",
+		Payload: append(repoPayloadFields[:len(repoPayloadFields):len(repoPayloadFields)],
+			PayloadField{Name: "error", Type: "string", Description: "the error that caused the clone to fail"},
+		),
+	})
+
+	RegisterEventType(EventType{
+		Key:         UserCreate,
+		Description: "sent when a user is created",
+		Payload:     userPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         UserDelete,
+		Description: "sent when a user is deleted",
+		Payload:     userPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         UserPromote,
+		Description: "sent when a user is promoted to site admin",
+		Payload:     userPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         CodeMonitorTrigger,
+		Description: "sent when a code monitor is triggered by new results",
+		Scope:       "the GraphQL ID of the code monitor",
+		Payload: []PayloadField{
+			{Name: "id", Type: "string", Description: "the GraphQL ID of the code monitor"},
+			{Name: "description", Type: "string", Description: "the description of the code monitor"},
+			{Name: "owner_user_id", Type: "string", Description: "the GraphQL ID of the user that owns the code monitor"},
+			{Name: "query", Type: "string", Description: "the search query that was run"},
+			{Name: "result_count", Type: "integer", Description: "the number of new results"},
+			{Name: "url", Type: "string", Description: "the URL of the code monitor"},
+		},
+	})
+
+	RegisterEventType(EventType{
+		Key:         InsightSeriesBackfill,
+		Description: "sent when the backfill of a code insight series completes",
+		Scope:       "the series ID of the code insight series",
+		Payload: []PayloadField{
+			{Name: "series_id", Type: "string", Description: "the series ID of the code insight series"},
+			{Name: "query", Type: "string", Description: "the search query of the series"},
+			{Name: "completed_at", Type: "string", Description: "the time the backfill completed, in RFC 3339 format"},
+		},
+	})
+
+	RegisterEventType(EventType{
+		Key:         SCIPUploadComplete,
+		Description: "sent when a SCIP upload is processed successfully",
+		Scope:       "the name of the repository",
+		Payload:     scipUploadPayloadFields,
+	})
+
+	RegisterEventType(EventType{
+		Key:         SCIPUploadError,
+		Description: "sent when a SCIP upload fails to be processed",
+		Scope:       "the name of the repository",
+		Payload: append(scipUploadPayloadFields[:len(scipUploadPayloadFields):len(scipUploadPayloadFields)],
+			PayloadField{Name: "error", Type: "string", Description: "the error that caused the processing to fail"},
+		),
+	})
+}
+
+// EnqueueEvent creates an outbound webhook job for an event of the given type
+// and optional scope, with the payload marshalled as JSON.
+//
+// As some events, such as repository clones, are frequent, no job is created if
+// no outbound webhook is registered to receive the event. Webhooks are fire and
+// forget from the point of view of calling code, so errors are only logged.
+func EnqueueEvent(
+	ctx context.Context, logger log.Logger, db database.DB,
+	eventType string, scope *string, payload any,
+) {
+	key := keyring.Default().OutboundWebhookKey
+	enqueueEvent(
+		ctx, logger,
+		db.OutboundWebhooks(key), &outboundWebhookService{store: db.OutboundWebhookJobs(key)},
+		eventType, scope, payload,
+	)
+}
+
+func enqueueEvent(
+	ctx context.Context, logger log.Logger,
+	store database.OutboundWebhookStore, svc OutboundWebhookService,
+	eventType string, scope *string, payload any,
+) {
+	logger = logger.With(
+		log.String("event_type", eventType),
+		log.Stringp("scope", scope),
+	)
+
+	count, err := store.Count(ctx, database.OutboundWebhookCountOpts{
+		EventTypes: FilterEventTypes(eventType, scope),
+	})
+	if err != nil {
+		logger.Error("error counting outbound webhooks", log.Error(err))
+		return
+	}
+	if count == 0 {
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("error marshalling webhook payload", log.Error(err))
+		return
+	}
+
+	if err := svc.Enqueue(ctx, eventType, scope, data); err != nil {
+		logger.Error("error enqueuing webhook job", log.Error(err))
+	}
+}
+
+// FilterEventTypes returns the filters that match the outbound webhooks that
+// receive an event of the given type and scope.
+//
+// Events without a scope are received by all webhooks registered for the event
+// type. Events with a scope are received by the webhooks registered for the
+// event type without a scope, or with the same scope.
+func FilterEventTypes(eventType string, scope *string) []database.FilterEventType {
+	if scope == nil {
+		return []database.FilterEventType{{EventType: eventType}}
+	}
+
+	noScope := database.FilterEventTypeNoScope
+	return []database.FilterEventType{
+		{EventType: eventType, Scope: &noScope},
+		{EventType: eventType, Scope: scope},
+	}
+}
@@ -0,0 +1,127 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mockassert "github.com/derision-test/go-mockgen/testutil/assert"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestEnqueueEvent(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	scope := "github.com/sourcegraph/sourcegraph"
+	payload := NewRepoPayload(1, "github.com/sourcegraph/sourcegraph")
+
+	t.Run("no webhooks", func(t *testing.T) {
+		store := database.NewMockOutboundWebhookStore()
+		store.CountFunc.SetDefaultReturn(0, nil)
+		jobStore := database.NewMockOutboundWebhookJobStore()
+
+		enqueueEvent(ctx, logger, store, &outboundWebhookService{jobStore}, RepoClone, &scope, payload)
+		mockassert.CalledOnce(t, store.CountFunc)
+		mockassert.NotCalled(t, jobStore.CreateFunc)
+	})
+
+	t.Run("count error", func(t *testing.T) {
+		store := database.NewMockOutboundWebhookStore()
+		store.CountFunc.SetDefaultReturn(0, errors.New("mock error"))
+		jobStore := database.NewMockOutboundWebhookJobStore()
+
+		enqueueEvent(ctx, logger, store, &outboundWebhookService{jobStore}, RepoClone, &scope, payload)
+		mockassert.NotCalled(t, jobStore.CreateFunc)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		store := database.NewMockOutboundWebhookStore()
+		store.CountFunc.SetDefaultHook(func(_ context.Context, opts database.OutboundWebhookCountOpts) (int64, error) {
+			assert.Equal(t, FilterEventTypes(RepoClone, &scope), opts.EventTypes)
+			return 1, nil
+		})
+		jobStore := database.NewMockOutboundWebhookJobStore()
+		jobStore.CreateFunc.SetDefaultHook(func(_ context.Context, eventType string, s *string, data []byte) (*types.OutboundWebhookJob, error) {
+			assert.Equal(t, RepoClone, eventType)
+			assert.Equal(t, &scope, s)
+			assert.JSONEq(t, `{"id":"UmVwb3NpdG9yeTox","name":"github.com/sourcegraph/sourcegraph"}`, string(data))
+			return &types.OutboundWebhookJob{}, nil
+		})
+
+		enqueueEvent(ctx, logger, store, &outboundWebhookService{jobStore}, RepoClone, &scope, payload)
+		mockassert.CalledOnce(t, jobStore.CreateFunc)
+	})
+}
+
+func TestFilterEventTypes(t *testing.T) {
+	t.Run("without scope", func(t *testing.T) {
+		assert.Equal(t,
+			[]database.FilterEventType{{EventType: UserCreate}},
+			FilterEventTypes(UserCreate, nil),
+		)
+	})
+
+	t.Run("with scope", func(t *testing.T) {
+		scope := "github.com/sourcegraph/sourcegraph"
+		noScope := database.FilterEventTypeNoScope
+		assert.Equal(t,
+			[]database.FilterEventType{
+				{EventType: RepoAdd, Scope: &noScope},
+				{EventType: RepoAdd, Scope: &scope},
+			},
+			FilterEventTypes(RepoAdd, &scope),
+		)
+	})
+}
+
+func TestEventTypePayloads(t *testing.T) {
+	// Ensure that the documented payload fields match the JSON payloads that
+	// are actually sent.
+	payloads := map[string]any{
+		RepoAdd:               RepoPayload{},
+		RepoRemove:            RepoPayload{},
+		RepoClone:             RepoPayload{},
+		RepoCloneError:        RepoPayload{Error: "error"},
+		UserCreate:            UserPayload{},
+		UserDelete:            UserPayload{},
+		UserPromote:           UserPayload{},
+		CodeMonitorTrigger:    CodeMonitorPayload{},
+		InsightSeriesBackfill: InsightSeriesPayload{CompletedAt: time.Now()},
+		SCIPUploadComplete:    SCIPUploadPayload{},
+		SCIPUploadError:       SCIPUploadPayload{Error: "error"},
+	}
+
+	registered := map[string]EventType{}
+	for _, et := range GetRegisteredEventTypes() {
+		registered[et.Key] = et
+	}
+
+	for key, payload := range payloads {
+		t.Run(key, func(t *testing.T) {
+			et, ok := registered[key]
+			require.True(t, ok, "event type is not registered")
+
+			data, err := json.Marshal(payload)
+			require.NoError(t, err)
+			var fields map[string]any
+			require.NoError(t, json.Unmarshal(data, &fields))
+
+			var have []string
+			for _, f := range et.Payload {
+				have = append(have, f.Name)
+			}
+			var want []string
+			for name := range fields {
+				want = append(want, name)
+			}
+			assert.ElementsMatch(t, want, have)
+		})
+	}
+}
@@ -37,6 +37,7 @@ type BatchSpec struct {
 	TransformChanges  *TransformChanges        `json:"transformChanges,omitempty" yaml:"transformChanges,omitempty"`
 	ImportChangesets  []ImportChangeset        `json:"importChangesets,omitempty" yaml:"importChangesets"`
 	ChangesetTemplate *ChangesetTemplate       `json:"changesetTemplate,omitempty" yaml:"changesetTemplate"`
+	Rollout           *RolloutPolicy           `json:"rollout,omitempty" yaml:"rollout,omitempty"`
 }
 
 type ChangesetTemplate struct {
@@ -47,6 +48,37 @@ type ChangesetTemplate struct {
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
 }
 
+// StackedChangesetTemplate describes a changeset in a stack of changesets. It
+// is declared on the step that produces the last of its changes, and fields
+// that are left empty fall back to the ChangesetTemplate.
+type StackedChangesetTemplate struct {
+	Title  string                  `json:"title,omitempty" yaml:"title"`
+	Body   string                  `json:"body,omitempty" yaml:"body"`
+	Branch string                  `json:"branch,omitempty" yaml:"branch"`
+	Commit *StackedChangesetCommit `json:"commit,omitempty" yaml:"commit"`
+}
+
+type StackedChangesetCommit struct {
+	Message string `json:"message,omitempty" yaml:"message"`
+}
+
+// RolloutPolicy describes how the changesets of a batch change are published
+// in waves, and when a wave is considered to have succeeded.
+type RolloutPolicy struct {
+	Waves                []RolloutWave `json:"waves,omitempty" yaml:"waves"`
+	RequirePassingChecks bool          `json:"requirePassingChecks,omitempty" yaml:"requirePassingChecks"`
+	RequireApproval      bool          `json:"requireApproval,omitempty" yaml:"requireApproval"`
+	Merge                bool          `json:"merge,omitempty" yaml:"merge"`
+	MaxFailurePercentage int           `json:"maxFailurePercentage,omitempty" yaml:"maxFailurePercentage"`
+}
+
+// RolloutWave selects the changesets of a wave, either by the repositories
+// matching a search query, or by the cumulative percentage of changesets.
+type RolloutWave struct {
+	RepositoriesMatchingQuery string `json:"repositoriesMatchingQuery,omitempty" yaml:"repositoriesMatchingQuery"`
+	Percentage                int    `json:"percentage,omitempty" yaml:"percentage"`
+}
+
 type GitCommitAuthor struct {
 	Name  string `json:"name" yaml:"name"`
 	Email string `json:"email" yaml:"email"`
@@ -95,6 +127,8 @@ type Step struct {
 	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 	Mount     []Mount           `json:"mount,omitempty" yaml:"mount,omitempty"`
 	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
+
+	Changeset *StackedChangesetTemplate `json:"changeset,omitempty" yaml:"changeset,omitempty"`
 }
 
 func (s *Step) IfCondition() string {
@@ -165,6 +199,27 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 		errs = errors.Append(errs, NewValidationError(errors.New("batch spec includes steps but no changesetTemplate")))
 	}
 
+	if spec.HasStackedChangesets() && spec.TransformChanges != nil && len(spec.TransformChanges.Group) != 0 {
+		errs = errors.Append(errs, NewValidationError(errors.New("steps that declare a changeset cannot be combined with transformChanges.group")))
+	}
+
+	if spec.Rollout != nil {
+		if spec.ChangesetTemplate != nil && spec.ChangesetTemplate.Published != nil {
+			errs = errors.Append(errs, NewValidationError(errors.New("rollout cannot be combined with changesetTemplate.published")))
+		}
+
+		lastPercentage := 0
+		for i, wave := range spec.Rollout.Waves {
+			if wave.Percentage == 0 {
+				continue
+			}
+			if wave.Percentage <= lastPercentage {
+				errs = errors.Append(errs, NewValidationError(errors.Newf("rollout wave %d must have a higher percentage than the waves before it", i+1)))
+			}
+			lastPercentage = wave.Percentage
+		}
+	}
+
 	for i, step := range spec.Steps {
 		for _, mount := range step.Mount {
 			if strings.Contains(mount.Path, invalidMountCharacters) {
@@ -181,6 +236,13 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 
 const invalidMountCharacters = ","
 
+// HasStackedChangesets returns whether any of the steps declares a changeset,
+// which makes the changes in each repository be published as a stack of
+// changesets.
+func (s *BatchSpec) HasStackedChangesets() bool {
+	return hasStackedChangesets(s.Steps)
+}
+
 func (on *OnQueryOrRepository) String() string {
 	if on.RepositoriesMatchingQuery != "" {
 		return on.RepositoriesMatchingQuery
@@ -210,6 +210,81 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+
+	t.Run("rollout", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/upgrade.sh
+    container: alpine:3
+changesetTemplate:
+  title: Upgrade
+  body: Upgrade the dependency
+  branch: upgrade
+  commit:
+    message: Upgrade
+rollout:
+  waves:
+    - repositoriesMatchingQuery: repo:canary
+    - percentage: 10
+    - percentage: 50
+  requirePassingChecks: true
+  merge: true
+  maxFailurePercentage: 5
+`
+		have, err := ParseBatchSpec([]byte(spec))
+		assert.NoError(t, err)
+		assert.Equal(t, &RolloutPolicy{
+			Waves: []RolloutWave{
+				{RepositoriesMatchingQuery: "repo:canary"},
+				{Percentage: 10},
+				{Percentage: 50},
+			},
+			RequirePassingChecks: true,
+			Merge:                true,
+			MaxFailurePercentage: 5,
+		}, have.Rollout)
+	})
+
+	t.Run("rollout with published", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+changesetTemplate:
+  title: Upgrade
+  body: Upgrade the dependency
+  branch: upgrade
+  commit:
+    message: Upgrade
+  published: true
+rollout:
+  waves:
+    - percentage: 10
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Equal(t, "rollout cannot be combined with changesetTemplate.published", err.Error())
+	})
+
+	t.Run("rollout with decreasing percentages", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+changesetTemplate:
+  title: Upgrade
+  body: Upgrade the dependency
+  branch: upgrade
+  commit:
+    message: Upgrade
+rollout:
+  waves:
+    - percentage: 50
+    - repositoriesMatchingQuery: repo:canary
+    - percentage: 10
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Equal(t, "rollout wave 3 must have a higher percentage than the waves before it", err.Error())
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
@@ -74,6 +74,11 @@ type ChangesetSpec struct {
 	HeadRepository string `json:"headRepository,omitempty"`
 	HeadRef        string `json:"headRef,omitempty"`
 
+	// StackParentRef is the head ref of the changeset this changeset is
+	// stacked on, if any. The commits of a stacked changeset contain the
+	// changes of all changesets below it in the stack.
+	StackParentRef string `json:"stackParentRef,omitempty"`
+
 	Title string `json:"title,omitempty"`
 	Body  string `json:"body,omitempty"`
 
@@ -98,6 +103,7 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		BaseRef        string                 `json:"baseRef,omitempty"`
 		HeadRepository string                 `json:"headRepository,omitempty"`
 		HeadRef        string                 `json:"headRef,omitempty"`
+		StackParentRef string                 `json:"stackParentRef,omitempty"`
 		Title          string                 `json:"title,omitempty"`
 		Body           string                 `json:"body,omitempty"`
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
@@ -109,6 +115,7 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		BaseRef:        c.BaseRef,
 		HeadRepository: c.HeadRepository,
 		HeadRef:        c.HeadRef,
+		StackParentRef: c.StackParentRef,
 		Title:          c.Title,
 		Body:           c.Body,
 		Commits:        c.Commits,
@@ -1,6 +1,7 @@
 package batches
 
 import (
+	"bytes"
 	"context"
 	"strings"
 
@@ -32,7 +33,14 @@ type ChangesetSpecInput struct {
 	TransformChanges      *TransformChanges               `json:"-"`
 	Path                  string
 
+	// Steps are the steps of the batch spec. If any of them declares a
+	// changeset, a stack of changesets is built.
+	Steps []Step `json:"-"`
+
 	Result execution.AfterStepResult
+	// StepResults are the results of the executed steps. They are only needed
+	// to build a stack of changesets.
+	StepResults []execution.AfterStepResult `json:"-"`
 }
 
 type ChangesetSpecAuthor struct {
@@ -41,19 +49,7 @@ type ChangesetSpecAuthor struct {
 }
 
 func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAuthor *ChangesetSpecAuthor) ([]*ChangesetSpec, error) {
-	tmplCtx := &template.ChangesetTemplateContext{
-		BatchChangeAttributes: *input.BatchChangeAttributes,
-		Steps: template.StepsContext{
-			Changes: input.Result.ChangedFiles,
-			Path:    input.Path,
-		},
-		Outputs: input.Result.Outputs,
-		Repository: template.Repository{
-			Name:        input.Repository.Name,
-			Branch:      strings.TrimPrefix(input.Repository.BaseRef, "refs/heads/"),
-			FileMatches: input.Repository.FileMatches,
-		},
-	}
+	tmplCtx := changesetTemplateContext(input, input.Result)
 
 	var author ChangesetSpecAuthor
 
@@ -137,6 +133,10 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 
 	var specs []*ChangesetSpec
 
+	if hasStackedChangesets(input.Steps) {
+		return buildStackedChangesetSpecs(input, defaultBranch, newSpec)
+	}
+
 	groups := groupsForRepository(input.Repository.Name, input.TransformChanges)
 	if len(groups) != 0 {
 		err := validateGroups(input.Repository.Name, input.Template.Branch, groups)
@@ -162,6 +162,130 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 	return specs, nil
 }
 
+func changesetTemplateContext(input *ChangesetSpecInput, result execution.AfterStepResult) *template.ChangesetTemplateContext {
+	return &template.ChangesetTemplateContext{
+		BatchChangeAttributes: *input.BatchChangeAttributes,
+		Steps: template.StepsContext{
+			Changes: result.ChangedFiles,
+			Path:    input.Path,
+		},
+		Outputs: result.Outputs,
+		Repository: template.Repository{
+			Name:        input.Repository.Name,
+			Branch:      strings.TrimPrefix(input.Repository.BaseRef, "refs/heads/"),
+			FileMatches: input.Repository.FileMatches,
+		},
+	}
+}
+
+func hasStackedChangesets(steps []Step) bool {
+	for _, step := range steps {
+		if step.Changeset != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildStackedChangesetSpecs builds a changeset spec for each changeset
+// declared by a step, followed by the one for the changesetTemplate, and
+// stacks each of them on the previous one.
+//
+// The diff of a stacked changeset is the cumulative diff after its last step,
+// so it includes the changes of the changesets below it. Changesets that
+// don't add any changes to the one below them are left out of the stack.
+func buildStackedChangesetSpecs(input *ChangesetSpecInput, defaultBranch string, newSpec func(branch string, diff []byte) *ChangesetSpec) ([]*ChangesetSpec, error) {
+	var (
+		specs      []*ChangesetSpec
+		parentDiff []byte
+	)
+
+	branches := make(map[string]struct{}, len(input.Steps)+1)
+	push := func(spec *ChangesetSpec, diff []byte) error {
+		if _, ok := branches[spec.HeadRef]; ok {
+			return NewValidationError(errors.Newf("stacked changesets in repository %s would have the same branch %q", input.Repository.Name, strings.TrimPrefix(spec.HeadRef, "refs/heads/")))
+		}
+		branches[spec.HeadRef] = struct{}{}
+
+		if len(specs) != 0 {
+			spec.StackParentRef = specs[len(specs)-1].HeadRef
+		}
+		specs = append(specs, spec)
+		parentDiff = diff
+		return nil
+	}
+
+	for i, step := range input.Steps {
+		if step.Changeset == nil {
+			continue
+		}
+
+		result, ok := stackStepResult(input, i)
+		if !ok || bytes.Equal(result.Diff, parentDiff) {
+			continue
+		}
+
+		tmplCtx := changesetTemplateContext(input, result)
+
+		branch, err := template.RenderChangesetTemplateField("branch", step.Changeset.Branch, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		title, err := renderStackedChangesetField("title", step.Changeset.Title, input.Template.Title, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		body, err := renderStackedChangesetField("body", step.Changeset.Body, input.Template.Body, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		var message string
+		if step.Changeset.Commit != nil {
+			message = step.Changeset.Commit.Message
+		}
+		message, err = renderStackedChangesetField("message", message, input.Template.Commit.Message, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := newSpec(branch, result.Diff)
+		spec.Title = title
+		spec.Body = body
+		spec.Commits[0].Message = message
+		if err := push(spec, result.Diff); err != nil {
+			return nil, err
+		}
+	}
+
+	if !bytes.Equal(input.Result.Diff, parentDiff) {
+		if err := push(newSpec(defaultBranch, input.Result.Diff), input.Result.Diff); err != nil {
+			return nil, err
+		}
+	}
+
+	return specs, nil
+}
+
+// stackStepResult returns the result of the last step up to and including the
+// step with the given index that was executed. Steps can be skipped, in which
+// case they don't produce a result.
+func stackStepResult(input *ChangesetSpecInput, stepIndex int) (result execution.AfterStepResult, ok bool) {
+	for _, r := range append([]execution.AfterStepResult{input.Result}, input.StepResults...) {
+		if r.StepIndex > stepIndex || (ok && r.StepIndex <= result.StepIndex) {
+			continue
+		}
+		result, ok = r, true
+	}
+	return result, ok
+}
+
+func renderStackedChangesetField(name, tmpl, fallback string, tmplCtx *template.ChangesetTemplateContext) (string, error) {
+	if tmpl == "" {
+		tmpl = fallback
+	}
+	return template.RenderChangesetTemplateField(name, tmpl, tmplCtx)
+}
+
 type RepoFetcher func(context.Context, []string) (map[string]string, error)
 
 func BuildImportChangesetSpecs(ctx context.Context, importChangesets []ImportChangeset, repoFetcher RepoFetcher) (specs []*ChangesetSpec, errs error) {
@@ -158,6 +158,46 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "stacked changesets",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Steps = []Step{
+					{Run: "echo first", Changeset: &StackedChangesetTemplate{Branch: "my-branch-1", Title: "First"}},
+					{Run: "echo unchanged", Changeset: &StackedChangesetTemplate{Branch: "my-branch-2"}},
+					{Run: "echo last"},
+				}
+				input.StepResults = []execution.AfterStepResult{
+					{StepIndex: 0, Diff: []byte("first diff")},
+					{StepIndex: 1, Diff: []byte("first diff")},
+				}
+				input.Result.StepIndex = 2
+				input.Template.Published = parsePublishedFieldString(t, "false")
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.HeadRef = "refs/heads/my-branch-1"
+					s.Title = "First"
+					s.Commits[0].Diff = []byte("first diff")
+				}),
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.StackParentRef = "refs/heads/my-branch-1"
+				}),
+			},
+		},
+		{
+			name: "stacked changesets with the same branch",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Steps = []Step{
+					{Run: "echo first", Changeset: &StackedChangesetTemplate{Branch: "my-branch"}},
+					{Run: "echo last"},
+				}
+				input.StepResults = []execution.AfterStepResult{
+					{StepIndex: 0, Diff: []byte("first diff")},
+				}
+				input.Result.StepIndex = 1
+			}),
+			wantErr: `stacked changesets in repository github.com/sourcegraph/src-cli would have the same branch "my-branch"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -151,7 +151,9 @@ func KeyForWorkspace(batchChangeAttributes *template.BatchChangeAttributes, r ba
 }
 
 // ChangesetSpecsFromCache takes the execution.Result and generates all changeset specs from it.
-func ChangesetSpecsFromCache(spec *batches.BatchSpec, r batches.Repository, result execution.AfterStepResult, path string, binaryDiffs bool, fallbackAuthor *batches.ChangesetSpecAuthor) ([]*batches.ChangesetSpec, error) {
+// The results of the previous steps are only used if the batch spec declares
+// stacked changesets.
+func ChangesetSpecsFromCache(spec *batches.BatchSpec, r batches.Repository, result execution.AfterStepResult, stepResults []execution.AfterStepResult, path string, binaryDiffs bool, fallbackAuthor *batches.ChangesetSpecAuthor) ([]*batches.ChangesetSpec, error) {
 	if len(result.Diff) == 0 {
 		return []*batches.ChangesetSpec{}, nil
 	}
@@ -166,7 +168,9 @@ func ChangesetSpecsFromCache(spec *batches.BatchSpec, r batches.Repository, resu
 		},
 		Template:         spec.ChangesetTemplate,
 		TransformChanges: spec.TransformChanges,
+		Steps:            spec.Steps,
 		Result:           result,
+		StepResults:      stepResults,
 		Path:             path,
 	}
 
@@ -237,6 +237,39 @@ const BatchSpecJSON = `{
                 }
               }
             }
+          },
+          "changeset": {
+            "title": "StackedChangesetTemplate",
+            "type": ["object", "null"],
+            "description": "Declares that the changes made by this step and the steps before it (up to the previous step that declares a changeset) are published as a separate changeset. The changesets of a repository form a stack: each one targets the branch of the changeset below it, and the changeset described by changesetTemplate sits on top of the stack. Once a changeset in the stack is merged, the changeset above it is rebased and retargeted onto the base branch.",
+            "additionalProperties": false,
+            "required": ["branch"],
+            "properties": {
+              "title": {
+                "type": "string",
+                "description": "The title of the changeset. Defaults to the title in changesetTemplate."
+              },
+              "body": {
+                "type": "string",
+                "description": "The body (description) of the changeset. Defaults to the body in changesetTemplate."
+              },
+              "branch": {
+                "type": "string",
+                "description": "The name of the Git branch to create or update on each repository with the changes of this changeset. It must be different from the branches of the other changesets in the stack."
+              },
+              "commit": {
+                "title": "StackedChangesetCommit",
+                "type": "object",
+                "description": "The Git commit to create with the changes.",
+                "additionalProperties": false,
+                "properties": {
+                  "message": {
+                    "type": "string",
+                    "description": "The Git commit message. Defaults to the commit message in changesetTemplate."
+                  }
+                }
+              }
+            }
           }
         }
       }
@@ -397,6 +430,68 @@ const BatchSpecJSON = `{
           ]
         }
       }
+    },
+    "rollout": {
+      "title": "RolloutPolicy",
+      "type": ["object", "null"],
+      "description": "Publish the changesets of the batch change in waves. A wave is only published once the changesets of the previous wave passed their gates, and the rollout is paused when too many changesets of a wave fail.",
+      "additionalProperties": false,
+      "required": ["waves"],
+      "properties": {
+        "waves": {
+          "type": "array",
+          "description": "The waves in which the changesets are published, in order. Changesets that aren't part of any wave are published in a final wave.",
+          "minItems": 1,
+          "items": {
+            "title": "RolloutWave",
+            "type": "object",
+            "additionalProperties": false,
+            "oneOf": [
+              {
+                "required": ["repositoriesMatchingQuery"]
+              },
+              {
+                "required": ["percentage"]
+              }
+            ],
+            "properties": {
+              "repositoriesMatchingQuery": {
+                "type": "string",
+                "description": "A Sourcegraph search query that matches the repositories whose changesets are part of this wave.",
+                "examples": ["repo:^github\\.com/acme/canary-"]
+              },
+              "percentage": {
+                "type": "integer",
+                "description": "The percentage of all changesets of the batch change that have been published once this wave is published, including those of the previous waves.",
+                "minimum": 1,
+                "maximum": 100
+              }
+            }
+          }
+        },
+        "requirePassingChecks": {
+          "type": "boolean",
+          "description": "Whether the checks of a changeset need to pass for it to pass its gate. Changesets with failing checks count as failed.",
+          "default": false
+        },
+        "requireApproval": {
+          "type": "boolean",
+          "description": "Whether a changeset needs to be approved for it to pass its gate. Changesets with requested changes count as failed.",
+          "default": false
+        },
+        "merge": {
+          "type": "boolean",
+          "description": "Whether to merge changesets once they passed their gate. If set, a changeset only counts as succeeded once it has been merged.",
+          "default": false
+        },
+        "maxFailurePercentage": {
+          "type": "integer",
+          "description": "The percentage of changesets in a wave that may fail before the rollout is paused.",
+          "minimum": 0,
+          "maximum": 100,
+          "default": 0
+        }
+      }
     }
   }
 }
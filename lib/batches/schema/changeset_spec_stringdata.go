@@ -66,6 +66,12 @@ const ChangesetSpecJSON = `{
           "pattern": "^refs\\/heads\\/\\S+$",
           "examples": ["refs/heads/fix-foo"]
         },
+        "stackParentRef": {
+          "type": "string",
+          "description": "The full name of the head ref of the changeset that this changeset is stacked on. While that changeset is open, this changeset targets its head ref instead of baseRef, and the commits contain the changes of both changesets. Once it is merged, this changeset is rebased onto and retargeted to baseRef.",
+          "pattern": "^refs\\/heads\\/\\S+$",
+          "examples": ["refs/heads/fix-foo-part-1"]
+        },
         "title": { "type": "string", "description": "The title of the changeset on the code host." },
         "body": { "type": "string", "description": "The body (description) of the changeset on the code host." },
         "commits": {
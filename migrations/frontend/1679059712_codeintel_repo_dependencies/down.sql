@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS codeintel_repo_dependency_syncs;
+DROP TABLE IF EXISTS codeintel_repo_dependencies;
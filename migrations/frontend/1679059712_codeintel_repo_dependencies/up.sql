@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS codeintel_repo_dependencies (
+    id SERIAL PRIMARY KEY,
+    repo_id INTEGER NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    path TEXT NOT NULL,
+    scheme TEXT NOT NULL,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL,
+    version_major INTEGER,
+    version_minor INTEGER,
+    version_patch INTEGER,
+    version_prerelease TEXT,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT codeintel_repo_dependencies_source_valid CHECK (source IN ('manifest', 'upload'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS codeintel_repo_dependencies_repo_source_package ON codeintel_repo_dependencies(repo_id, source, path, scheme, name, version);
+CREATE INDEX IF NOT EXISTS codeintel_repo_dependencies_scheme_name ON codeintel_repo_dependencies(scheme, name);
+
+COMMENT ON TABLE codeintel_repo_dependencies IS 'The packages that each repository depends on at the tip of its default branch, as declared in manifests and lockfiles or referenced by precise code intelligence uploads.';
+COMMENT ON COLUMN codeintel_repo_dependencies.source IS 'Where the dependency was discovered: `manifest` for manifests and lockfiles, `upload` for package references of an upload.';
+COMMENT ON COLUMN codeintel_repo_dependencies.path IS 'The path of the manifest, or the root of the upload, that declares the dependency.';
+COMMENT ON COLUMN codeintel_repo_dependencies.version IS 'The version as written in the source, which might not be a semantic version.';
+COMMENT ON COLUMN codeintel_repo_dependencies.version_major IS 'The major component of version if it parses as a semantic version, used for version range matching.';
+
+CREATE TABLE IF NOT EXISTS codeintel_repo_dependency_syncs (
+    repo_id INTEGER PRIMARY KEY REFERENCES repo(id) ON DELETE CASCADE,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE codeintel_repo_dependency_syncs IS 'Tracks when the entries of each repository in codeintel_repo_dependencies were last recomputed.';
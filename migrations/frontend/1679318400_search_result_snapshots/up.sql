@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS search_result_snapshots (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    query TEXT NOT NULL,
+    entries JSONB NOT NULL,
+    limit_hit BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS search_result_snapshots_user_id_created_at ON search_result_snapshots(user_id, created_at);
+
+COMMENT ON TABLE search_result_snapshots IS 'Snapshots of the result sets of searches, used to compute which results changed between two executions of a search.';
+COMMENT ON COLUMN search_result_snapshots.entries IS 'The repository, path, line and content hash of each result, sorted by location.';
+COMMENT ON COLUMN search_result_snapshots.limit_hit IS 'Whether the search hit a limit, in which case the snapshot does not contain the full result set.';
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS vulnerability_suppression_rules;
+
+ALTER TABLE vulnerability_matches
+    DROP CONSTRAINT IF EXISTS vulnerability_matches_triage_state_valid,
+    DROP COLUMN IF EXISTS triage_state,
+    DROP COLUMN IF EXISTS triage_justification,
+    DROP COLUMN IF EXISTS triaged_by,
+    DROP COLUMN IF EXISTS triaged_at;
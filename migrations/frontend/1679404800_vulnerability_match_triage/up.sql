@@ -0,0 +1,30 @@
+ALTER TABLE vulnerability_matches
+    ADD COLUMN IF NOT EXISTS triage_state TEXT,
+    ADD COLUMN IF NOT EXISTS triage_justification TEXT,
+    ADD COLUMN IF NOT EXISTS triaged_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS triaged_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE vulnerability_matches
+    DROP CONSTRAINT IF EXISTS vulnerability_matches_triage_state_valid,
+    ADD CONSTRAINT vulnerability_matches_triage_state_valid CHECK (triage_state IN ('open', 'not_affected', 'accepted_risk', 'fixed'));
+
+COMMENT ON COLUMN vulnerability_matches.triage_state IS 'The state assigned to this match by a user. If null, the state is determined by the suppression rules of the repository.';
+COMMENT ON COLUMN vulnerability_matches.triage_justification IS 'Why the match was assigned its triage state.';
+
+CREATE TABLE IF NOT EXISTS vulnerability_suppression_rules (
+    id SERIAL PRIMARY KEY,
+    repository_id INTEGER NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    vulnerability TEXT NOT NULL,
+    package_name TEXT,
+    state TEXT NOT NULL,
+    justification TEXT NOT NULL,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT vulnerability_suppression_rules_state_valid CHECK (state IN ('not_affected', 'accepted_risk', 'fixed'))
+);
+
+CREATE INDEX IF NOT EXISTS vulnerability_suppression_rules_repository_id ON vulnerability_suppression_rules(repository_id);
+
+COMMENT ON TABLE vulnerability_suppression_rules IS 'Statements about the exploitability of a vulnerability in a repository, applied to all current and future matches of the vulnerability in the repository''s precise indexes.';
+COMMENT ON COLUMN vulnerability_suppression_rules.vulnerability IS 'The source identifier or an alias (e.g. a CVE identifier) of the vulnerability.';
+COMMENT ON COLUMN vulnerability_suppression_rules.package_name IS 'If set, the rule only applies to matches of this affected package.';
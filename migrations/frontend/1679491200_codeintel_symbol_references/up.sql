@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS codeintel_symbol_references (
+    id BIGSERIAL PRIMARY KEY,
+    upload_id INTEGER NOT NULL REFERENCES lsif_uploads(id) ON DELETE CASCADE,
+    symbol_name TEXT NOT NULL
+);
+
+-- Symbol names are unbounded in length, so we use a hash index (which only supports
+-- equality) rather than a btree index, which would reject overly long values.
+CREATE INDEX IF NOT EXISTS codeintel_symbol_references_symbol_name ON codeintel_symbol_references USING hash (symbol_name);
+CREATE INDEX IF NOT EXISTS codeintel_symbol_references_upload_id ON codeintel_symbol_references(upload_id);
+
+COMMENT ON TABLE codeintel_symbol_references IS 'A global index of the SCIP symbols referenced (but not defined) by each processed upload. Used to find cross-repository references to symbols whose packages are not published by any upload.';
+COMMENT ON COLUMN codeintel_symbol_references.upload_id IS 'The identifier of the upload that references the symbol.';
+COMMENT ON COLUMN codeintel_symbol_references.symbol_name IS 'The SCIP symbol name.';
@@ -0,0 +1 @@
+ALTER TABLE changeset_specs DROP COLUMN IF EXISTS stack_parent_ref;
@@ -0,0 +1,3 @@
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS stack_parent_ref TEXT;
+
+COMMENT ON COLUMN changeset_specs.stack_parent_ref IS 'The head ref of the changeset in the same repository that this changeset is stacked on.';
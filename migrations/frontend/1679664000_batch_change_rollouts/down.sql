@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS batch_change_rollout_changesets;
+DROP TABLE IF EXISTS batch_change_rollouts;
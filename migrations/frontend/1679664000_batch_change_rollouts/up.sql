@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS batch_change_rollouts (
+    id BIGSERIAL PRIMARY KEY,
+    batch_change_id BIGINT NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    wave INTEGER NOT NULL DEFAULT 0,
+    state TEXT NOT NULL DEFAULT 'ACTIVE',
+    pause_reason TEXT,
+    failures_accepted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT batch_change_rollouts_state_valid CHECK (state IN ('ACTIVE', 'PAUSED', 'COMPLETED'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS batch_change_rollouts_batch_change_id ON batch_change_rollouts(batch_change_id);
+
+COMMENT ON TABLE batch_change_rollouts IS 'The progress of publishing the changesets of a batch change in the waves declared by the rollout policy of its batch spec.';
+COMMENT ON COLUMN batch_change_rollouts.wave IS 'The zero-based index of the wave that is currently rolled out.';
+COMMENT ON COLUMN batch_change_rollouts.failures_accepted IS 'Whether the rollout was resumed after being paused for the current wave, in which case failures of the current wave no longer pause it.';
+
+CREATE TABLE IF NOT EXISTS batch_change_rollout_changesets (
+    rollout_id BIGINT NOT NULL REFERENCES batch_change_rollouts(id) ON DELETE CASCADE DEFERRABLE,
+    changeset_id BIGINT NOT NULL REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE,
+    wave INTEGER NOT NULL,
+    merge_job_id BIGINT REFERENCES changeset_jobs(id) ON DELETE SET NULL DEFERRABLE,
+    PRIMARY KEY (rollout_id, changeset_id)
+);
+
+COMMENT ON TABLE batch_change_rollout_changesets IS 'The wave that each changeset of a batch change rollout was assigned to.';
+COMMENT ON COLUMN batch_change_rollout_changesets.merge_job_id IS 'The changeset job that merges the changeset, if the rollout policy merges changesets that passed their gate.';
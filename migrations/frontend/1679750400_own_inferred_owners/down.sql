@@ -0,0 +1 @@
+DROP TABLE IF EXISTS own_inferred_owners;
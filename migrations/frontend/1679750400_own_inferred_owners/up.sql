@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS own_inferred_owners (
+    id BIGSERIAL PRIMARY KEY,
+    repo_id INTEGER NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    file_path TEXT NOT NULL,
+    signal TEXT NOT NULL,
+    owner_handle TEXT NOT NULL DEFAULT '',
+    owner_email TEXT NOT NULL DEFAULT '',
+    score DOUBLE PRECISION NOT NULL,
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    window_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT own_inferred_owners_signal_valid CHECK (signal IN ('recent-contributor', 'recent-reviewer'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS own_inferred_owners_repo_id_file_path_signal_owner ON own_inferred_owners(repo_id, file_path, signal, owner_handle, owner_email);
+
+COMMENT ON TABLE own_inferred_owners IS 'Owners of files inferred from recent activity in repositories without relying on CODEOWNERS files.';
+COMMENT ON COLUMN own_inferred_owners.signal IS 'The signal the owner was inferred from: recent-contributor for authors of recent commits, recent-reviewer for reviewers of recent changesets.';
+COMMENT ON COLUMN own_inferred_owners.score IS 'The share of the recent activity on the file attributed to the owner for the signal, weighted by recency. The scores of a file and signal sum up to 1.';
+COMMENT ON COLUMN own_inferred_owners.window_start IS 'The start of the recency window the activity was considered in.';
+COMMENT ON COLUMN own_inferred_owners.window_end IS 'The end of the recency window the activity was considered in.';
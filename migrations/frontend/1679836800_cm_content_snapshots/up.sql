@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS cm_content_snapshots (
+    monitor_id BIGINT PRIMARY KEY REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    matches JSONB NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT matches_is_array CHECK (jsonb_typeof(matches) = 'array')
+);
+
+COMMENT ON TABLE cm_content_snapshots IS 'The content and symbol matches of the query of a code monitor on its last run, used to detect matches that appear or disappear between runs.';
+COMMENT ON COLUMN cm_content_snapshots.matches IS 'The matches of the last run of the query of the code monitor.';
+
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS content_changes JSONB;
+
+ALTER TABLE cm_trigger_jobs DROP CONSTRAINT IF EXISTS content_changes_is_array;
+ALTER TABLE cm_trigger_jobs ADD CONSTRAINT content_changes_is_array CHECK (jsonb_typeof(content_changes) = 'array');
+
+COMMENT ON COLUMN cm_trigger_jobs.content_changes IS 'The content and symbol matches that appeared or disappeared since the previous run, for code monitors with a content or symbol query.';
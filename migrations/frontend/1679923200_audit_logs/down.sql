@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS audit_logs_prevent_update ON audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_prevent_update();
+
+DROP TABLE IF EXISTS audit_logs;
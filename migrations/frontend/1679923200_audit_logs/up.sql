@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    audit_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    actor TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    forwarded_for TEXT NOT NULL,
+    entity TEXT NOT NULL,
+    action TEXT NOT NULL,
+    fields JSONB NOT NULL DEFAULT '{}'::jsonb,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+COMMENT ON TABLE audit_logs IS 'Persisted audit log entries. Entries form an append-only hash chain to detect tampering.';
+COMMENT ON COLUMN audit_logs.prev_hash IS 'The hash of the entry appended before this one, or an empty string for the first entry.';
+COMMENT ON COLUMN audit_logs.hash IS 'The SHA-256 hash of prev_hash and the contents of this entry.';
+
+CREATE INDEX IF NOT EXISTS audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX IF NOT EXISTS audit_logs_actor ON audit_logs (actor);
+CREATE INDEX IF NOT EXISTS audit_logs_entity_action ON audit_logs (entity, action);
+
+CREATE OR REPLACE FUNCTION audit_logs_prevent_update() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$ BEGIN
+    RAISE EXCEPTION 'audit log entries cannot be modified';
+END $$;
+
+DROP TRIGGER IF EXISTS audit_logs_prevent_update ON audit_logs;
+CREATE TRIGGER audit_logs_prevent_update BEFORE UPDATE ON audit_logs FOR EACH ROW EXECUTE FUNCTION audit_logs_prevent_update();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS audit_logs_prevent_truncate ON audit_logs;
+DROP TRIGGER IF EXISTS audit_logs_prevent_delete ON audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_prevent_delete();
@@ -0,0 +1,17 @@
+CREATE OR REPLACE FUNCTION audit_logs_prevent_delete() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$ BEGIN
+    -- Only the retention policy may remove entries, and it always keeps the
+    -- newest entry so that the head of the hash chain can be verified.
+    IF TG_OP = 'DELETE' AND current_setting('audit_logs.retention', true) = 'on' AND OLD.id < (SELECT MAX(id) FROM audit_logs) THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'audit log entries can only be removed by the retention policy';
+END $$;
+
+DROP TRIGGER IF EXISTS audit_logs_prevent_delete ON audit_logs;
+CREATE TRIGGER audit_logs_prevent_delete BEFORE DELETE ON audit_logs FOR EACH ROW EXECUTE FUNCTION audit_logs_prevent_delete();
+
+DROP TRIGGER IF EXISTS audit_logs_prevent_truncate ON audit_logs;
+CREATE TRIGGER audit_logs_prevent_truncate BEFORE TRUNCATE ON audit_logs FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_prevent_delete();
@@ -84,6 +84,8 @@ type AuditLog struct {
 	GraphQL bool `json:"graphQL"`
 	// InternalTraffic description: Capture security events performed by the internal traffic (adds significant noise).
 	InternalTraffic bool `json:"internalTraffic"`
+	// RetentionDays description: Number of days that audit log entries are kept in the database before they are deleted.
+	RetentionDays int `json:"retentionDays,omitempty"`
 	// SeverityLevel description: Severity logging level for the audit log.
 	SeverityLevel string `json:"severityLevel,omitempty"`
 }
@@ -599,16 +601,24 @@ type EmailTemplates struct {
 
 // Embeddings description: Configuration for embeddings service.
 type Embeddings struct {
-	// AccessToken description: The access token used to authenticate with the external embedding API service.
-	AccessToken string `json:"accessToken"`
+	// AccessToken description: The access token used to authenticate with the external embedding API service. It can be omitted for self-hosted services that do not require authentication.
+	AccessToken string `json:"accessToken,omitempty"`
+	// BatchSize description: The maximum number of texts sent to the provider in a single request. Defaults to 512.
+	BatchSize int `json:"batchSize,omitempty"`
+	// Chunking description: How code files are split into chunks before they are embedded. "lines" splits files by line counts and at blank lines and declarations. "symbols" splits files at the definitions of functions, methods and classes reported by the symbols service, so that search results can be shown as symbols. Files for which the symbols service reports no symbols are split by lines.
+	Chunking string `json:"chunking,omitempty"`
 	// Dimensions description: The dimensionality of the embedding vectors.
 	Dimensions int `json:"dimensions"`
 	// Enabled description: Toggles whether embedding service is enabled.
 	Enabled bool `json:"enabled"`
 	// Model description: The model used for embedding.
-	Model string `json:"model"`
+	Model string `json:"model,omitempty"`
+	// Provider description: The provider used for embedding. "openai" sends texts to an API compatible with the OpenAI embeddings API at the configured url, which can be a self-hosted embeddings server. "fake" embeds texts deterministically without a model and is only meant for testing.
+	Provider string `json:"provider,omitempty"`
+	// TokenLimit description: The maximum number of tokens of a single text sent to the provider. Longer texts are truncated. Defaults to 8191 for the openai provider, which is the limit of the text-embedding-ada-002 model.
+	TokenLimit int `json:"tokenLimit,omitempty"`
 	// Url description: The url to the external embedding API service.
-	Url string `json:"url"`
+	Url string `json:"url,omitempty"`
 }
 
 // EncryptionKey description: Config for a key
@@ -720,6 +730,12 @@ type ExcludedGitoliteRepo struct {
 	// Pattern description: Regular expression which matches against the name of a Gitolite repo to exclude from mirroring.
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedMercurialRepo struct {
+	// Name description: The name of a Mercurial repo ("my-repo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Mercurial repo to exclude from mirroring.
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedOtherRepo struct {
 	// Name description: The name of a Other repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -1377,6 +1393,21 @@ type MavenRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+
+// MercurialConnection description: Configuration for a connection to Mercurial (hg) repositories. Repositories are converted to Git on Sourcegraph, preserving branches, bookmarks and tags.
+type MercurialConnection struct {
+	// Exclude description: A list of repositories to never mirror by name after applying repositoryPathPattern. Supports excluding by exact name ({"name": "myrepo"}) or regular expression ({"pattern": ".*secret.*"}).
+	Exclude []*ExcludedMercurialRepo `json:"exclude,omitempty"`
+	Repos   []string                 `json:"repos"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for the repositories. In the pattern, the variable "{base}" is replaced with the Mercurial base URL host and path, and "{repo}" is replaced with the repository path taken from the `repos` field.
+	//
+	// For example, if your Mercurial base URL is https://hg.example.com/repos and `repos` contains the value "my/repo", then a repositoryPathPattern of "{base}/{repo}" would mean that a repository at https://hg.example.com/repos/my/repo is available on Sourcegraph at https://sourcegraph.example.com/hg.example.com/repos/my/repo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: The base URL of the Mercurial server. Paths in `repos` are resolved relative to this URL.
+	Url string `json:"url"`
+}
 type Mount struct {
 	// Mountpoint description: The path in the container to mount the path on the local machine to.
 	Mountpoint string `json:"mountpoint"`
@@ -2365,7 +2396,7 @@ type SiteConfiguration struct {
 	CodeIntelAutoIndexingAllowGlobalPolicies *bool `json:"codeIntelAutoIndexing.allowGlobalPolicies,omitempty"`
 	// CodeIntelAutoIndexingEnabled description: Enables/disables the code intel auto-indexing feature. Currently experimental.
 	CodeIntelAutoIndexingEnabled *bool `json:"codeIntelAutoIndexing.enabled,omitempty"`
-	// CodeIntelAutoIndexingIndexerMap description: Overrides the default Docker images used by auto-indexing.
+	// CodeIntelAutoIndexingIndexerMap description: Overrides the default Docker images used by auto-indexing, keyed by language. Languages without a default image (`csharp` and `php`) are only auto-indexed once an image is set here.
 	CodeIntelAutoIndexingIndexerMap map[string]string `json:"codeIntelAutoIndexing.indexerMap,omitempty"`
 	// CodeIntelAutoIndexingPolicyRepositoryMatchLimit description: The maximum number of repositories to which a single auto-indexing policy can apply. Default is -1, which is unlimited.
 	CodeIntelAutoIndexingPolicyRepositoryMatchLimit *int `json:"codeIntelAutoIndexing.policyRepositoryMatchLimit,omitempty"`
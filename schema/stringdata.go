@@ -70,6 +70,11 @@ var RustPackagesSchemaJSON string
 //go:embed ruby-packages.schema.json
 var RubyPackagesSchemaJSON string
 
+// MercurialSchemaJSON is the content of the file "mercurial.schema.json".
+//
+//go:embed mercurial.schema.json
+var MercurialSchemaJSON string
+
 // OtherExternalServiceSchemaJSON is the content of the file "other_external_service.schema.json".
 //
 //go:embed other_external_service.schema.json